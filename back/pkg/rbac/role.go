@@ -0,0 +1,37 @@
+package rbac
+
+import "strings"
+
+// 用户角色（与用户服务 users.role 字段取值保持一致）
+const (
+	RoleBoss      int32 = 1 // 老板（下单方）
+	RoleCompanion int32 = 2 // 陪玩（服务提供方）
+	RoleAdmin     int32 = 3 // 管理员
+)
+
+var roleNames = map[int32]string{
+	RoleBoss:      "boss",
+	RoleCompanion: "companion",
+	RoleAdmin:     "admin",
+}
+
+// RoleName 返回角色名称，未知角色返回空字符串
+func RoleName(role int32) string {
+	return roleNames[role]
+}
+
+// ParseRole 将角色名称（boss/companion/admin，不区分大小写）解析为角色值
+func ParseRole(name string) (int32, bool) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	for role, n := range roleNames {
+		if n == name {
+			return role, true
+		}
+	}
+	return 0, false
+}
+
+// IsAdmin 是否为管理员
+func IsAdmin(role int32) bool {
+	return role == RoleAdmin
+}
@@ -0,0 +1,241 @@
+package verifyticket
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	zeroredis "github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// 验证码用途（与 code 服务模板的 purpose 保持一致）
+const (
+	PurposeLogin          = "login"
+	PurposeRegister       = "register"
+	PurposeForgetPassword = "forget_password"
+	PurposeChangePhone    = "change_phone"
+	PurposeChangePhoneNew = "change_phone_new"
+	PurposeChangePassword = "change_password"
+	PurposeBindEmail      = "bind_email"
+)
+
+// DefaultTTL 票据默认有效期
+const DefaultTTL = 5 * time.Minute
+
+// usedKeyPrefix 已使用票据的 Redis 键前缀
+const usedKeyPrefix = "verify:ticket:used:"
+
+// SecretEnv 票据签名密钥的环境变量，配置文件与 Nacos 都未设置密钥时读取
+const SecretEnv = "VERIFY_TICKET_SECRET"
+
+// devSecret 仅 dev 模式下未配置密钥时使用的开发密钥
+const devSecret = "slgaming-verify-ticket-dev"
+
+// devMode 与 go-zero service.DevMode 一致
+const devMode = "dev"
+
+var (
+	ErrMissing   = errors.New("verify ticket is required")
+	ErrMalformed = errors.New("verify ticket is malformed")
+	ErrSignature = errors.New("verify ticket signature mismatch")
+	ErrExpired   = errors.New("verify ticket expired")
+	ErrMismatch  = errors.New("verify ticket does not match target or purpose")
+	ErrUsed      = errors.New("verify ticket already used")
+	ErrNoSecret  = errors.New("verify ticket secret not configured")
+	ErrNoStorage = errors.New("verify ticket storage not available")
+	ErrDevSecret = errors.New("verify ticket dev secret is not allowed outside dev mode")
+)
+
+// Claims 票据内容
+// 票据证明「某手机号/邮箱在某用途下刚通过了验证码校验」，由 code 服务签发，由 user 服务校验并消费
+type Claims struct {
+	ID        string `json:"jti"`
+	Target    string `json:"sub"` // 手机号或邮箱
+	Purpose   string `json:"purpose"`
+	ExpiresAt int64  `json:"exp"`
+}
+
+// ResolveSecret 确定票据签名密钥：优先使用配置（本地文件或 Nacos），其次读取环境变量 VERIFY_TICKET_SECRET
+// dev 模式下都未设置时使用开发密钥；其他模式下密钥为空或为开发密钥时返回错误，服务应拒绝启动
+func ResolveSecret(configured, mode string) (string, error) {
+	secret := strings.TrimSpace(configured)
+	if secret == "" {
+		secret = strings.TrimSpace(os.Getenv(SecretEnv))
+	}
+	if mode == devMode {
+		if secret == "" {
+			secret = devSecret
+		}
+		return secret, nil
+	}
+	if secret == "" {
+		return "", ErrNoSecret
+	}
+	if secret == devSecret {
+		return "", ErrDevSecret
+	}
+	return secret, nil
+}
+
+// Issue 签发票据，格式为 base64url(claims).base64url(HMAC-SHA256)
+func Issue(secret, target, purpose string, ttl time.Duration) (string, *Claims, error) {
+	if secret == "" {
+		return "", nil, ErrNoSecret
+	}
+	if ttl <= 0 {
+		ttl = DefaultTTL
+	}
+	claims := &Claims{
+		ID:        uuid.NewString(),
+		Target:    target,
+		Purpose:   purpose,
+		ExpiresAt: time.Now().Add(ttl).Unix(),
+	}
+	payload, err := json.Marshal(claims)
+	if err != nil {
+		return "", nil, err
+	}
+	body := base64.RawURLEncoding.EncodeToString(payload)
+	return body + "." + sign(secret, body), claims, nil
+}
+
+// Parse 校验签名与有效期，返回票据内容（不检查是否已使用）
+func Parse(secret, ticket string, now time.Time) (*Claims, error) {
+	if secret == "" {
+		return nil, ErrNoSecret
+	}
+	ticket = strings.TrimSpace(ticket)
+	if ticket == "" {
+		return nil, ErrMissing
+	}
+	body, sig, ok := strings.Cut(ticket, ".")
+	if !ok || body == "" || sig == "" {
+		return nil, ErrMalformed
+	}
+	if !hmac.Equal([]byte(sig), []byte(sign(secret, body))) {
+		return nil, ErrSignature
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(body)
+	if err != nil {
+		return nil, ErrMalformed
+	}
+	var claims Claims
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return nil, ErrMalformed
+	}
+	if claims.ID == "" || claims.Target == "" || claims.Purpose == "" {
+		return nil, ErrMalformed
+	}
+	if now.Unix() >= claims.ExpiresAt {
+		return nil, ErrExpired
+	}
+	return &claims, nil
+}
+
+// Spec 一张待消费的票据及其应绑定的接收方（手机号或邮箱）与用途
+type Spec struct {
+	Ticket  string
+	Target  string
+	Purpose string
+}
+
+// SpecError 某一张票据校验或消费失败，Err 为具体原因（ErrExpired、ErrUsed 等）
+type SpecError struct {
+	Spec Spec
+	Err  error
+}
+
+func (e *SpecError) Error() string {
+	return e.Spec.Purpose + ": " + e.Err.Error()
+}
+
+func (e *SpecError) Unwrap() error {
+	return e.Err
+}
+
+// consumeScript 原子地把多张票据标记为已使用：任一票据已使用时不标记任何票据
+// KEYS: 各票据的已使用标记  ARGV: 各标记的有效期（秒）
+// 返回: 0 全部标记成功 / i 第 i 张票据已被使用
+var consumeScript = zeroredis.NewScript(`
+for i, key in ipairs(KEYS) do
+  if redis.call("EXISTS", key) == 1 then
+    return i
+  end
+end
+for i, key in ipairs(KEYS) do
+  redis.call("SET", key, "1", "EX", ARGV[i])
+end
+return 0
+`)
+
+// Verify 校验票据签名、有效期，并绑定接收方与用途（不检查、不标记是否已使用）
+func Verify(secret, ticket, target, purpose string, now time.Time) (*Claims, error) {
+	claims, err := Parse(secret, ticket, now)
+	if err != nil {
+		return nil, err
+	}
+	if claims.Target != target || claims.Purpose != purpose {
+		return nil, ErrMismatch
+	}
+	return claims, nil
+}
+
+// Consume 校验票据并绑定接收方（手机号或邮箱）与用途，然后原子地标记为已使用（同一票据只能成功消费一次）
+func Consume(ctx context.Context, rds *zeroredis.Redis, secret, ticket, target, purpose string) (*Claims, error) {
+	claims, err := ConsumeAll(ctx, rds, secret, Spec{Ticket: ticket, Target: target, Purpose: purpose})
+	if err != nil {
+		var se *SpecError
+		if errors.As(err, &se) {
+			return nil, se.Err
+		}
+		return nil, err
+	}
+	return claims[0], nil
+}
+
+// ConsumeAll 一次消费多张票据（如更换手机号时的新旧手机号票据）：先校验全部票据，
+// 全部有效后才原子地标记为已使用；任一票据无效或已使用时返回 *SpecError，其他票据保持可用
+func ConsumeAll(ctx context.Context, rds *zeroredis.Redis, secret string, specs ...Spec) ([]*Claims, error) {
+	if len(specs) == 0 {
+		return nil, ErrMissing
+	}
+	now := time.Now()
+	claims := make([]*Claims, 0, len(specs))
+	keys := make([]string, 0, len(specs))
+	ttls := make([]interface{}, 0, len(specs))
+	for _, spec := range specs {
+		c, err := Verify(secret, spec.Ticket, spec.Target, spec.Purpose, now)
+		if err != nil {
+			return nil, &SpecError{Spec: spec, Err: err}
+		}
+		claims = append(claims, c)
+		keys = append(keys, usedKeyPrefix+c.ID)
+		// 标记保留到票据过期为止，过期后票据本身已无效
+		ttls = append(ttls, int(c.ExpiresAt-now.Unix())+1)
+	}
+	if rds == nil {
+		return nil, ErrNoStorage
+	}
+
+	val, err := rds.ScriptRunCtx(ctx, consumeScript, keys, ttls...)
+	if err != nil {
+		return nil, err
+	}
+	if used, _ := val.(int64); used > 0 && int(used) <= len(specs) {
+		return nil, &SpecError{Spec: specs[used-1], Err: ErrUsed}
+	}
+	return claims, nil
+}
+
+func sign(secret, body string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(body))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,222 @@
+package verifyticket
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	zeroredis "github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const testSecret = "test-secret"
+
+// signClaims 用指定内容签发票据（用于构造异常票据）
+func signClaims(t *testing.T, secret string, claims any) string {
+	t.Helper()
+	payload, err := json.Marshal(claims)
+	require.NoError(t, err)
+	body := base64.RawURLEncoding.EncodeToString(payload)
+	return body + "." + sign(secret, body)
+}
+
+func TestParse(t *testing.T) {
+	now := time.Now()
+	valid, claims, err := Issue(testSecret, "13800000000", PurposeLogin, time.Minute)
+	require.NoError(t, err)
+	body, _, _ := strings.Cut(valid, ".")
+	notJSON := base64.RawURLEncoding.EncodeToString([]byte("not-json"))
+
+	tests := []struct {
+		name    string
+		secret  string
+		ticket  string
+		now     time.Time
+		wantErr error
+	}{
+		{name: "有效票据", secret: testSecret, ticket: valid, now: now},
+		{name: "未配置密钥", secret: "", ticket: valid, now: now, wantErr: ErrNoSecret},
+		{name: "空票据", secret: testSecret, ticket: "  ", now: now, wantErr: ErrMissing},
+		{name: "缺少签名", secret: testSecret, ticket: body, now: now, wantErr: ErrMalformed},
+		{name: "签名不匹配", secret: testSecret, ticket: body + ".abc", now: now, wantErr: ErrSignature},
+		{name: "密钥不同", secret: "other-secret", ticket: valid, now: now, wantErr: ErrSignature},
+		{name: "已过期", secret: testSecret, ticket: valid, now: time.Unix(claims.ExpiresAt, 0), wantErr: ErrExpired},
+		{name: "内容不是 JSON", secret: testSecret, ticket: notJSON + "." + sign(testSecret, notJSON), now: now, wantErr: ErrMalformed},
+		{
+			name:    "缺少用途",
+			secret:  testSecret,
+			ticket:  signClaims(t, testSecret, Claims{ID: "1", Target: "13800000000", ExpiresAt: now.Add(time.Minute).Unix()}),
+			now:     now,
+			wantErr: ErrMalformed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(tt.secret, tt.ticket, tt.now)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, claims, got)
+		})
+	}
+}
+
+func TestIssueDefaultTTL(t *testing.T) {
+	_, claims, err := Issue(testSecret, "a@example.com", PurposeBindEmail, 0)
+	require.NoError(t, err)
+	assert.InDelta(t, time.Now().Add(DefaultTTL).Unix(), claims.ExpiresAt, 1)
+
+	_, _, err = Issue("", "a@example.com", PurposeBindEmail, time.Minute)
+	assert.ErrorIs(t, err, ErrNoSecret)
+}
+
+func TestConsume(t *testing.T) {
+	mr := miniredis.RunT(t)
+	rds := zeroredis.New(mr.Addr())
+	ctx := context.Background()
+
+	ticket, _, err := Issue(testSecret, "13800000000", PurposeForgetPassword, time.Minute)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		rds     *zeroredis.Redis
+		target  string
+		purpose string
+		wantErr error
+	}{
+		{name: "接收方不匹配", rds: rds, target: "13900000000", purpose: PurposeForgetPassword, wantErr: ErrMismatch},
+		{name: "用途不匹配", rds: rds, target: "13800000000", purpose: PurposeLogin, wantErr: ErrMismatch},
+		{name: "没有 Redis", rds: nil, target: "13800000000", purpose: PurposeForgetPassword, wantErr: ErrNoStorage},
+		{name: "首次消费", rds: rds, target: "13800000000", purpose: PurposeForgetPassword},
+		{name: "重复消费", rds: rds, target: "13800000000", purpose: PurposeForgetPassword, wantErr: ErrUsed},
+	}
+
+	// 用例按顺序执行：前面校验失败的用例不会占用票据
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			claims, err := Consume(ctx, tt.rds, testSecret, ticket, tt.target, tt.purpose)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.target, claims.Target)
+			assert.True(t, mr.Exists(usedKeyPrefix+claims.ID))
+		})
+	}
+}
+
+func TestResolveSecret(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured string
+		env        string
+		mode       string
+		want       string
+		wantErr    error
+	}{
+		{name: "优先使用配置", configured: " conf-secret ", env: "env-secret", mode: "pro", want: "conf-secret"},
+		{name: "配置为空时读取环境变量", env: "env-secret", mode: "pro", want: "env-secret"},
+		{name: "dev 模式回退到开发密钥", mode: devMode, want: devSecret},
+		{name: "dev 模式使用配置", configured: "conf-secret", mode: devMode, want: "conf-secret"},
+		{name: "非 dev 模式未配置", mode: "pro", wantErr: ErrNoSecret},
+		{name: "非 dev 模式使用开发密钥", configured: devSecret, mode: "test", wantErr: ErrDevSecret},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(SecretEnv, tt.env)
+			got, err := ResolveSecret(tt.configured, tt.mode)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestConsumeAll(t *testing.T) {
+	ctx := context.Background()
+	issue := func(target, purpose string, ttl time.Duration) Spec {
+		ticket, _, err := Issue(testSecret, target, purpose, ttl)
+		require.NoError(t, err)
+		return Spec{Ticket: ticket, Target: target, Purpose: purpose}
+	}
+
+	tests := []struct {
+		name       string
+		specs      func(mr *miniredis.Miniredis) []Spec
+		wantErr    error
+		wantFailed string // 失败票据的用途
+		wantUsed   []bool // 调用后各票据是否已被标记
+	}{
+		{
+			name: "两张票据都有效",
+			specs: func(*miniredis.Miniredis) []Spec {
+				return []Spec{
+					issue("13800000000", PurposeChangePhone, time.Minute),
+					issue("13900000000", PurposeChangePhoneNew, time.Minute),
+				}
+			},
+			wantUsed: []bool{true, true},
+		},
+		{
+			name: "新手机号票据不匹配时不消费原手机号票据",
+			specs: func(*miniredis.Miniredis) []Spec {
+				bad := issue("13700000000", PurposeChangePhoneNew, time.Minute)
+				bad.Target = "13900000000"
+				return []Spec{issue("13800000000", PurposeChangePhone, time.Minute), bad}
+			},
+			wantErr:    ErrMismatch,
+			wantFailed: PurposeChangePhoneNew,
+			wantUsed:   []bool{false, false},
+		},
+		{
+			name: "新手机号票据已使用时不消费原手机号票据",
+			specs: func(mr *miniredis.Miniredis) []Spec {
+				used := issue("13900000000", PurposeChangePhoneNew, time.Minute)
+				claims, err := Parse(testSecret, used.Ticket, time.Now())
+				require.NoError(t, err)
+				require.NoError(t, mr.Set(usedKeyPrefix+claims.ID, "1"))
+				return []Spec{issue("13800000000", PurposeChangePhone, time.Minute), used}
+			},
+			wantErr:    ErrUsed,
+			wantFailed: PurposeChangePhoneNew,
+			wantUsed:   []bool{false, true},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mr := miniredis.RunT(t)
+			specs := tt.specs(mr)
+
+			_, err := ConsumeAll(ctx, zeroredis.New(mr.Addr()), testSecret, specs...)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				var se *SpecError
+				require.ErrorAs(t, err, &se)
+				assert.Equal(t, tt.wantFailed, se.Spec.Purpose)
+			} else {
+				require.NoError(t, err)
+			}
+
+			for i, spec := range specs {
+				claims, err := Parse(testSecret, spec.Ticket, time.Now())
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantUsed[i], mr.Exists(usedKeyPrefix+claims.ID), spec.Purpose)
+			}
+		})
+	}
+}
@@ -76,6 +76,7 @@ type RecommendCompanionRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserInput     string                 `protobuf:"bytes,1,opt,name=user_input,json=userInput,proto3" json:"user_input,omitempty"` // 用户输入内容（如："我想要一个王者荣耀的陪玩"）
 	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`         // 用户ID（可选，用于个性化推荐）
+	Priority      int32                  `protobuf:"varint,3,opt,name=priority,proto3" json:"priority,omitempty"`                   // 推荐优先级（会员权益，0=普通用户；越高候选池越大并优先展示高评分陪玩）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -124,17 +125,25 @@ func (x *RecommendCompanionRequest) GetUserId() uint64 {
 	return 0
 }
 
+func (x *RecommendCompanionRequest) GetPriority() int32 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
 // 陪玩推荐结果
 type CompanionRecommendation struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                     // 陪玩用户ID
-	GameSkill     string                 `protobuf:"bytes,2,opt,name=game_skill,json=gameSkill,proto3" json:"game_skill,omitempty"`             // 游戏技能
+	GameSkill     string                 `protobuf:"bytes,2,opt,name=game_skill,json=gameSkill,proto3" json:"game_skill,omitempty"`             // 游戏技能（命中的游戏）
 	Gender        string                 `protobuf:"bytes,3,opt,name=gender,proto3" json:"gender,omitempty"`                                    // 性别
 	Age           int32                  `protobuf:"varint,4,opt,name=age,proto3" json:"age,omitempty"`                                         // 年龄
 	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`                          // 描述
-	PricePerHour  int64                  `protobuf:"varint,6,opt,name=price_per_hour,json=pricePerHour,proto3" json:"price_per_hour,omitempty"` // 每小时价格
+	PricePerHour  int64                  `protobuf:"varint,6,opt,name=price_per_hour,json=pricePerHour,proto3" json:"price_per_hour,omitempty"` // 每小时价格（命中游戏的价格）
 	Rating        float64                `protobuf:"fixed64,7,opt,name=rating,proto3" json:"rating,omitempty"`                                  // 评分
 	Similarity    float64                `protobuf:"fixed64,8,opt,name=similarity,proto3" json:"similarity,omitempty"`                          // 相似度分数（0-1）
+	RatingScore   float64                `protobuf:"fixed64,9,opt,name=rating_score,json=ratingScore,proto3" json:"rating_score,omitempty"`     // 贝叶斯评分（旧数据可能为0）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -225,6 +234,13 @@ func (x *CompanionRecommendation) GetSimilarity() float64 {
 	return 0
 }
 
+func (x *CompanionRecommendation) GetRatingScore() float64 {
+	if x != nil {
+		return x.RatingScore
+	}
+	return 0
+}
+
 // 推荐陪玩响应
 type RecommendCompanionResponse struct {
 	state         protoimpl.MessageState     `protogen:"open.v1"`
@@ -288,6 +304,8 @@ type AddCompanionToVectorDBRequest struct {
 	Description   string                 `protobuf:"bytes,5,opt,name=description,proto3" json:"description,omitempty"`                          // 陪玩描述文本（用于向量化）
 	PricePerHour  int64                  `protobuf:"varint,6,opt,name=price_per_hour,json=pricePerHour,proto3" json:"price_per_hour,omitempty"` // 每小时价格（帅币）
 	Rating        float64                `protobuf:"fixed64,7,opt,name=rating,proto3" json:"rating,omitempty"`                                  // 评分（0-5分）
+	RatingScore   float64                `protobuf:"fixed64,8,opt,name=rating_score,json=ratingScore,proto3" json:"rating_score,omitempty"`     // 贝叶斯评分（按接单数修正后的评分，用于推荐排序；为0时使用 rating）
+	Skills        []*CompanionSkillEntry `protobuf:"bytes,9,rep,name=skills,proto3" json:"skills,omitempty"`                                    // 陪玩提供的全部游戏（每个游戏写入一条向量记录；为空时使用 game_skill/price_per_hour）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -371,6 +389,73 @@ func (x *AddCompanionToVectorDBRequest) GetRating() float64 {
 	return 0
 }
 
+func (x *AddCompanionToVectorDBRequest) GetRatingScore() float64 {
+	if x != nil {
+		return x.RatingScore
+	}
+	return 0
+}
+
+func (x *AddCompanionToVectorDBRequest) GetSkills() []*CompanionSkillEntry {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+// 陪玩的单个游戏技能
+type CompanionSkillEntry struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameSkill     string                 `protobuf:"bytes,1,opt,name=game_skill,json=gameSkill,proto3" json:"game_skill,omitempty"`             // 游戏名称
+	PricePerHour  int64                  `protobuf:"varint,2,opt,name=price_per_hour,json=pricePerHour,proto3" json:"price_per_hour,omitempty"` // 该游戏的每小时价格（帅币）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompanionSkillEntry) Reset() {
+	*x = CompanionSkillEntry{}
+	mi := &file_agent_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanionSkillEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanionSkillEntry) ProtoMessage() {}
+
+func (x *CompanionSkillEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_agent_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanionSkillEntry.ProtoReflect.Descriptor instead.
+func (*CompanionSkillEntry) Descriptor() ([]byte, []int) {
+	return file_agent_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CompanionSkillEntry) GetGameSkill() string {
+	if x != nil {
+		return x.GameSkill
+	}
+	return ""
+}
+
+func (x *CompanionSkillEntry) GetPricePerHour() int64 {
+	if x != nil {
+		return x.PricePerHour
+	}
+	return 0
+}
+
 // 添加陪玩信息到向量数据库响应
 type AddCompanionToVectorDBResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -383,7 +468,7 @@ type AddCompanionToVectorDBResponse struct {
 
 func (x *AddCompanionToVectorDBResponse) Reset() {
 	*x = AddCompanionToVectorDBResponse{}
-	mi := &file_agent_proto_msgTypes[4]
+	mi := &file_agent_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -395,7 +480,7 @@ func (x *AddCompanionToVectorDBResponse) String() string {
 func (*AddCompanionToVectorDBResponse) ProtoMessage() {}
 
 func (x *AddCompanionToVectorDBResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[4]
+	mi := &file_agent_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -408,7 +493,7 @@ func (x *AddCompanionToVectorDBResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddCompanionToVectorDBResponse.ProtoReflect.Descriptor instead.
 func (*AddCompanionToVectorDBResponse) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{4}
+	return file_agent_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *AddCompanionToVectorDBResponse) GetCompanionId() uint64 {
@@ -446,7 +531,7 @@ type ModerateAvatarRequest struct {
 
 func (x *ModerateAvatarRequest) Reset() {
 	*x = ModerateAvatarRequest{}
-	mi := &file_agent_proto_msgTypes[5]
+	mi := &file_agent_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -458,7 +543,7 @@ func (x *ModerateAvatarRequest) String() string {
 func (*ModerateAvatarRequest) ProtoMessage() {}
 
 func (x *ModerateAvatarRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[5]
+	mi := &file_agent_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -471,7 +556,7 @@ func (x *ModerateAvatarRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModerateAvatarRequest.ProtoReflect.Descriptor instead.
 func (*ModerateAvatarRequest) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{5}
+	return file_agent_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *ModerateAvatarRequest) GetUserId() uint64 {
@@ -523,7 +608,7 @@ type ModerateAvatarResponse struct {
 
 func (x *ModerateAvatarResponse) Reset() {
 	*x = ModerateAvatarResponse{}
-	mi := &file_agent_proto_msgTypes[6]
+	mi := &file_agent_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -535,7 +620,7 @@ func (x *ModerateAvatarResponse) String() string {
 func (*ModerateAvatarResponse) ProtoMessage() {}
 
 func (x *ModerateAvatarResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_agent_proto_msgTypes[6]
+	mi := &file_agent_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -548,7 +633,7 @@ func (x *ModerateAvatarResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ModerateAvatarResponse.ProtoReflect.Descriptor instead.
 func (*ModerateAvatarResponse) Descriptor() ([]byte, []int) {
-	return file_agent_proto_rawDescGZIP(), []int{6}
+	return file_agent_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *ModerateAvatarResponse) GetDecision() ModerationDecision {
@@ -590,11 +675,12 @@ var File_agent_proto protoreflect.FileDescriptor
 
 const file_agent_proto_rawDesc = "" +
 	"\n" +
-	"\vagent.proto\x12\x05agent\"S\n" +
+	"\vagent.proto\x12\x05agent\"o\n" +
 	"\x19RecommendCompanionRequest\x12\x1d\n" +
 	"\n" +
 	"user_input\x18\x01 \x01(\tR\tuserInput\x12\x17\n" +
-	"\auser_id\x18\x02 \x01(\x04R\x06userId\"\xfb\x01\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x1a\n" +
+	"\bpriority\x18\x03 \x01(\x05R\bpriority\"\x9e\x02\n" +
 	"\x17CompanionRecommendation\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1d\n" +
 	"\n" +
@@ -606,12 +692,13 @@ const file_agent_proto_rawDesc = "" +
 	"\x06rating\x18\a \x01(\x01R\x06rating\x12\x1e\n" +
 	"\n" +
 	"similarity\x18\b \x01(\x01R\n" +
-	"similarity\"~\n" +
+	"similarity\x12!\n" +
+	"\frating_score\x18\t \x01(\x01R\vratingScore\"~\n" +
 	"\x1aRecommendCompanionResponse\x12>\n" +
 	"\n" +
 	"companions\x18\x01 \x03(\v2\x1e.agent.CompanionRecommendationR\n" +
 	"companions\x12 \n" +
-	"\vexplanation\x18\x02 \x01(\tR\vexplanation\"\xe1\x01\n" +
+	"\vexplanation\x18\x02 \x01(\tR\vexplanation\"\xb8\x02\n" +
 	"\x1dAddCompanionToVectorDBRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x16\n" +
 	"\x06gender\x18\x02 \x01(\tR\x06gender\x12\x10\n" +
@@ -620,7 +707,13 @@ const file_agent_proto_rawDesc = "" +
 	"game_skill\x18\x04 \x01(\tR\tgameSkill\x12 \n" +
 	"\vdescription\x18\x05 \x01(\tR\vdescription\x12$\n" +
 	"\x0eprice_per_hour\x18\x06 \x01(\x03R\fpricePerHour\x12\x16\n" +
-	"\x06rating\x18\a \x01(\x01R\x06rating\"w\n" +
+	"\x06rating\x18\a \x01(\x01R\x06rating\x12!\n" +
+	"\frating_score\x18\b \x01(\x01R\vratingScore\x122\n" +
+	"\x06skills\x18\t \x03(\v2\x1a.agent.CompanionSkillEntryR\x06skills\"Z\n" +
+	"\x13CompanionSkillEntry\x12\x1d\n" +
+	"\n" +
+	"game_skill\x18\x01 \x01(\tR\tgameSkill\x12$\n" +
+	"\x0eprice_per_hour\x18\x02 \x01(\x03R\fpricePerHour\"w\n" +
 	"\x1eAddCompanionToVectorDBResponse\x12!\n" +
 	"\fcompanion_id\x18\x01 \x01(\x04R\vcompanionId\x12\x18\n" +
 	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
@@ -665,31 +758,33 @@ func file_agent_proto_rawDescGZIP() []byte {
 }
 
 var file_agent_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_agent_proto_goTypes = []any{
 	(ModerationDecision)(0),                // 0: agent.ModerationDecision
 	(*RecommendCompanionRequest)(nil),      // 1: agent.RecommendCompanionRequest
 	(*CompanionRecommendation)(nil),        // 2: agent.CompanionRecommendation
 	(*RecommendCompanionResponse)(nil),     // 3: agent.RecommendCompanionResponse
 	(*AddCompanionToVectorDBRequest)(nil),  // 4: agent.AddCompanionToVectorDBRequest
-	(*AddCompanionToVectorDBResponse)(nil), // 5: agent.AddCompanionToVectorDBResponse
-	(*ModerateAvatarRequest)(nil),          // 6: agent.ModerateAvatarRequest
-	(*ModerateAvatarResponse)(nil),         // 7: agent.ModerateAvatarResponse
+	(*CompanionSkillEntry)(nil),            // 5: agent.CompanionSkillEntry
+	(*AddCompanionToVectorDBResponse)(nil), // 6: agent.AddCompanionToVectorDBResponse
+	(*ModerateAvatarRequest)(nil),          // 7: agent.ModerateAvatarRequest
+	(*ModerateAvatarResponse)(nil),         // 8: agent.ModerateAvatarResponse
 }
 var file_agent_proto_depIdxs = []int32{
 	2, // 0: agent.RecommendCompanionResponse.companions:type_name -> agent.CompanionRecommendation
-	0, // 1: agent.ModerateAvatarResponse.decision:type_name -> agent.ModerationDecision
-	1, // 2: agent.Agent.RecommendCompanion:input_type -> agent.RecommendCompanionRequest
-	4, // 3: agent.Agent.AddCompanionToVectorDB:input_type -> agent.AddCompanionToVectorDBRequest
-	6, // 4: agent.Agent.ModerateAvatar:input_type -> agent.ModerateAvatarRequest
-	3, // 5: agent.Agent.RecommendCompanion:output_type -> agent.RecommendCompanionResponse
-	5, // 6: agent.Agent.AddCompanionToVectorDB:output_type -> agent.AddCompanionToVectorDBResponse
-	7, // 7: agent.Agent.ModerateAvatar:output_type -> agent.ModerateAvatarResponse
-	5, // [5:8] is the sub-list for method output_type
-	2, // [2:5] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	5, // 1: agent.AddCompanionToVectorDBRequest.skills:type_name -> agent.CompanionSkillEntry
+	0, // 2: agent.ModerateAvatarResponse.decision:type_name -> agent.ModerationDecision
+	1, // 3: agent.Agent.RecommendCompanion:input_type -> agent.RecommendCompanionRequest
+	4, // 4: agent.Agent.AddCompanionToVectorDB:input_type -> agent.AddCompanionToVectorDBRequest
+	7, // 5: agent.Agent.ModerateAvatar:input_type -> agent.ModerateAvatarRequest
+	3, // 6: agent.Agent.RecommendCompanion:output_type -> agent.RecommendCompanionResponse
+	6, // 7: agent.Agent.AddCompanionToVectorDB:output_type -> agent.AddCompanionToVectorDBResponse
+	8, // 8: agent.Agent.ModerateAvatar:output_type -> agent.ModerateAvatarResponse
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_agent_proto_init() }
@@ -703,7 +798,7 @@ func file_agent_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_agent_proto_rawDesc), len(file_agent_proto_rawDesc)),
 			NumEnums:      1,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
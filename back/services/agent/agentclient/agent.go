@@ -17,6 +17,7 @@ type (
 	AddCompanionToVectorDBRequest  = agent.AddCompanionToVectorDBRequest
 	AddCompanionToVectorDBResponse = agent.AddCompanionToVectorDBResponse
 	CompanionRecommendation        = agent.CompanionRecommendation
+	CompanionSkillEntry            = agent.CompanionSkillEntry
 	ModerateAvatarRequest          = agent.ModerateAvatarRequest
 	ModerateAvatarResponse         = agent.ModerateAvatarResponse
 	RecommendCompanionRequest      = agent.RecommendCompanionRequest
@@ -11,6 +11,7 @@ import (
 
 	"SLGaming/back/services/agent/internal/embedder"
 
+	"github.com/milvus-io/milvus-sdk-go/v2/client"
 	"github.com/milvus-io/milvus-sdk-go/v2/entity"
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -18,6 +19,8 @@ import (
 const (
 	collectionName = "companion"
 	vectorDim      = 8120 // 向量维度（BinaryVector）
+
+	ratingScoreField = "rating_score" // 贝叶斯评分字段（后加字段，旧 Collection 可能没有）
 )
 
 type AddCompanionToVectorDBLogic struct {
@@ -100,20 +103,64 @@ func (l *AddCompanionToVectorDBLogic) AddCompanionToVectorDB(in *agent.AddCompan
 	vectorBytes := l.float64ToBinaryVector(vectors[0])
 
 	// 准备插入数据（id 字段由 Milvus 自动生成，不传入）
+	// 陪玩提供多个游戏时每个游戏写入一条记录（共用同一向量），便于按游戏和价格过滤
+	skills := in.GetSkills()
+	if len(skills) == 0 {
+		skills = []*agent.CompanionSkillEntry{{GameSkill: in.GetGameSkill(), PricePerHour: in.GetPricePerHour()}}
+	}
+	rows := len(skills)
+
 	gender := l.genderToInt16(in.Gender)
 	age := int16(in.Age)
-	pricePerHour := int16(in.PricePerHour)
 	rating := float32(in.Rating)
+	ratingScore := float32(in.RatingScore)
+	if ratingScore == 0 {
+		ratingScore = rating
+	}
+
+	vectorCol := make([][]byte, 0, rows)
+	companionIDs := make([]int64, 0, rows)
+	genders := make([]int16, 0, rows)
+	ratings := make([]float32, 0, rows)
+	ages := make([]int16, 0, rows)
+	games := make([]string, 0, rows)
+	descriptions := make([]string, 0, rows)
+	prices := make([]int16, 0, rows)
+	ratingScores := make([]float32, 0, rows)
+	for _, sk := range skills {
+		vectorCol = append(vectorCol, vectorBytes)
+		companionIDs = append(companionIDs, int64(in.UserId))
+		genders = append(genders, gender)
+		ratings = append(ratings, rating)
+		ages = append(ages, age)
+		games = append(games, sk.GetGameSkill())
+		descriptions = append(descriptions, in.Description)
+		prices = append(prices, int16(sk.GetPricePerHour()))
+		ratingScores = append(ratingScores, ratingScore)
+	}
 
 	data := []entity.Column{
-		entity.NewColumnBinaryVector("vector", vectorDim, [][]byte{vectorBytes}),
-		entity.NewColumnInt64("companion_id", []int64{int64(in.UserId)}),
-		entity.NewColumnInt16("gender", []int16{gender}),
-		entity.NewColumnFloat("rating", []float32{rating}),
-		entity.NewColumnInt16("age", []int16{age}),
-		entity.NewColumnVarChar("game", []string{in.GameSkill}),
-		entity.NewColumnVarChar("description", []string{in.Description}),
-		entity.NewColumnInt16("price_per_hour", []int16{pricePerHour}),
+		entity.NewColumnBinaryVector("vector", vectorDim, vectorCol),
+		entity.NewColumnInt64("companion_id", companionIDs),
+		entity.NewColumnInt16("gender", genders),
+		entity.NewColumnFloat("rating", ratings),
+		entity.NewColumnInt16("age", ages),
+		entity.NewColumnVarChar("game", games),
+		entity.NewColumnVarChar("description", descriptions),
+		entity.NewColumnInt16("price_per_hour", prices),
+	}
+	// 旧 Collection 没有 rating_score 字段时不写入，推荐时回退为按 rating 排序
+	if collectionHasField(l.ctx, l.svcCtx.MilvusClient, ratingScoreField) {
+		data = append(data, entity.NewColumnFloat(ratingScoreField, ratingScores))
+	}
+
+	// 先删除该陪玩已有的记录，重复同步时覆盖而不是追加
+	if err := l.svcCtx.MilvusClient.Delete(l.ctx, collectionName, "", fmt.Sprintf("companion_id == %d", in.UserId)); err != nil {
+		l.Errorf("delete existing companion rows failed: %v", err)
+		return &agent.AddCompanionToVectorDBResponse{
+			Success: false,
+			Message: fmt.Sprintf("删除旧数据失败: %v", err),
+		}, err
 	}
 
 	// 插入数据（id 字段由 Milvus 自动生成）
@@ -133,7 +180,7 @@ func (l *AddCompanionToVectorDBLogic) AddCompanionToVectorDB(in *agent.AddCompan
 	}
 
 	companionIDUint := uint64(in.UserId)
-	l.Infof("成功存储陪玩信息到向量数据库, companion_id=%d (user_id)", companionIDUint)
+	l.Infof("成功存储陪玩信息到向量数据库, companion_id=%d (user_id) rows=%d", companionIDUint, rows)
 
 	l.Infof("AddCompanionToVectorDB done user_id=%d companion_id=%d duration=%s", in.GetUserId(), companionIDUint, time.Since(start))
 
@@ -280,5 +327,23 @@ func (l *AddCompanionToVectorDBLogic) getCollectionFields() []*entity.Field {
 			Name:     "price_per_hour",
 			DataType: entity.FieldTypeInt16,
 		},
+		{
+			Name:     ratingScoreField,
+			DataType: entity.FieldTypeFloat,
+		},
+	}
+}
+
+// collectionHasField 检查 Collection 是否包含指定字段（字段为后加时，已有的 Collection 需重建才会包含）
+func collectionHasField(ctx context.Context, cli client.Client, field string) bool {
+	coll, err := cli.DescribeCollection(ctx, collectionName)
+	if err != nil || coll == nil || coll.Schema == nil {
+		return false
+	}
+	for _, f := range coll.Schema.Fields {
+		if f.Name == field {
+			return true
+		}
 	}
+	return false
 }
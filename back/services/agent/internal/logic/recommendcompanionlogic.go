@@ -3,6 +3,7 @@ package logic
 import (
 	"context"
 	"fmt"
+	"sort"
 	"time"
 
 	"SLGaming/back/services/agent/agent"
@@ -17,6 +18,10 @@ import (
 
 const (
 	recommendTopK = 10 // 返回前10个结果
+
+	// 会员推荐优先级：每级额外扩大一倍候选池，再按相似度与评分综合重排
+	maxRecommendCandidates = 50
+	recommendRatingWeight  = 0.3
 )
 
 type RecommendCompanionLogic struct {
@@ -167,17 +172,22 @@ func (l *RecommendCompanionLogic) RecommendCompanion(in *agent.RecommendCompanio
 		}, err
 	}
 
+	outputFields := []string{"companion_id", "gender", "age", "game", "description", "price_per_hour", "rating"}
+	if collectionHasField(l.ctx, l.svcCtx.MilvusClient, ratingScoreField) {
+		outputFields = append(outputFields, ratingScoreField)
+	}
+
 	searchResult, err := l.svcCtx.MilvusClient.Search(
 		l.ctx,
 		collectionName,
-		[]string{}, // partitions
-		filterExpr, // expr: 过滤表达式
-		[]string{"companion_id", "gender", "age", "game", "description", "price_per_hour", "rating"}, // output fields
-		[]entity.Vector{binaryVector}, // vectors
-		"vector",                      // vector field name
-		entity.HAMMING,                // metric type
-		recommendTopK,                 // topK
-		searchParam,                   // search params
+		[]string{},                      // partitions
+		filterExpr,                      // expr: 过滤表达式
+		outputFields,                    // output fields
+		[]entity.Vector{binaryVector},   // vectors
+		"vector",                        // vector field name
+		entity.HAMMING,                  // metric type
+		candidateTopK(in.GetPriority()), // topK
+		searchParam,                     // search params
 	)
 	if err != nil {
 		l.Errorf("milvus search failed: %v", err)
@@ -191,14 +201,21 @@ func (l *RecommendCompanionLogic) RecommendCompanion(in *agent.RecommendCompanio
 	var companions []*agent.CompanionRecommendation
 	if len(searchResult) > 0 {
 		result := searchResult[0]
+		// 同一陪玩的多个游戏各有一条记录，结果按相似度排序，只保留每位陪玩的第一条
+		seen := make(map[int64]bool, result.ResultCount)
 		// 遍历所有结果
 		for i := 0; i < result.ResultCount; i++ {
 			// 获取字段值（按索引获取）
 			companionID := l.getFieldValueInt64ByIndex(result.Fields, "companion_id", i)
+			if seen[companionID] {
+				continue
+			}
+			seen[companionID] = true
 			genderVal := l.getFieldValueInt16ByIndex(result.Fields, "gender", i)
 			age := l.getFieldValueInt16ByIndex(result.Fields, "age", i)
 			pricePerHour := l.getFieldValueInt16ByIndex(result.Fields, "price_per_hour", i)
 			rating := l.getFieldValueFloatByIndex(result.Fields, "rating", i)
+			ratingScore := l.getFieldValueFloatByIndex(result.Fields, ratingScoreField, i)
 			game := l.getFieldValueStringByIndex(result.Fields, "game", i)
 			description := l.getFieldValueStringByIndex(result.Fields, "description", i)
 
@@ -224,11 +241,17 @@ func (l *RecommendCompanionLogic) RecommendCompanion(in *agent.RecommendCompanio
 				Description:  description,
 				PricePerHour: int64(pricePerHour),
 				Rating:       float64(rating),
+				RatingScore:  float64(ratingScore),
 				Similarity:   similarity,
 			})
 		}
 	}
 
+	// 会员优先推荐：从更大的候选池中按综合得分取前 recommendTopK 个
+	if in.GetPriority() > 0 {
+		companions = rerankByPriority(companions)
+	}
+
 	explanation := fmt.Sprintf("根据您的需求\"%s\"，为您推荐了 %d 位陪玩", in.UserInput, len(companions))
 	if filterExpr != "" {
 		explanation += fmt.Sprintf("（已应用过滤条件：%s）", filterExpr)
@@ -242,6 +265,37 @@ func (l *RecommendCompanionLogic) RecommendCompanion(in *agent.RecommendCompanio
 	}, nil
 }
 
+// candidateTopK 根据推荐优先级计算向量检索的候选数量
+func candidateTopK(priority int32) int {
+	if priority <= 0 {
+		return recommendTopK
+	}
+	topK := recommendTopK * int(priority+1)
+	if topK > maxRecommendCandidates {
+		topK = maxRecommendCandidates
+	}
+	return topK
+}
+
+// rerankByPriority 按“相似度 + 评分”综合得分重排并截取前 recommendTopK 个
+// 评分优先使用贝叶斯评分，避免单数很少的满分陪玩被过度提前；旧数据没有时回退为原始评分
+func rerankByPriority(companions []*agent.CompanionRecommendation) []*agent.CompanionRecommendation {
+	score := func(c *agent.CompanionRecommendation) float64 {
+		rating := c.RatingScore
+		if rating == 0 {
+			rating = c.Rating
+		}
+		return c.Similarity*(1-recommendRatingWeight) + rating/5*recommendRatingWeight
+	}
+	sort.SliceStable(companions, func(i, j int) bool {
+		return score(companions[i]) > score(companions[j])
+	})
+	if len(companions) > recommendTopK {
+		companions = companions[:recommendTopK]
+	}
+	return companions
+}
+
 // float64ToBinaryVector 将 float64 向量转换为 BinaryVector
 func (l *RecommendCompanionLogic) float64ToBinaryVector(vector []float64, vectorDim int) []byte {
 	byteLen := (vectorDim + 7) / 8
@@ -10,9 +10,12 @@ import (
 	"sync"
 	"syscall"
 
+	"SLGaming/back/pkg/verifyticket"
 	"SLGaming/back/services/code/code"
+	"SLGaming/back/services/code/internal/email"
 	"SLGaming/back/services/code/internal/ioc"
 	"SLGaming/back/services/code/internal/server"
+	"SLGaming/back/services/code/internal/sms"
 	"SLGaming/back/services/code/internal/svc"
 
 	"github.com/prometheus/client_golang/prometheus/promhttp"
@@ -67,6 +70,24 @@ func main() {
 	flag.Parse()
 
 	c := ioc.LoadConfig(*configFile, *templatesFile)
+	secret, err := verifyticket.ResolveSecret(c.VerifyTicket.Secret, c.Mode)
+	if err != nil {
+		logx.Errorf("[server] failed: invalid verify ticket secret, mode=%s, error=%v", c.Mode, err)
+		os.Exit(1)
+	}
+	c.VerifyTicket.Secret = secret
+	smsProvider, err := sms.ResolveDefaultProvider(c.SMS, c.Mode)
+	if err != nil {
+		logx.Errorf("[server] failed: invalid sms provider, mode=%s, provider=%s, error=%v", c.Mode, c.SMS.DefaultProvider, err)
+		os.Exit(1)
+	}
+	c.SMS.DefaultProvider = smsProvider
+	emailProvider, err := email.ResolveProvider(c.Email, c.Mode)
+	if err != nil {
+		logx.Errorf("[server] failed: invalid email provider, mode=%s, provider=%s, error=%v", c.Mode, c.Email.Provider, err)
+		os.Exit(1)
+	}
+	c.Email.Provider = emailProvider
 	ctx := svc.NewServiceContext(c)
 
 	metricsPort, err := startMetricsServer(c.MetricsPort)
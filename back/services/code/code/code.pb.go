@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go. DO NOT EDIT.
 // versions:
-// 	protoc-gen-go v1.36.2
-// 	protoc        v5.29.3
+// 	protoc-gen-go v1.36.11
+// 	protoc        v3.19.4
 // source: code.proto
 
 package code
@@ -11,6 +11,7 @@ import (
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	reflect "reflect"
 	sync "sync"
+	unsafe "unsafe"
 )
 
 const (
@@ -21,11 +22,16 @@ const (
 )
 
 type SendCodeRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
-	Purpose       string                 `protobuf:"bytes,2,opt,name=purpose,proto3" json:"purpose,omitempty"` // e.g. register/login/forget_password
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Phone          string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	Purpose        string                 `protobuf:"bytes,2,opt,name=purpose,proto3" json:"purpose,omitempty"`                                     // e.g. register/login/forget_password
+	Channel        string                 `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`                                     // 发送渠道：sms（默认，使用 phone）/ email（使用 email）
+	Email          string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`                                         // channel=email 时的收件邮箱
+	ClientIp       string                 `protobuf:"bytes,5,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`                   // 终端用户 IP，用于风险识别
+	UserAgent      string                 `protobuf:"bytes,6,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`                // 终端用户 User-Agent，用于识别新设备
+	ChallengeToken string                 `protobuf:"bytes,7,opt,name=challenge_token,json=challengeToken,proto3" json:"challenge_token,omitempty"` // 人机验证通过后获得的一次性令牌，触发风控时必填
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *SendCodeRequest) Reset() {
@@ -72,12 +78,49 @@ func (x *SendCodeRequest) GetPurpose() string {
 	return ""
 }
 
+func (x *SendCodeRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *SendCodeRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *SendCodeRequest) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *SendCodeRequest) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *SendCodeRequest) GetChallengeToken() string {
+	if x != nil {
+		return x.ChallengeToken
+	}
+	return ""
+}
+
 type SendCodeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	RequestId     string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
-	ExpireAt      int64                  `protobuf:"varint,2,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"` // unix timestamp
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	RequestId         string                 `protobuf:"bytes,1,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	ExpireAt          int64                  `protobuf:"varint,2,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`                            // unix timestamp
+	ChallengeRequired bool                   `protobuf:"varint,3,opt,name=challenge_required,json=challengeRequired,proto3" json:"challenge_required,omitempty"` // 触发风控且未携带有效令牌：本次未发送，需先完成人机验证
+	ChallengeReason   string                 `protobuf:"bytes,4,opt,name=challenge_reason,json=challengeReason,proto3" json:"challenge_reason,omitempty"`        // 触发原因：ip_targets / global_rate / new_device / invalid_token
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *SendCodeResponse) Reset() {
@@ -124,11 +167,27 @@ func (x *SendCodeResponse) GetExpireAt() int64 {
 	return 0
 }
 
+func (x *SendCodeResponse) GetChallengeRequired() bool {
+	if x != nil {
+		return x.ChallengeRequired
+	}
+	return false
+}
+
+func (x *SendCodeResponse) GetChallengeReason() string {
+	if x != nil {
+		return x.ChallengeReason
+	}
+	return ""
+}
+
 type VerifyCodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
 	Purpose       string                 `protobuf:"bytes,2,opt,name=purpose,proto3" json:"purpose,omitempty"`
 	Code          string                 `protobuf:"bytes,3,opt,name=code,proto3" json:"code,omitempty"`
+	Channel       string                 `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"` // 与发送时一致：sms（默认）/ email
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`     // channel=email 时的邮箱
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -184,11 +243,29 @@ func (x *VerifyCodeRequest) GetCode() string {
 	return ""
 }
 
+func (x *VerifyCodeRequest) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *VerifyCodeRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
 type VerifyCodeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Passed        bool                   `protobuf:"varint,1,opt,name=passed,proto3" json:"passed,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Passed            bool                   `protobuf:"varint,1,opt,name=passed,proto3" json:"passed,omitempty"`
+	RemainingAttempts int32                  `protobuf:"varint,2,opt,name=remaining_attempts,json=remainingAttempts,proto3" json:"remaining_attempts,omitempty"` // 未通过时该验证码剩余可尝试次数，为 0 表示需要重新获取
+	NeedResend        bool                   `protobuf:"varint,3,opt,name=need_resend,json=needResend,proto3" json:"need_resend,omitempty"`                      // 验证码不存在、已过期或错误次数过多被作废，需重新发送
+	Ticket            string                 `protobuf:"bytes,4,opt,name=ticket,proto3" json:"ticket,omitempty"`                                                 // 通过时签发的一次性验证票据（绑定手机号与用途），调用用户服务敏感接口时携带
+	TicketExpireAt    int64                  `protobuf:"varint,5,opt,name=ticket_expire_at,json=ticketExpireAt,proto3" json:"ticket_expire_at,omitempty"`        // 票据过期时间（Unix 秒）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *VerifyCodeResponse) Reset() {
@@ -228,66 +305,347 @@ func (x *VerifyCodeResponse) GetPassed() bool {
 	return false
 }
 
-var File_code_proto protoreflect.FileDescriptor
+func (x *VerifyCodeResponse) GetRemainingAttempts() int32 {
+	if x != nil {
+		return x.RemainingAttempts
+	}
+	return 0
+}
+
+func (x *VerifyCodeResponse) GetNeedResend() bool {
+	if x != nil {
+		return x.NeedResend
+	}
+	return false
+}
+
+func (x *VerifyCodeResponse) GetTicket() string {
+	if x != nil {
+		return x.Ticket
+	}
+	return ""
+}
+
+func (x *VerifyCodeResponse) GetTicketExpireAt() int64 {
+	if x != nil {
+		return x.TicketExpireAt
+	}
+	return 0
+}
+
+type CreateChallengeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ClientIp      string                 `protobuf:"bytes,1,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChallengeRequest) Reset() {
+	*x = CreateChallengeRequest{}
+	mi := &file_code_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChallengeRequest) ProtoMessage() {}
+
+func (x *CreateChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_code_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChallengeRequest.ProtoReflect.Descriptor instead.
+func (*CreateChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_code_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *CreateChallengeRequest) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+type CreateChallengeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	Image         string                 `protobuf:"bytes,2,opt,name=image,proto3" json:"image,omitempty"`                        // 题目图片（data:image/png;base64,...）
+	ExpireAt      int64                  `protobuf:"varint,3,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"` // 题目过期时间（Unix 秒）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateChallengeResponse) Reset() {
+	*x = CreateChallengeResponse{}
+	mi := &file_code_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
 
-var file_code_proto_rawDesc = []byte{
-	0x0a, 0x0a, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x22, 0x41, 0x0a, 0x0f, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x70,
-	0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x75,
-	0x72, 0x70, 0x6f, 0x73, 0x65, 0x22, 0x4e, 0x0a, 0x10, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x65, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x65, 0x78, 0x70,
-	0x69, 0x72, 0x65, 0x41, 0x74, 0x22, 0x57, 0x0a, 0x11, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43,
-	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x70, 0x68,
-	0x6f, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x70, 0x68, 0x6f, 0x6e, 0x65,
-	0x12, 0x18, 0x0a, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x07, 0x70, 0x75, 0x72, 0x70, 0x6f, 0x73, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x6f,
-	0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64, 0x65, 0x22, 0x2c,
-	0x0a, 0x12, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x61, 0x73, 0x73, 0x65, 0x64, 0x32, 0x82, 0x01, 0x0a,
-	0x04, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x39, 0x0a, 0x08, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x64,
-	0x65, 0x12, 0x15, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x2e,
-	0x53, 0x65, 0x6e, 0x64, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3f, 0x0a, 0x0a, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x17,
-	0x2e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x63, 0x6f, 0x64, 0x65, 0x2e, 0x56,
-	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x42, 0x08, 0x5a, 0x06, 0x2e, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x33,
+func (x *CreateChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateChallengeResponse) ProtoMessage() {}
+
+func (x *CreateChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_code_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateChallengeResponse.ProtoReflect.Descriptor instead.
+func (*CreateChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_code_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *CreateChallengeResponse) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *CreateChallengeResponse) GetImage() string {
+	if x != nil {
+		return x.Image
+	}
+	return ""
+}
+
+func (x *CreateChallengeResponse) GetExpireAt() int64 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
+type VerifyChallengeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeId   string                 `protobuf:"bytes,1,opt,name=challenge_id,json=challengeId,proto3" json:"challenge_id,omitempty"`
+	Answer        string                 `protobuf:"bytes,2,opt,name=answer,proto3" json:"answer,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
+func (x *VerifyChallengeRequest) Reset() {
+	*x = VerifyChallengeRequest{}
+	mi := &file_code_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyChallengeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyChallengeRequest) ProtoMessage() {}
+
+func (x *VerifyChallengeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_code_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyChallengeRequest.ProtoReflect.Descriptor instead.
+func (*VerifyChallengeRequest) Descriptor() ([]byte, []int) {
+	return file_code_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *VerifyChallengeRequest) GetChallengeId() string {
+	if x != nil {
+		return x.ChallengeId
+	}
+	return ""
+}
+
+func (x *VerifyChallengeRequest) GetAnswer() string {
+	if x != nil {
+		return x.Answer
+	}
+	return ""
+}
+
+func (x *VerifyChallengeRequest) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+type VerifyChallengeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Passed        bool                   `protobuf:"varint,1,opt,name=passed,proto3" json:"passed,omitempty"`
+	Token         string                 `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`                                         // 通过时签发的一次性令牌，发送验证码时携带
+	TokenExpireAt int64                  `protobuf:"varint,3,opt,name=token_expire_at,json=tokenExpireAt,proto3" json:"token_expire_at,omitempty"` // 令牌过期时间（Unix 秒）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyChallengeResponse) Reset() {
+	*x = VerifyChallengeResponse{}
+	mi := &file_code_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyChallengeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyChallengeResponse) ProtoMessage() {}
+
+func (x *VerifyChallengeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_code_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyChallengeResponse.ProtoReflect.Descriptor instead.
+func (*VerifyChallengeResponse) Descriptor() ([]byte, []int) {
+	return file_code_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *VerifyChallengeResponse) GetPassed() bool {
+	if x != nil {
+		return x.Passed
+	}
+	return false
+}
+
+func (x *VerifyChallengeResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *VerifyChallengeResponse) GetTokenExpireAt() int64 {
+	if x != nil {
+		return x.TokenExpireAt
+	}
+	return 0
+}
+
+var File_code_proto protoreflect.FileDescriptor
+
+const file_code_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"code.proto\x12\x04code\"\xd6\x01\n" +
+	"\x0fSendCodeRequest\x12\x14\n" +
+	"\x05phone\x18\x01 \x01(\tR\x05phone\x12\x18\n" +
+	"\apurpose\x18\x02 \x01(\tR\apurpose\x12\x18\n" +
+	"\achannel\x18\x03 \x01(\tR\achannel\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12\x1b\n" +
+	"\tclient_ip\x18\x05 \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x06 \x01(\tR\tuserAgent\x12'\n" +
+	"\x0fchallenge_token\x18\a \x01(\tR\x0echallengeToken\"\xa8\x01\n" +
+	"\x10SendCodeResponse\x12\x1d\n" +
+	"\n" +
+	"request_id\x18\x01 \x01(\tR\trequestId\x12\x1b\n" +
+	"\texpire_at\x18\x02 \x01(\x03R\bexpireAt\x12-\n" +
+	"\x12challenge_required\x18\x03 \x01(\bR\x11challengeRequired\x12)\n" +
+	"\x10challenge_reason\x18\x04 \x01(\tR\x0fchallengeReason\"\x87\x01\n" +
+	"\x11VerifyCodeRequest\x12\x14\n" +
+	"\x05phone\x18\x01 \x01(\tR\x05phone\x12\x18\n" +
+	"\apurpose\x18\x02 \x01(\tR\apurpose\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\x12\x18\n" +
+	"\achannel\x18\x04 \x01(\tR\achannel\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\"\xbe\x01\n" +
+	"\x12VerifyCodeResponse\x12\x16\n" +
+	"\x06passed\x18\x01 \x01(\bR\x06passed\x12-\n" +
+	"\x12remaining_attempts\x18\x02 \x01(\x05R\x11remainingAttempts\x12\x1f\n" +
+	"\vneed_resend\x18\x03 \x01(\bR\n" +
+	"needResend\x12\x16\n" +
+	"\x06ticket\x18\x04 \x01(\tR\x06ticket\x12(\n" +
+	"\x10ticket_expire_at\x18\x05 \x01(\x03R\x0eticketExpireAt\"5\n" +
+	"\x16CreateChallengeRequest\x12\x1b\n" +
+	"\tclient_ip\x18\x01 \x01(\tR\bclientIp\"o\n" +
+	"\x17CreateChallengeResponse\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12\x14\n" +
+	"\x05image\x18\x02 \x01(\tR\x05image\x12\x1b\n" +
+	"\texpire_at\x18\x03 \x01(\x03R\bexpireAt\"p\n" +
+	"\x16VerifyChallengeRequest\x12!\n" +
+	"\fchallenge_id\x18\x01 \x01(\tR\vchallengeId\x12\x16\n" +
+	"\x06answer\x18\x02 \x01(\tR\x06answer\x12\x1b\n" +
+	"\tclient_ip\x18\x03 \x01(\tR\bclientIp\"o\n" +
+	"\x17VerifyChallengeResponse\x12\x16\n" +
+	"\x06passed\x18\x01 \x01(\bR\x06passed\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\x12&\n" +
+	"\x0ftoken_expire_at\x18\x03 \x01(\x03R\rtokenExpireAt2\xa2\x02\n" +
+	"\x04Code\x129\n" +
+	"\bSendCode\x12\x15.code.SendCodeRequest\x1a\x16.code.SendCodeResponse\x12?\n" +
+	"\n" +
+	"VerifyCode\x12\x17.code.VerifyCodeRequest\x1a\x18.code.VerifyCodeResponse\x12N\n" +
+	"\x0fCreateChallenge\x12\x1c.code.CreateChallengeRequest\x1a\x1d.code.CreateChallengeResponse\x12N\n" +
+	"\x0fVerifyChallenge\x12\x1c.code.VerifyChallengeRequest\x1a\x1d.code.VerifyChallengeResponseB\bZ\x06./codeb\x06proto3"
+
 var (
 	file_code_proto_rawDescOnce sync.Once
-	file_code_proto_rawDescData = file_code_proto_rawDesc
+	file_code_proto_rawDescData []byte
 )
 
 func file_code_proto_rawDescGZIP() []byte {
 	file_code_proto_rawDescOnce.Do(func() {
-		file_code_proto_rawDescData = protoimpl.X.CompressGZIP(file_code_proto_rawDescData)
+		file_code_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_code_proto_rawDesc), len(file_code_proto_rawDesc)))
 	})
 	return file_code_proto_rawDescData
 }
 
-var file_code_proto_msgTypes = make([]protoimpl.MessageInfo, 4)
+var file_code_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_code_proto_goTypes = []any{
-	(*SendCodeRequest)(nil),    // 0: code.SendCodeRequest
-	(*SendCodeResponse)(nil),   // 1: code.SendCodeResponse
-	(*VerifyCodeRequest)(nil),  // 2: code.VerifyCodeRequest
-	(*VerifyCodeResponse)(nil), // 3: code.VerifyCodeResponse
+	(*SendCodeRequest)(nil),         // 0: code.SendCodeRequest
+	(*SendCodeResponse)(nil),        // 1: code.SendCodeResponse
+	(*VerifyCodeRequest)(nil),       // 2: code.VerifyCodeRequest
+	(*VerifyCodeResponse)(nil),      // 3: code.VerifyCodeResponse
+	(*CreateChallengeRequest)(nil),  // 4: code.CreateChallengeRequest
+	(*CreateChallengeResponse)(nil), // 5: code.CreateChallengeResponse
+	(*VerifyChallengeRequest)(nil),  // 6: code.VerifyChallengeRequest
+	(*VerifyChallengeResponse)(nil), // 7: code.VerifyChallengeResponse
 }
 var file_code_proto_depIdxs = []int32{
 	0, // 0: code.Code.SendCode:input_type -> code.SendCodeRequest
 	2, // 1: code.Code.VerifyCode:input_type -> code.VerifyCodeRequest
-	1, // 2: code.Code.SendCode:output_type -> code.SendCodeResponse
-	3, // 3: code.Code.VerifyCode:output_type -> code.VerifyCodeResponse
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
+	4, // 2: code.Code.CreateChallenge:input_type -> code.CreateChallengeRequest
+	6, // 3: code.Code.VerifyChallenge:input_type -> code.VerifyChallengeRequest
+	1, // 4: code.Code.SendCode:output_type -> code.SendCodeResponse
+	3, // 5: code.Code.VerifyCode:output_type -> code.VerifyCodeResponse
+	5, // 6: code.Code.CreateChallenge:output_type -> code.CreateChallengeResponse
+	7, // 7: code.Code.VerifyChallenge:output_type -> code.VerifyChallengeResponse
+	4, // [4:8] is the sub-list for method output_type
+	0, // [0:4] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -302,9 +660,9 @@ func file_code_proto_init() {
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
-			RawDescriptor: file_code_proto_rawDesc,
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_code_proto_rawDesc), len(file_code_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   4,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -313,7 +671,6 @@ func file_code_proto_init() {
 		MessageInfos:      file_code_proto_msgTypes,
 	}.Build()
 	File_code_proto = out.File
-	file_code_proto_rawDesc = nil
 	file_code_proto_goTypes = nil
 	file_code_proto_depIdxs = nil
 }
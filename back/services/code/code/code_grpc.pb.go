@@ -1,7 +1,7 @@
 // Code generated by protoc-gen-go-grpc. DO NOT EDIT.
 // versions:
-// - protoc-gen-go-grpc v1.5.1
-// - protoc             v5.29.3
+// - protoc-gen-go-grpc v1.6.1
+// - protoc             v3.19.4
 // source: code.proto
 
 package code
@@ -19,8 +19,10 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Code_SendCode_FullMethodName   = "/code.Code/SendCode"
-	Code_VerifyCode_FullMethodName = "/code.Code/VerifyCode"
+	Code_SendCode_FullMethodName        = "/code.Code/SendCode"
+	Code_VerifyCode_FullMethodName      = "/code.Code/VerifyCode"
+	Code_CreateChallenge_FullMethodName = "/code.Code/CreateChallenge"
+	Code_VerifyChallenge_FullMethodName = "/code.Code/VerifyChallenge"
 )
 
 // CodeClient is the client API for Code service.
@@ -29,6 +31,8 @@ const (
 type CodeClient interface {
 	SendCode(ctx context.Context, in *SendCodeRequest, opts ...grpc.CallOption) (*SendCodeResponse, error)
 	VerifyCode(ctx context.Context, in *VerifyCodeRequest, opts ...grpc.CallOption) (*VerifyCodeResponse, error)
+	CreateChallenge(ctx context.Context, in *CreateChallengeRequest, opts ...grpc.CallOption) (*CreateChallengeResponse, error)
+	VerifyChallenge(ctx context.Context, in *VerifyChallengeRequest, opts ...grpc.CallOption) (*VerifyChallengeResponse, error)
 }
 
 type codeClient struct {
@@ -59,12 +63,34 @@ func (c *codeClient) VerifyCode(ctx context.Context, in *VerifyCodeRequest, opts
 	return out, nil
 }
 
+func (c *codeClient) CreateChallenge(ctx context.Context, in *CreateChallengeRequest, opts ...grpc.CallOption) (*CreateChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateChallengeResponse)
+	err := c.cc.Invoke(ctx, Code_CreateChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *codeClient) VerifyChallenge(ctx context.Context, in *VerifyChallengeRequest, opts ...grpc.CallOption) (*VerifyChallengeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyChallengeResponse)
+	err := c.cc.Invoke(ctx, Code_VerifyChallenge_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CodeServer is the server API for Code service.
 // All implementations must embed UnimplementedCodeServer
 // for forward compatibility.
 type CodeServer interface {
 	SendCode(context.Context, *SendCodeRequest) (*SendCodeResponse, error)
 	VerifyCode(context.Context, *VerifyCodeRequest) (*VerifyCodeResponse, error)
+	CreateChallenge(context.Context, *CreateChallengeRequest) (*CreateChallengeResponse, error)
+	VerifyChallenge(context.Context, *VerifyChallengeRequest) (*VerifyChallengeResponse, error)
 	mustEmbedUnimplementedCodeServer()
 }
 
@@ -76,10 +102,16 @@ type CodeServer interface {
 type UnimplementedCodeServer struct{}
 
 func (UnimplementedCodeServer) SendCode(context.Context, *SendCodeRequest) (*SendCodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendCode not implemented")
+	return nil, status.Error(codes.Unimplemented, "method SendCode not implemented")
 }
 func (UnimplementedCodeServer) VerifyCode(context.Context, *VerifyCodeRequest) (*VerifyCodeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method VerifyCode not implemented")
+	return nil, status.Error(codes.Unimplemented, "method VerifyCode not implemented")
+}
+func (UnimplementedCodeServer) CreateChallenge(context.Context, *CreateChallengeRequest) (*CreateChallengeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateChallenge not implemented")
+}
+func (UnimplementedCodeServer) VerifyChallenge(context.Context, *VerifyChallengeRequest) (*VerifyChallengeResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyChallenge not implemented")
 }
 func (UnimplementedCodeServer) mustEmbedUnimplementedCodeServer() {}
 func (UnimplementedCodeServer) testEmbeddedByValue()              {}
@@ -92,7 +124,7 @@ type UnsafeCodeServer interface {
 }
 
 func RegisterCodeServer(s grpc.ServiceRegistrar, srv CodeServer) {
-	// If the following call pancis, it indicates UnimplementedCodeServer was
+	// If the following call panics, it indicates UnimplementedCodeServer was
 	// embedded by pointer and is nil.  This will cause panics if an
 	// unimplemented method is ever invoked, so we test this at initialization
 	// time to prevent it from happening at runtime later due to I/O.
@@ -138,6 +170,42 @@ func _Code_VerifyCode_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Code_CreateChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodeServer).CreateChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Code_CreateChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodeServer).CreateChallenge(ctx, req.(*CreateChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Code_VerifyChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CodeServer).VerifyChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Code_VerifyChallenge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CodeServer).VerifyChallenge(ctx, req.(*VerifyChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Code_ServiceDesc is the grpc.ServiceDesc for Code service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -153,6 +221,14 @@ var Code_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "VerifyCode",
 			Handler:    _Code_VerifyCode_Handler,
 		},
+		{
+			MethodName: "CreateChallenge",
+			Handler:    _Code_CreateChallenge_Handler,
+		},
+		{
+			MethodName: "VerifyChallenge",
+			Handler:    _Code_VerifyChallenge_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "code.proto",
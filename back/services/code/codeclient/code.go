@@ -14,14 +14,20 @@ import (
 )
 
 type (
-	SendCodeRequest    = code.SendCodeRequest
-	SendCodeResponse   = code.SendCodeResponse
-	VerifyCodeRequest  = code.VerifyCodeRequest
-	VerifyCodeResponse = code.VerifyCodeResponse
+	CreateChallengeRequest  = code.CreateChallengeRequest
+	CreateChallengeResponse = code.CreateChallengeResponse
+	SendCodeRequest         = code.SendCodeRequest
+	SendCodeResponse        = code.SendCodeResponse
+	VerifyChallengeRequest  = code.VerifyChallengeRequest
+	VerifyChallengeResponse = code.VerifyChallengeResponse
+	VerifyCodeRequest       = code.VerifyCodeRequest
+	VerifyCodeResponse      = code.VerifyCodeResponse
 
 	Code interface {
 		SendCode(ctx context.Context, in *SendCodeRequest, opts ...grpc.CallOption) (*SendCodeResponse, error)
 		VerifyCode(ctx context.Context, in *VerifyCodeRequest, opts ...grpc.CallOption) (*VerifyCodeResponse, error)
+		CreateChallenge(ctx context.Context, in *CreateChallengeRequest, opts ...grpc.CallOption) (*CreateChallengeResponse, error)
+		VerifyChallenge(ctx context.Context, in *VerifyChallengeRequest, opts ...grpc.CallOption) (*VerifyChallengeResponse, error)
 	}
 
 	defaultCode struct {
@@ -44,3 +50,13 @@ func (m *defaultCode) VerifyCode(ctx context.Context, in *VerifyCodeRequest, opt
 	client := code.NewCodeClient(m.cli.Conn())
 	return client.VerifyCode(ctx, in, opts...)
 }
+
+func (m *defaultCode) CreateChallenge(ctx context.Context, in *CreateChallengeRequest, opts ...grpc.CallOption) (*CreateChallengeResponse, error) {
+	client := code.NewCodeClient(m.cli.Conn())
+	return client.CreateChallenge(ctx, in, opts...)
+}
+
+func (m *defaultCode) VerifyChallenge(ctx context.Context, in *VerifyChallengeRequest, opts ...grpc.CallOption) (*VerifyChallengeResponse, error) {
+	client := code.NewCodeClient(m.cli.Conn())
+	return client.VerifyChallenge(ctx, in, opts...)
+}
@@ -0,0 +1,149 @@
+package captcha
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/png"
+	"math/rand/v2"
+	"strconv"
+)
+
+// 图片尺寸与字形缩放倍数
+const (
+	imageWidth  = 160
+	imageHeight = 50
+	glyphScale  = 3
+)
+
+// Puzzle 一道算术验证码：图片中展示算式，答案为计算结果
+type Puzzle struct {
+	Question string // 如 "23x4+7=?"，仅用于日志排查，不返回给客户端
+	Answer   string
+	Image    []byte // PNG
+}
+
+// Generate 生成一道「两位数 × 一位数 ± 一位数」的算术题，并渲染为带干扰线和噪点的 PNG
+// 答案约有 900 种取值，单次猜中最可能答案的概率低于 0.3%
+func Generate() (*Puzzle, error) {
+	a, b, c := rand.IntN(89)+11, rand.IntN(8)+2, rand.IntN(9)+1
+	var op rune
+	var answer int
+	if rand.IntN(2) == 0 {
+		op, answer = '+', a*b+c
+	} else {
+		op, answer = '-', a*b-c
+	}
+
+	question := fmt.Sprintf("%dx%d%c%d=?", a, b, op, c)
+	img, err := render(question)
+	if err != nil {
+		return nil, err
+	}
+	return &Puzzle{
+		Question: question,
+		Answer:   strconv.Itoa(answer),
+		Image:    img,
+	}, nil
+}
+
+// render 把算式逐字符绘制到图片上：每个字符随机颜色、上下偏移和倾斜，再叠加噪点与干扰线
+func render(text string) ([]byte, error) {
+	img := image.NewRGBA(image.Rect(0, 0, imageWidth, imageHeight))
+	bg := color.RGBA{uint8(235 + rand.IntN(20)), uint8(235 + rand.IntN(20)), uint8(235 + rand.IntN(20)), 255}
+	for y := 0; y < imageHeight; y++ {
+		for x := 0; x < imageWidth; x++ {
+			img.Set(x, y, bg)
+		}
+	}
+
+	for i := 0; i < 150; i++ {
+		img.Set(rand.IntN(imageWidth), rand.IntN(imageHeight), randomColor(100, 200))
+	}
+	for i := 0; i < 2; i++ {
+		drawLine(img, randomColor(120, 200))
+	}
+
+	advance := glyphWidth*glyphScale + 3
+	x := (imageWidth - advance*len([]rune(text))) / 2
+	for _, ch := range text {
+		glyph, ok := glyphs[ch]
+		if !ok {
+			return nil, fmt.Errorf("captcha: unsupported character %q", ch)
+		}
+		maxOffset := imageHeight - glyphHeight*glyphScale - 8
+		y := 4 + rand.IntN(maxOffset+1)
+		shear := rand.IntN(3) - 1
+		drawGlyph(img, glyph, x+rand.IntN(3)-1, y, shear, randomColor(0, 110))
+		x += advance
+	}
+
+	// 压在字符上的干扰线，防止按颜色直接分割字符
+	for i := 0; i < 2; i++ {
+		drawLine(img, randomColor(40, 140))
+	}
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("captcha: encode png failed: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// drawGlyph 按缩放倍数绘制点阵字形，shear 为每行的水平倾斜量（像素）
+func drawGlyph(img *image.RGBA, glyph [7]string, x0, y0, shear int, c color.Color) {
+	for row, line := range glyph {
+		offset := shear * (glyphHeight/2 - row)
+		for col, cell := range line {
+			if cell != '#' {
+				continue
+			}
+			for dy := 0; dy < glyphScale; dy++ {
+				for dx := 0; dx < glyphScale; dx++ {
+					img.Set(x0+col*glyphScale+dx+offset, y0+row*glyphScale+dy, c)
+				}
+			}
+		}
+	}
+}
+
+// drawLine 从左边缘到右边缘画一条随机斜线（Bresenham）
+func drawLine(img *image.RGBA, c color.Color) {
+	x0, y0 := 0, rand.IntN(imageHeight)
+	x1, y1 := imageWidth-1, rand.IntN(imageHeight)
+	dx, dy := x1-x0, abs(y1-y0)
+	sy := 1
+	if y0 > y1 {
+		sy = -1
+	}
+	e := dx - dy
+	for {
+		img.Set(x0, y0, c)
+		img.Set(x0, y0+1, c)
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * e
+		if e2 > -dy {
+			e -= dy
+			x0++
+		}
+		if e2 < dx {
+			e += dx
+			y0 += sy
+		}
+	}
+}
+
+func randomColor(lo, hi int) color.RGBA {
+	n := hi - lo
+	return color.RGBA{uint8(lo + rand.IntN(n)), uint8(lo + rand.IntN(n)), uint8(lo + rand.IntN(n)), 255}
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
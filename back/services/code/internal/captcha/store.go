@@ -0,0 +1,81 @@
+package captcha
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// Redis 键：题目答案 captcha:challenge:{id}，通过后的令牌 captcha:token:{token}（值为绑定的客户端 IP）
+const (
+	challengeKeyPrefix = "captcha:challenge:"
+	tokenKeyPrefix     = "captcha:token:"
+)
+
+// takeScript 读取后立即删除，保证题目答案和令牌都只能使用一次
+const takeScript = `
+local v = redis.call("GET", KEYS[1])
+if v then
+	redis.call("DEL", KEYS[1])
+	return v
+end
+return ""
+`
+
+// Store 基于 Redis 保存题目答案与通过令牌
+type Store struct {
+	rds *redis.Redis
+}
+
+func NewStore(rds *redis.Redis) *Store {
+	return &Store{rds: rds}
+}
+
+// SaveAnswer 保存题目答案，返回题目 ID
+func (s *Store) SaveAnswer(ctx context.Context, answer string, ttl time.Duration) (string, error) {
+	id := uuid.NewString()
+	if err := s.rds.SetexCtx(ctx, challengeKeyPrefix+id, answer, int(ttl/time.Second)); err != nil {
+		return "", fmt.Errorf("save challenge answer failed: %w", err)
+	}
+	return id, nil
+}
+
+// TakeAnswer 取出并作废题目答案；题目不存在或已过期时返回空字符串
+func (s *Store) TakeAnswer(ctx context.Context, id string) (string, error) {
+	return s.take(ctx, challengeKeyPrefix+id)
+}
+
+// IssueToken 为通过验证的客户端签发一次性令牌，令牌与客户端 IP 绑定
+func (s *Store) IssueToken(ctx context.Context, clientIP string, ttl time.Duration) (string, error) {
+	token := uuid.NewString()
+	if err := s.rds.SetexCtx(ctx, tokenKeyPrefix+token, clientIP, int(ttl/time.Second)); err != nil {
+		return "", fmt.Errorf("save challenge token failed: %w", err)
+	}
+	return token, nil
+}
+
+// ConsumeToken 校验并作废令牌，令牌不存在、已使用或 IP 不一致时返回 false
+func (s *Store) ConsumeToken(ctx context.Context, token, clientIP string) (bool, error) {
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return false, nil
+	}
+	boundIP, err := s.take(ctx, tokenKeyPrefix+token)
+	if err != nil {
+		return false, err
+	}
+	return boundIP != "" && boundIP == clientIP, nil
+}
+
+func (s *Store) take(ctx context.Context, key string) (string, error) {
+	result, err := s.rds.EvalCtx(ctx, takeScript, []string{key})
+	if err != nil {
+		return "", err
+	}
+	v, _ := result.(string)
+	return v, nil
+}
@@ -6,12 +6,17 @@ import (
 
 type Config struct {
 	zrpc.RpcServerConf
-	Nacos         NacosConf           `json:",optional"`
-	Consul        ConsulConf          `json:",optional"`
-	TemplateNacos TemplateNacosConf   `json:",optional"`
-	Template      map[string]Template `json:",optional"`
-	RateLimit     RateLimitConf       `json:",optional"`
-	MetricsPort   int                 `json:",default=9092"`
+	Nacos         NacosConf                `json:",optional"`
+	Consul        ConsulConf               `json:",optional"`
+	TemplateNacos TemplateNacosConf        `json:",optional"`
+	Template      map[string]Template      `json:",optional"`
+	EmailTemplate map[string]EmailTemplate `json:",optional"`
+	RateLimit     RateLimitConf            `json:",optional"`
+	SMS           SMSConf                  `json:",optional"`
+	Email         EmailConf                `json:",optional"`
+	VerifyTicket  VerifyTicketConf         `json:",optional"`
+	Challenge     ChallengeConf            `json:",optional"`
+	MetricsPort   int                      `json:",default=9092"`
 }
 
 type Template struct {
@@ -19,16 +24,99 @@ type Template struct {
 	CodeLength       int    `json:",default=6"`
 	ExpireSeconds    int64  `json:",default=300"`
 	MaxDailySends    int    `json:",default=10"`
-	ProviderTemplate string `json:",optional"`
+	ProviderTemplate string `json:",optional"` // 短信服务商模板，格式 provider:templateId（如 aliyun:SMS_123），省略 provider 时使用 SMS.DefaultProvider
 	ContentTemplate  string `json:",optional"`
 }
 
+// EmailTemplate 邮件验证码模板，与短信模板按 purpose 一一对应
+type EmailTemplate struct {
+	ID              string `json:",optional"`
+	CodeLength      int    `json:",default=6"`
+	ExpireSeconds   int64  `json:",default=600"`
+	MaxDailySends   int    `json:",default=10"`
+	Subject         string `json:",optional"` // 邮件标题，支持 {{.ExpireMinutes}}
+	ContentTemplate string `json:",optional"` // 邮件正文，支持 {{.Code}} {{.ExpireMinutes}}
+}
+
+// SMSConf 短信发送配置
+type SMSConf struct {
+	DefaultProvider string         `json:",optional"`  // 默认服务商：aliyun / tencent，console / file 只允许 dev 模式（dev 模式未配置时为 file）
+	Timeout         int            `json:",default=5"` // 调用服务商接口的超时时间（秒）
+	Aliyun          AliyunSMSConf  `json:",optional"`
+	Tencent         TencentSMSConf `json:",optional"`
+	File            FileSMSConf    `json:",optional"`
+}
+
+type AliyunSMSConf struct {
+	AccessKeyId     string `json:",optional"`
+	AccessKeySecret string `json:",optional"`
+	SignName        string `json:",optional"`
+	RegionId        string `json:",default=cn-hangzhou"`
+}
+
+type TencentSMSConf struct {
+	SecretId  string `json:",optional"`
+	SecretKey string `json:",optional"`
+	SdkAppId  string `json:",optional"`
+	SignName  string `json:",optional"`
+	Region    string `json:",default=ap-guangzhou"`
+	Endpoint  string `json:",default=sms.tencentcloudapi.com"`
+}
+
+// FileSMSConf 本地开发用：短信内容追加写入文件（每行一条 JSON）
+type FileSMSConf struct {
+	Path string `json:",default=logs/sms.log"`
+}
+
+// EmailConf 邮件发送配置
+type EmailConf struct {
+	Provider string       `json:",optional"`   // smtp；file 只允许 dev 模式（dev 模式未配置时为 file）
+	Timeout  int          `json:",default=10"` // 发送超时（秒）
+	SMTP     SMTPConf     `json:",optional"`
+	File     FileMailConf `json:",optional"`
+}
+
+type SMTPConf struct {
+	Host     string `json:",optional"`
+	Port     int    `json:",default=465"` // 465 使用隐式 TLS，其他端口在服务端支持时使用 STARTTLS
+	Username string `json:",optional"`
+	Password string `json:",optional"`
+	From     string `json:",optional"` // 发件人，如 "SLGaming <no-reply@example.com>"
+}
+
+// FileMailConf 本地开发/测试用：邮件追加写入文件（每行一条 JSON）
+type FileMailConf struct {
+	Path string `json:",default=logs/mailbox.log"`
+}
+
+// VerifyTicketConf 验证通过后签发的一次性票据，Secret 需与 user 服务一致
+// 未配置时读取环境变量 VERIFY_TICKET_SECRET，非 dev 模式下仍为空或为开发密钥时拒绝启动
+type VerifyTicketConf struct {
+	Secret string `json:",optional"`
+	TTL    int    `json:",default=300"` // 有效期（秒）
+}
+
+// ChallengeConf 发送验证码前的人机验证（算术图片验证码）
+// 命中任一风险信号时，SendCode 必须携带通过人机验证获得的一次性令牌
+type ChallengeConf struct {
+	Enabled              bool `json:",default=true"`
+	ExpireSeconds        int  `json:",default=120"`  // 题目有效期（秒），只能作答一次
+	TokenTTL             int  `json:",default=300"`  // 通过后令牌有效期（秒）
+	IPTargetThreshold    int  `json:",default=3"`    // 同一 IP 一小时内请求的不同手机号/邮箱数达到该值后需要验证
+	GlobalSendsPerMinute int  `json:",default=200"`  // 全局每分钟发送量达到该值后所有请求都需要验证
+	NewDevice            bool `json:",default=true"` // 接收方有发送记录但当前设备从未出现过时需要验证
+	IPPerMinute          int  `json:",default=10"`   // 同一 IP 每分钟获取题目、提交答案各自的次数上限
+	GlobalPerMinute      int  `json:",default=3000"` // 全局每分钟获取题目、提交答案各自的次数上限
+}
+
 type RateLimitConf struct {
 	IPSendInterval        int `json:",default=60"`
 	IPDailyLimit          int `json:",default=100"`
 	PhoneSendInterval     int `json:",default=60"`
-	VerifyPhoneDailyLimit int `json:",default=50"`
+	EmailSendInterval     int `json:",default=60"` // 同一邮箱发送间隔（秒）
+	VerifyPhoneDailyLimit int `json:",default=50"` // 同一手机号/邮箱每日验证次数上限
 	VerifyIPDailyLimit    int `json:",default=200"`
+	VerifyMaxAttempts     int `json:",default=5"` // 单个验证码允许的错误次数，达到后作废需重新发送
 }
 
 type NacosConf struct {
@@ -0,0 +1,69 @@
+package email
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// FileSender 本地开发/测试用的邮箱：把邮件追加写入文件（每行一条 JSON），便于脚本读取验证码
+type FileSender struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileSender(path string) *FileSender {
+	if path == "" {
+		path = "logs/mailbox.log"
+	}
+	return &FileSender{path: path}
+}
+
+func (s *FileSender) Name() string {
+	return ProviderFile
+}
+
+// mailboxRecord 邮箱文件中的一封邮件
+type mailboxRecord struct {
+	MessageID string `json:"message_id"`
+	To        string `json:"to"`
+	Subject   string `json:"subject"`
+	Body      string `json:"body"`
+	Code      string `json:"code"`
+	SentAt    int64  `json:"sent_at"`
+}
+
+func (s *FileSender) Send(ctx context.Context, msg *Message) (*Receipt, error) {
+	record := mailboxRecord{
+		MessageID: uuid.NewString(),
+		To:        msg.To,
+		Subject:   msg.Subject,
+		Body:      msg.Body,
+		Code:      msg.Code,
+		SentAt:    time.Now().Unix(),
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return nil, err
+	}
+	return &Receipt{Provider: ProviderFile, MessageID: record.MessageID}, nil
+}
@@ -0,0 +1,125 @@
+package email
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"time"
+
+	"SLGaming/back/services/code/internal/config"
+	"SLGaming/back/services/code/internal/metrics"
+
+	"github.com/zeromicro/go-zero/core/service"
+)
+
+// 邮件服务名称
+const (
+	ProviderSMTP = "smtp"
+	ProviderFile = "file"
+)
+
+// Message 一封待发送的验证码邮件
+type Message struct {
+	To      string
+	Subject string
+	Body    string // 纯文本正文
+	Code    string // 验证码（仅文件投递时记录，便于测试读取）
+}
+
+// Receipt 邮件服务受理回执
+type Receipt struct {
+	Provider  string
+	MessageID string
+}
+
+// Sender 邮件服务
+type Sender interface {
+	// Name 服务名称
+	Name() string
+	// Send 发送一封邮件，服务端受理后返回回执
+	Send(ctx context.Context, msg *Message) (*Receipt, error)
+}
+
+var (
+	// ErrNoProvider 非 dev 模式下未配置邮件服务，或 SMTP 未配置服务器地址
+	ErrNoProvider = errors.New("email provider not configured")
+	// ErrLocalProvider 文件投递不会真正发出邮件，且会在本地留下验证码，只允许 dev 模式使用
+	ErrLocalProvider = errors.New("file email provider is only allowed in dev mode")
+)
+
+// ResolveProvider 校验并返回邮件服务：dev 模式下未配置时写入本地邮箱文件，其他模式下必须配置 SMTP
+func ResolveProvider(c config.EmailConf, mode string) (string, error) {
+	provider := strings.ToLower(strings.TrimSpace(c.Provider))
+	local := mode == service.DevMode
+	switch provider {
+	case "":
+		if !local {
+			return "", ErrNoProvider
+		}
+		return ProviderFile, nil
+	case ProviderFile:
+		if !local {
+			return "", ErrLocalProvider
+		}
+	case ProviderSMTP:
+		if c.SMTP.Host == "" {
+			return "", fmt.Errorf("%w: smtp host is empty", ErrNoProvider)
+		}
+	default:
+		return "", fmt.Errorf("%w: %s", ErrNoProvider, provider)
+	}
+	return provider, nil
+}
+
+// Mailer 按配置选择邮件服务发送，并记录发送指标
+type Mailer struct {
+	sender  Sender
+	timeout time.Duration
+}
+
+// NewMailer 按配置创建邮件服务，Provider 需先经 ResolveProvider 校验
+// Provider 为 smtp 时使用 SMTP，否则写入本地邮箱文件（仅 dev 模式）
+func NewMailer(c config.EmailConf) *Mailer {
+	timeout := time.Duration(c.Timeout) * time.Second
+	if timeout <= 0 {
+		timeout = 10 * time.Second
+	}
+
+	var sender Sender
+	switch strings.ToLower(strings.TrimSpace(c.Provider)) {
+	case ProviderSMTP:
+		sender = NewSMTPSender(c.SMTP)
+	default:
+		sender = NewFileSender(c.File.Path)
+	}
+	return &Mailer{sender: sender, timeout: timeout}
+}
+
+// Send 发送邮件，记录受理与失败指标
+func (m *Mailer) Send(ctx context.Context, msg *Message) (*Receipt, error) {
+	ctx, cancel := context.WithTimeout(ctx, m.timeout)
+	defer cancel()
+
+	name := m.sender.Name()
+	start := time.Now()
+	receipt, err := m.sender.Send(ctx, msg)
+	metrics.CodeEmailSendDuration.WithLabelValues(name).Observe(time.Since(start).Seconds())
+	if err != nil {
+		metrics.CodeEmailSendTotal.WithLabelValues(name, "failed").Inc()
+		return nil, err
+	}
+	metrics.CodeEmailSendTotal.WithLabelValues(name, "accepted").Inc()
+	return receipt, nil
+}
+
+// NormalizeAddress 校验并规范化邮箱地址（只接受纯地址，不带显示名），统一转为小写
+func NormalizeAddress(addr string) (string, bool) {
+	addr = strings.TrimSpace(addr)
+	parsed, err := mail.ParseAddress(addr)
+	if err != nil || parsed.Address != addr || len(addr) > 128 {
+		return "", false
+	}
+	return strings.ToLower(addr), true
+}
@@ -0,0 +1,43 @@
+package email
+
+import (
+	"testing"
+
+	"SLGaming/back/services/code/internal/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeromicro/go-zero/core/service"
+)
+
+func TestResolveProvider(t *testing.T) {
+	smtp := config.SMTPConf{Host: "smtp.example.com"}
+
+	tests := []struct {
+		name    string
+		conf    config.EmailConf
+		mode    string
+		want    string
+		wantErr error
+	}{
+		{name: "dev 模式未配置时写入文件", mode: service.DevMode, want: ProviderFile},
+		{name: "dev 模式允许文件", conf: config.EmailConf{Provider: "File"}, mode: service.DevMode, want: ProviderFile},
+		{name: "生产模式未配置", mode: service.ProMode, wantErr: ErrNoProvider},
+		{name: "生产模式拒绝文件", conf: config.EmailConf{Provider: ProviderFile}, mode: service.ProMode, wantErr: ErrLocalProvider},
+		{name: "SMTP 未配置服务器", conf: config.EmailConf{Provider: ProviderSMTP}, mode: service.ProMode, wantErr: ErrNoProvider},
+		{name: "SMTP", conf: config.EmailConf{Provider: ProviderSMTP, SMTP: smtp}, mode: service.ProMode, want: ProviderSMTP},
+		{name: "未知服务", conf: config.EmailConf{Provider: "foo"}, mode: service.DevMode, wantErr: ErrNoProvider},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveProvider(tt.conf, tt.mode)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
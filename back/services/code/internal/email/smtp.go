@@ -0,0 +1,141 @@
+package email
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"mime"
+	"net"
+	"net/mail"
+	"net/smtp"
+	"strconv"
+	"time"
+
+	"SLGaming/back/services/code/internal/config"
+
+	"github.com/google/uuid"
+)
+
+// SMTPSender 通过 SMTP 发送邮件
+// 465 端口使用隐式 TLS；其他端口在服务端支持时升级 STARTTLS，有账号时使用 PLAIN 认证
+type SMTPSender struct {
+	conf config.SMTPConf
+}
+
+func NewSMTPSender(c config.SMTPConf) *SMTPSender {
+	if c.Port == 0 {
+		c.Port = 465
+	}
+	return &SMTPSender{conf: c}
+}
+
+func (s *SMTPSender) Name() string {
+	return ProviderSMTP
+}
+
+func (s *SMTPSender) Send(ctx context.Context, msg *Message) (*Receipt, error) {
+	if s.conf.Host == "" || s.conf.From == "" {
+		return nil, errors.New("smtp host or from not configured")
+	}
+	from, err := mail.ParseAddress(s.conf.From)
+	if err != nil {
+		return nil, fmt.Errorf("invalid smtp from: %w", err)
+	}
+
+	client, err := s.dial(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+
+	if s.conf.Username != "" {
+		if err := client.Auth(smtp.PlainAuth("", s.conf.Username, s.conf.Password, s.conf.Host)); err != nil {
+			return nil, fmt.Errorf("smtp auth failed: %w", err)
+		}
+	}
+	if err := client.Mail(from.Address); err != nil {
+		return nil, fmt.Errorf("smtp mail from failed: %w", err)
+	}
+	if err := client.Rcpt(msg.To); err != nil {
+		return nil, fmt.Errorf("smtp rcpt to failed: %w", err)
+	}
+
+	messageID := fmt.Sprintf("<%s@%s>", uuid.NewString(), s.conf.Host)
+	w, err := client.Data()
+	if err != nil {
+		return nil, fmt.Errorf("smtp data failed: %w", err)
+	}
+	if _, err := w.Write(buildMessage(from, msg, messageID)); err != nil {
+		_ = w.Close()
+		return nil, fmt.Errorf("smtp write failed: %w", err)
+	}
+	if err := w.Close(); err != nil {
+		return nil, fmt.Errorf("smtp data close failed: %w", err)
+	}
+	_ = client.Quit()
+
+	return &Receipt{Provider: ProviderSMTP, MessageID: messageID}, nil
+}
+
+// dial 建立连接并完成 TLS 协商，连接整体受 ctx 截止时间约束
+func (s *SMTPSender) dial(ctx context.Context) (*smtp.Client, error) {
+	addr := net.JoinHostPort(s.conf.Host, strconv.Itoa(s.conf.Port))
+	tlsConf := &tls.Config{ServerName: s.conf.Host, MinVersion: tls.VersionTLS12}
+
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return nil, fmt.Errorf("smtp dial failed: %w", err)
+	}
+	if deadline, ok := ctx.Deadline(); ok {
+		_ = conn.SetDeadline(deadline)
+	}
+	if s.conf.Port == 465 {
+		conn = tls.Client(conn, tlsConf)
+	}
+
+	client, err := smtp.NewClient(conn, s.conf.Host)
+	if err != nil {
+		conn.Close()
+		return nil, fmt.Errorf("smtp handshake failed: %w", err)
+	}
+	if s.conf.Port != 465 {
+		if ok, _ := client.Extension("STARTTLS"); ok {
+			if err := client.StartTLS(tlsConf); err != nil {
+				client.Close()
+				return nil, fmt.Errorf("smtp starttls failed: %w", err)
+			}
+		}
+	}
+	return client, nil
+}
+
+// buildMessage 组装 UTF-8 纯文本邮件（标题按 RFC 2047 编码，正文 base64）
+func buildMessage(from *mail.Address, msg *Message, messageID string) []byte {
+	var buf bytes.Buffer
+	headers := [][2]string{
+		{"From", from.String()},
+		{"To", msg.To},
+		{"Subject", mime.BEncoding.Encode("UTF-8", msg.Subject)},
+		{"Date", time.Now().Format(time.RFC1123Z)},
+		{"Message-ID", messageID},
+		{"MIME-Version", "1.0"},
+		{"Content-Type", "text/plain; charset=UTF-8"},
+		{"Content-Transfer-Encoding", "base64"},
+	}
+	for _, h := range headers {
+		buf.WriteString(h[0] + ": " + h[1] + "\r\n")
+	}
+	buf.WriteString("\r\n")
+
+	encoded := base64.StdEncoding.EncodeToString([]byte(msg.Body))
+	for len(encoded) > 76 {
+		buf.WriteString(encoded[:76] + "\r\n")
+		encoded = encoded[76:]
+	}
+	buf.WriteString(encoded + "\r\n")
+	return buf.Bytes()
+}
@@ -12,6 +12,7 @@ type LogOperation string
 const (
 	OpSendCode   LogOperation = "send_code"
 	OpVerifyCode LogOperation = "verify_code"
+	OpChallenge  LogOperation = "challenge"
 	OpServer     LogOperation = "server"
 )
 
@@ -75,3 +76,11 @@ func MaskPhone(phone string) string {
 	}
 	return phone[:3] + "****" + phone[len(phone)-4:]
 }
+
+func MaskEmail(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at <= 0 {
+		return email
+	}
+	return email[:1] + "***" + email[at:]
+}
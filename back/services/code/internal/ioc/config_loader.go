@@ -60,7 +60,8 @@ func LoadConfig(configFile, templatesFile string) config.Config {
 }
 
 type templateFile struct {
-	Templates map[string]config.Template `json:"templates" yaml:"templates"`
+	Templates      map[string]config.Template      `json:"templates" yaml:"templates"`
+	EmailTemplates map[string]config.EmailTemplate `json:"emailTemplates" yaml:"emailTemplates"`
 }
 
 func loadTemplatesFromFile(cfg *config.Config, path string) {
@@ -78,6 +79,7 @@ func loadTemplatesFromFile(cfg *config.Config, path string) {
 		return
 	}
 	cfg.Template = tf.Templates
+	cfg.EmailTemplate = tf.EmailTemplates
 	logx.Infof("load template file success %+v", cfg.Template)
 }
 
@@ -108,4 +110,7 @@ func loadTemplatesFromNacos(client config_client.IConfigClient, cfg *config.Conf
 	if len(tf.Templates) > 0 {
 		cfg.Template = tf.Templates
 	}
+	if len(tf.EmailTemplates) > 0 {
+		cfg.EmailTemplate = tf.EmailTemplates
+	}
 }
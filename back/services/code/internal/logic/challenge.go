@@ -0,0 +1,236 @@
+package logic
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net"
+	"strings"
+	"time"
+
+	"SLGaming/back/services/code/code"
+	"SLGaming/back/services/code/internal/helper"
+	"SLGaming/back/services/code/internal/metrics"
+	"SLGaming/back/services/code/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/peer"
+)
+
+// 触发人机验证的风险信号
+const (
+	riskIPTargets    = "ip_targets"    // 同一 IP 短时间内请求了过多不同的手机号/邮箱
+	riskGlobalRate   = "global_rate"   // 全局发送量异常
+	riskNewDevice    = "new_device"    // 接收方有发送记录，但当前设备从未出现过
+	riskInvalidToken = "invalid_token" // 携带的令牌无效、已使用或与 IP 不一致
+)
+
+// 接收方历史发送设备保留 30 天
+const sendDevicesTTL = 30 * 24 * 3600
+
+// 人机验证接口的限流动作
+const (
+	challengeActionCreate = "create"
+	challengeActionVerify = "verify"
+)
+
+// errChallengeRateLimited 获取题目或提交答案过于频繁
+var errChallengeRateLimited = fmt.Errorf("人机验证请求过于频繁，请稍后再试")
+
+// checkChallenge 评估发送风险；命中风险信号且未携带有效令牌时返回要求人机验证的响应（本次不发送）
+func (l *SendCodeLogic) checkChallenge(in *code.SendCodeRequest, target codeTarget, clientIP, device string) *code.SendCodeResponse {
+	if !l.svcCtx.Config.Challenge.Enabled {
+		return nil
+	}
+	reason := l.assessSendRisk(target, clientIP, device)
+	if reason == "" {
+		return nil
+	}
+
+	if token := strings.TrimSpace(in.GetChallengeToken()); token != "" {
+		ok, err := l.svcCtx.Captcha.ConsumeToken(l.ctx, token, clientIP)
+		if err != nil {
+			metrics.CodeRedisErrorTotal.Inc()
+			helper.LogError(l.Logger, helper.OpChallenge, "consume challenge token failed", err, map[string]interface{}{
+				"client_ip": clientIP,
+			})
+		}
+		if ok {
+			metrics.CodeChallengeTotal.WithLabelValues("token_accepted", reason).Inc()
+			return nil
+		}
+		reason = riskInvalidToken
+	}
+
+	metrics.CodeChallengeTotal.WithLabelValues("required", reason).Inc()
+	helper.LogWarning(l.Logger, helper.OpChallenge, "challenge required before sending code", map[string]interface{}{
+		"target":    target.masked(),
+		"client_ip": clientIP,
+		"reason":    reason,
+	})
+	return &code.SendCodeResponse{
+		ChallengeRequired: true,
+		ChallengeReason:   reason,
+	}
+}
+
+// assessSendRisk 依次检查各风险信号，返回命中的第一个原因；Redis 异常时该信号视为未命中
+func (l *SendCodeLogic) assessSendRisk(target codeTarget, clientIP, device string) string {
+	cfg := l.svcCtx.Config.Challenge
+
+	// 同一 IP 一小时内请求的不同接收方数量（HyperLogLog 计数，本次请求也计入）
+	if clientIP != "" && cfg.IPTargetThreshold > 0 {
+		key := fmt.Sprintf("risk:ip:targets:%s:%s", clientIP, time.Now().Format("2006010215"))
+		if _, err := l.svcCtx.Redis.PfaddCtx(l.ctx, key, target.scope()+":"+target.address); err != nil {
+			metrics.CodeRedisErrorTotal.Inc()
+			helper.LogError(l.Logger, helper.OpChallenge, "record ip targets failed", err, map[string]interface{}{
+				"client_ip": clientIP,
+			})
+		} else {
+			_ = l.svcCtx.Redis.ExpireCtx(l.ctx, key, 7200)
+			if count, err := l.svcCtx.Redis.PfcountCtx(l.ctx, key); err == nil && count >= int64(cfg.IPTargetThreshold) {
+				return riskIPTargets
+			}
+		}
+	}
+
+	// 全局每分钟发送量
+	if cfg.GlobalSendsPerMinute > 0 {
+		countStr, err := l.svcCtx.Redis.GetCtx(l.ctx, globalSendsKey(time.Now()))
+		if err == nil && countStr != "" {
+			var count int
+			if _, err := fmt.Sscanf(countStr, "%d", &count); err == nil && count >= cfg.GlobalSendsPerMinute {
+				return riskGlobalRate
+			}
+		}
+	}
+
+	// 接收方历史设备中没有当前设备
+	if cfg.NewDevice {
+		key := sendDevicesKey(target)
+		known, err := l.svcCtx.Redis.ScardCtx(l.ctx, key)
+		if err == nil && known > 0 {
+			seen, err := l.svcCtx.Redis.SismemberCtx(l.ctx, key, device)
+			if err == nil && !seen {
+				return riskNewDevice
+			}
+		}
+	}
+
+	return ""
+}
+
+// recordSendRisk 发送成功后累计全局发送量，并记住接收方使用过的设备
+func (l *SendCodeLogic) recordSendRisk(target codeTarget, device string) {
+	if !l.svcCtx.Config.Challenge.Enabled {
+		return
+	}
+
+	key := globalSendsKey(time.Now())
+	if _, err := l.svcCtx.Redis.IncrCtx(l.ctx, key); err != nil {
+		metrics.CodeRedisErrorTotal.Inc()
+		helper.LogError(l.Logger, helper.OpChallenge, "incr global sends failed", err, nil)
+	} else {
+		_ = l.svcCtx.Redis.ExpireCtx(l.ctx, key, 120)
+	}
+
+	devicesKey := sendDevicesKey(target)
+	if _, err := l.svcCtx.Redis.SaddCtx(l.ctx, devicesKey, device); err != nil {
+		metrics.CodeRedisErrorTotal.Inc()
+		helper.LogError(l.Logger, helper.OpChallenge, "record send device failed", err, map[string]interface{}{
+			"target": target.masked(),
+		})
+	} else {
+		_ = l.svcCtx.Redis.ExpireCtx(l.ctx, devicesKey, sendDevicesTTL)
+	}
+}
+
+// challengeRateLimit 一个按分钟计数的限流窗口
+type challengeRateLimit struct {
+	key   string
+	limit int
+	scope string // ip / global
+}
+
+// checkChallengeRate 按 IP 与全局限制每分钟获取题目、提交答案的次数，防止批量刷题猜答案
+// Redis 异常时放行，由网关路由限流兜底
+func checkChallengeRate(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, action, clientIP string) error {
+	cfg := svcCtx.Config.Challenge
+	minute := time.Now().Format("200601021504")
+
+	var limits []challengeRateLimit
+	if clientIP != "" && cfg.IPPerMinute > 0 {
+		limits = append(limits, challengeRateLimit{
+			key:   fmt.Sprintf("risk:challenge:%s:ip:%s:%s", action, clientIP, minute),
+			limit: cfg.IPPerMinute,
+			scope: "ip",
+		})
+	}
+	if cfg.GlobalPerMinute > 0 {
+		limits = append(limits, challengeRateLimit{
+			key:   fmt.Sprintf("risk:challenge:%s:global:%s", action, minute),
+			limit: cfg.GlobalPerMinute,
+			scope: "global",
+		})
+	}
+
+	for _, item := range limits {
+		count, err := svcCtx.Redis.IncrCtx(ctx, item.key)
+		if err != nil {
+			metrics.CodeRedisErrorTotal.Inc()
+			helper.LogError(logger, helper.OpChallenge, "incr challenge rate failed", err, map[string]interface{}{
+				"action": action,
+				"scope":  item.scope,
+			})
+			continue
+		}
+		if count == 1 {
+			_ = svcCtx.Redis.ExpireCtx(ctx, item.key, 120)
+		}
+		if count > int64(item.limit) {
+			metrics.CodeRateLimitTotal.WithLabelValues("challenge_" + action + "_" + item.scope).Inc()
+			helper.LogWarning(logger, helper.OpChallenge, "rate limited: challenge", map[string]interface{}{
+				"action":    action,
+				"scope":     item.scope,
+				"client_ip": clientIP,
+			})
+			return errChallengeRateLimited
+		}
+	}
+	return nil
+}
+
+func globalSendsKey(now time.Time) string {
+	return "risk:global:sends:" + now.Format("200601021504")
+}
+
+func sendDevicesKey(target codeTarget) string {
+	return fmt.Sprintf("risk:devices:%s:%s", target.scope(), target.address)
+}
+
+// deviceFingerprint 以规范化后的 User-Agent 摘要标识设备，未上报时统一记为 unknown
+func deviceFingerprint(userAgent string) string {
+	ua := strings.ToLower(strings.Join(strings.Fields(userAgent), " "))
+	if ua == "" {
+		return "unknown"
+	}
+	sum := sha256.Sum256([]byte(ua))
+	return hex.EncodeToString(sum[:16])
+}
+
+// clientIP 优先使用网关上报的终端 IP，未上报时退化为 gRPC 对端地址
+func (l *SendCodeLogic) clientIP(reported string) string {
+	if ip := strings.TrimSpace(reported); ip != "" {
+		return ip
+	}
+	p, ok := peer.FromContext(l.ctx)
+	if !ok {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
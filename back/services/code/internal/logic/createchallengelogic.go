@@ -0,0 +1,73 @@
+package logic
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	"SLGaming/back/services/code/code"
+	"SLGaming/back/services/code/internal/captcha"
+	"SLGaming/back/services/code/internal/helper"
+	"SLGaming/back/services/code/internal/metrics"
+	"SLGaming/back/services/code/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CreateChallengeLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateChallengeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateChallengeLogic {
+	return &CreateChallengeLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CreateChallenge 生成一道算术图片验证码，答案保存在 Redis 中，只能作答一次
+func (l *CreateChallengeLogic) CreateChallenge(in *code.CreateChallengeRequest) (*code.CreateChallengeResponse, error) {
+	if err := checkChallengeRate(l.ctx, l.svcCtx, l.Logger, challengeActionCreate, in.GetClientIp()); err != nil {
+		return nil, err
+	}
+
+	puzzle, err := captcha.Generate()
+	if err != nil {
+		helper.LogError(l.Logger, helper.OpChallenge, "generate challenge failed", err, nil)
+		return nil, fmt.Errorf("生成人机验证失败，请稍后重试")
+	}
+
+	expire := challengeExpire(l.svcCtx.Config.Challenge.ExpireSeconds)
+	id, err := l.svcCtx.Captcha.SaveAnswer(l.ctx, puzzle.Answer, expire)
+	if err != nil {
+		metrics.CodeRedisErrorTotal.Inc()
+		helper.LogError(l.Logger, helper.OpChallenge, "save challenge failed", err, map[string]interface{}{
+			"client_ip": in.GetClientIp(),
+		})
+		return nil, fmt.Errorf("生成人机验证失败，请稍后重试")
+	}
+
+	metrics.CodeChallengeTotal.WithLabelValues("created", "").Inc()
+	helper.LogInfo(l.Logger, helper.OpChallenge, "challenge created", map[string]interface{}{
+		"challenge_id": id,
+		"client_ip":    in.GetClientIp(),
+	})
+
+	return &code.CreateChallengeResponse{
+		ChallengeId: id,
+		Image:       "data:image/png;base64," + base64.StdEncoding.EncodeToString(puzzle.Image),
+		ExpireAt:    time.Now().Add(expire).Unix(),
+	}, nil
+}
+
+// challengeExpire 题目有效期，未配置时默认 120 秒
+func challengeExpire(seconds int) time.Duration {
+	if seconds <= 0 {
+		seconds = 120
+	}
+	return time.Duration(seconds) * time.Second
+}
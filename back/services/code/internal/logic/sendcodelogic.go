@@ -2,14 +2,18 @@ package logic
 
 import (
 	"context"
+	"crypto/rand"
 	"fmt"
+	"math/big"
 	"strings"
 	"text/template"
 	"time"
 
 	"SLGaming/back/services/code/code"
+	"SLGaming/back/services/code/internal/email"
 	"SLGaming/back/services/code/internal/helper"
 	"SLGaming/back/services/code/internal/metrics"
+	"SLGaming/back/services/code/internal/sms"
 	"SLGaming/back/services/code/internal/svc"
 
 	"github.com/google/uuid"
@@ -20,6 +24,23 @@ const defaultCodeLength = 6
 const defaultExpireSeconds = 300
 
 const defaultPhoneSendInterval = 60
+const defaultEmailSendInterval = 60
+
+const (
+	defaultEmailExpireSeconds = 600
+	defaultEmailSubject       = "验证码"
+	defaultEmailContent       = "您的验证码是：{{.Code}}，{{.ExpireMinutes}}分钟内有效，请勿泄露。"
+)
+
+// codeTemplate 某个渠道、某个用途的验证码模板
+type codeTemplate struct {
+	CodeLength       int
+	ExpireSeconds    int64
+	Content          string
+	Subject          string // 邮件标题
+	MaxDailySends    int
+	ProviderTemplate string // 短信服务商模板
+}
 
 type SendCodeLogic struct {
 	ctx    context.Context
@@ -38,32 +59,45 @@ func NewSendCodeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SendCode
 func (l *SendCodeLogic) SendCode(in *code.SendCodeRequest) (*code.SendCodeResponse, error) {
 	start := time.Now()
 	purpose := in.GetPurpose()
-	phone := in.GetPhone()
-	maskedPhone := helper.MaskPhone(phone)
+
+	target, err := resolveTarget(in.GetChannel(), in.GetPhone(), in.GetEmail())
+	if err != nil {
+		return nil, err
+	}
+	masked := target.masked()
+	clientIP := l.clientIP(in.GetClientIp())
+	device := deviceFingerprint(in.GetUserAgent())
 
 	helper.LogRequest(l.Logger, helper.OpSendCode, map[string]interface{}{
-		"phone":   maskedPhone,
-		"purpose": purpose,
+		"channel":   target.channel,
+		"target":    masked,
+		"purpose":   purpose,
+		"client_ip": clientIP,
 	})
 
-	getTemplate := l.getTemplate(purpose)
+	getTemplate := l.getTemplate(target, purpose)
 
-	if err := l.checkPhoneRateLimit(phone, purpose, getTemplate.MaxDailySends); err != nil {
-		metrics.CodeRateLimitTotal.WithLabelValues("phone_rate_limit").Inc()
+	if err := l.checkTargetRateLimit(target, purpose, getTemplate.MaxDailySends); err != nil {
+		metrics.CodeRateLimitTotal.WithLabelValues(target.scope() + "_rate_limit").Inc()
 		return nil, err
 	}
 
+	// 命中风险信号时要求先完成人机验证，本次不发送也不计入发送频率
+	if resp := l.checkChallenge(in, target, clientIP, device); resp != nil {
+		return resp, nil
+	}
+
 	expire := time.Duration(getTemplate.ExpireSeconds) * time.Second
 	if expire <= 0 {
 		expire = defaultExpireSeconds * time.Second
 	}
 
-	key := fmt.Sprintf("code:%s:%s", purpose, phone)
+	key := target.codeKey(purpose)
 
 	codeValue, err := generateCode(getTemplate.CodeLength)
 	if err != nil {
 		helper.LogError(l.Logger, helper.OpSendCode, "generate code failed", err, map[string]interface{}{
-			"phone": maskedPhone,
+			"target": masked,
 		})
 		return nil, err
 	}
@@ -71,43 +105,75 @@ func (l *SendCodeLogic) SendCode(in *code.SendCodeRequest) (*code.SendCodeRespon
 	ttl, err := l.svcCtx.Redis.Ttl(key)
 	if err != nil {
 		helper.LogError(l.Logger, helper.OpSendCode, "check code ttl failed", err, map[string]interface{}{
-			"phone": maskedPhone,
-			"key":   key,
+			"target": masked,
+			"key":    key,
 		})
 	} else if ttl > 0 {
 		helper.LogWarning(l.Logger, helper.OpSendCode, "code not expired", map[string]interface{}{
-			"phone":      maskedPhone,
+			"target":     masked,
 			"remain_ttl": ttl,
 		})
 		return nil, fmt.Errorf("验证码尚未过期，请 %d 秒后再试", ttl)
 	}
 
+	// 新验证码重新计算错误次数
+	if _, err := l.svcCtx.Redis.Del(codeAttemptsKey(key)); err != nil {
+		metrics.CodeRedisErrorTotal.Inc()
+		helper.LogError(l.Logger, helper.OpSendCode, "reset code attempts failed", err, map[string]interface{}{
+			"target": masked,
+			"key":    key,
+		})
+	}
+
 	err = l.svcCtx.Redis.Setex(key, codeValue, int(expire/time.Second))
 	if err != nil {
 		metrics.CodeRedisErrorTotal.Inc()
 		metrics.CodeSendTotal.WithLabelValues(purpose, "failure").Inc()
 		metrics.CodeSendDuration.WithLabelValues(purpose).Observe(time.Since(start).Seconds())
 		helper.LogError(l.Logger, helper.OpSendCode, "redis set code failed", err, map[string]interface{}{
-			"phone": maskedPhone,
-			"key":   key,
+			"target": masked,
+			"key":    key,
 		})
 		return nil, fmt.Errorf("set code failed: %w", err)
 	}
 
-	l.updateRateLimitCounters(phone, purpose)
+	// 投递验证码；失败时删除已写入的验证码，且不计入发送频率，便于用户立即重试
+	provider, messageID, err := l.deliver(target, getTemplate, codeValue, int(expire/time.Minute))
+	if err != nil {
+		if _, delErr := l.svcCtx.Redis.Del(key); delErr != nil {
+			metrics.CodeRedisErrorTotal.Inc()
+			helper.LogError(l.Logger, helper.OpSendCode, "rollback code after delivery failure failed", delErr, map[string]interface{}{
+				"target": masked,
+				"key":    key,
+			})
+		}
+		metrics.CodeSendTotal.WithLabelValues(purpose, "failure").Inc()
+		metrics.CodeSendDuration.WithLabelValues(purpose).Observe(time.Since(start).Seconds())
+		helper.LogError(l.Logger, helper.OpSendCode, "code delivery failed", err, map[string]interface{}{
+			"channel": target.channel,
+			"target":  masked,
+			"purpose": purpose,
+		})
+		if target.channel == ChannelEmail {
+			return nil, fmt.Errorf("邮件发送失败，请稍后重试")
+		}
+		return nil, fmt.Errorf("短信发送失败，请稍后重试")
+	}
+
+	l.updateRateLimitCounters(target, purpose)
+	l.recordSendRisk(target, device)
 
 	metrics.CodeSendTotal.WithLabelValues(purpose, "success").Inc()
 	metrics.CodeSendDuration.WithLabelValues(purpose).Observe(time.Since(start).Seconds())
 
 	expireAt := time.Now().Add(expire).Unix()
 	helper.LogSuccess(l.Logger, helper.OpSendCode, map[string]interface{}{
-		"phone":     maskedPhone,
-		"purpose":   purpose,
-		"expire_at": expireAt,
-	})
-
-	helper.LogInfo(l.Logger, helper.OpSendCode, "code content", map[string]interface{}{
-		"content": renderTemplate(getTemplate.Content, codeValue, int(expire/time.Minute)),
+		"channel":    target.channel,
+		"target":     masked,
+		"purpose":    purpose,
+		"expire_at":  expireAt,
+		"provider":   provider,
+		"message_id": messageID,
 	})
 
 	return &code.SendCodeResponse{
@@ -116,23 +182,70 @@ func (l *SendCodeLogic) SendCode(in *code.SendCodeRequest) (*code.SendCodeRespon
 	}, nil
 }
 
-func (l *SendCodeLogic) getTemplate(purpose string) struct {
-	CodeLength    int
-	ExpireSeconds int64
-	Content       string
-	MaxDailySends int
-} {
+// deliver 按渠道投递验证码，返回服务商名称与回执流水号
+func (l *SendCodeLogic) deliver(target codeTarget, tpl codeTemplate, codeValue string, expireMinutes int) (string, string, error) {
+	content := renderTemplate(tpl.Content, codeValue, expireMinutes)
+	if target.channel == ChannelEmail {
+		receipt, err := l.svcCtx.Mailer.Send(l.ctx, &email.Message{
+			To:      target.address,
+			Subject: renderTemplate(tpl.Subject, codeValue, expireMinutes),
+			Body:    content,
+			Code:    codeValue,
+		})
+		if err != nil {
+			return "", "", err
+		}
+		return receipt.Provider, receipt.MessageID, nil
+	}
+
+	receipt, err := l.svcCtx.SMS.Send(l.ctx, tpl.ProviderTemplate, &sms.Message{
+		Phone:         target.address,
+		Code:          codeValue,
+		ExpireMinutes: expireMinutes,
+		Content:       content,
+	})
+	if err != nil {
+		return "", "", err
+	}
+	return receipt.Provider, receipt.MessageID, nil
+}
+
+func (l *SendCodeLogic) getTemplate(target codeTarget, purpose string) codeTemplate {
+	if target.channel == ChannelEmail {
+		result := codeTemplate{
+			CodeLength:    defaultCodeLength,
+			ExpireSeconds: defaultEmailExpireSeconds,
+			Content:       defaultEmailContent,
+			Subject:       defaultEmailSubject,
+			MaxDailySends: 10,
+		}
+		if tpl, ok := l.svcCtx.Config.EmailTemplate[purpose]; ok {
+			if tpl.CodeLength > 0 {
+				result.CodeLength = tpl.CodeLength
+			}
+			if tpl.ExpireSeconds > 0 {
+				result.ExpireSeconds = tpl.ExpireSeconds
+			}
+			if tpl.MaxDailySends > 0 {
+				result.MaxDailySends = tpl.MaxDailySends
+			}
+			if tpl.Subject != "" {
+				result.Subject = tpl.Subject
+			}
+			if tpl.ContentTemplate != "" {
+				result.Content = tpl.ContentTemplate
+			}
+		}
+		return result
+	}
+
 	if tpl, ok := l.svcCtx.Config.Template[purpose]; ok {
-		result := struct {
-			CodeLength    int
-			ExpireSeconds int64
-			Content       string
-			MaxDailySends int
-		}{
-			CodeLength:    tpl.CodeLength,
-			ExpireSeconds: tpl.ExpireSeconds,
-			Content:       tpl.ContentTemplate,
-			MaxDailySends: tpl.MaxDailySends,
+		result := codeTemplate{
+			CodeLength:       tpl.CodeLength,
+			ExpireSeconds:    tpl.ExpireSeconds,
+			Content:          tpl.ContentTemplate,
+			MaxDailySends:    tpl.MaxDailySends,
+			ProviderTemplate: tpl.ProviderTemplate,
 		}
 		if result.CodeLength <= 0 {
 			result.CodeLength = defaultCodeLength
@@ -145,12 +258,7 @@ func (l *SendCodeLogic) getTemplate(purpose string) struct {
 		}
 		return result
 	}
-	return struct {
-		CodeLength    int
-		ExpireSeconds int64
-		Content       string
-		MaxDailySends int
-	}{
+	return codeTemplate{
 		CodeLength:    defaultCodeLength,
 		ExpireSeconds: defaultExpireSeconds,
 		Content:       "",
@@ -158,8 +266,21 @@ func (l *SendCodeLogic) getTemplate(purpose string) struct {
 	}
 }
 
+// generateCode 使用 crypto/rand 生成指定位数的数字验证码（允许前导 0）
 func generateCode(length int) (string, error) {
-	return "123456", nil
+	if length <= 0 {
+		length = defaultCodeLength
+	}
+	digits := make([]byte, length)
+	ten := big.NewInt(10)
+	for i := range digits {
+		n, err := rand.Int(rand.Reader, ten)
+		if err != nil {
+			return "", fmt.Errorf("generate random code failed: %w", err)
+		}
+		digits[i] = byte('0' + n.Int64())
+	}
+	return string(digits), nil
 }
 
 func renderTemplate(content string, code string, expireMinutes int) string {
@@ -184,107 +305,122 @@ func renderTemplate(content string, code string, expireMinutes int) string {
 	return buf.String()
 }
 
-func (l *SendCodeLogic) checkPhoneRateLimit(phone, purpose string, maxDailySends int) error {
-	if maxDailySends <= 0 {
-		maxDailySends = 10
+// sendInterval 同一接收方两次发送的最小间隔（秒）
+func (l *SendCodeLogic) sendInterval(target codeTarget) int {
+	cfg := l.svcCtx.Config.RateLimit
+	if target.channel == ChannelEmail {
+		if cfg.EmailSendInterval > 0 {
+			return cfg.EmailSendInterval
+		}
+		return defaultEmailSendInterval
+	}
+	if cfg.PhoneSendInterval > 0 {
+		return cfg.PhoneSendInterval
 	}
+	return defaultPhoneSendInterval
+}
 
-	cfg := l.svcCtx.Config.RateLimit
-	sendInterval := cfg.PhoneSendInterval
-	if sendInterval <= 0 {
-		sendInterval = defaultPhoneSendInterval
+// targetLabel 提示语中的接收方名称
+func targetLabel(target codeTarget) string {
+	if target.channel == ChannelEmail {
+		return "邮箱"
+	}
+	return "手机号"
+}
+
+func (l *SendCodeLogic) checkTargetRateLimit(target codeTarget, purpose string, maxDailySends int) error {
+	if maxDailySends <= 0 {
+		maxDailySends = 10
 	}
 
-	maskedPhone := helper.MaskPhone(phone)
+	masked := target.masked()
+	scope := target.scope()
+	label := targetLabel(target)
 
-	phoneLockKey := fmt.Sprintf("rate:phone:lock:%s", phone)
-	exists, err := l.svcCtx.Redis.Exists(phoneLockKey)
+	lockKey := fmt.Sprintf("rate:%s:lock:%s", scope, target.address)
+	exists, err := l.svcCtx.Redis.Exists(lockKey)
 	if err != nil {
-		helper.LogError(l.Logger, helper.OpSendCode, "check phone lock exists failed", err, map[string]interface{}{
-			"phone": maskedPhone,
+		helper.LogError(l.Logger, helper.OpSendCode, "check send lock exists failed", err, map[string]interface{}{
+			"target": masked,
 		})
 	} else if exists {
-		ttl, err := l.svcCtx.Redis.Ttl(phoneLockKey)
+		ttl, err := l.svcCtx.Redis.Ttl(lockKey)
 		if err != nil {
-			helper.LogError(l.Logger, helper.OpSendCode, "check phone lock ttl failed", err, map[string]interface{}{
-				"phone": maskedPhone,
+			helper.LogError(l.Logger, helper.OpSendCode, "check send lock ttl failed", err, map[string]interface{}{
+				"target": masked,
 			})
-			return fmt.Errorf("手机号发送过于频繁，请稍后再试")
+			return fmt.Errorf("%s发送过于频繁，请稍后再试", label)
 		}
 		if ttl > 0 {
-			metrics.CodeRateLimitTotal.WithLabelValues("phone_interval").Inc()
-			helper.LogWarning(l.Logger, helper.OpSendCode, "rate limited: phone interval", map[string]interface{}{
-				"phone":      maskedPhone,
+			metrics.CodeRateLimitTotal.WithLabelValues(scope + "_interval").Inc()
+			helper.LogWarning(l.Logger, helper.OpSendCode, "rate limited: send interval", map[string]interface{}{
+				"target":     masked,
 				"remain_ttl": ttl,
-				"type":       "phone_interval",
+				"type":       scope + "_interval",
 			})
-			return fmt.Errorf("手机号发送过于频繁，请 %d 秒后再试", ttl)
+			return fmt.Errorf("%s发送过于频繁，请 %d 秒后再试", label, ttl)
 		}
 	}
 
 	today := time.Now().Format("20060102")
-	phoneDailyKey := fmt.Sprintf("rate:phone:daily:%s:%s:%s", phone, purpose, today)
-	countStr, err := l.svcCtx.Redis.Get(phoneDailyKey)
+	dailyKey := fmt.Sprintf("rate:%s:daily:%s:%s:%s", scope, target.address, purpose, today)
+	countStr, err := l.svcCtx.Redis.Get(dailyKey)
 	if err != nil {
-		helper.LogError(l.Logger, helper.OpSendCode, "get phone daily count failed", err, map[string]interface{}{
-			"phone": maskedPhone,
-			"key":   phoneDailyKey,
+		helper.LogError(l.Logger, helper.OpSendCode, "get daily send count failed", err, map[string]interface{}{
+			"target": masked,
+			"key":    dailyKey,
 		})
 	} else {
 		count := 0
 		if countStr != "" {
 			if _, err := fmt.Sscanf(countStr, "%d", &count); err != nil {
-				helper.LogError(l.Logger, helper.OpSendCode, "parse phone daily count failed", err, map[string]interface{}{
-					"phone": maskedPhone,
-					"value": countStr,
+				helper.LogError(l.Logger, helper.OpSendCode, "parse daily send count failed", err, map[string]interface{}{
+					"target": masked,
+					"value":  countStr,
 				})
 			}
 		}
 		if count >= maxDailySends {
-			metrics.CodeRateLimitTotal.WithLabelValues("phone_daily_limit").Inc()
+			metrics.CodeRateLimitTotal.WithLabelValues(scope + "_daily_limit").Inc()
 			helper.LogWarning(l.Logger, helper.OpSendCode, "rate limited: daily limit exceeded", map[string]interface{}{
-				"phone":       maskedPhone,
+				"target":      masked,
 				"daily_count": count,
 				"max_limit":   maxDailySends,
-				"type":        "phone_daily_limit",
+				"type":        scope + "_daily_limit",
 			})
-			return fmt.Errorf("该手机号今日发送次数已达上限（%d次）", maxDailySends)
+			return fmt.Errorf("该%s今日发送次数已达上限（%d次）", label, maxDailySends)
 		}
 	}
 
 	return nil
 }
 
-func (l *SendCodeLogic) updateRateLimitCounters(phone, purpose string) {
-	cfg := l.svcCtx.Config.RateLimit
+func (l *SendCodeLogic) updateRateLimitCounters(target codeTarget, purpose string) {
 	today := time.Now().Format("20060102")
-	maskedPhone := helper.MaskPhone(phone)
-
-	phoneSendInterval := cfg.PhoneSendInterval
-	if phoneSendInterval <= 0 {
-		phoneSendInterval = defaultPhoneSendInterval
-	}
-	phoneLockKey := fmt.Sprintf("rate:phone:lock:%s", phone)
-	if err := l.svcCtx.Redis.Setex(phoneLockKey, "1", phoneSendInterval); err != nil {
-		helper.LogError(l.Logger, helper.OpSendCode, "set phone lock failed", err, map[string]interface{}{
-			"phone": maskedPhone,
-			"key":   phoneLockKey,
+	masked := target.masked()
+	scope := target.scope()
+
+	lockKey := fmt.Sprintf("rate:%s:lock:%s", scope, target.address)
+	if err := l.svcCtx.Redis.Setex(lockKey, "1", l.sendInterval(target)); err != nil {
+		helper.LogError(l.Logger, helper.OpSendCode, "set send lock failed", err, map[string]interface{}{
+			"target": masked,
+			"key":    lockKey,
 		})
 	}
 
-	phoneDailyKey := fmt.Sprintf("rate:phone:daily:%s:%s:%s", phone, purpose, today)
-	_, err := l.svcCtx.Redis.Incr(phoneDailyKey)
+	dailyKey := fmt.Sprintf("rate:%s:daily:%s:%s:%s", scope, target.address, purpose, today)
+	_, err := l.svcCtx.Redis.Incr(dailyKey)
 	if err != nil {
-		helper.LogError(l.Logger, helper.OpSendCode, "incr phone daily count failed", err, map[string]interface{}{
-			"phone": maskedPhone,
-			"key":   phoneDailyKey,
+		helper.LogError(l.Logger, helper.OpSendCode, "incr daily send count failed", err, map[string]interface{}{
+			"target": masked,
+			"key":    dailyKey,
 		})
 	} else {
 		remainingSeconds := 86400 - (time.Now().Unix() % 86400)
-		if err := l.svcCtx.Redis.Expire(phoneDailyKey, int(remainingSeconds)); err != nil {
-			helper.LogError(l.Logger, helper.OpSendCode, "expire phone daily count failed", err, map[string]interface{}{
-				"phone": maskedPhone,
-				"key":   phoneDailyKey,
+		if err := l.svcCtx.Redis.Expire(dailyKey, int(remainingSeconds)); err != nil {
+			helper.LogError(l.Logger, helper.OpSendCode, "expire daily send count failed", err, map[string]interface{}{
+				"target": masked,
+				"key":    dailyKey,
 			})
 		}
 	}
@@ -0,0 +1,66 @@
+package logic
+
+import (
+	"fmt"
+	"strings"
+
+	"SLGaming/back/services/code/internal/email"
+	"SLGaming/back/services/code/internal/helper"
+)
+
+// 验证码发送渠道
+const (
+	ChannelSMS   = "sms"
+	ChannelEmail = "email"
+)
+
+// codeTarget 验证码的接收方：手机号或邮箱
+// 两种渠道的验证码、发送频率和验证次数分别计数，手机号渠道沿用原有的 Redis 键
+type codeTarget struct {
+	channel string
+	address string
+}
+
+// resolveTarget 根据渠道取出接收方并校验格式，渠道为空时按短信处理
+func resolveTarget(channel, phone, mail string) (codeTarget, error) {
+	switch strings.ToLower(strings.TrimSpace(channel)) {
+	case "", ChannelSMS:
+		phone = strings.TrimSpace(phone)
+		if phone == "" {
+			return codeTarget{}, fmt.Errorf("手机号不能为空")
+		}
+		return codeTarget{channel: ChannelSMS, address: phone}, nil
+	case ChannelEmail:
+		addr, ok := email.NormalizeAddress(mail)
+		if !ok {
+			return codeTarget{}, fmt.Errorf("邮箱格式不正确")
+		}
+		return codeTarget{channel: ChannelEmail, address: addr}, nil
+	default:
+		return codeTarget{}, fmt.Errorf("不支持的发送渠道：%s", channel)
+	}
+}
+
+// scope 频率限制键中的维度名
+func (t codeTarget) scope() string {
+	if t.channel == ChannelEmail {
+		return "email"
+	}
+	return "phone"
+}
+
+// codeKey 验证码存储键：短信为 code:{purpose}:{phone}，邮件为 code:email:{purpose}:{email}
+func (t codeTarget) codeKey(purpose string) string {
+	if t.channel == ChannelEmail {
+		return fmt.Sprintf("code:email:%s:%s", purpose, t.address)
+	}
+	return fmt.Sprintf("code:%s:%s", purpose, t.address)
+}
+
+// masked 日志中展示的脱敏接收方
+func (t codeTarget) masked() string {
+	if t.channel == ChannelEmail {
+		return helper.MaskEmail(t.address)
+	}
+	return helper.MaskPhone(t.address)
+}
@@ -2,11 +2,13 @@ package logic
 
 import (
 	"context"
+	"crypto/subtle"
 	"fmt"
 	"net"
 	"strings"
 	"time"
 
+	"SLGaming/back/pkg/verifyticket"
 	"SLGaming/back/services/code/code"
 	"SLGaming/back/services/code/internal/helper"
 	"SLGaming/back/services/code/internal/metrics"
@@ -19,8 +21,32 @@ import (
 const (
 	defaultVerifyPhoneDailyLimit = 50
 	defaultVerifyIPDailyLimit    = 200
+	defaultVerifyMaxAttempts     = 5
 )
 
+// recordFailedAttemptScript 原子地累加验证码错误次数：
+// 错误次数键与验证码同时过期，达到上限后同时删除验证码与计数，强制重新发送
+const recordFailedAttemptScript = `
+local n = redis.call('INCR', KEYS[2])
+if n == 1 then
+	local ttl = redis.call('TTL', KEYS[1])
+	if ttl > 0 then
+		redis.call('EXPIRE', KEYS[2], ttl)
+	else
+		redis.call('EXPIRE', KEYS[2], ARGV[2])
+	end
+end
+if n >= tonumber(ARGV[1]) then
+	redis.call('DEL', KEYS[1], KEYS[2])
+end
+return n
+`
+
+// codeAttemptsKey 验证码错误次数键（如 code:{purpose}:{phone}:attempts），与验证码同时过期
+func codeAttemptsKey(codeKey string) string {
+	return codeKey + ":attempts"
+}
+
 type VerifyCodeLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -37,12 +63,13 @@ func NewVerifyCodeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *Verify
 
 func (l *VerifyCodeLogic) VerifyCode(in *code.VerifyCodeRequest) (*code.VerifyCodeResponse, error) {
 	start := time.Now()
-	phone := strings.TrimSpace(in.GetPhone())
-	if phone == "" {
-		phone = "unknown"
-	}
 	purpose := in.GetPurpose()
-	maskedPhone := helper.MaskPhone(phone)
+	target, err := resolveTarget(in.GetChannel(), in.GetPhone(), in.GetEmail())
+	if err != nil {
+		metrics.CodeVerifyTotal.WithLabelValues("failure").Inc()
+		return nil, err
+	}
+	maskedTarget := target.masked()
 
 	clientIP := l.getClientIP()
 	if clientIP == "" {
@@ -50,13 +77,14 @@ func (l *VerifyCodeLogic) VerifyCode(in *code.VerifyCodeRequest) (*code.VerifyCo
 	}
 
 	helper.LogRequest(l.Logger, helper.OpVerifyCode, map[string]interface{}{
-		"phone":     maskedPhone,
+		"channel":   target.channel,
+		"target":    maskedTarget,
 		"purpose":   purpose,
 		"client_ip": clientIP,
 	})
 
-	if err := l.checkVerifyPhoneDailyLimit(phone); err != nil {
-		metrics.CodeRateLimitTotal.WithLabelValues("verify_phone_daily").Inc()
+	if err := l.checkVerifyTargetDailyLimit(target); err != nil {
+		metrics.CodeRateLimitTotal.WithLabelValues("verify_" + target.scope() + "_daily").Inc()
 		metrics.CodeVerifyTotal.WithLabelValues("failure").Inc()
 		metrics.CodeVerifyDuration.Observe(time.Since(start).Seconds())
 		return nil, err
@@ -68,69 +96,142 @@ func (l *VerifyCodeLogic) VerifyCode(in *code.VerifyCodeRequest) (*code.VerifyCo
 		return nil, err
 	}
 
-	key := fmt.Sprintf("code:%s:%s", purpose, phone)
+	key := target.codeKey(purpose)
 	val, err := l.svcCtx.Redis.Get(key)
 	if err != nil {
 		helper.LogError(l.Logger, helper.OpVerifyCode, "redis get code failed", err, map[string]interface{}{
-			"phone": maskedPhone,
-			"key":   key,
+			"target": maskedTarget,
+			"key":    key,
 		})
 		metrics.CodeRedisErrorTotal.Inc()
 		metrics.CodeVerifyTotal.WithLabelValues("failure").Inc()
 		metrics.CodeVerifyDuration.Observe(time.Since(start).Seconds())
-		l.recordVerifyPhoneUsage(phone)
+		l.recordVerifyTargetUsage(target)
 		l.recordVerifyIPUsage(clientIP)
 		return &code.VerifyCodeResponse{
 			Passed: false,
 		}, nil
 	}
 
-	passed := val == in.GetCode()
-	if passed {
-		_, err := l.svcCtx.Redis.Del(key)
+	resp := &code.VerifyCodeResponse{}
+	input := strings.TrimSpace(in.GetCode())
+	switch {
+	case val == "":
+		// 验证码不存在、已过期或已因错误次数过多被作废
+		resp.NeedResend = true
+		metrics.CodeVerifyTotal.WithLabelValues("failure").Inc()
+	case input != "" && subtle.ConstantTimeCompare([]byte(val), []byte(input)) == 1:
+		// 签发一次性票据，用户服务凭票据确认手机号/邮箱归属，不再依赖网关的调用顺序
+		cfg := l.svcCtx.Config.VerifyTicket
+		ticket, claims, err := verifyticket.Issue(cfg.Secret, target.address, purpose, time.Duration(cfg.TTL)*time.Second)
+		if err != nil {
+			helper.LogError(l.Logger, helper.OpVerifyCode, "issue verify ticket failed", err, map[string]interface{}{
+				"target":  maskedTarget,
+				"purpose": purpose,
+			})
+			metrics.CodeVerifyTotal.WithLabelValues("failure").Inc()
+			metrics.CodeVerifyDuration.Observe(time.Since(start).Seconds())
+			return nil, fmt.Errorf("验证服务暂时不可用，请稍后重试")
+		}
+		// 删除成功才算本次校验消费了验证码，避免并发请求用同一验证码换到多张票据
+		deleted, err := l.svcCtx.Redis.Del(key)
 		if err != nil {
 			helper.LogError(l.Logger, helper.OpVerifyCode, "redis del code failed", err, map[string]interface{}{
-				"phone": maskedPhone,
-				"key":   key,
+				"target": maskedTarget,
+				"key":    key,
 			})
+			metrics.CodeRedisErrorTotal.Inc()
+			metrics.CodeVerifyTotal.WithLabelValues("failure").Inc()
+			metrics.CodeVerifyDuration.Observe(time.Since(start).Seconds())
+			return nil, fmt.Errorf("验证服务暂时不可用，请稍后重试")
 		}
+		if deleted == 0 {
+			resp.NeedResend = true
+			metrics.CodeVerifyTotal.WithLabelValues("failure").Inc()
+			break
+		}
+		if _, err := l.svcCtx.Redis.Del(codeAttemptsKey(key)); err != nil {
+			metrics.CodeRedisErrorTotal.Inc()
+		}
+		resp.Passed = true
+		resp.Ticket = ticket
+		resp.TicketExpireAt = claims.ExpiresAt
 		metrics.CodeVerifyTotal.WithLabelValues("success").Inc()
-	} else {
+	default:
+		resp.RemainingAttempts, resp.NeedResend = l.recordFailedAttempt(key, maskedTarget)
 		metrics.CodeVerifyTotal.WithLabelValues("failure").Inc()
 	}
 
 	metrics.CodeVerifyDuration.Observe(time.Since(start).Seconds())
-	l.recordVerifyPhoneUsage(phone)
+	l.recordVerifyTargetUsage(target)
 	l.recordVerifyIPUsage(clientIP)
 
 	helper.LogSuccess(l.Logger, helper.OpVerifyCode, map[string]interface{}{
-		"phone":     maskedPhone,
-		"purpose":   purpose,
-		"passed":    passed,
-		"client_ip": clientIP,
+		"channel":            target.channel,
+		"target":             maskedTarget,
+		"purpose":            purpose,
+		"passed":             resp.Passed,
+		"remaining_attempts": resp.RemainingAttempts,
+		"need_resend":        resp.NeedResend,
+		"client_ip":          clientIP,
 	})
 
-	return &code.VerifyCodeResponse{
-		Passed: passed,
-	}, nil
+	return resp, nil
+}
+
+// recordFailedAttempt 记录一次错误尝试，返回剩余次数以及验证码是否已被作废
+func (l *VerifyCodeLogic) recordFailedAttempt(key, maskedTarget string) (int32, bool) {
+	maxAttempts := l.svcCtx.Config.RateLimit.VerifyMaxAttempts
+	if maxAttempts <= 0 {
+		maxAttempts = defaultVerifyMaxAttempts
+	}
+
+	result, err := l.svcCtx.Redis.Eval(recordFailedAttemptScript, []string{key, codeAttemptsKey(key)},
+		maxAttempts, defaultExpireSeconds)
+	if err != nil {
+		metrics.CodeRedisErrorTotal.Inc()
+		helper.LogError(l.Logger, helper.OpVerifyCode, "record failed attempt failed", err, map[string]interface{}{
+			"target": maskedTarget,
+			"key":    key,
+		})
+		// 计数失败时作废验证码，宁可让用户重新获取也不放开猜测次数
+		if _, delErr := l.svcCtx.Redis.Del(key); delErr != nil {
+			metrics.CodeRedisErrorTotal.Inc()
+		}
+		return 0, true
+	}
+
+	attempts, _ := result.(int64)
+	remaining := int64(maxAttempts) - attempts
+	if remaining <= 0 {
+		metrics.CodeRateLimitTotal.WithLabelValues("verify_code_attempts").Inc()
+		helper.LogWarning(l.Logger, helper.OpVerifyCode, "code invalidated: too many failed attempts", map[string]interface{}{
+			"target":       maskedTarget,
+			"attempts":     attempts,
+			"max_attempts": maxAttempts,
+			"type":         "verify_code_attempts",
+		})
+		return 0, true
+	}
+	return int32(remaining), false
 }
 
-func (l *VerifyCodeLogic) checkVerifyPhoneDailyLimit(phone string) error {
+func (l *VerifyCodeLogic) checkVerifyTargetDailyLimit(target codeTarget) error {
 	cfg := l.svcCtx.Config.RateLimit
 	dailyLimit := cfg.VerifyPhoneDailyLimit
 	if dailyLimit <= 0 {
 		dailyLimit = defaultVerifyPhoneDailyLimit
 	}
 
-	maskedPhone := helper.MaskPhone(phone)
+	maskedTarget := target.masked()
 	today := time.Now().Format("20060102")
-	key := fmt.Sprintf("verify:phone:daily:%s:%s", phone, today)
+	key := fmt.Sprintf("verify:%s:daily:%s:%s", target.scope(), target.address, today)
 
 	countStr, err := l.svcCtx.Redis.Get(key)
 	if err != nil {
-		helper.LogError(l.Logger, helper.OpVerifyCode, "get verify phone daily count failed", err, map[string]interface{}{
-			"phone": maskedPhone,
-			"key":   key,
+		helper.LogError(l.Logger, helper.OpVerifyCode, "get verify daily count failed", err, map[string]interface{}{
+			"target": maskedTarget,
+			"key":    key,
 		})
 		return nil
 	}
@@ -138,21 +239,21 @@ func (l *VerifyCodeLogic) checkVerifyPhoneDailyLimit(phone string) error {
 	count := 0
 	if countStr != "" {
 		if _, err := fmt.Sscanf(countStr, "%d", &count); err != nil {
-			helper.LogError(l.Logger, helper.OpVerifyCode, "parse verify phone daily count failed", err, map[string]interface{}{
-				"phone": maskedPhone,
-				"value": countStr,
+			helper.LogError(l.Logger, helper.OpVerifyCode, "parse verify daily count failed", err, map[string]interface{}{
+				"target": maskedTarget,
+				"value":  countStr,
 			})
 		}
 	}
 
 	if count >= dailyLimit {
-		helper.LogWarning(l.Logger, helper.OpVerifyCode, "rate limited: phone daily limit exceeded", map[string]interface{}{
-			"phone":       maskedPhone,
+		helper.LogWarning(l.Logger, helper.OpVerifyCode, "rate limited: daily verify limit exceeded", map[string]interface{}{
+			"target":      maskedTarget,
 			"daily_count": count,
 			"max_limit":   dailyLimit,
-			"type":        "verify_phone_daily",
+			"type":        "verify_" + target.scope() + "_daily",
 		})
-		return fmt.Errorf("该手机号今日验证次数已达上限（%d次）", dailyLimit)
+		return fmt.Errorf("该%s今日验证次数已达上限（%d次）", targetLabel(target), dailyLimit)
 	}
 
 	return nil
@@ -200,24 +301,24 @@ func (l *VerifyCodeLogic) checkVerifyIPDailyLimit(ip string) error {
 	return nil
 }
 
-func (l *VerifyCodeLogic) recordVerifyPhoneUsage(phone string) {
+func (l *VerifyCodeLogic) recordVerifyTargetUsage(target codeTarget) {
 	today := time.Now().Format("20060102")
-	key := fmt.Sprintf("verify:phone:daily:%s:%s", phone, today)
-	maskedPhone := helper.MaskPhone(phone)
+	key := fmt.Sprintf("verify:%s:daily:%s:%s", target.scope(), target.address, today)
+	maskedTarget := target.masked()
 
 	if _, err := l.svcCtx.Redis.Incr(key); err != nil {
-		helper.LogError(l.Logger, helper.OpVerifyCode, "incr verify phone daily count failed", err, map[string]interface{}{
-			"phone": maskedPhone,
-			"key":   key,
+		helper.LogError(l.Logger, helper.OpVerifyCode, "incr verify daily count failed", err, map[string]interface{}{
+			"target": maskedTarget,
+			"key":    key,
 		})
 		return
 	}
 
 	remainingSeconds := 86400 - int(time.Now().Unix()%86400)
 	if err := l.svcCtx.Redis.Expire(key, remainingSeconds); err != nil {
-		helper.LogError(l.Logger, helper.OpVerifyCode, "expire verify phone daily count failed", err, map[string]interface{}{
-			"phone": maskedPhone,
-			"key":   key,
+		helper.LogError(l.Logger, helper.OpVerifyCode, "expire verify daily count failed", err, map[string]interface{}{
+			"target": maskedTarget,
+			"key":    key,
 		})
 	}
 }
@@ -51,6 +51,62 @@ var (
 		[]string{"type"},
 	)
 
+	// CodeSMSSendTotal 短信下发结果（accepted=服务商已受理并返回回执, failed=调用失败或被拒绝）
+	CodeSMSSendTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "code_sms_send_total",
+			Help: "Total number of SMS delivery attempts by provider and result",
+		},
+		[]string{"provider", "status"},
+	)
+
+	// CodeSMSSendDuration 调用短信服务商的耗时
+	CodeSMSSendDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "code_sms_send_duration_seconds",
+			Help:    "Duration of SMS provider calls in seconds",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"provider"},
+	)
+
+	// CodeSMSProviderErrorTotal 短信服务商返回的错误（按服务商错误码）
+	CodeSMSProviderErrorTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "code_sms_provider_error_total",
+			Help: "Total number of SMS provider errors by error code",
+		},
+		[]string{"provider", "code"},
+	)
+
+	// CodeEmailSendTotal 邮件发送结果：provider × status(accepted/failed)
+	CodeEmailSendTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "code_email_send_total",
+			Help: "Total number of verification emails sent by provider and status",
+		},
+		[]string{"provider", "status"},
+	)
+
+	// CodeEmailSendDuration 调用邮件服务耗时
+	CodeEmailSendDuration = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "code_email_send_duration_seconds",
+			Help:    "Duration of email provider calls in seconds",
+			Buckets: prometheus.DefBuckets,
+		},
+		[]string{"provider"},
+	)
+
+	// CodeChallengeTotal 人机验证：发送时要求验证（required，按触发原因）、令牌通过（token_accepted）、题目作答（passed/failed）
+	CodeChallengeTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "code_challenge_total",
+			Help: "Total number of human verification challenge events by result and reason",
+		},
+		[]string{"result", "reason"},
+	)
+
 	// CodeRedisErrorTotal Redis 错误总数
 	CodeRedisErrorTotal = prometheus.NewCounter(
 		prometheus.CounterOpts{
@@ -67,4 +123,10 @@ func init() {
 	prometheus.MustRegister(CodeVerifyDuration)
 	prometheus.MustRegister(CodeRateLimitTotal)
 	prometheus.MustRegister(CodeRedisErrorTotal)
+	prometheus.MustRegister(CodeSMSSendTotal)
+	prometheus.MustRegister(CodeSMSSendDuration)
+	prometheus.MustRegister(CodeSMSProviderErrorTotal)
+	prometheus.MustRegister(CodeEmailSendTotal)
+	prometheus.MustRegister(CodeEmailSendDuration)
+	prometheus.MustRegister(CodeChallengeTotal)
 }
@@ -32,3 +32,13 @@ func (s *CodeServer) VerifyCode(ctx context.Context, in *code.VerifyCodeRequest)
 	l := logic.NewVerifyCodeLogic(ctx, s.svcCtx)
 	return l.VerifyCode(in)
 }
+
+func (s *CodeServer) CreateChallenge(ctx context.Context, in *code.CreateChallengeRequest) (*code.CreateChallengeResponse, error) {
+	l := logic.NewCreateChallengeLogic(ctx, s.svcCtx)
+	return l.CreateChallenge(in)
+}
+
+func (s *CodeServer) VerifyChallenge(ctx context.Context, in *code.VerifyChallengeRequest) (*code.VerifyChallengeResponse, error) {
+	l := logic.NewVerifyChallengeLogic(ctx, s.svcCtx)
+	return l.VerifyChallenge(in)
+}
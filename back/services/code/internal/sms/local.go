@@ -0,0 +1,94 @@
+package sms
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"SLGaming/back/services/code/internal/helper"
+
+	"github.com/google/uuid"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ConsoleSender 本地开发用：只在日志中记录发送了一条短信，不真正发送，也不记录内容（内容含验证码）
+// 需要读取验证码时使用 FileSender
+type ConsoleSender struct{}
+
+func NewConsoleSender() *ConsoleSender {
+	return &ConsoleSender{}
+}
+
+func (s *ConsoleSender) Name() string {
+	return ProviderConsole
+}
+
+func (s *ConsoleSender) Send(ctx context.Context, msg *Message) (*Receipt, error) {
+	id := uuid.NewString()
+	helper.LogInfo(logx.WithContext(ctx), helper.OpSendCode, "sms (console)", map[string]interface{}{
+		"phone":      helper.MaskPhone(msg.Phone),
+		"template":   msg.TemplateID,
+		"message_id": id,
+	})
+	return &Receipt{Provider: ProviderConsole, MessageID: id}, nil
+}
+
+// FileSender 本地开发/联调用：把短信追加写入文件（每行一条 JSON），便于脚本读取验证码
+type FileSender struct {
+	path string
+	mu   sync.Mutex
+}
+
+func NewFileSender(path string) *FileSender {
+	if path == "" {
+		path = "logs/sms.log"
+	}
+	return &FileSender{path: path}
+}
+
+func (s *FileSender) Name() string {
+	return ProviderFile
+}
+
+// fileRecord 文件中的一条记录
+type fileRecord struct {
+	MessageID string `json:"message_id"`
+	Phone     string `json:"phone"`
+	Template  string `json:"template"`
+	Code      string `json:"code"`
+	Content   string `json:"content"`
+	SentAt    int64  `json:"sent_at"`
+}
+
+func (s *FileSender) Send(ctx context.Context, msg *Message) (*Receipt, error) {
+	record := fileRecord{
+		MessageID: uuid.NewString(),
+		Phone:     msg.Phone,
+		Template:  msg.TemplateID,
+		Code:      msg.Code,
+		Content:   msg.Content,
+		SentAt:    time.Now().Unix(),
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o755); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(s.path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0o600)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		return nil, err
+	}
+	return &Receipt{Provider: ProviderFile, MessageID: record.MessageID}, nil
+}
@@ -0,0 +1,179 @@
+package sms
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"SLGaming/back/services/code/internal/config"
+	"SLGaming/back/services/code/internal/metrics"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/service"
+)
+
+// 服务商名称（ProviderTemplate 的前缀）
+const (
+	ProviderAliyun  = "aliyun"
+	ProviderTencent = "tencent"
+	ProviderConsole = "console"
+	ProviderFile    = "file"
+)
+
+// Message 一条待发送的验证码短信
+type Message struct {
+	Phone         string
+	TemplateID    string // 服务商模板 ID（已去掉 provider 前缀）
+	Code          string
+	ExpireMinutes int
+	Content       string // 按 ContentTemplate 渲染后的完整内容（含验证码，只允许写入 dev 模式的短信文件，不得写入日志）
+}
+
+// Receipt 服务商受理回执
+type Receipt struct {
+	Provider  string
+	MessageID string // 服务商返回的流水号，用于对账和排查
+}
+
+// ProviderError 服务商拒绝发送（如模板未审核、号码格式错误、触发服务商流控）
+type ProviderError struct {
+	Provider string
+	Code     string
+	Message  string
+}
+
+func (e *ProviderError) Error() string {
+	return fmt.Sprintf("%s sms rejected: code=%s, message=%s", e.Provider, e.Code, e.Message)
+}
+
+// SMSSender 短信服务商
+type SMSSender interface {
+	// Name 服务商名称
+	Name() string
+	// Send 发送一条短信，服务商受理后返回回执
+	Send(ctx context.Context, msg *Message) (*Receipt, error)
+}
+
+var (
+	// ErrUnknownProvider ProviderTemplate 指定的服务商未配置
+	ErrUnknownProvider = errors.New("sms provider not configured")
+	// ErrNoDefaultProvider 非 dev 模式下未配置默认服务商
+	ErrNoDefaultProvider = errors.New("sms default provider not configured")
+	// ErrLocalProvider 控制台与文件发送不会真正下发短信，且会在本地留下验证码，只允许 dev 模式使用
+	ErrLocalProvider = errors.New("console and file sms providers are only allowed in dev mode")
+)
+
+// ResolveDefaultProvider 校验并返回默认服务商（未在 ProviderTemplate 中指定服务商的模板使用）
+// dev 模式下未配置时使用文件发送；其他模式下必须配置阿里云或腾讯云，且对应密钥已配置
+func ResolveDefaultProvider(c config.SMSConf, mode string) (string, error) {
+	provider := strings.ToLower(strings.TrimSpace(c.DefaultProvider))
+	local := mode == service.DevMode
+	switch provider {
+	case "":
+		if !local {
+			return "", ErrNoDefaultProvider
+		}
+		return ProviderFile, nil
+	case ProviderConsole, ProviderFile:
+		if !local {
+			return "", ErrLocalProvider
+		}
+	case ProviderAliyun:
+		if c.Aliyun.AccessKeyId == "" {
+			return "", fmt.Errorf("%w: %s", ErrUnknownProvider, provider)
+		}
+	case ProviderTencent:
+		if c.Tencent.SecretId == "" {
+			return "", fmt.Errorf("%w: %s", ErrUnknownProvider, provider)
+		}
+	default:
+		return "", fmt.Errorf("%w: %s", ErrUnknownProvider, provider)
+	}
+	return provider, nil
+}
+
+// Dispatcher 根据模板的 ProviderTemplate 选择服务商发送，并记录发送指标
+type Dispatcher struct {
+	senders         map[string]SMSSender
+	defaultProvider string
+	timeout         time.Duration
+}
+
+// NewDispatcher 按配置创建所有可用的服务商，默认服务商需先经 ResolveDefaultProvider 校验
+// 控制台与文件发送只在 dev 模式下注册；阿里云、腾讯云只有在配置了密钥时才会创建
+func NewDispatcher(c config.SMSConf, mode string) *Dispatcher {
+	d := &Dispatcher{
+		senders:         make(map[string]SMSSender),
+		defaultProvider: strings.ToLower(strings.TrimSpace(c.DefaultProvider)),
+		timeout:         time.Duration(c.Timeout) * time.Second,
+	}
+	if d.timeout <= 0 {
+		d.timeout = 5 * time.Second
+	}
+
+	if mode == service.DevMode {
+		d.Register(NewConsoleSender())
+		d.Register(NewFileSender(c.File.Path))
+	}
+	if c.Aliyun.AccessKeyId != "" {
+		sender, err := NewAliyunSender(c.Aliyun, d.timeout)
+		if err != nil {
+			logx.Errorf("[sms] init aliyun sender failed: %v", err)
+		} else {
+			d.Register(sender)
+		}
+	}
+	if c.Tencent.SecretId != "" {
+		d.Register(NewTencentSender(c.Tencent, d.timeout))
+	}
+	return d
+}
+
+// Register 注册（或替换）一个服务商
+func (d *Dispatcher) Register(sender SMSSender) {
+	d.senders[sender.Name()] = sender
+}
+
+// Resolve 解析 ProviderTemplate（provider:templateId），返回服务商与模板 ID
+func (d *Dispatcher) Resolve(providerTemplate string) (SMSSender, string, error) {
+	provider, templateID := d.defaultProvider, strings.TrimSpace(providerTemplate)
+	if name, id, ok := strings.Cut(templateID, ":"); ok {
+		provider, templateID = strings.ToLower(strings.TrimSpace(name)), strings.TrimSpace(id)
+	}
+	sender, ok := d.senders[provider]
+	if !ok {
+		return nil, "", fmt.Errorf("%w: %s", ErrUnknownProvider, provider)
+	}
+	return sender, templateID, nil
+}
+
+// Send 选择服务商发送短信，记录受理回执与失败指标
+func (d *Dispatcher) Send(ctx context.Context, providerTemplate string, msg *Message) (*Receipt, error) {
+	sender, templateID, err := d.Resolve(providerTemplate)
+	if err != nil {
+		metrics.CodeSMSSendTotal.WithLabelValues("unknown", "failed").Inc()
+		return nil, err
+	}
+	msg.TemplateID = templateID
+
+	ctx, cancel := context.WithTimeout(ctx, d.timeout)
+	defer cancel()
+
+	start := time.Now()
+	receipt, err := sender.Send(ctx, msg)
+	metrics.CodeSMSSendDuration.WithLabelValues(sender.Name()).Observe(time.Since(start).Seconds())
+	if err != nil {
+		metrics.CodeSMSSendTotal.WithLabelValues(sender.Name(), "failed").Inc()
+		errCode := "request_failed"
+		var pe *ProviderError
+		if errors.As(err, &pe) {
+			errCode = pe.Code
+		}
+		metrics.CodeSMSProviderErrorTotal.WithLabelValues(sender.Name(), errCode).Inc()
+		return nil, err
+	}
+	metrics.CodeSMSSendTotal.WithLabelValues(sender.Name(), "accepted").Inc()
+	return receipt, nil
+}
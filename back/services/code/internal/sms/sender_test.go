@@ -0,0 +1,64 @@
+package sms
+
+import (
+	"testing"
+
+	"SLGaming/back/services/code/internal/config"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeromicro/go-zero/core/service"
+)
+
+func TestResolveDefaultProvider(t *testing.T) {
+	aliyun := config.AliyunSMSConf{AccessKeyId: "ak"}
+
+	tests := []struct {
+		name    string
+		conf    config.SMSConf
+		mode    string
+		want    string
+		wantErr error
+	}{
+		{name: "dev 模式未配置时写入文件", mode: service.DevMode, want: ProviderFile},
+		{name: "dev 模式允许控制台", conf: config.SMSConf{DefaultProvider: "Console"}, mode: service.DevMode, want: ProviderConsole},
+		{name: "生产模式未配置", mode: service.ProMode, wantErr: ErrNoDefaultProvider},
+		{name: "生产模式拒绝控制台", conf: config.SMSConf{DefaultProvider: ProviderConsole}, mode: service.ProMode, wantErr: ErrLocalProvider},
+		{name: "测试模式拒绝文件", conf: config.SMSConf{DefaultProvider: ProviderFile}, mode: service.TestMode, wantErr: ErrLocalProvider},
+		{name: "阿里云未配置密钥", conf: config.SMSConf{DefaultProvider: ProviderAliyun}, mode: service.ProMode, wantErr: ErrUnknownProvider},
+		{name: "阿里云", conf: config.SMSConf{DefaultProvider: ProviderAliyun, Aliyun: aliyun}, mode: service.ProMode, want: ProviderAliyun},
+		{name: "未知服务商", conf: config.SMSConf{DefaultProvider: "foo"}, mode: service.DevMode, wantErr: ErrUnknownProvider},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ResolveDefaultProvider(tt.conf, tt.mode)
+			if tt.wantErr != nil {
+				assert.ErrorIs(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestDispatcherLocalProvidersOnlyInDev(t *testing.T) {
+	conf := config.SMSConf{DefaultProvider: ProviderTencent, Tencent: config.TencentSMSConf{SecretId: "id"}}
+
+	for _, provider := range []string{ProviderConsole, ProviderFile} {
+		_, _, err := NewDispatcher(conf, service.ProMode).Resolve(provider + ":SMS_1")
+		assert.ErrorIs(t, err, ErrUnknownProvider, provider)
+
+		sender, id, err := NewDispatcher(conf, service.DevMode).Resolve(provider + ":SMS_1")
+		require.NoError(t, err, provider)
+		assert.Equal(t, provider, sender.Name())
+		assert.Equal(t, "SMS_1", id)
+	}
+
+	// 不带前缀的模板使用默认服务商
+	sender, id, err := NewDispatcher(conf, service.ProMode).Resolve("SMS_2")
+	require.NoError(t, err)
+	assert.Equal(t, ProviderTencent, sender.Name())
+	assert.Equal(t, "SMS_2", id)
+}
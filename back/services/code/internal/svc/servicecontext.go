@@ -1,19 +1,29 @@
 package svc
 
 import (
+	"SLGaming/back/services/code/internal/captcha"
 	"SLGaming/back/services/code/internal/config"
+	"SLGaming/back/services/code/internal/email"
+	"SLGaming/back/services/code/internal/sms"
 
 	"github.com/zeromicro/go-zero/core/stores/redis"
 )
 
 type ServiceContext struct {
-	Config config.Config
-	Redis  *redis.Redis
+	Config  config.Config
+	Redis   *redis.Redis
+	SMS     *sms.Dispatcher
+	Mailer  *email.Mailer
+	Captcha *captcha.Store
 }
 
 func NewServiceContext(c config.Config) *ServiceContext {
+	rds := redis.MustNewRedis(c.Redis.RedisConf)
 	return &ServiceContext{
-		Config: c,
-		Redis:  redis.MustNewRedis(c.Redis.RedisConf),
+		Config:  c,
+		Redis:   rds,
+		SMS:     sms.NewDispatcher(c.SMS, c.Mode),
+		Mailer:  email.NewMailer(c.Email),
+		Captcha: captcha.NewStore(rds),
 	}
 }
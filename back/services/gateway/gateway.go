@@ -25,6 +25,7 @@ import (
 	"strings"
 	"sync"
 	"syscall"
+	"time"
 
 	pkgIoc "SLGaming/back/pkg/ioc"
 	_ "SLGaming/back/services/gateway/docs"
@@ -49,6 +50,12 @@ func main() {
 	// 加载配置（支持从 Nacos 加载）
 	c := ioc.LoadConfig(*configFile)
 
+	// 客户端 IP 只采信可信反向代理写入的转发头
+	if err := middleware.SetTrustedProxies(c.TrustedProxies); err != nil {
+		helper.LogError(logger, helper.OpServer, "invalid trusted proxies", err, nil)
+		os.Exit(1)
+	}
+
 	// 创建 REST 服务器
 	server := rest.MustNewServer(c.RestConf)
 
@@ -94,13 +101,28 @@ func main() {
 	server.Use(middleware.CORSMiddleware(nil))
 
 	// 全局应用限流中间件（在鉴权之前，避免无效请求占用资源）
-	// 使用可管理的限流器，支持优雅停止
-	rateLimiterMiddleware = middleware.NewRateLimiterMiddleware(&c.RateLimit)
+	// 使用可管理的限流器，支持优雅停止；配置 Redis 时多副本共享配额
+	var rateLimitOpts []middleware.RateLimiterOption
+	if c.RateLimit.Distributed && ctx.CacheRedis != nil {
+		rateLimitOpts = append(rateLimitOpts, middleware.WithRedis(ctx.CacheRedis))
+	}
+	rateLimiterMiddleware = middleware.NewRateLimiterMiddleware(&c.RateLimit, rateLimitOpts...)
 	server.Use(rateLimiterMiddleware.Handler)
+	ctx.RateLimit = rateLimiterMiddleware
+
+	// 监听 Nacos 上的限流规则，校验通过后原子替换，无需重启
+	ioc.WatchRateLimitConfig(c.Nacos, c.RateLimit, rateLimiterMiddleware.UpdateRules)
 
 	// 全局应用鉴权中间件（公开接口会在中间件中自动跳过）
 	server.Use(middleware.AuthMiddleware(ctx))
 
+	// 基于角色的访问控制（依赖鉴权中间件写入的角色），拒绝记录写入审计
+	server.Use(middleware.RBACMiddleware(ctx, &c.RBAC))
+
+	// 用户级别限流需要在鉴权之后才能拿到用户 ID，会员按权益倍数放大配额
+	rateLimiterMiddleware.SetUserQuotaProvider(middleware.NewVipTierCache(ctx, time.Minute))
+	server.Use(rateLimiterMiddleware.UserHandler)
+
 	// 注册路由处理器
 	handler.RegisterHandlers(server, ctx)
 
@@ -20,6 +20,9 @@ type Config struct {
 	Upload    UploadConf    `json:",optional"` // 上传配置
 	Alipay    AlipayConf    `json:",optional"` // 支付宝配置
 	RocketMQ  RocketMQConf  `json:",optional"` // RocketMQ 配置
+	RBAC      RBACConf      `json:",optional"` // 基于角色的访问控制配置
+	// 可信反向代理（IP 或 CIDR），只有来自这些地址的请求才采信 X-Forwarded-For / X-Real-IP，为空时使用连接对端地址
+	TrustedProxies []string `json:",optional"`
 }
 
 // JWTConf JWT 配置
@@ -27,6 +30,12 @@ type JWTConf struct {
 	SecretKey            string        `json:",optional"`         // JWT 密钥
 	AccessTokenDuration  time.Duration `json:",default=600s"`     // Access Token 过期时间，默认 10 分钟
 	RefreshTokenDuration time.Duration `json:",default=1209600s"` // Refresh Token 过期时间，默认 14 天
+	// Refresh Token 轮换后，旧 token 在该时间内再次提交视为并发刷新而非盗用
+	RefreshReuseGrace time.Duration `json:",default=10s"`
+	// 每个用户同时登录的最大设备数，超出时踢掉最早登录的设备，<=0 表示不限制
+	MaxSessions int `json:",default=5"`
+	// 按角色覆盖最大设备数，key 为 boss / companion / admin
+	RoleMaxSessions map[string]int `json:",optional"`
 }
 
 // NacosConf Nacos 配置结构
@@ -73,9 +82,28 @@ type RedisConf struct {
 
 // RateLimitConf 限流配置
 type RateLimitConf struct {
-	Enabled   bool                 `json:",default=true"` // 是否启用限流
-	GlobalQPS int                  `json:",default=2000"` // 全局 QPS 限制
-	Routes    []RouteRateLimitConf `json:",optional"`     // 路由级别的限流配置
+	Enabled   bool `json:",default=true"` // 是否启用限流
+	GlobalQPS int  `json:",default=2000"` // 全局 QPS 限制
+	// 使用 Redis 分布式计数（多副本共享配额），Redis 不可用时自动降级为本地令牌桶
+	Distributed bool `json:",default=true"`
+	// 限流规则热更新的 Nacos DataId（与 Nacos.DataId 同组同命名空间），为空则不监听
+	// 内容与本地 RateLimit 段格式相同；Distributed 修改需重启生效
+	NacosDataId string               `json:",optional"`
+	Routes      []RouteRateLimitConf `json:",optional"` // 路由级别的限流配置
+}
+
+// RBACConf 基于角色的访问控制配置
+// /api/admin/ 下的接口即使没有配置规则也仅允许管理员访问
+type RBACConf struct {
+	Enabled bool           `json:",default=true"` // 是否启用
+	Rules   []RBACRuleConf `json:",optional"`     // 路由权限规则
+}
+
+// RBACRuleConf 路由权限规则
+type RBACRuleConf struct {
+	Path   string   // 路径，支持以 /* 结尾的前缀匹配，如 /api/admin/*
+	Method string   `json:",optional"` // HTTP 方法，为空表示所有方法
+	Roles  []string // 允许访问的角色：boss / companion / admin
 }
 
 // UploadConf 上传配置
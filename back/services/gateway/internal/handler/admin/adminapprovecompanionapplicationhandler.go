@@ -0,0 +1,47 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/admin"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// AdminApproveCompanionApplicationHandler 通过陪玩入驻申请
+// @Summary 通过入驻申请
+// @Description 通过待审核的入驻申请：申请人升级为陪玩，写入申请的技能并标记认证，审核结果通知申请人；申请人需重新登录或刷新 Token 后获得陪玩权限（仅管理员）
+// @Tags 管理后台
+// @Accept json
+// @Produce json
+// @Param request body types.AdminApproveCompanionApplicationRequest true "审核请求"
+// @Success 200 {object} types.AdminReviewCompanionApplicationResponse "成功"
+// @Failure 400 {object} types.BaseResp "参数错误或申请已审核"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Failure 403 {object} types.BaseResp "无权限"
+// @Failure 404 {object} types.BaseResp "申请不存在"
+// @Router /api/admin/companion-applications/approve [post]
+// @Security BearerAuth
+func AdminApproveCompanionApplicationHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AdminApproveCompanionApplicationRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := admin.NewAdminApproveCompanionApplicationLogic(r.Context(), svcCtx)
+		resp, err := l.AdminApproveCompanionApplication(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/admin"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// AdminBanUserHandler 管理员封禁用户
+// @Summary 封禁用户
+// @Description 封禁指定用户：撤销其全部 Token 并禁止登录，陪玩从列表和推荐中隐藏；duration 为封禁时长（秒），0 表示永久（仅管理员）
+// @Tags 管理后台
+// @Accept json
+// @Produce json
+// @Param request body types.AdminModerateUserRequest true "封禁请求"
+// @Success 200 {object} types.AdminModerateUserResponse "成功"
+// @Failure 400 {object} types.BaseResp "参数错误"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Failure 403 {object} types.BaseResp "无权限"
+// @Failure 404 {object} types.BaseResp "用户不存在"
+// @Router /api/admin/users/ban [post]
+// @Security BearerAuth
+func AdminBanUserHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AdminModerateUserRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := admin.NewAdminBanUserLogic(r.Context(), svcCtx)
+		resp, err := l.AdminBanUser(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
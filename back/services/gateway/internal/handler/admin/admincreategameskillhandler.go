@@ -0,0 +1,47 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/admin"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// AdminCreateGameSkillHandler 新增游戏技能
+// @Summary 新增游戏技能
+// @Description 新增游戏技能词典项，成功后清理公开技能列表缓存（仅管理员）
+// @Tags 管理后台
+// @Accept json
+// @Produce json
+// @Param request body types.AdminCreateGameSkillRequest true "新增技能请求"
+// @Success 200 {object} types.AdminGameSkillResponse "成功"
+// @Failure 400 {object} types.BaseResp "请求参数错误"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Failure 403 {object} types.BaseResp "无权限"
+// @Failure 409 {object} types.BaseResp "技能名称已存在"
+// @Router /api/admin/gameskills [post]
+// @Security BearerAuth
+func AdminCreateGameSkillHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AdminCreateGameSkillRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := admin.NewAdminCreateGameSkillLogic(r.Context(), svcCtx)
+		resp, err := l.AdminCreateGameSkill(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,45 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/admin"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// AdminDeleteGameSkillHandler 删除游戏技能
+// @Summary 删除游戏技能
+// @Description 删除游戏技能词典项，成功后清理公开技能列表缓存（仅管理员）
+// @Tags 管理后台
+// @Produce json
+// @Param id query int true "技能ID"
+// @Success 200 {object} types.AdminDeleteGameSkillResponse "成功"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Failure 403 {object} types.BaseResp "无权限"
+// @Failure 404 {object} types.BaseResp "技能不存在"
+// @Router /api/admin/gameskills [delete]
+// @Security BearerAuth
+func AdminDeleteGameSkillHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AdminDeleteGameSkillRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := admin.NewAdminDeleteGameSkillLogic(r.Context(), svcCtx)
+		resp, err := l.AdminDeleteGameSkill(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/admin"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// AdminGetOrderHandler 管理员查询订单详情
+// @Summary 管理员查询订单详情
+// @Description 按订单ID或订单号查询订单，包含老板/陪玩已删除的订单（仅管理员）
+// @Tags 管理后台
+// @Produce json
+// @Param id query int false "订单ID"
+// @Param orderNo query string false "订单号"
+// @Success 200 {object} types.GetOrderResponse "成功"
+// @Failure 400 {object} types.BaseResp "请求参数错误"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Failure 403 {object} types.BaseResp "无权限"
+// @Failure 404 {object} types.BaseResp "订单不存在"
+// @Router /api/admin/orders/detail [get]
+// @Security BearerAuth
+func AdminGetOrderHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AdminGetOrderRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := admin.NewAdminGetOrderLogic(r.Context(), svcCtx)
+		resp, err := l.AdminGetOrder(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
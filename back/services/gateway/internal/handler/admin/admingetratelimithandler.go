@@ -0,0 +1,36 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/admin"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// AdminGetRateLimitHandler 管理员查看限流规则
+// @Summary 查看限流规则与计数
+// @Description 返回当前生效的限流规则（含 Nacos 热更新后的版本）以及本实例启动以来的命中/拒绝次数（仅管理员）
+// @Tags 管理后台
+// @Produce json
+// @Success 200 {object} types.GetRateLimitStatusResponse "成功"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Failure 403 {object} types.BaseResp "无权限"
+// @Router /api/admin/ratelimit [get]
+// @Security BearerAuth
+func AdminGetRateLimitHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := admin.NewAdminGetRateLimitLogic(r.Context(), svcCtx)
+		resp, err := l.AdminGetRateLimit()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
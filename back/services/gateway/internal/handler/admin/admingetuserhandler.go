@@ -0,0 +1,48 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/admin"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// AdminGetUserHandler 管理员查询用户
+// @Summary 管理员查询用户
+// @Description 按用户ID、UID或手机号查询用户信息与钱包（手机号不脱敏，仅管理员）
+// @Tags 管理后台
+// @Produce json
+// @Param id query int false "用户ID"
+// @Param uid query int false "用户UID"
+// @Param phone query string false "手机号"
+// @Success 200 {object} types.AdminGetUserResponse "成功"
+// @Failure 400 {object} types.BaseResp "请求参数错误"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Failure 403 {object} types.BaseResp "无权限"
+// @Failure 404 {object} types.BaseResp "用户不存在"
+// @Router /api/admin/users [get]
+// @Security BearerAuth
+func AdminGetUserHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AdminGetUserRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := admin.NewAdminGetUserLogic(r.Context(), svcCtx)
+		resp, err := l.AdminGetUser(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
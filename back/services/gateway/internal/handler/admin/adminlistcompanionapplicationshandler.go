@@ -0,0 +1,47 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/admin"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// AdminListCompanionApplicationsHandler 陪玩入驻申请审核队列
+// @Summary 入驻申请审核队列
+// @Description 分页查询陪玩入驻申请，待审核按提交时间正序，其余按更新时间倒序；返回完整身份证号和审核备注（仅管理员）
+// @Tags 管理后台
+// @Produce json
+// @Param status query int false "0=全部, 1=待审核, 2=已通过, 3=已驳回"
+// @Param userId query int false "按申请人筛选"
+// @Param page query int false "页码（从1开始）"
+// @Param pageSize query int false "每页数量"
+// @Success 200 {object} types.AdminListCompanionApplicationsResponse "成功"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Failure 403 {object} types.BaseResp "无权限"
+// @Router /api/admin/companion-applications [get]
+// @Security BearerAuth
+func AdminListCompanionApplicationsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AdminListCompanionApplicationsRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := admin.NewAdminListCompanionApplicationsLogic(r.Context(), svcCtx)
+		resp, err := l.AdminListCompanionApplications(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
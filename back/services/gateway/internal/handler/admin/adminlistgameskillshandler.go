@@ -0,0 +1,36 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/admin"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// AdminListGameSkillsHandler 管理员获取游戏技能列表
+// @Summary 管理员获取游戏技能列表
+// @Description 直接查询用户服务，不走公开接口的缓存（仅管理员）
+// @Tags 管理后台
+// @Produce json
+// @Success 200 {object} types.ListGameSkillsResponse "成功"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Failure 403 {object} types.BaseResp "无权限"
+// @Router /api/admin/gameskills [get]
+// @Security BearerAuth
+func AdminListGameSkillsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := admin.NewAdminListGameSkillsLogic(r.Context(), svcCtx)
+		resp, err := l.AdminListGameSkills()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/admin"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// AdminMuteUserHandler 管理员禁言用户
+// @Summary 禁言用户
+// @Description 禁言指定用户：不能聊天和评价订单，下单与订单流程不受影响；duration 为禁言时长（秒），0 表示永久（仅管理员）
+// @Tags 管理后台
+// @Accept json
+// @Produce json
+// @Param request body types.AdminModerateUserRequest true "禁言请求"
+// @Success 200 {object} types.AdminModerateUserResponse "成功"
+// @Failure 400 {object} types.BaseResp "参数错误"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Failure 403 {object} types.BaseResp "无权限"
+// @Failure 404 {object} types.BaseResp "用户不存在"
+// @Router /api/admin/users/mute [post]
+// @Security BearerAuth
+func AdminMuteUserHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AdminModerateUserRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := admin.NewAdminMuteUserLogic(r.Context(), svcCtx)
+		resp, err := l.AdminMuteUser(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,47 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/admin"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// AdminRejectCompanionApplicationHandler 驳回陪玩入驻申请
+// @Summary 驳回入驻申请
+// @Description 驳回待审核的入驻申请，驳回原因会通知申请人；申请人需等待冷却期后才能再次申请（仅管理员）
+// @Tags 管理后台
+// @Accept json
+// @Produce json
+// @Param request body types.AdminRejectCompanionApplicationRequest true "审核请求"
+// @Success 200 {object} types.AdminReviewCompanionApplicationResponse "成功"
+// @Failure 400 {object} types.BaseResp "参数错误或申请已审核"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Failure 403 {object} types.BaseResp "无权限"
+// @Failure 404 {object} types.BaseResp "申请不存在"
+// @Router /api/admin/companion-applications/reject [post]
+// @Security BearerAuth
+func AdminRejectCompanionApplicationHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AdminRejectCompanionApplicationRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := admin.NewAdminRejectCompanionApplicationLogic(r.Context(), svcCtx)
+		resp, err := l.AdminRejectCompanionApplication(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
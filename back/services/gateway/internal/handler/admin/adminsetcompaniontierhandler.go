@@ -0,0 +1,47 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/admin"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// AdminSetCompanionTierHandler 指定陪玩等级
+// @Summary 指定陪玩等级
+// @Description 管理员指定陪玩等级，指定后不再参与定期评估，价格超过新等级上限的技能会被降到上限；tier 传 0 取消指定并立即按表现重新评估（仅管理员）
+// @Tags 管理后台
+// @Accept json
+// @Produce json
+// @Param request body types.AdminSetCompanionTierRequest true "指定等级请求"
+// @Success 200 {object} types.AdminSetCompanionTierResponse "成功"
+// @Failure 400 {object} types.BaseResp "参数错误或等级不存在"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Failure 403 {object} types.BaseResp "无权限"
+// @Failure 404 {object} types.BaseResp "陪玩资料不存在"
+// @Router /api/admin/companion/tier [put]
+// @Security BearerAuth
+func AdminSetCompanionTierHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.AdminSetCompanionTierRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := admin.NewAdminSetCompanionTierLogic(r.Context(), svcCtx)
+		resp, err := l.AdminSetCompanionTier(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
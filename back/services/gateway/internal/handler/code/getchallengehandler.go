@@ -0,0 +1,33 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package code
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/code"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// GetChallengeHandler 获取人机验证题目
+// @Summary 获取人机验证题目
+// @Description 获取一道算术图片验证码（data URI），作答后调用 /api/code/challenge/verify；题目只能作答一次
+// @Tags 验证码
+// @Produce json
+// @Success 200 {object} types.GetChallengeResponse "成功"
+// @Router /api/code/challenge [get]
+func GetChallengeHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := code.NewGetChallengeLogic(r.Context(), svcCtx)
+		resp, err := l.GetChallenge()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
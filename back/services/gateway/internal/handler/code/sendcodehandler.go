@@ -16,7 +16,7 @@ import (
 
 // SendCodeHandler 发送验证码
 // @Summary 发送验证码
-// @Description 向指定手机号发送短信验证码，用于注册、登录、修改密码等场景
+// @Description 向指定手机号发送短信验证码（或向邮箱发送邮件验证码），用于注册、登录、修改密码等场景；触发风控时返回 challengeRequired，需先完成人机验证并携带 challengeToken 重试
 // @Tags 验证码
 // @Accept json
 // @Produce json
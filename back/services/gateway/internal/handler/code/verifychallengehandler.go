@@ -0,0 +1,43 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package code
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/code"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// VerifyChallengeHandler 提交人机验证答案
+// @Summary 提交人机验证答案
+// @Description 校验算术题答案，通过后返回一次性令牌，发送验证码时作为 challengeToken 携带；令牌与当前 IP 绑定
+// @Tags 验证码
+// @Accept json
+// @Produce json
+// @Param request body types.VerifyChallengeRequest true "人机验证答案"
+// @Success 200 {object} types.VerifyChallengeResponse "成功"
+// @Failure 400 {object} types.BaseResp "答案错误或题目已过期"
+// @Router /api/code/challenge/verify [post]
+func VerifyChallengeHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.VerifyChallengeRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := code.NewVerifyChallengeLogic(r.Context(), svcCtx)
+		resp, err := l.VerifyChallenge(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
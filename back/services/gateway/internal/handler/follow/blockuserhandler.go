@@ -0,0 +1,43 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package follow
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/follow"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// BlockUserHandler 拉黑用户
+// @Summary 拉黑用户
+// @Description 拉黑指定用户，双方自动互相取消关注；之后不能互相关注、下单、打赏或送礼，需要登录
+// @Tags 关注
+// @Accept json
+// @Produce json
+// @Param request body types.BlockUserRequest true "拉黑用户请求"
+// @Success 200 {object} types.BlockUserResponse "成功"
+// @Failure 400 {object} types.BaseResp "请求参数错误"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Router /api/user/block [post]
+// @Security BearerAuth
+func BlockUserHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.BlockUserRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := follow.NewBlockUserLogic(r.Context(), svcCtx)
+		resp, err := l.BlockUser(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package follow
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/follow"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// GetFeedHandler 关注动态
+// @Summary 关注动态
+// @Description 获取关注的陪玩的动态（上线、新评价、调价、新帖子），按时间倒序，使用 nextCursor 翻页
+// @Tags 关注
+// @Accept json
+// @Produce json
+// @Param cursor query uint64 false "上一页返回的 nextCursor，首页不传"
+// @Param limit query int false "每页数量（默认20，最大50）"
+// @Success 200 {object} types.GetFeedResponse "成功"
+// @Failure 400 {object} types.BaseResp "请求参数错误"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Router /api/user/feed [get]
+// @Security BearerAuth
+func GetFeedHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.GetFeedRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := follow.NewGetFeedLogic(r.Context(), svcCtx)
+		resp, err := l.GetFeed(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
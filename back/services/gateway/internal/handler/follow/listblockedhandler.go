@@ -0,0 +1,43 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package follow
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/follow"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// ListBlockedHandler 获取我的黑名单
+// @Summary 获取我的黑名单
+// @Description 获取当前登录用户拉黑的用户列表，按拉黑时间倒序，支持分页
+// @Tags 关注
+// @Accept json
+// @Produce json
+// @Param page query int false "页码（从1开始）" default(1)
+// @Param pageSize query int false "每页数量" default(10)
+// @Success 200 {object} types.ListBlockedResponse "成功"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Router /api/user/blocked [get]
+// @Security BearerAuth
+func ListBlockedHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.ListBlockedRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := follow.NewListBlockedLogic(r.Context(), svcCtx)
+		resp, err := l.ListBlocked(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
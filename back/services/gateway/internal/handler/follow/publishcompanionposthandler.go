@@ -0,0 +1,43 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package follow
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/follow"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// PublishCompanionPostHandler 陪玩发布帖子
+// @Summary 陪玩发布帖子
+// @Description 陪玩发布文字或图片帖子（内容最多500字、图片最多9张，至少有一项），发布后出现在粉丝的关注动态中
+// @Tags 关注
+// @Accept json
+// @Produce json
+// @Param request body types.PublishCompanionPostRequest true "帖子内容"
+// @Success 200 {object} types.PublishCompanionPostResponse "成功"
+// @Failure 400 {object} types.BaseResp "请求参数错误"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Router /api/user/companion/post [post]
+// @Security BearerAuth
+func PublishCompanionPostHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.PublishCompanionPostRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := follow.NewPublishCompanionPostLogic(r.Context(), svcCtx)
+		resp, err := l.PublishCompanionPost(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
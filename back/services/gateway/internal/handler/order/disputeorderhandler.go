@@ -0,0 +1,45 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package order
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/order"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// DisputeOrderHandler 订单纠纷
+// @Summary 订单纠纷
+// @Description 老板对已完成的订单发起纠纷（完成后7天内，每单一次），计入陪玩等级评估的纠纷率
+// @Tags 订单
+// @Accept json
+// @Produce json
+// @Param request body types.DisputeOrderRequest true "订单纠纷请求"
+// @Success 200 {object} types.DisputeOrderResponse "成功"
+// @Failure 400 {object} types.BaseResp "请求参数错误"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Router /api/order/dispute [post]
+// @Security BearerAuth
+func DisputeOrderHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.DisputeOrderRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := order.NewDisputeOrderLogic(r.Context(), svcCtx)
+		resp, err := l.DisputeOrder(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
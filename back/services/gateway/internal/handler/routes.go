@@ -6,6 +6,7 @@ package handler
 import (
 	"net/http"
 
+	admin "SLGaming/back/services/gateway/internal/handler/admin"
 	agent "SLGaming/back/services/gateway/internal/handler/agent"
 	code "SLGaming/back/services/gateway/internal/handler/code"
 	follow "SLGaming/back/services/gateway/internal/handler/follow"
@@ -17,6 +18,91 @@ import (
 )
 
 func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
+	server.AddRoutes(
+		[]rest.Route{
+			{
+				Method:  http.MethodGet,
+				Path:    "/api/admin/companion-applications",
+				Handler: admin.AdminListCompanionApplicationsHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/api/admin/companion-applications/approve",
+				Handler: admin.AdminApproveCompanionApplicationHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/api/admin/companion-applications/reject",
+				Handler: admin.AdminRejectCompanionApplicationHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPut,
+				Path:    "/api/admin/companion/tier",
+				Handler: admin.AdminSetCompanionTierHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/api/admin/gameskills",
+				Handler: admin.AdminListGameSkillsHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/api/admin/gameskills",
+				Handler: admin.AdminCreateGameSkillHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPut,
+				Path:    "/api/admin/gameskills",
+				Handler: admin.AdminUpdateGameSkillHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodDelete,
+				Path:    "/api/admin/gameskills",
+				Handler: admin.AdminDeleteGameSkillHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/api/admin/orders",
+				Handler: admin.AdminListOrdersHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/api/admin/orders/detail",
+				Handler: admin.AdminGetOrderHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/api/admin/ratelimit",
+				Handler: admin.AdminGetRateLimitHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/api/admin/users",
+				Handler: admin.AdminGetUserHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/api/admin/users/ban",
+				Handler: admin.AdminBanUserHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/api/admin/users/mute",
+				Handler: admin.AdminMuteUserHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/api/admin/users/unban",
+				Handler: admin.AdminUnbanUserHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/api/admin/users/unlock-login",
+				Handler: admin.AdminUnlockLoginHandler(serverCtx),
+			},
+		},
+	)
+
 	server.AddRoutes(
 		[]rest.Route{
 			{
@@ -29,6 +115,16 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 
 	server.AddRoutes(
 		[]rest.Route{
+			{
+				Method:  http.MethodGet,
+				Path:    "/api/code/challenge",
+				Handler: code.GetChallengeHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/api/code/challenge/verify",
+				Handler: code.VerifyChallengeHandler(serverCtx),
+			},
 			{
 				Method:  http.MethodPost,
 				Path:    "/api/code/send",
@@ -39,6 +135,26 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 
 	server.AddRoutes(
 		[]rest.Route{
+			{
+				Method:  http.MethodPost,
+				Path:    "/api/user/block",
+				Handler: follow.BlockUserHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/api/user/blocked",
+				Handler: follow.ListBlockedHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/api/user/companion/post",
+				Handler: follow.PublishCompanionPostHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/api/user/feed",
+				Handler: follow.GetFeedHandler(serverCtx),
+			},
 			{
 				Method:  http.MethodPost,
 				Path:    "/api/user/follow",
@@ -64,6 +180,11 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/api/user/following",
 				Handler: follow.GetMyFollowingListHandler(serverCtx),
 			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/api/user/unblock",
+				Handler: follow.UnblockUserHandler(serverCtx),
+			},
 			{
 				Method:  http.MethodPost,
 				Path:    "/api/user/unfollow",
@@ -104,6 +225,11 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/api/order/delete",
 				Handler: order.DeleteOrderHandler(serverCtx),
 			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/api/order/dispute",
+				Handler: order.DisputeOrderHandler(serverCtx),
+			},
 			{
 				Method:  http.MethodPost,
 				Path:    "/api/order/rate",
@@ -149,11 +275,21 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/api/user/change-phone",
 				Handler: user.ChangePhoneHandler(serverCtx),
 			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/api/user/companion/application",
+				Handler: user.GetMyCompanionApplicationHandler(serverCtx),
+			},
 			{
 				Method:  http.MethodPost,
 				Path:    "/api/user/companion/apply",
 				Handler: user.ApplyCompanionHandler(serverCtx),
 			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/api/user/companion/heartbeat",
+				Handler: user.CompanionHeartbeatHandler(serverCtx),
+			},
 			{
 				Method:  http.MethodGet,
 				Path:    "/api/user/companion/profile",
@@ -174,6 +310,11 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/api/user/companion/status",
 				Handler: user.UpdateCompanionStatusHandler(serverCtx),
 			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/api/user/companion/tier",
+				Handler: user.GetCompanionTierHandler(serverCtx),
+			},
 			{
 				Method:  http.MethodGet,
 				Path:    "/api/user/companions",
@@ -189,6 +330,11 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/api/user/companions/ranking/ratings",
 				Handler: user.GetCompanionRatingRankingHandler(serverCtx),
 			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/api/user/email/bind",
+				Handler: user.BindEmailHandler(serverCtx),
+			},
 			{
 				Method:  http.MethodPut,
 				Path:    "/api/user/forgetPassword",
@@ -199,6 +345,16 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/api/user/gameskills",
 				Handler: user.ListGameSkillsHandler(serverCtx),
 			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/api/user/gifts",
+				Handler: user.ListGiftsHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/api/user/gifts/send",
+				Handler: user.SendGiftHandler(serverCtx),
+			},
 			{
 				Method:  http.MethodPost,
 				Path:    "/api/user/login",
@@ -209,6 +365,11 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/api/user/login-by-code",
 				Handler: user.LoginByCodeHandler(serverCtx),
 			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/api/user/login-history",
+				Handler: user.LoginHistoryHandler(serverCtx),
+			},
 			{
 				Method:  http.MethodPost,
 				Path:    "/api/user/logout",
@@ -244,11 +405,46 @@ func RegisterHandlers(server *rest.Server, serverCtx *svc.ServiceContext) {
 				Path:    "/api/user/register",
 				Handler: user.RegisterHandler(serverCtx),
 			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/api/user/sessions",
+				Handler: user.ListSessionsHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodDelete,
+				Path:    "/api/user/sessions/:id",
+				Handler: user.RevokeSessionHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/api/user/vip",
+				Handler: user.GetVipEntitlementsHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPut,
+				Path:    "/api/user/vip/auto-renew",
+				Handler: user.SetVipAutoRenewHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodGet,
+				Path:    "/api/user/vip/plans",
+				Handler: user.ListVipPlansHandler(serverCtx),
+			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/api/user/vip/subscribe",
+				Handler: user.SubscribeVipHandler(serverCtx),
+			},
 			{
 				Method:  http.MethodGet,
 				Path:    "/api/user/wallet",
 				Handler: user.GetWalletHandler(serverCtx),
 			},
+			{
+				Method:  http.MethodPost,
+				Path:    "/api/user/wallet/transfer",
+				Handler: user.TransferHandler(serverCtx),
+			},
 		},
 	)
 }
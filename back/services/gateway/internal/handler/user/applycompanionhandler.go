@@ -14,7 +14,7 @@ import (
 
 // ApplyCompanionHandler 申请成为陪玩
 // @Summary 申请成为陪玩
-// @Description 申请成为陪玩，需要设置游戏技能和价格
+// @Description 提交陪玩入驻申请：身份信息、技能证明截图、语音样本和游戏技能（gameSkill+pricePerHour 或 skills 多个游戏），进入审核队列，审核通过后才成为陪玩；同时只能有一条待审核申请，被驳回后需等待冷却期
 // @Tags 用户
 // @Accept json
 // @Produce json
@@ -22,6 +22,7 @@ import (
 // @Success 200 {object} types.ApplyCompanionResponse "成功"
 // @Failure 400 {object} types.BaseResp "请求参数错误"
 // @Failure 401 {object} types.BaseResp "未授权"
+// @Failure 429 {object} types.BaseResp "被驳回后冷却期内再次申请"
 // @Router /api/user/companion/apply [post]
 // @Security BearerAuth
 func ApplyCompanionHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
@@ -0,0 +1,52 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/user"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/gateway/internal/validator"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// BindEmailHandler 绑定邮箱
+// @Summary 绑定邮箱
+// @Description 为当前登录用户绑定或更换邮箱，需先向该邮箱发送 bind_email 验证码；绑定后可用邮箱验证码登录和找回密码
+// @Tags 用户
+// @Accept json
+// @Produce json
+// @Param request body types.BindEmailRequest true "绑定邮箱请求"
+// @Success 200 {object} types.BindEmailResponse "成功"
+// @Failure 400 {object} types.BaseResp "请求参数错误"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Router /api/user/email/bind [post]
+// @Security BearerAuth
+func BindEmailHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.BindEmailRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		// 表单验证
+		if err := validator.ValidateBindEmailRequest(&req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := user.NewBindEmailLogic(r.Context(), svcCtx)
+		resp, err := l.BindEmail(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
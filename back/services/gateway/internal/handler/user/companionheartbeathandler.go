@@ -0,0 +1,36 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/user"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// CompanionHeartbeatHandler 陪玩心跳
+// @Summary 陪玩心跳
+// @Description 在线/忙碌的陪玩按返回的 intervalSeconds 定期调用以保持在线；超过有效期未发送心跳的在线陪玩会被自动置为离线。离线状态下调用不会自动上线
+// @Tags 用户
+// @Produce json
+// @Success 200 {object} types.CompanionHeartbeatResponse "成功"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Failure 403 {object} types.BaseResp "非陪玩用户"
+// @Router /api/user/companion/heartbeat [post]
+// @Security BearerAuth
+func CompanionHeartbeatHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := user.NewCompanionHeartbeatLogic(r.Context(), svcCtx)
+		resp, err := l.CompanionHeartbeat()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
@@ -16,18 +16,26 @@ import (
 
 // GetCompanionListHandler 获取陪玩列表
 // @Summary 获取陪玩列表
-// @Description 获取陪玩列表，支持按游戏技能、价格、状态等筛选
+// @Description 获取陪玩列表，支持按游戏技能、价格、状态、性别、年龄、评分、关键词组合筛选和多种排序
 // @Tags 用户
 // @Accept json
 // @Produce json
-// @Param gameSkill query string false "游戏技能筛选"
-// @Param minPrice query int false "最低价格"
-// @Param maxPrice query int false "最高价格"
-// @Param status query int false "状态筛选：0=离线, 1=在线, 2=忙碌" default(1)
+// @Param gameSkill query string false "游戏技能筛选（匹配提供该游戏的陪玩）"
+// @Param minPrice query int false "最低价格（指定游戏时按该游戏的价格）"
+// @Param maxPrice query int false "最高价格（指定游戏时按该游戏的价格）"
+// @Param status query int false "状态筛选：1=在线, 2=忙碌（不传返回在线和忙碌）"
+// @Param onlineOnly query bool false "只看在线（可接单）陪玩"
 // @Param isVerified query bool false "是否只返回认证陪玩"
+// @Param gender query int false "性别：1=男, 2=女"
+// @Param minAge query int false "最小年龄"
+// @Param maxAge query int false "最大年龄"
+// @Param minRating query number false "最低评分（0-5）"
+// @Param keyword query string false "关键词（匹配昵称或个人简介）"
+// @Param sortBy query string false "排序：rating（默认）、price_asc、price_desc、popularity、newest"
 // @Param page query int false "页码（从1开始）" default(1)
 // @Param pageSize query int false "每页数量" default(10)
 // @Success 200 {object} types.GetCompanionListResponse "成功"
+// @Failure 400 {object} types.BaseResp "筛选条件无效"
 // @Router /api/user/companions [get]
 func GetCompanionListHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
@@ -16,12 +16,14 @@ import (
 
 // GetCompanionOrdersRankingHandler 获取陪玩接单数排行榜
 // @Summary 获取陪玩接单数排行榜
-// @Description 获取陪玩按接单数排名的排行榜
+// @Description 获取陪玩按接单数排名的排行榜，支持按日/周/月和游戏筛选
 // @Tags 用户
 // @Accept json
 // @Produce json
 // @Param page query int false "页码（从1开始）" default(1)
 // @Param pageSize query int false "每页数量" default(10)
+// @Param period query string false "周期：day/week/month/all" default(all)
+// @Param game query string false "游戏名称（为空时不限游戏）"
 // @Success 200 {object} types.GetCompanionOrdersRankingResponse "成功"
 // @Router /api/user/companions/ranking/orders [get]
 func GetCompanionOrdersRankingHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
@@ -16,12 +16,14 @@ import (
 
 // GetCompanionRatingRankingHandler 获取陪玩评分排行榜
 // @Summary 获取陪玩评分排行榜
-// @Description 获取陪玩按评分排名的排行榜
+// @Description 获取陪玩按贝叶斯评分（按接单数修正后的评分）排名的排行榜，支持按日/周/月和游戏筛选
 // @Tags 用户
 // @Accept json
 // @Produce json
 // @Param page query int false "页码（从1开始）" default(1)
 // @Param pageSize query int false "每页数量" default(10)
+// @Param period query string false "周期：day/week/month/all" default(all)
+// @Param game query string false "游戏名称（为空时不限游戏）"
 // @Success 200 {object} types.GetCompanionRatingRankingResponse "成功"
 // @Router /api/user/companions/ranking/ratings [get]
 func GetCompanionRatingRankingHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
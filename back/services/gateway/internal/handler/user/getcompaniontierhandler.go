@@ -0,0 +1,45 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/user"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// GetCompanionTierHandler 查看陪玩等级
+// @Summary 查看陪玩等级
+// @Description 查看自己当前的陪玩等级（按近期完成单数、评分与取消率评估）以及每次等级变化的原因（仅陪玩）
+// @Tags 用户
+// @Produce json
+// @Param page query int false "页码"
+// @Param pageSize query int false "每页数量"
+// @Success 200 {object} types.GetCompanionTierResponse "成功"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Failure 404 {object} types.BaseResp "陪玩资料不存在"
+// @Router /api/user/companion/tier [get]
+// @Security BearerAuth
+func GetCompanionTierHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.GetCompanionTierRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := user.NewGetCompanionTierLogic(r.Context(), svcCtx)
+		resp, err := l.GetCompanionTier(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
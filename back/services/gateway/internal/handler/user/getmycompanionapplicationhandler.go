@@ -0,0 +1,35 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/user"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// GetMyCompanionApplicationHandler 查询我的入驻申请
+// @Summary 查询我的入驻申请
+// @Description 查询当前用户最近一次的陪玩入驻申请及审核结果（身份证号脱敏）；被驳回时返回原因和可再次申请的时间，从未申请时 data 为 null
+// @Tags 用户
+// @Produce json
+// @Success 200 {object} types.GetMyCompanionApplicationResponse "成功"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Router /api/user/companion/application [get]
+// @Security BearerAuth
+func GetMyCompanionApplicationHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := user.NewGetMyCompanionApplicationLogic(r.Context(), svcCtx)
+		resp, err := l.GetMyCompanionApplication()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
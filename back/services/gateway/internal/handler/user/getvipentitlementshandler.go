@@ -0,0 +1,35 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/user"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// GetVipEntitlementsHandler 获取当前会员权益
+// @Summary 获取当前会员权益
+// @Description 返回当前登录用户的会员等级、徽章、下单折扣、推荐优先级与限流倍数
+// @Tags 用户
+// @Produce json
+// @Success 200 {object} types.GetVipEntitlementsResponse "成功"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Router /api/user/vip [get]
+// @Security BearerAuth
+func GetVipEntitlementsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := user.NewGetVipEntitlementsLogic(r.Context(), svcCtx)
+		resp, err := l.GetVipEntitlements()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
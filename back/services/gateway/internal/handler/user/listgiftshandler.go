@@ -0,0 +1,32 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/user"
+	"SLGaming/back/services/gateway/internal/svc"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// ListGiftsHandler 获取礼物列表
+// @Summary 获取礼物列表
+// @Description 获取当前上架的礼物目录（名称、单价、图标）
+// @Tags 用户
+// @Accept json
+// @Produce json
+// @Success 200 {object} types.ListGiftsResponse "成功"
+// @Router /api/user/gifts [get]
+func ListGiftsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := user.NewListGiftsLogic(r.Context(), svcCtx)
+		resp, err := l.ListGifts()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			httpx.OkJsonCtx(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,35 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/user"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// ListSessionsHandler 查看已登录的设备
+// @Summary 查看已登录的设备
+// @Description 列出当前账号所有未过期的登录会话（设备名称、User-Agent、IP、最后活跃时间），并标记当前设备
+// @Tags 用户
+// @Produce json
+// @Success 200 {object} types.ListSessionsResponse "成功"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Router /api/user/sessions [get]
+// @Security BearerAuth
+func ListSessionsHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := user.NewListSessionsLogic(r.Context(), svcCtx)
+		resp, err := l.ListSessions()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
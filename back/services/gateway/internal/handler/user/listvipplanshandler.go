@@ -0,0 +1,33 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/user"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// ListVipPlansHandler 获取会员套餐列表
+// @Summary 获取会员套餐列表
+// @Description 返回当前可购买的会员套餐及对应权益（公开接口，无需登录）
+// @Tags 用户
+// @Produce json
+// @Success 200 {object} types.ListVipPlansResponse "成功"
+// @Router /api/user/vip/plans [get]
+func ListVipPlansHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		l := user.NewListVipPlansLogic(r.Context(), svcCtx)
+		resp, err := l.ListVipPlans()
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
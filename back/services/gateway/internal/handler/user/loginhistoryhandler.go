@@ -0,0 +1,44 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/user"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// LoginHistoryHandler 查看登录记录
+// @Summary 查看登录记录
+// @Description 分页查看当前账号的登录记录（密码/验证码登录与刷新 Token，含失败尝试），newDevice 表示该次登录来自首次出现的设备或地区
+// @Tags 用户
+// @Produce json
+// @Param page query int false "页码（从1开始）"
+// @Param pageSize query int false "每页数量"
+// @Success 200 {object} types.LoginHistoryResponse "成功"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Router /api/user/login-history [get]
+// @Security BearerAuth
+func LoginHistoryHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.LoginHistoryRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := user.NewLoginHistoryLogic(r.Context(), svcCtx)
+		resp, err := l.LoginHistory(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
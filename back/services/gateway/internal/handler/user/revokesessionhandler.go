@@ -0,0 +1,44 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/user"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// RevokeSessionHandler 下线指定设备
+// @Summary 下线指定设备
+// @Description 撤销指定登录会话，该设备的 Refresh Token 与已签发的 Access Token 立即失效
+// @Tags 用户
+// @Produce json
+// @Param id path string true "会话ID"
+// @Success 200 {object} types.RevokeSessionResponse "成功"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Failure 404 {object} types.BaseResp "会话不存在"
+// @Router /api/user/sessions/{id} [delete]
+// @Security BearerAuth
+func RevokeSessionHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.RevokeSessionRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := user.NewRevokeSessionLogic(r.Context(), svcCtx)
+		resp, err := l.RevokeSession(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
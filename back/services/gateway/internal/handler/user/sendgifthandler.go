@@ -0,0 +1,46 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/user"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// SendGiftHandler 赠送礼物
+// @Summary 赠送礼物
+// @Description 当前登录用户向指定用户赠送礼物，按礼物单价乘以数量扣减帅币
+// @Tags 用户
+// @Accept json
+// @Produce json
+// @Param request body types.SendGiftRequest true "赠送礼物请求"
+// @Success 200 {object} types.SendGiftResponse "成功"
+// @Failure 400 {object} types.BaseResp "请求参数错误"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Failure 429 {object} types.BaseResp "余额不足或超出今日限额"
+// @Router /api/user/gifts/send [post]
+// @Security BearerAuth
+func SendGiftHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.SendGiftRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := user.NewSendGiftLogic(r.Context(), svcCtx)
+		resp, err := l.SendGift(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
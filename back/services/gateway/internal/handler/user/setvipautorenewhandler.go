@@ -0,0 +1,45 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/user"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// SetVipAutoRenewHandler 设置会员自动续费
+// @Summary 设置会员自动续费
+// @Description 开启后会员到期前自动从帅币余额扣款续费，余额不足时自动关闭
+// @Tags 用户
+// @Accept json
+// @Produce json
+// @Param request body types.SetVipAutoRenewRequest true "自动续费设置"
+// @Success 200 {object} types.SetVipAutoRenewResponse "成功"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Failure 404 {object} types.BaseResp "未开通会员"
+// @Router /api/user/vip/auto-renew [put]
+// @Security BearerAuth
+func SetVipAutoRenewHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.SetVipAutoRenewRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := user.NewSetVipAutoRenewLogic(r.Context(), svcCtx)
+		resp, err := l.SetVipAutoRenew(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
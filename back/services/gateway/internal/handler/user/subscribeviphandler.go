@@ -0,0 +1,46 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/user"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// SubscribeVipHandler 开通/续费会员
+// @Summary 开通/续费会员
+// @Description 使用帅币购买会员套餐；会员生效中再次购买同级或更高等级套餐将顺延到期时间
+// @Tags 用户
+// @Accept json
+// @Produce json
+// @Param request body types.SubscribeVipRequest true "开通会员请求"
+// @Success 200 {object} types.SubscribeVipResponse "成功"
+// @Failure 400 {object} types.BaseResp "请求参数错误"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Failure 429 {object} types.BaseResp "余额不足"
+// @Router /api/user/vip/subscribe [post]
+// @Security BearerAuth
+func SubscribeVipHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.SubscribeVipRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := user.NewSubscribeVipLogic(r.Context(), svcCtx)
+		resp, err := l.SubscribeVip(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
@@ -0,0 +1,46 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"net/http"
+
+	"SLGaming/back/services/gateway/internal/logic/user"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+// TransferHandler 帅币打赏
+// @Summary 帅币打赏
+// @Description 当前登录用户向指定用户打赏帅币，双方钱包在同一事务内变更；传入相同 requestId 重复提交不会重复扣款
+// @Tags 用户
+// @Accept json
+// @Produce json
+// @Param request body types.TransferRequest true "打赏请求"
+// @Success 200 {object} types.TransferResponse "成功"
+// @Failure 400 {object} types.BaseResp "请求参数错误"
+// @Failure 401 {object} types.BaseResp "未授权"
+// @Failure 429 {object} types.BaseResp "余额不足或超出今日限额"
+// @Router /api/user/wallet/transfer [post]
+// @Security BearerAuth
+func TransferHandler(svcCtx *svc.ServiceContext) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req types.TransferRequest
+		if err := httpx.Parse(r, &req); err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+			return
+		}
+
+		l := user.NewTransferLogic(r.Context(), svcCtx)
+		resp, err := l.Transfer(&req)
+		if err != nil {
+			httpx.ErrorCtx(r.Context(), w, err)
+		} else {
+			// 根据业务 code 返回正确的 HTTP 状态码
+			utils.WriteResponse(r.Context(), w, resp)
+		}
+	}
+}
@@ -16,7 +16,7 @@ import (
 
 // UpdateCompanionProfileHandler 更新陪玩资料
 // @Summary 更新陪玩资料
-// @Description 更新当前登录用户的陪玩资料信息
+// @Description 更新当前登录用户的陪玩资料信息；skills 非空时整体替换提供的游戏（每个游戏单独设置段位和价格）
 // @Tags 用户
 // @Accept json
 // @Produce json
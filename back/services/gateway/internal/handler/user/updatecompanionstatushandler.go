@@ -14,7 +14,7 @@ import (
 
 // UpdateCompanionStatusHandler 更新陪玩状态
 // @Summary 更新陪玩状态
-// @Description 更新陪玩的在线状态：0=离线, 1=在线, 2=忙碌
+// @Description 更新陪玩的在线状态：0=离线, 1=在线；忙碌状态由进行中的订单自动维护，不能手动设置
 // @Tags 用户
 // @Accept json
 // @Produce json
@@ -10,34 +10,47 @@ import (
 type LogOperation string
 
 const (
-	OpLogin             LogOperation = "login"
-	OpLoginByCode       LogOperation = "login_by_code"
-	OpRegister          LogOperation = "register"
-	OpLogout            LogOperation = "logout"
-	OpRefreshToken      LogOperation = "refresh_token"
-	OpGetUser           LogOperation = "get_user"
-	OpUpdateUser        LogOperation = "update_user"
-	OpUploadAvatar      LogOperation = "upload_avatar"
-	OpGetWallet         LogOperation = "get_wallet"
-	OpCreateOrder       LogOperation = "create_order"
-	OpGetOrder          LogOperation = "get_order"
-	OpGetOrderList      LogOperation = "get_order_list"
-	OpCancelOrder       LogOperation = "cancel_order"
-	OpAcceptOrder       LogOperation = "accept_order"
-	OpCompleteOrder     LogOperation = "complete_order"
-	OpStartOrder        LogOperation = "start_order"
-	OpRateOrder         LogOperation = "rate_order"
-	OpSendCode          LogOperation = "send_code"
-	OpFollowUser        LogOperation = "follow_user"
-	OpUnfollowUser      LogOperation = "unfollow_user"
-	OpCheckFollowStatus LogOperation = "check_follow_status"
-	OpRechargeCreate    LogOperation = "recharge_create"
-	OpRechargeQuery     LogOperation = "recharge_query"
-	OpRechargeList      LogOperation = "recharge_list"
-	OpAlipayNotify      LogOperation = "alipay_notify"
-	OpServer            LogOperation = "server"
-	OpAuth              LogOperation = "auth"
-	OpRateLimit         LogOperation = "rate_limit"
+	OpLogin              LogOperation = "login"
+	OpLoginByCode        LogOperation = "login_by_code"
+	OpRegister           LogOperation = "register"
+	OpLogout             LogOperation = "logout"
+	OpRefreshToken       LogOperation = "refresh_token"
+	OpGetUser            LogOperation = "get_user"
+	OpUpdateUser         LogOperation = "update_user"
+	OpUploadAvatar       LogOperation = "upload_avatar"
+	OpGetWallet          LogOperation = "get_wallet"
+	OpCreateOrder        LogOperation = "create_order"
+	OpGetOrder           LogOperation = "get_order"
+	OpGetOrderList       LogOperation = "get_order_list"
+	OpCancelOrder        LogOperation = "cancel_order"
+	OpAcceptOrder        LogOperation = "accept_order"
+	OpCompleteOrder      LogOperation = "complete_order"
+	OpStartOrder         LogOperation = "start_order"
+	OpRateOrder          LogOperation = "rate_order"
+	OpSendCode           LogOperation = "send_code"
+	OpFollowUser         LogOperation = "follow_user"
+	OpUnfollowUser       LogOperation = "unfollow_user"
+	OpCheckFollowStatus  LogOperation = "check_follow_status"
+	OpRechargeCreate     LogOperation = "recharge_create"
+	OpRechargeQuery      LogOperation = "recharge_query"
+	OpRechargeList       LogOperation = "recharge_list"
+	OpAlipayNotify       LogOperation = "alipay_notify"
+	OpTransfer           LogOperation = "transfer"
+	OpSendGift           LogOperation = "send_gift"
+	OpSubscribeVip       LogOperation = "subscribe_vip"
+	OpSetVipAutoRenew    LogOperation = "set_vip_auto_renew"
+	OpSession            LogOperation = "session"
+	OpSecurity           LogOperation = "security"
+	OpServer             LogOperation = "server"
+	OpAuth               LogOperation = "auth"
+	OpRateLimit          LogOperation = "rate_limit"
+	OpAudit              LogOperation = "audit"
+	OpAdminGameSkill     LogOperation = "admin_game_skill"
+	OpAdminQuery         LogOperation = "admin_query"
+	OpAdminUnlockLogin   LogOperation = "admin_unlock_login"
+	OpAdminModeration    LogOperation = "admin_moderation"
+	OpAdminReview        LogOperation = "admin_review"
+	OpAdminCompanionTier LogOperation = "admin_companion_tier"
 )
 
 func LogRequest(logger logx.Logger, operation LogOperation, fields map[string]interface{}) {
@@ -100,3 +113,11 @@ func MaskPhone(phone string) string {
 	}
 	return phone[:3] + "****" + phone[len(phone)-4:]
 }
+
+func MaskEmail(email string) string {
+	at := strings.LastIndex(email, "@")
+	if at <= 0 {
+		return email
+	}
+	return email[:1] + "***" + email[at:]
+}
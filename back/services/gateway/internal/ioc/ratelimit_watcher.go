@@ -0,0 +1,61 @@
+package ioc
+
+import (
+	"strings"
+
+	"SLGaming/back/services/gateway/internal/config"
+
+	"github.com/zeromicro/go-zero/core/conf"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// RateLimitApplier 接收新的限流配置，校验失败时返回错误且不生效
+type RateLimitApplier func(cfg config.RateLimitConf, source string) error
+
+// WatchRateLimitConfig 从 Nacos 加载限流规则并监听变化
+// 规则内容格式与本地配置的 RateLimit 段相同，解析或校验失败时保留当前规则
+func WatchRateLimitConfig(nacosCfg config.NacosConf, current config.RateLimitConf, apply RateLimitApplier) {
+	if len(nacosCfg.Hosts) == 0 || current.NacosDataId == "" {
+		return
+	}
+
+	nacosCfg.DataId = current.NacosDataId
+	client, err := InitNacos(nacosCfg)
+	if err != nil {
+		logx.Errorf("init nacos for rate limit failed, use local rules: %v", err)
+		return
+	}
+
+	onChange := func(content string) {
+		if strings.TrimSpace(content) == "" {
+			return
+		}
+
+		var rlCfg config.RateLimitConf
+		if err := conf.LoadFromYamlBytes([]byte(content), &rlCfg); err != nil {
+			logx.Errorf("parse rate limit config from nacos failed, keep current rules: %v", err)
+			return
+		}
+		if rlCfg.Distributed != current.Distributed {
+			logx.Infof("rate limit Distributed changed in nacos, restart service to take effect")
+		}
+		if err := apply(rlCfg, "nacos"); err != nil {
+			logx.Errorf("invalid rate limit config from nacos, keep current rules: %v", err)
+			return
+		}
+		logx.Infof("rate limit rules reloaded from nacos: routes=%d, enabled=%v, globalQPS=%d",
+			len(rlCfg.Routes), rlCfg.Enabled, rlCfg.GlobalQPS)
+	}
+
+	if content, err := FetchConfig(client, nacosCfg); err != nil {
+		logx.Errorf("fetch rate limit config from nacos failed, use local rules: %v", err)
+	} else {
+		onChange(content)
+	}
+
+	go func() {
+		if err := ListenConfig(client, nacosCfg, onChange); err != nil {
+			logx.Errorf("listen nacos rate limit config failed: %v", err)
+		}
+	}()
+}
@@ -12,10 +12,18 @@ var (
 	ErrExpiredToken = errors.New("token expired")
 )
 
+// Token 类型（typ 声明），防止 Access Token 与 Refresh Token 互相冒用
+const (
+	TokenTypeAccess  = "access"
+	TokenTypeRefresh = "refresh"
+)
+
 // Claims JWT 载荷结构
 type Claims struct {
-	UserID uint64 `json:"user_id"`
-	Role   int32  `json:"role"`
+	UserID    uint64 `json:"user_id"`
+	Role      int32  `json:"role"`
+	SessionID string `json:"sid,omitempty"` // 登录会话（设备）ID，同一次登录签发的 Access/Refresh Token 相同
+	TokenType string `json:"typ,omitempty"` // Token 类型：access / refresh
 	jwt.RegisteredClaims
 }
 
@@ -36,11 +44,13 @@ func NewJWTManager(secretKey string, accessTokenDuration, refreshTokenDuration t
 }
 
 // GenerateAccessToken 生成 Access Token（短期）
-func (m *JWTManager) GenerateAccessToken(userID uint64, role int32) (string, error) {
+func (m *JWTManager) GenerateAccessToken(userID uint64, role int32, sessionID string) (string, error) {
 	now := time.Now()
 	claims := &Claims{
-		UserID: userID,
-		Role:   role,
+		UserID:    userID,
+		Role:      role,
+		SessionID: sessionID,
+		TokenType: TokenTypeAccess,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(now.Add(m.accessTokenDuration)),
 			IssuedAt:  jwt.NewNumericDate(now),
@@ -54,16 +64,20 @@ func (m *JWTManager) GenerateAccessToken(userID uint64, role int32) (string, err
 }
 
 // GenerateRefreshToken 生成 Refresh Token（长期）
-func (m *JWTManager) GenerateRefreshToken(userID uint64, role int32) (string, error) {
+// tokenID 写入 jti，用于轮换时识别同一会话（Token 家族）中的当前 Refresh Token
+func (m *JWTManager) GenerateRefreshToken(userID uint64, role int32, sessionID, tokenID string) (string, error) {
 	now := time.Now()
 	claims := &Claims{
-		UserID: userID,
-		Role:   role,
+		UserID:    userID,
+		Role:      role,
+		SessionID: sessionID,
+		TokenType: TokenTypeRefresh,
 		RegisteredClaims: jwt.RegisteredClaims{
 			ExpiresAt: jwt.NewNumericDate(now.Add(m.refreshTokenDuration)),
 			IssuedAt:  jwt.NewNumericDate(now),
 			NotBefore: jwt.NewNumericDate(now),
 			Issuer:    "gateway",
+			ID:        tokenID,
 		},
 	}
 
@@ -73,7 +87,7 @@ func (m *JWTManager) GenerateRefreshToken(userID uint64, role int32) (string, er
 
 // GenerateToken 生成 JWT token（兼容旧接口，生成 Access Token）
 func (m *JWTManager) GenerateToken(userID uint64, role int32) (string, error) {
-	return m.GenerateAccessToken(userID, role)
+	return m.GenerateAccessToken(userID, role, "")
 }
 
 // GetAccessTokenDuration 获取 Access Token 过期时间
@@ -110,3 +124,28 @@ func (m *JWTManager) VerifyToken(tokenString string) (*Claims, error) {
 
 	return nil, ErrInvalidToken
 }
+
+// VerifyAccessToken 验证 Access Token，拒绝把 Refresh Token 当作 Access Token 使用
+// 没有 typ 声明的旧 Access Token 仍然放行，直到自然过期
+func (m *JWTManager) VerifyAccessToken(tokenString string) (*Claims, error) {
+	claims, err := m.VerifyToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if claims.TokenType == TokenTypeRefresh {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
+
+// VerifyRefreshToken 验证 Refresh Token，只接受 typ=refresh 的 token
+func (m *JWTManager) VerifyRefreshToken(tokenString string) (*Claims, error) {
+	claims, err := m.VerifyToken(tokenString)
+	if err != nil {
+		return nil, err
+	}
+	if claims.TokenType != TokenTypeRefresh {
+		return nil, ErrInvalidToken
+	}
+	return claims, nil
+}
@@ -0,0 +1,62 @@
+package jwt
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestVerifyTokenType(t *testing.T) {
+	m := NewJWTManager("test-secret", time.Minute, time.Hour)
+
+	access, err := m.GenerateAccessToken(1, 0, "sess-1")
+	require.NoError(t, err)
+	refresh, err := m.GenerateRefreshToken(1, 0, "sess-1", "jti-1")
+	require.NoError(t, err)
+	// 没有 typ 声明的旧 token（typ 上线前签发）
+	legacy, err := jwt.NewWithClaims(jwt.SigningMethodHS256, &Claims{
+		UserID: 1,
+		RegisteredClaims: jwt.RegisteredClaims{
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		},
+	}).SignedString([]byte("test-secret"))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name       string
+		token      string
+		accessErr  error
+		refreshErr error
+	}{
+		{name: "Access Token", token: access, refreshErr: ErrInvalidToken},
+		{name: "Refresh Token", token: refresh, accessErr: ErrInvalidToken},
+		{name: "无 typ 的旧 token", token: legacy, refreshErr: ErrInvalidToken},
+		{name: "签名错误", token: access + "x", accessErr: ErrInvalidToken, refreshErr: ErrInvalidToken},
+		{name: "空 token", token: "", accessErr: ErrInvalidToken, refreshErr: ErrInvalidToken},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := m.VerifyAccessToken(tt.token)
+			assert.ErrorIs(t, err, tt.accessErr)
+			_, err = m.VerifyRefreshToken(tt.token)
+			assert.ErrorIs(t, err, tt.refreshErr)
+		})
+	}
+}
+
+func TestVerifyTokenExpired(t *testing.T) {
+	m := NewJWTManager("test-secret", -time.Minute, -time.Minute)
+
+	access, err := m.GenerateAccessToken(1, 0, "sess-1")
+	require.NoError(t, err)
+	refresh, err := m.GenerateRefreshToken(1, 0, "sess-1", "jti-1")
+	require.NoError(t, err)
+
+	_, err = m.VerifyAccessToken(access)
+	assert.ErrorIs(t, err, ErrExpiredToken)
+	_, err = m.VerifyRefreshToken(refresh)
+	assert.ErrorIs(t, err, ErrExpiredToken)
+}
@@ -0,0 +1,72 @@
+package jwt
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// RotateResult Refresh Token 轮换结果
+type RotateResult int
+
+const (
+	// RotateUnknown 会话没有轮换记录（会话已撤销或为轮换上线前签发的 token）
+	RotateUnknown RotateResult = iota
+	// RotateOK 提交的是当前 Refresh Token，已替换为新的 jti
+	RotateOK
+	// RotateGrace 提交的是刚被轮换掉的 Refresh Token，且仍在宽限期内（并发刷新）
+	RotateGrace
+	// RotateReused 提交的是已被轮换掉的旧 Refresh Token，视为 token 被盗用
+	RotateReused
+)
+
+// rotateScript 原子地比较并替换会话当前的 Refresh Token jti
+// KEYS[1]: 当前 jti  KEYS[2]: 上一个 jti（宽限期内存在）
+// ARGV[1]: 提交的 jti  ARGV[2]: 新 jti  ARGV[3]: 新 token 有效期（秒）  ARGV[4]: 宽限期（秒）
+// 返回: -1 无记录 / 1 轮换成功 / 2 宽限期内的并发刷新 / 0 旧 token 被重复使用
+var rotateScript = redis.NewScript(`
+local cur = redis.call("GET", KEYS[1])
+if not cur then
+  return -1
+end
+if cur == ARGV[1] then
+  redis.call("SET", KEYS[1], ARGV[2], "EX", ARGV[3])
+  if tonumber(ARGV[4]) > 0 then
+    redis.call("SET", KEYS[2], ARGV[1], "EX", ARGV[4])
+  end
+  return 1
+end
+if redis.call("GET", KEYS[2]) == ARGV[1] then
+  return 2
+end
+return 0
+`)
+
+// RotateRefreshToken 轮换会话的 Refresh Token：只有当前 jti 可以换取新 jti
+// grace 为并发刷新的宽限期，期间再次提交刚被替换的 jti 不视为盗用
+func (r *RedisTokenStore) RotateRefreshToken(ctx context.Context, sessionID, oldTokenID, newTokenID string, ttl, grace time.Duration) (RotateResult, error) {
+	val, err := r.redis.ScriptRunCtx(ctx, rotateScript,
+		[]string{r.getRefreshFamilyKey(sessionID), r.getRefreshFamilyPrevKey(sessionID)},
+		oldTokenID, newTokenID, max(int(ttl.Seconds()), 60), int(math.Ceil(grace.Seconds())))
+	if err != nil {
+		return RotateUnknown, err
+	}
+
+	code, ok := val.(int64)
+	if !ok {
+		return RotateUnknown, fmt.Errorf("unexpected rotate script result: %v", val)
+	}
+	switch code {
+	case 1:
+		return RotateOK, nil
+	case 2:
+		return RotateGrace, nil
+	case 0:
+		return RotateReused, nil
+	default:
+		return RotateUnknown, nil
+	}
+}
@@ -0,0 +1,99 @@
+package jwt
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// newTestStore 基于 miniredis 创建 Token 存储
+func newTestStore(t *testing.T) (*RedisTokenStore, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	return NewRedisTokenStore(redis.New(mr.Addr())), mr
+}
+
+func TestRotateRefreshToken(t *testing.T) {
+	const sid = "sess-1"
+
+	tests := []struct {
+		name    string
+		current string // 会话当前 jti，空表示没有轮换记录
+		prev    string // 宽限期内的上一个 jti
+		submit  string
+		grace   time.Duration
+		want    RotateResult
+		wantCur string
+	}{
+		{name: "没有轮换记录", submit: "a", want: RotateUnknown},
+		{name: "提交当前 jti", current: "a", submit: "a", grace: 10 * time.Second, want: RotateOK, wantCur: "b"},
+		{name: "宽限期内提交刚被替换的 jti", current: "b", prev: "a", submit: "a", want: RotateGrace, wantCur: "b"},
+		{name: "提交已过宽限期的旧 jti", current: "b", submit: "a", want: RotateReused, wantCur: "b"},
+		{name: "提交未知 jti", current: "b", prev: "a", submit: "x", want: RotateReused, wantCur: "b"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store, mr := newTestStore(t)
+			if tt.current != "" {
+				require.NoError(t, mr.Set(store.getRefreshFamilyKey(sid), tt.current))
+			}
+			if tt.prev != "" {
+				require.NoError(t, mr.Set(store.getRefreshFamilyPrevKey(sid), tt.prev))
+			}
+
+			got, err := store.RotateRefreshToken(context.Background(), sid, tt.submit, "b", time.Hour, tt.grace)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+
+			if tt.wantCur != "" {
+				cur, err := mr.Get(store.getRefreshFamilyKey(sid))
+				require.NoError(t, err)
+				assert.Equal(t, tt.wantCur, cur)
+			}
+		})
+	}
+}
+
+func TestRotateRefreshTokenGraceWindow(t *testing.T) {
+	const sid = "sess-1"
+	store, mr := newTestStore(t)
+	ctx := context.Background()
+	require.NoError(t, mr.Set(store.getRefreshFamilyKey(sid), "a"))
+
+	got, err := store.RotateRefreshToken(ctx, sid, "a", "b", time.Hour, 5*time.Second)
+	require.NoError(t, err)
+	require.Equal(t, RotateOK, got)
+
+	// 宽限期内重复提交旧 jti 视为并发刷新
+	got, err = store.RotateRefreshToken(ctx, sid, "a", "c", time.Hour, 5*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, RotateGrace, got)
+
+	// 宽限期过后再提交旧 jti 视为盗用
+	mr.FastForward(6 * time.Second)
+	got, err = store.RotateRefreshToken(ctx, sid, "a", "c", time.Hour, 5*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, RotateReused, got)
+}
+
+func TestRotateRefreshTokenWithoutGrace(t *testing.T) {
+	const sid = "sess-1"
+	store, mr := newTestStore(t)
+	ctx := context.Background()
+	require.NoError(t, mr.Set(store.getRefreshFamilyKey(sid), "a"))
+
+	got, err := store.RotateRefreshToken(ctx, sid, "a", "b", time.Hour, 0)
+	require.NoError(t, err)
+	require.Equal(t, RotateOK, got)
+	assert.False(t, mr.Exists(store.getRefreshFamilyPrevKey(sid)))
+
+	got, err = store.RotateRefreshToken(ctx, sid, "a", "c", time.Hour, 0)
+	require.NoError(t, err)
+	assert.Equal(t, RotateReused, got)
+}
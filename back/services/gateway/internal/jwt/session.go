@@ -0,0 +1,214 @@
+package jwt
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// Session 一次登录会话（对应一个设备上签发的 Refresh Token）
+type Session struct {
+	ID         string `json:"id"`
+	UserID     uint64 `json:"user_id"`
+	Role       int32  `json:"role"`
+	DeviceName string `json:"device_name"`
+	UserAgent  string `json:"user_agent"`
+	IP         string `json:"ip"`
+	CreatedAt  int64  `json:"created_at"`   // 登录时间（Unix 秒）
+	LastSeenAt int64  `json:"last_seen_at"` // 最后活跃时间（登录或刷新 Token 时更新）
+	ExpiresAt  int64  `json:"expires_at"`   // Refresh Token 过期时间
+
+	RefreshTokenID string `json:"-"` // 当前有效的 Refresh Token jti，单独存放用于轮换校验
+}
+
+// NewSessionID 生成随机会话 ID
+func NewSessionID() (string, error) {
+	return randomID()
+}
+
+// NewTokenID 生成随机 Token ID（jti）
+func NewTokenID() (string, error) {
+	return randomID()
+}
+
+func randomID() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// CreateSession 记录一次登录会话，超出 maxSessions（<=0 表示不限制）时踢掉最早登录的会话
+// 会话按用户存放在同一个 Hash 中，Hash 的过期时间随最新会话顺延
+func (r *RedisTokenStore) CreateSession(ctx context.Context, session *Session, maxSessions int, accessTokenDuration time.Duration) ([]*Session, error) {
+	key := r.getSessionKey(session.UserID)
+	sessions, err := r.loadSessions(ctx, session.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var evicted []*Session
+	if maxSessions > 0 && len(sessions) >= maxSessions {
+		sort.Slice(sessions, func(i, j int) bool {
+			return sessions[i].CreatedAt < sessions[j].CreatedAt
+		})
+		evicted = sessions[:len(sessions)-maxSessions+1]
+		for _, s := range evicted {
+			if _, err := r.RevokeSession(ctx, s.UserID, s.ID, accessTokenDuration); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	data, err := json.Marshal(session)
+	if err != nil {
+		return nil, err
+	}
+	if err := r.redis.HsetCtx(ctx, key, session.ID, string(data)); err != nil {
+		return nil, err
+	}
+	ttl := session.ExpiresAt - time.Now().Unix()
+	if ttl > 0 {
+		if err := r.redis.ExpireCtx(ctx, key, int(ttl)); err != nil {
+			logx.Errorf("expire session key for user %d failed: %v", session.UserID, err)
+		}
+	}
+	if session.RefreshTokenID != "" {
+		if err := r.redis.SetexCtx(ctx, r.getRefreshFamilyKey(session.ID), session.RefreshTokenID, int(max(ttl, 60))); err != nil {
+			return nil, err
+		}
+	}
+
+	return evicted, nil
+}
+
+// ListSessions 获取用户当前所有未过期的会话（按最后活跃时间倒序）
+func (r *RedisTokenStore) ListSessions(ctx context.Context, userID uint64) ([]*Session, error) {
+	sessions, err := r.loadSessions(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeenAt > sessions[j].LastSeenAt
+	})
+	return sessions, nil
+}
+
+// TouchSession 更新会话的最后活跃时间与 IP（expiresAt > 0 时顺延会话过期时间），会话不存在时返回 false
+func (r *RedisTokenStore) TouchSession(ctx context.Context, userID uint64, sessionID, ip string, expiresAt int64) (bool, error) {
+	key := r.getSessionKey(userID)
+	val, err := r.redis.HgetCtx(ctx, key, sessionID)
+	if err != nil {
+		if errors.Is(err, redis.Nil) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	var s Session
+	if err := json.Unmarshal([]byte(val), &s); err != nil {
+		return false, err
+	}
+	s.LastSeenAt = time.Now().Unix()
+	if ip != "" {
+		s.IP = ip
+	}
+	if expiresAt > 0 {
+		s.ExpiresAt = expiresAt
+	}
+
+	data, err := json.Marshal(&s)
+	if err != nil {
+		return false, err
+	}
+	if err := r.redis.HsetCtx(ctx, key, sessionID, string(data)); err != nil {
+		return false, err
+	}
+	if ttl := expiresAt - time.Now().Unix(); ttl > 0 {
+		if err := r.redis.ExpireCtx(ctx, key, int(ttl)); err != nil {
+			logx.Errorf("expire session key for user %d failed: %v", userID, err)
+		}
+	}
+	return true, nil
+}
+
+// RevokeSession 撤销指定会话，会话不存在时返回 false
+// 删除会话后该设备无法再刷新 Token；同时写入会话黑名单，使已签发但未过期的 Access Token 立即失效
+func (r *RedisTokenStore) RevokeSession(ctx context.Context, userID uint64, sessionID string, accessTokenDuration time.Duration) (bool, error) {
+	deleted, err := r.redis.HdelCtx(ctx, r.getSessionKey(userID), sessionID)
+	if err != nil {
+		return false, err
+	}
+	if !deleted {
+		return false, nil
+	}
+	if _, err := r.redis.DelCtx(ctx, r.getRefreshFamilyKey(sessionID), r.getRefreshFamilyPrevKey(sessionID)); err != nil {
+		logx.Errorf("delete refresh token family of session %s failed: %v", sessionID, err)
+	}
+
+	ttl := int(accessTokenDuration.Seconds())
+	if ttl <= 0 {
+		ttl = 60
+	}
+	if err := r.redis.SetexCtx(ctx, r.getSessionBlacklistKey(sessionID), "1", ttl); err != nil {
+		return true, err
+	}
+	logx.Infof("Revoked session %s for user %d", sessionID, userID)
+	return true, nil
+}
+
+// loadSessions 读取用户的全部会话，顺带清理已过期的会话
+func (r *RedisTokenStore) loadSessions(ctx context.Context, userID uint64) ([]*Session, error) {
+	key := r.getSessionKey(userID)
+	all, err := r.redis.HgetallCtx(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now().Unix()
+	sessions := make([]*Session, 0, len(all))
+	var expired []string
+	for id, val := range all {
+		var s Session
+		if err := json.Unmarshal([]byte(val), &s); err != nil || s.ExpiresAt <= now {
+			expired = append(expired, id)
+			continue
+		}
+		sessions = append(sessions, &s)
+	}
+	if len(expired) > 0 {
+		if _, err := r.redis.HdelCtx(ctx, key, expired...); err != nil {
+			logx.Errorf("clean expired sessions for user %d failed: %v", userID, err)
+		}
+	}
+	return sessions, nil
+}
+
+// getSessionKey 获取用户会话 Hash 的 Redis key
+func (r *RedisTokenStore) getSessionKey(userID uint64) string {
+	return fmt.Sprintf("session:user:%d", userID)
+}
+
+// getRefreshFamilyKey 获取会话当前 Refresh Token jti 的 Redis key
+func (r *RedisTokenStore) getRefreshFamilyKey(sessionID string) string {
+	return fmt.Sprintf("session:refresh:%s", sessionID)
+}
+
+// getRefreshFamilyPrevKey 获取会话上一个 Refresh Token jti 的 Redis key（仅在宽限期内存在）
+func (r *RedisTokenStore) getRefreshFamilyPrevKey(sessionID string) string {
+	return fmt.Sprintf("session:refresh:prev:%s", sessionID)
+}
+
+// getSessionBlacklistKey 获取会话黑名单的 Redis key
+func (r *RedisTokenStore) getSessionBlacklistKey(sessionID string) string {
+	return fmt.Sprintf("blacklist:session:%s", sessionID)
+}
@@ -14,18 +14,31 @@ type TokenStore interface {
 	// StoreRefreshToken 不再存储有效 token（黑名单模式不需要）
 	// 保留此方法以兼容接口，实际为空操作
 	StoreRefreshToken(ctx context.Context, userID uint64, token string, expiration time.Duration) error
-	// VerifyRefreshToken 验证 Refresh Token 是否在黑名单中（已被撤销）
+	// VerifyRefreshToken 验证 Refresh Token 是否在黑名单中（已被撤销），带会话 ID 时还要求会话仍然存在
 	// 返回 true 表示 token 有效（不在黑名单），false 表示已被撤销
-	VerifyRefreshToken(ctx context.Context, userID uint64, token string, tokenExpiration time.Time) (bool, error)
-	// VerifyAccessToken 验证 Access Token 是否在黑名单中（已被撤销）
+	VerifyRefreshToken(ctx context.Context, userID uint64, sessionID, token string, tokenExpiration time.Time) (bool, error)
+	// VerifyAccessToken 验证 Access Token 是否在黑名单中（已被撤销），带会话 ID 时同时检查会话黑名单
 	// 返回 true 表示 token 有效（不在黑名单），false 表示已被撤销
-	VerifyAccessToken(ctx context.Context, userID uint64, token string, tokenExpiration time.Time) (bool, error)
+	VerifyAccessToken(ctx context.Context, userID uint64, sessionID, token string, tokenExpiration time.Time) (bool, error)
 	// RevokeRefreshToken 撤销 Refresh Token（加入黑名单）
 	RevokeRefreshToken(ctx context.Context, userID uint64, token string, remainingTTL time.Duration) error
 	// RevokeAccessToken 撤销 Access Token（加入黑名单）
 	RevokeAccessToken(ctx context.Context, userID uint64, token string, remainingTTL time.Duration) error
 	// RevokeAllUserTokens 撤销用户的所有 Token（设置用户级别黑名单，包括 Access Token 和 Refresh Token）
 	RevokeAllUserTokens(ctx context.Context, userID uint64, refreshTokenDuration time.Duration) error
+	// ClearUserRevocation 清除用户级别黑名单（解除封禁后允许重新登录）
+	ClearUserRevocation(ctx context.Context, userID uint64) error
+
+	// CreateSession 记录一次登录会话，超出 maxSessions 时踢掉最早登录的会话并返回被踢掉的会话
+	CreateSession(ctx context.Context, session *Session, maxSessions int, accessTokenDuration time.Duration) ([]*Session, error)
+	// ListSessions 获取用户当前所有未过期的会话（按最后活跃时间倒序）
+	ListSessions(ctx context.Context, userID uint64) ([]*Session, error)
+	// TouchSession 更新会话的最后活跃时间与 IP（expiresAt > 0 时顺延会话过期时间），会话不存在时返回 false
+	TouchSession(ctx context.Context, userID uint64, sessionID, ip string, expiresAt int64) (bool, error)
+	// RotateRefreshToken 轮换会话的 Refresh Token（比较并替换当前 jti），用于检测旧 token 被重复使用
+	RotateRefreshToken(ctx context.Context, sessionID, oldTokenID, newTokenID string, ttl, grace time.Duration) (RotateResult, error)
+	// RevokeSession 撤销指定会话（该设备的 Refresh Token 与已签发的 Access Token 均失效），会话不存在时返回 false
+	RevokeSession(ctx context.Context, userID uint64, sessionID string, accessTokenDuration time.Duration) (bool, error)
 }
 
 // RedisTokenStore Redis Token 存储
@@ -50,7 +63,7 @@ func (r *RedisTokenStore) StoreRefreshToken(ctx context.Context, userID uint64,
 // VerifyRefreshToken 验证 Refresh Token 是否在黑名单中（已被撤销）
 // tokenExpiration: token 的过期时间，用于计算黑名单的 TTL
 // 返回 true 表示 token 有效（不在黑名单），false 表示已被撤销
-func (r *RedisTokenStore) VerifyRefreshToken(ctx context.Context, userID uint64, token string, tokenExpiration time.Time) (bool, error) {
+func (r *RedisTokenStore) VerifyRefreshToken(ctx context.Context, userID uint64, sessionID, token string, tokenExpiration time.Time) (bool, error) {
 	// 1. 先检查用户级别的黑名单
 	userBlacklistKey := r.getUserBlacklistKey(userID)
 	exists, err := r.redis.Exists(userBlacklistKey)
@@ -73,6 +86,18 @@ func (r *RedisTokenStore) VerifyRefreshToken(ctx context.Context, userID uint64,
 		return false, nil
 	}
 
+	// 3. 会话被撤销或被挤下线后，该设备的 Refresh Token 不能再使用
+	// 未携带会话 ID 的旧 token 只走黑名单校验
+	if sessionID != "" {
+		exists, err = r.redis.HexistsCtx(ctx, r.getSessionKey(userID), sessionID)
+		if err != nil {
+			return false, err
+		}
+		if !exists {
+			return false, nil
+		}
+	}
+
 	// token 不在黑名单中，有效
 	return true, nil
 }
@@ -80,26 +105,22 @@ func (r *RedisTokenStore) VerifyRefreshToken(ctx context.Context, userID uint64,
 // VerifyAccessToken 验证 Access Token 是否在黑名单中（已被撤销）
 // tokenExpiration: token 的过期时间，用于计算黑名单的 TTL
 // 返回 true 表示 token 有效（不在黑名单），false 表示已被撤销
-func (r *RedisTokenStore) VerifyAccessToken(ctx context.Context, userID uint64, token string, tokenExpiration time.Time) (bool, error) {
-	// 1. 先检查用户级别的黑名单
-	userBlacklistKey := r.getUserBlacklistKey(userID)
-	exists, err := r.redis.Exists(userBlacklistKey)
-	if err != nil {
-		return false, err
+// 用户级、token 级与会话级黑名单通过一次 EXISTS 检查，不读取会话详情，保持每个请求只访问一次 Redis
+func (r *RedisTokenStore) VerifyAccessToken(ctx context.Context, userID uint64, sessionID, token string, tokenExpiration time.Time) (bool, error) {
+	keys := []string{
+		r.getUserBlacklistKey(userID),
+		r.getAccessTokenBlacklistKey(userID, token),
 	}
-	if exists {
-		// 用户所有 token 都被撤销
-		return false, nil
+	if sessionID != "" {
+		keys = append(keys, r.getSessionBlacklistKey(sessionID))
 	}
 
-	// 2. 检查具体 token 的黑名单
-	tokenBlacklistKey := r.getAccessTokenBlacklistKey(userID, token)
-	exists, err = r.redis.Exists(tokenBlacklistKey)
+	n, err := r.redis.ExistsManyCtx(ctx, keys...)
 	if err != nil {
 		return false, err
 	}
-	if exists {
-		// token 已被撤销
+	if n > 0 {
+		// 用户所有 token、该 token 或该会话已被撤销
 		return false, nil
 	}
 
@@ -154,6 +175,20 @@ func (r *RedisTokenStore) RevokeAllUserTokens(ctx context.Context, userID uint64
 	err := r.redis.Setex(userBlacklistKey, "1", ttl)
 	if err == nil {
 		logx.Infof("Revoked all tokens for user %d, TTL: %d seconds", userID, ttl)
+		// 所有会话随之失效
+		if _, delErr := r.redis.DelCtx(ctx, r.getSessionKey(userID)); delErr != nil {
+			logx.Errorf("delete sessions for user %d failed: %v", userID, delErr)
+		}
+	}
+	return err
+}
+
+// ClearUserRevocation 清除用户级别黑名单
+// 用户级黑名单不区分签发时间，解除封禁后必须清除，否则重新登录拿到的新 token 也会被拒绝
+func (r *RedisTokenStore) ClearUserRevocation(ctx context.Context, userID uint64) error {
+	_, err := r.redis.DelCtx(ctx, r.getUserBlacklistKey(userID))
+	if err == nil {
+		logx.Infof("Cleared token revocation for user %d", userID)
 	}
 	return err
 }
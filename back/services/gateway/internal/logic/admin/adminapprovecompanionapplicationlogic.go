@@ -0,0 +1,31 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminApproveCompanionApplicationLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewAdminApproveCompanionApplicationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminApproveCompanionApplicationLogic {
+	return &AdminApproveCompanionApplicationLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AdminApproveCompanionApplicationLogic) AdminApproveCompanionApplication(req *types.AdminApproveCompanionApplicationRequest) (resp *types.AdminReviewCompanionApplicationResponse, err error) {
+	return reviewCompanionApplication(l.ctx, l.svcCtx, l.Logger, req.Id, true, "", req.Note), nil
+}
@@ -0,0 +1,31 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminBanUserLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewAdminBanUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminBanUserLogic {
+	return &AdminBanUserLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AdminBanUserLogic) AdminBanUser(req *types.AdminModerateUserRequest) (resp *types.AdminModerateUserResponse, err error) {
+	return moderateUser(l.ctx, l.svcCtx, l.Logger, req.UserId, userStatusBanned, req.Duration, req.Reason), nil
+}
@@ -0,0 +1,59 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/helper"
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminDeleteGameSkillLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewAdminDeleteGameSkillLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminDeleteGameSkillLogic {
+	return &AdminDeleteGameSkillLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AdminDeleteGameSkillLogic) AdminDeleteGameSkill(req *types.AdminDeleteGameSkillRequest) (resp *types.AdminDeleteGameSkillResponse, err error) {
+	if req.Id == 0 {
+		return &types.AdminDeleteGameSkillResponse{BaseResp: types.BaseResp{Code: 400, Msg: "技能ID不能为空"}}, nil
+	}
+
+	if l.svcCtx.UserRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "UserRPC")
+		return &types.AdminDeleteGameSkillResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	if _, err := l.svcCtx.UserRPC.DeleteGameSkill(l.ctx, &userclient.DeleteGameSkillRequest{Id: req.Id}); err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "DeleteGameSkill")
+		return &types.AdminDeleteGameSkillResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	invalidateGameSkillsCache(l.svcCtx, l.Logger)
+	operatorID, _ := middleware.GetUserID(l.ctx)
+	helper.LogSuccess(l.Logger, helper.OpAdminGameSkill, map[string]interface{}{
+		"action":      "delete",
+		"operator_id": operatorID,
+		"skill_id":    req.Id,
+	})
+
+	return &types.AdminDeleteGameSkillResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: utils.GetSuccessMsg("DeleteGameSkill")},
+	}, nil
+}
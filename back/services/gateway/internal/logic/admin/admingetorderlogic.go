@@ -0,0 +1,63 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/helper"
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/order/orderclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminGetOrderLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewAdminGetOrderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminGetOrderLogic {
+	return &AdminGetOrderLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AdminGetOrderLogic) AdminGetOrder(req *types.AdminGetOrderRequest) (resp *types.GetOrderResponse, err error) {
+	if req.Id == 0 && req.OrderNo == "" {
+		return &types.GetOrderResponse{BaseResp: types.BaseResp{Code: 400, Msg: "请提供订单ID或订单号"}}, nil
+	}
+
+	if l.svcCtx.OrderRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "OrderRPC")
+		return &types.GetOrderResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	// 不传 operator_id：管理员可以查看双方已删除的订单
+	rpcResp, err := l.svcCtx.OrderRPC.GetOrder(l.ctx, &orderclient.GetOrderRequest{
+		Id:      req.Id,
+		OrderNo: req.OrderNo,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "GetOrder")
+		return &types.GetOrderResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	operatorID, _ := middleware.GetUserID(l.ctx)
+	helper.LogInfo(l.Logger, helper.OpAdminQuery, "admin inspect order", map[string]interface{}{
+		"operator_id": operatorID,
+		"order_id":    rpcResp.GetOrder().GetId(),
+	})
+
+	return &types.GetOrderResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: "success"},
+		Data:     toOrderInfo(rpcResp.GetOrder()),
+	}, nil
+}
@@ -0,0 +1,40 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminGetRateLimitLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewAdminGetRateLimitLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminGetRateLimitLogic {
+	return &AdminGetRateLimitLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AdminGetRateLimitLogic) AdminGetRateLimit() (resp *types.GetRateLimitStatusResponse, err error) {
+	if l.svcCtx.RateLimit == nil {
+		return &types.GetRateLimitStatusResponse{
+			BaseResp: types.BaseResp{Code: 503, Msg: "限流器未初始化"},
+		}, nil
+	}
+
+	return &types.GetRateLimitStatusResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: "success"},
+		Data:     l.svcCtx.RateLimit.RateLimitStatus(),
+	}, nil
+}
@@ -0,0 +1,99 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/helper"
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminGetUserLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewAdminGetUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminGetUserLogic {
+	return &AdminGetUserLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AdminGetUserLogic) AdminGetUser(req *types.AdminGetUserRequest) (resp *types.AdminGetUserResponse, err error) {
+	if req.Id == 0 && req.Uid == 0 && req.Phone == "" {
+		return &types.AdminGetUserResponse{BaseResp: types.BaseResp{Code: 400, Msg: "请提供用户ID、UID或手机号"}}, nil
+	}
+
+	if l.svcCtx.UserRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "UserRPC")
+		return &types.AdminGetUserResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	rpcResp, err := l.svcCtx.UserRPC.GetUser(l.ctx, &userclient.GetUserRequest{
+		Id:    req.Id,
+		Uid:   req.Uid,
+		Phone: req.Phone,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "GetUser")
+		return &types.AdminGetUserResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	u := rpcResp.GetUser()
+	data := types.AdminUserData{
+		User: types.UserInfo{
+			Id:             u.GetId(),
+			Uid:            u.GetUid(),
+			Nickname:       u.GetNickname(),
+			Phone:          u.GetPhone(),
+			Email:          u.GetEmail(),
+			Role:           int(u.GetRole()),
+			AvatarUrl:      u.GetAvatarUrl(),
+			Bio:            u.GetBio(),
+			FollowerCount:  u.GetFollowerCount(),
+			FollowingCount: u.GetFollowingCount(),
+			VipLevel:       u.GetVipLevel(),
+			VipBadge:       u.GetVipBadge(),
+			VipExpireAt:    u.GetVipExpireAt(),
+			Gender:         u.GetGender(),
+			Birthday:       u.GetBirthday(),
+		},
+		Moderation: toUserModeration(u),
+	}
+
+	// 钱包信息查询失败不影响用户信息返回
+	walletResp, err := l.svcCtx.UserRPC.GetWallet(l.ctx, &userclient.GetWalletRequest{UserId: u.GetId()})
+	if err != nil {
+		l.Infof("admin get user wallet failed user_id=%d err=%v", u.GetId(), err)
+	} else if w := walletResp.GetWallet(); w != nil {
+		data.Wallet = types.WalletInfo{
+			UserId:        w.GetUserId(),
+			Balance:       w.GetBalance(),
+			FrozenBalance: w.GetFrozenBalance(),
+		}
+		data.User.Balance = w.GetBalance()
+		data.User.FrozenBalance = w.GetFrozenBalance()
+	}
+
+	operatorID, _ := middleware.GetUserID(l.ctx)
+	helper.LogInfo(l.Logger, helper.OpAdminQuery, "admin lookup user", map[string]interface{}{
+		"operator_id": operatorID,
+		"user_id":     u.GetId(),
+	})
+
+	return &types.AdminGetUserResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: "success"},
+		Data:     data,
+	}, nil
+}
@@ -0,0 +1,62 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminListCompanionApplicationsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewAdminListCompanionApplicationsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminListCompanionApplicationsLogic {
+	return &AdminListCompanionApplicationsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AdminListCompanionApplicationsLogic) AdminListCompanionApplications(req *types.AdminListCompanionApplicationsRequest) (resp *types.AdminListCompanionApplicationsResponse, err error) {
+	if l.svcCtx.UserRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "UserRPC")
+		return &types.AdminListCompanionApplicationsResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	rpcResp, err := l.svcCtx.UserRPC.ListCompanionApplications(l.ctx, &userclient.ListCompanionApplicationsRequest{
+		Status:   req.Status,
+		UserId:   req.UserId,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "ListCompanionApplications")
+		return &types.AdminListCompanionApplicationsResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	apps := make([]types.CompanionApplicationInfo, 0, len(rpcResp.GetApplications()))
+	for _, a := range rpcResp.GetApplications() {
+		apps = append(apps, toCompanionApplicationInfo(a))
+	}
+
+	return &types.AdminListCompanionApplicationsResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: utils.GetSuccessMsg("ListCompanionApplications")},
+		Data: types.AdminListCompanionApplicationsData{
+			Applications: apps,
+			Total:        rpcResp.GetTotal(),
+			Page:         rpcResp.GetPage(),
+			PageSize:     rpcResp.GetPageSize(),
+		},
+	}, nil
+}
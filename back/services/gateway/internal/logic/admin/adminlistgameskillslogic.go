@@ -0,0 +1,52 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminListGameSkillsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewAdminListGameSkillsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminListGameSkillsLogic {
+	return &AdminListGameSkillsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AdminListGameSkillsLogic) AdminListGameSkills() (resp *types.ListGameSkillsResponse, err error) {
+	if l.svcCtx.UserRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "UserRPC")
+		return &types.ListGameSkillsResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	rpcResp, err := l.svcCtx.UserRPC.ListGameSkills(l.ctx, &userclient.ListGameSkillsRequest{})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "ListGameSkills")
+		return &types.ListGameSkillsResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	data := make([]types.GameSkill, 0, len(rpcResp.GetSkills()))
+	for _, gs := range rpcResp.GetSkills() {
+		data = append(data, toGameSkill(gs))
+	}
+
+	return &types.ListGameSkillsResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: "success"},
+		Data:     data,
+	}, nil
+}
@@ -0,0 +1,63 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/order/orderclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminListOrdersLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewAdminListOrdersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminListOrdersLogic {
+	return &AdminListOrdersLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AdminListOrdersLogic) AdminListOrders(req *types.AdminListOrdersRequest) (resp *types.GetOrderListResponse, err error) {
+	if l.svcCtx.OrderRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "OrderRPC")
+		return &types.GetOrderListResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	rpcResp, err := l.svcCtx.OrderRPC.GetOrderList(l.ctx, &orderclient.GetOrderListRequest{
+		BossId:      req.BossId,
+		CompanionId: req.CompanionId,
+		Status:      req.Status,
+		Page:        req.Page,
+		PageSize:    req.PageSize,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "GetOrderList")
+		return &types.GetOrderListResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	orders := make([]types.OrderInfo, 0, len(rpcResp.GetOrders()))
+	for _, o := range rpcResp.GetOrders() {
+		orders = append(orders, toOrderInfo(o))
+	}
+
+	return &types.GetOrderListResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: "success"},
+		Data: types.GetOrderListData{
+			Orders:   orders,
+			Total:    rpcResp.GetTotal(),
+			Page:     rpcResp.GetPage(),
+			PageSize: rpcResp.GetPageSize(),
+		},
+	}, nil
+}
@@ -0,0 +1,31 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminMuteUserLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewAdminMuteUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminMuteUserLogic {
+	return &AdminMuteUserLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AdminMuteUserLogic) AdminMuteUser(req *types.AdminModerateUserRequest) (resp *types.AdminModerateUserResponse, err error) {
+	return moderateUser(l.ctx, l.svcCtx, l.Logger, req.UserId, userStatusMuted, req.Duration, req.Reason), nil
+}
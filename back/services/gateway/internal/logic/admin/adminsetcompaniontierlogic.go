@@ -0,0 +1,79 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"context"
+	"strings"
+
+	"SLGaming/back/services/gateway/internal/helper"
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminSetCompanionTierLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewAdminSetCompanionTierLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminSetCompanionTierLogic {
+	return &AdminSetCompanionTierLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// AdminSetCompanionTier 指定陪玩等级；tier 为 0 时取消指定，恢复按表现评估
+func (l *AdminSetCompanionTierLogic) AdminSetCompanionTier(req *types.AdminSetCompanionTierRequest) (resp *types.AdminSetCompanionTierResponse, err error) {
+	if req.UserId == 0 {
+		return &types.AdminSetCompanionTierResponse{BaseResp: types.BaseResp{Code: 400, Msg: "陪玩ID不能为空"}}, nil
+	}
+	if req.Tier < 0 {
+		return &types.AdminSetCompanionTierResponse{BaseResp: types.BaseResp{Code: 400, Msg: "等级参数无效"}}, nil
+	}
+
+	operatorID, err := middleware.GetUserID(l.ctx)
+	if err != nil || operatorID == 0 {
+		return &types.AdminSetCompanionTierResponse{BaseResp: types.BaseResp{Code: 401, Msg: "未登录或登录已过期"}}, nil
+	}
+
+	if l.svcCtx.UserRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "UserRPC")
+		return &types.AdminSetCompanionTierResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	rpcResp, err := l.svcCtx.UserRPC.SetCompanionTierOverride(l.ctx, &userclient.SetCompanionTierOverrideRequest{
+		UserId:     req.UserId,
+		OperatorId: operatorID,
+		Tier:       req.Tier,
+		Note:       strings.TrimSpace(req.Note),
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "SetCompanionTierOverride")
+		return &types.AdminSetCompanionTierResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	helper.LogInfo(l.Logger, helper.OpAdminCompanionTier, "admin set companion tier", map[string]interface{}{
+		"operator_id": operatorID,
+		"user_id":     req.UserId,
+		"tier":        rpcResp.GetTier(),
+		"overridden":  rpcResp.GetOverridden(),
+	})
+
+	return &types.AdminSetCompanionTierResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: utils.GetSuccessMsg("SetCompanionTier")},
+		Data: types.AdminSetCompanionTierData{
+			Tier:       rpcResp.GetTier(),
+			TierName:   rpcResp.GetTierName(),
+			Overridden: rpcResp.GetOverridden(),
+		},
+	}, nil
+}
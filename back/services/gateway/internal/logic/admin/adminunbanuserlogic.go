@@ -0,0 +1,31 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminUnbanUserLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewAdminUnbanUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminUnbanUserLogic {
+	return &AdminUnbanUserLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AdminUnbanUserLogic) AdminUnbanUser(req *types.AdminUnbanUserRequest) (resp *types.AdminModerateUserResponse, err error) {
+	return moderateUser(l.ctx, l.svcCtx, l.Logger, req.UserId, userStatusActive, 0, req.Reason), nil
+}
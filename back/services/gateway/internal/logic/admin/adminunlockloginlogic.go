@@ -0,0 +1,67 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"context"
+	"strings"
+
+	"SLGaming/back/services/gateway/internal/helper"
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminUnlockLoginLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewAdminUnlockLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminUnlockLoginLogic {
+	return &AdminUnlockLoginLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AdminUnlockLoginLogic) AdminUnlockLogin(req *types.AdminUnlockLoginRequest) (resp *types.AdminUnlockLoginResponse, err error) {
+	phone := strings.TrimSpace(req.Phone)
+	ip := strings.TrimSpace(req.Ip)
+	if phone == "" && ip == "" {
+		return &types.AdminUnlockLoginResponse{BaseResp: types.BaseResp{Code: 400, Msg: "请提供手机号或IP"}}, nil
+	}
+
+	if l.svcCtx.UserRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "UserRPC")
+		return &types.AdminUnlockLoginResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	rpcResp, err := l.svcCtx.UserRPC.UnlockLogin(l.ctx, &userclient.UnlockLoginRequest{
+		Phone: phone,
+		Ip:    ip,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "UnlockLogin")
+		return &types.AdminUnlockLoginResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	operatorID, _ := middleware.GetUserID(l.ctx)
+	helper.LogInfo(l.Logger, helper.OpAdminUnlockLogin, "admin unlock login", map[string]interface{}{
+		"operator_id": operatorID,
+		"phone":       phone,
+		"ip":          ip,
+		"was_locked":  rpcResp.GetWasLocked(),
+	})
+
+	return &types.AdminUnlockLoginResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: utils.GetSuccessMsg("UnlockLogin")},
+		Data:     types.AdminUnlockLoginData{WasLocked: rpcResp.GetWasLocked()},
+	}, nil
+}
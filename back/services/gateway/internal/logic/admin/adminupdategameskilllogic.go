@@ -0,0 +1,68 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package admin
+
+import (
+	"context"
+	"strings"
+
+	"SLGaming/back/services/gateway/internal/helper"
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type AdminUpdateGameSkillLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewAdminUpdateGameSkillLogic(ctx context.Context, svcCtx *svc.ServiceContext) *AdminUpdateGameSkillLogic {
+	return &AdminUpdateGameSkillLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *AdminUpdateGameSkillLogic) AdminUpdateGameSkill(req *types.AdminUpdateGameSkillRequest) (resp *types.AdminGameSkillResponse, err error) {
+	name := strings.TrimSpace(req.Name)
+	if req.Id == 0 || name == "" {
+		return &types.AdminGameSkillResponse{BaseResp: types.BaseResp{Code: 400, Msg: "技能ID和名称不能为空"}}, nil
+	}
+
+	if l.svcCtx.UserRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "UserRPC")
+		return &types.AdminGameSkillResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	rpcResp, err := l.svcCtx.UserRPC.UpdateGameSkill(l.ctx, &userclient.UpdateGameSkillRequest{
+		Id:          req.Id,
+		Name:        name,
+		Description: strings.TrimSpace(req.Description),
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "UpdateGameSkill")
+		return &types.AdminGameSkillResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	invalidateGameSkillsCache(l.svcCtx, l.Logger)
+	operatorID, _ := middleware.GetUserID(l.ctx)
+	helper.LogSuccess(l.Logger, helper.OpAdminGameSkill, map[string]interface{}{
+		"action":      "update",
+		"operator_id": operatorID,
+		"skill_id":    req.Id,
+		"name":        name,
+	})
+
+	return &types.AdminGameSkillResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: utils.GetSuccessMsg("UpdateGameSkill")},
+		Data:     toGameSkill(rpcResp.GetSkill()),
+	}, nil
+}
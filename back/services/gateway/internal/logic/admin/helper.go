@@ -0,0 +1,249 @@
+package admin
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"SLGaming/back/services/gateway/internal/helper"
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/order/orderclient"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// gameSkillsCacheKey 与公开接口 /api/user/gameskills 共用的缓存 key，技能变更后需删除
+const gameSkillsCacheKey = "cache:gameskills:all"
+
+// invalidateGameSkillsCache 删除游戏技能列表缓存
+func invalidateGameSkillsCache(svcCtx *svc.ServiceContext, logger logx.Logger) {
+	if svcCtx.CacheRedis == nil {
+		return
+	}
+	if _, err := svcCtx.CacheRedis.Del(gameSkillsCacheKey); err != nil {
+		logger.Errorf("delete game skills cache failed: %v", err)
+	}
+}
+
+// toGameSkill 将 RPC 的 GameSkill 转为网关层结构
+func toGameSkill(gs *userclient.GameSkill) types.GameSkill {
+	if gs == nil {
+		return types.GameSkill{}
+	}
+	return types.GameSkill{
+		Id:          gs.GetId(),
+		Name:        gs.GetName(),
+		Description: gs.GetDescription(),
+	}
+}
+
+// toCompanionApplicationInfo 将 RPC 的入驻申请转为网关层结构（管理员视图，含完整身份证号和审核备注）
+func toCompanionApplicationInfo(a *userclient.CompanionApplicationInfo) types.CompanionApplicationInfo {
+	if a == nil {
+		return types.CompanionApplicationInfo{}
+	}
+	skills := make([]types.CompanionSkill, 0, len(a.GetSkills()))
+	for _, s := range a.GetSkills() {
+		skills = append(skills, types.CompanionSkill{
+			GameSkillId:  s.GetGameSkillId(),
+			GameName:     s.GetGameName(),
+			Rank:         s.GetRank(),
+			PricePerHour: s.GetPricePerHour(),
+			IsVerified:   s.GetIsVerified(),
+		})
+	}
+	return types.CompanionApplicationInfo{
+		Id:             a.GetId(),
+		UserId:         a.GetUserId(),
+		RealName:       a.GetRealName(),
+		IdCardNo:       a.GetIdCardNo(),
+		IdCardFrontUrl: a.GetIdCardFrontUrl(),
+		IdCardBackUrl:  a.GetIdCardBackUrl(),
+		SkillProofUrls: a.GetSkillProofUrls(),
+		VoiceSampleUrl: a.GetVoiceSampleUrl(),
+		Skills:         skills,
+		Bio:            a.GetBio(),
+		Status:         a.GetStatus(),
+		RejectReason:   a.GetRejectReason(),
+		ReviewNote:     a.GetReviewNote(),
+		ReviewerId:     a.GetReviewerId(),
+		ReviewedAt:     a.GetReviewedAt(),
+		CreatedAt:      a.GetCreatedAt(),
+	}
+}
+
+// reviewCompanionApplication 审核入驻申请，审核人为当前登录的管理员
+func reviewCompanionApplication(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, id uint64, approve bool, reason, note string) *types.AdminReviewCompanionApplicationResponse {
+	if id == 0 {
+		return &types.AdminReviewCompanionApplicationResponse{BaseResp: types.BaseResp{Code: 400, Msg: "申请ID不能为空"}}
+	}
+	reason = strings.TrimSpace(reason)
+	if !approve && reason == "" {
+		return &types.AdminReviewCompanionApplicationResponse{BaseResp: types.BaseResp{Code: 400, Msg: "请填写驳回原因"}}
+	}
+
+	reviewerID, err := middleware.GetUserID(ctx)
+	if err != nil || reviewerID == 0 {
+		return &types.AdminReviewCompanionApplicationResponse{BaseResp: types.BaseResp{Code: 401, Msg: "未登录或登录已过期"}}
+	}
+
+	if svcCtx.UserRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(logger, "UserRPC")
+		return &types.AdminReviewCompanionApplicationResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}
+	}
+
+	rpcResp, err := svcCtx.UserRPC.ReviewCompanionApplication(ctx, &userclient.ReviewCompanionApplicationRequest{
+		ApplicationId: id,
+		ReviewerId:    reviewerID,
+		Approve:       approve,
+		RejectReason:  reason,
+		Note:          strings.TrimSpace(note),
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, logger, "ReviewCompanionApplication")
+		return &types.AdminReviewCompanionApplicationResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}
+	}
+
+	op := "RejectCompanionApplication"
+	if approve {
+		op = "ApproveCompanionApplication"
+	}
+	helper.LogInfo(logger, helper.OpAdminReview, "admin review companion application", map[string]interface{}{
+		"reviewer_id":    reviewerID,
+		"application_id": id,
+		"approve":        approve,
+		"user_id":        rpcResp.GetApplication().GetUserId(),
+	})
+
+	return &types.AdminReviewCompanionApplicationResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: utils.GetSuccessMsg(op)},
+		Data:     toCompanionApplicationInfo(rpcResp.GetApplication()),
+	}
+}
+
+// toOrderInfo 将 RPC 的 OrderInfo 转为网关层的 OrderInfo
+func toOrderInfo(o *orderclient.OrderInfo) types.OrderInfo {
+	if o == nil {
+		return types.OrderInfo{}
+	}
+	return types.OrderInfo{
+		Id:             o.Id,
+		OrderNo:        o.OrderNo,
+		BossId:         o.BossId,
+		CompanionId:    o.CompanionId,
+		GameName:       o.GameName,
+		DurationHours:  o.DurationHours,
+		PricePerHour:   o.PricePerHour,
+		TotalAmount:    o.TotalAmount,
+		Status:         o.Status,
+		CreatedAt:      o.CreatedAt,
+		PaidAt:         o.PaidAt,
+		AcceptedAt:     o.AcceptedAt,
+		StartAt:        o.StartAt,
+		CompletedAt:    o.CompletedAt,
+		CancelledAt:    o.CancelledAt,
+		Rating:         o.Rating,
+		Comment:        o.Comment,
+		CancelReason:   o.CancelReason,
+		DiscountAmount: o.DiscountAmount,
+	}
+}
+
+// 账号状态（与用户服务一致）
+const (
+	userStatusActive = 0
+	userStatusMuted  = 1
+	userStatusBanned = 2
+)
+
+// toUserModeration 将 RPC 的用户状态转为网关层结构
+func toUserModeration(u *userclient.UserInfo) types.UserModeration {
+	if u == nil {
+		return types.UserModeration{}
+	}
+	return types.UserModeration{
+		Status:      u.GetStatus(),
+		StatusUntil: u.GetStatusUntil(),
+		Reason:      u.GetStatusReason(),
+	}
+}
+
+// moderateUser 设置账号状态并同步处理 Token：封禁撤销全部 Token，解除封禁清除用户级黑名单
+func moderateUser(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, userID uint64, status int32, duration int64, reason string) *types.AdminModerateUserResponse {
+	if userID == 0 {
+		return &types.AdminModerateUserResponse{BaseResp: types.BaseResp{Code: 400, Msg: "用户ID不能为空"}}
+	}
+	if duration < 0 {
+		return &types.AdminModerateUserResponse{BaseResp: types.BaseResp{Code: 400, Msg: "时长不能为负数"}}
+	}
+	reason = strings.TrimSpace(reason)
+	if status != userStatusActive && reason == "" {
+		return &types.AdminModerateUserResponse{BaseResp: types.BaseResp{Code: 400, Msg: "请填写原因"}}
+	}
+
+	operatorID, err := middleware.GetUserID(ctx)
+	if err != nil || operatorID == 0 {
+		return &types.AdminModerateUserResponse{BaseResp: types.BaseResp{Code: 401, Msg: "未登录或登录已过期"}}
+	}
+
+	if svcCtx.UserRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(logger, "UserRPC")
+		return &types.AdminModerateUserResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}
+	}
+
+	var until int64
+	if status != userStatusActive && duration > 0 {
+		until = time.Now().Add(time.Duration(duration) * time.Second).Unix()
+	}
+	rpcResp, err := svcCtx.UserRPC.SetUserStatus(ctx, &userclient.SetUserStatusRequest{
+		UserId:     userID,
+		Status:     status,
+		Until:      until,
+		Reason:     reason,
+		OperatorId: operatorID,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, logger, "ModerateUser")
+		return &types.AdminModerateUserResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}
+	}
+
+	if svcCtx.TokenStore != nil {
+		switch {
+		case status == userStatusBanned:
+			// 用户级黑名单的有效期不超过封禁期，到期后重新登录拿到的 token 不会被误拒
+			ttl := svcCtx.JWT.GetRefreshTokenDuration()
+			if until > 0 {
+				ttl = min(ttl, time.Until(time.Unix(until, 0)))
+			}
+			if err := svcCtx.TokenStore.RevokeAllUserTokens(ctx, userID, ttl); err != nil {
+				logger.Errorf("revoke tokens of banned user failed: user_id=%d, err=%v", userID, err)
+			}
+		case rpcResp.GetPreviousStatus() == userStatusBanned:
+			if err := svcCtx.TokenStore.ClearUserRevocation(ctx, userID); err != nil {
+				logger.Errorf("clear token revocation failed: user_id=%d, err=%v", userID, err)
+			}
+		}
+	}
+
+	helper.LogInfo(logger, helper.OpAdminModeration, "admin moderate user", map[string]interface{}{
+		"operator_id":     operatorID,
+		"user_id":         userID,
+		"status":          status,
+		"previous_status": rpcResp.GetPreviousStatus(),
+		"until":           until,
+		"reason":          reason,
+	})
+
+	return &types.AdminModerateUserResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: utils.GetSuccessMsg("ModerateUser")},
+		Data: types.AdminModerateUserData{
+			UserId:         userID,
+			PreviousStatus: rpcResp.GetPreviousStatus(),
+			Moderation:     toUserModeration(rpcResp.GetUser()),
+		},
+	}
+}
@@ -5,6 +5,7 @@ package agent
 
 import (
 	"context"
+	"sort"
 	"strings"
 
 	"SLGaming/back/services/agent/agentclient"
@@ -12,6 +13,7 @@ import (
 	"SLGaming/back/services/gateway/internal/svc"
 	"SLGaming/back/services/gateway/internal/types"
 	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/user/userclient"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -55,6 +57,7 @@ func (l *RecommendCompanionLogic) RecommendCompanion(req *types.RecommendCompani
 	rpcResp, err := l.svcCtx.AgentRPC.RecommendCompanion(l.ctx, &agentclient.RecommendCompanionRequest{
 		UserInput: input,
 		UserId:    userID,
+		Priority:  l.recommendPriority(userID),
 	})
 	if err != nil {
 		code, msg := utils.HandleRPCError(err, l.Logger, "RecommendCompanion")
@@ -63,8 +66,19 @@ func (l *RecommendCompanionLogic) RecommendCompanion(req *types.RecommendCompani
 		}, nil
 	}
 
+	banned := l.bannedCompanions(rpcResp.Companions)
+	blocked := l.blockedCompanions(userID, rpcResp.Companions)
+	presence := l.companionPresence(rpcResp.Companions)
 	companions := make([]types.CompanionRecommendation, 0, len(rpcResp.Companions))
 	for _, c := range rpcResp.Companions {
+		// 向量库中的陪玩数据不随封禁更新，在返回前过滤
+		if banned[c.UserId] {
+			continue
+		}
+		// 与当前用户存在拉黑关系的陪玩不推荐
+		if blocked[c.UserId] {
+			continue
+		}
 		companions = append(companions, types.CompanionRecommendation{
 			UserId:       c.UserId,
 			GameSkill:    c.GameSkill,
@@ -73,9 +87,15 @@ func (l *RecommendCompanionLogic) RecommendCompanion(req *types.RecommendCompani
 			Description:  c.Description,
 			PricePerHour: c.PricePerHour,
 			Rating:       c.Rating,
+			RatingScore:  c.RatingScore,
 			Similarity:   c.Similarity,
+			Status:       presence[c.UserId],
 		})
 	}
+	// 按实时状态排序：在线优先，其次忙碌，离线最后；同状态保持相似度顺序
+	sort.SliceStable(companions, func(i, j int) bool {
+		return presenceRank(companions[i].Status) < presenceRank(companions[j].Status)
+	})
 
 	l.Infof("recommend companion success user_id=%d input_len=%d results=%d", userID, len(input), len(companions))
 
@@ -87,3 +107,94 @@ func (l *RecommendCompanionLogic) RecommendCompanion(req *types.RecommendCompani
 		},
 	}, nil
 }
+
+// bannedCompanions 查询推荐结果中处于封禁期的陪玩，查询失败时不过滤
+func (l *RecommendCompanionLogic) bannedCompanions(companions []*agentclient.CompanionRecommendation) map[uint64]bool {
+	if l.svcCtx.UserRPC == nil || len(companions) == 0 {
+		return nil
+	}
+	ids := make([]uint64, 0, len(companions))
+	for _, c := range companions {
+		ids = append(ids, c.UserId)
+	}
+	resp, err := l.svcCtx.UserRPC.FilterBannedUsers(l.ctx, &userclient.FilterBannedUsersRequest{UserIds: ids})
+	if err != nil {
+		l.Infof("filter banned companions failed, skip filtering err=%v", err)
+		return nil
+	}
+	banned := make(map[uint64]bool, len(resp.GetBannedUserIds()))
+	for _, id := range resp.GetBannedUserIds() {
+		banned[id] = true
+	}
+	return banned
+}
+
+// blockedCompanions 查询推荐结果中与当前用户存在拉黑关系的陪玩，查询失败时不过滤
+func (l *RecommendCompanionLogic) blockedCompanions(userID uint64, companions []*agentclient.CompanionRecommendation) map[uint64]bool {
+	if l.svcCtx.UserRPC == nil || userID == 0 || len(companions) == 0 {
+		return nil
+	}
+	ids := make([]uint64, 0, len(companions))
+	for _, c := range companions {
+		ids = append(ids, c.UserId)
+	}
+	resp, err := l.svcCtx.UserRPC.FilterBlockedUsers(l.ctx, &userclient.FilterBlockedUsersRequest{UserId: userID, UserIds: ids})
+	if err != nil {
+		l.Infof("filter blocked companions failed, skip filtering user_id=%d err=%v", userID, err)
+		return nil
+	}
+	blocked := make(map[uint64]bool, len(resp.GetBlockedUserIds()))
+	for _, id := range resp.GetBlockedUserIds() {
+		blocked[id] = true
+	}
+	return blocked
+}
+
+// companionPresence 查询推荐结果中陪玩的实时状态（已考虑心跳超时），查询失败时全部按离线处理
+func (l *RecommendCompanionLogic) companionPresence(companions []*agentclient.CompanionRecommendation) map[uint64]int {
+	if l.svcCtx.UserRPC == nil || len(companions) == 0 {
+		return nil
+	}
+	ids := make([]uint64, 0, len(companions))
+	for _, c := range companions {
+		ids = append(ids, c.UserId)
+	}
+	resp, err := l.svcCtx.UserRPC.GetCompanionPresence(l.ctx, &userclient.GetCompanionPresenceRequest{UserIds: ids})
+	if err != nil {
+		l.Infof("get companion presence failed, skip sorting err=%v", err)
+		return nil
+	}
+	presence := make(map[uint64]int, len(resp.GetPresences()))
+	for _, p := range resp.GetPresences() {
+		presence[p.UserId] = int(p.Status)
+	}
+	return presence
+}
+
+// presenceRank 状态排序权重：在线 < 忙碌 < 离线
+func presenceRank(status int) int {
+	switch status {
+	case 1:
+		return 0
+	case 2:
+		return 1
+	default:
+		return 2
+	}
+}
+
+// recommendPriority 查询用户的会员推荐优先级，查询失败时按普通用户处理
+func (l *RecommendCompanionLogic) recommendPriority(userID uint64) int32 {
+	if l.svcCtx.UserRPC == nil || userID == 0 {
+		return 0
+	}
+	resp, err := l.svcCtx.UserRPC.GetVipEntitlements(l.ctx, &userclient.GetVipEntitlementsRequest{UserId: userID})
+	if err != nil {
+		l.Infof("get vip entitlements failed, use default priority user_id=%d err=%v", userID, err)
+		return 0
+	}
+	if !resp.GetIsVip() {
+		return 0
+	}
+	return resp.GetRecommendPriority()
+}
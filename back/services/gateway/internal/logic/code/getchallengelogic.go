@@ -0,0 +1,58 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package code
+
+import (
+	"context"
+
+	"SLGaming/back/services/code/codeclient"
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetChallengeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetChallengeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetChallengeLogic {
+	return &GetChallengeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetChallengeLogic) GetChallenge() (resp *types.GetChallengeResponse, err error) {
+	if l.svcCtx.CodeRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "CodeRPC")
+		return &types.GetChallengeResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+
+	rpcResp, err := l.svcCtx.CodeRPC.CreateChallenge(l.ctx, &codeclient.CreateChallengeRequest{
+		ClientIp: middleware.GetClientInfo(l.ctx).IP,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "GetChallenge")
+		return &types.GetChallengeResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+
+	return &types.GetChallengeResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: "success"},
+		Data: types.ChallengeData{
+			ChallengeId: rpcResp.ChallengeId,
+			Image:       rpcResp.Image,
+			ExpireAt:    rpcResp.ExpireAt,
+		},
+	}, nil
+}
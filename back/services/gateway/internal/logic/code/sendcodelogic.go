@@ -8,6 +8,7 @@ import (
 
 	"SLGaming/back/services/code/codeclient"
 	"SLGaming/back/services/gateway/internal/helper"
+	"SLGaming/back/services/gateway/internal/middleware"
 	"SLGaming/back/services/gateway/internal/svc"
 	"SLGaming/back/services/gateway/internal/types"
 	"SLGaming/back/services/gateway/internal/utils"
@@ -30,8 +31,14 @@ func NewSendCodeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SendCode
 }
 
 func (l *SendCodeLogic) SendCode(req *types.SendCodeRequest) (resp *types.SendCodeResponse, err error) {
+	// 未填手机号而填写了邮箱时走邮件渠道
+	channel, target := "sms", helper.MaskPhone(req.Phone)
+	if req.Phone == "" && req.Email != "" {
+		channel, target = "email", helper.MaskEmail(req.Email)
+	}
 	helper.LogRequest(l.Logger, helper.OpSendCode, map[string]interface{}{
-		"phone":   helper.MaskPhone(req.Phone),
+		"channel": channel,
+		"target":  target,
 		"purpose": req.Purpose,
 	})
 
@@ -44,9 +51,15 @@ func (l *SendCodeLogic) SendCode(req *types.SendCodeRequest) (resp *types.SendCo
 	}
 
 	// 调用验证码服务的 RPC
-	_, err = l.svcCtx.CodeRPC.SendCode(l.ctx, &codeclient.SendCodeRequest{
-		Phone:   req.Phone,
-		Purpose: req.Purpose,
+	client := middleware.GetClientInfo(l.ctx)
+	rpcResp, err := l.svcCtx.CodeRPC.SendCode(l.ctx, &codeclient.SendCodeRequest{
+		Phone:          req.Phone,
+		Purpose:        req.Purpose,
+		Channel:        channel,
+		Email:          req.Email,
+		ClientIp:       client.IP,
+		UserAgent:      client.UserAgent,
+		ChallengeToken: req.ChallengeToken,
 	})
 	if err != nil {
 		code, msg := utils.HandleRPCError(err, l.Logger, "SendCode")
@@ -58,8 +71,29 @@ func (l *SendCodeLogic) SendCode(req *types.SendCodeRequest) (resp *types.SendCo
 		}, nil
 	}
 
+	// 触发风控：未发送，提示客户端先完成人机验证
+	if rpcResp.ChallengeRequired {
+		helper.LogWarning(l.Logger, helper.OpSendCode, "challenge required", map[string]interface{}{
+			"channel": channel,
+			"target":  target,
+			"reason":  rpcResp.ChallengeReason,
+		})
+		msg := "请先完成人机验证"
+		if rpcResp.ChallengeReason == "invalid_token" {
+			msg = "人机验证已失效，请重新验证"
+		}
+		return &types.SendCodeResponse{
+			BaseResp: types.BaseResp{Code: 400, Msg: msg},
+			Data: types.SendCodeData{
+				ChallengeRequired: true,
+				ChallengeReason:   rpcResp.ChallengeReason,
+			},
+		}, nil
+	}
+
 	helper.LogSuccess(l.Logger, helper.OpSendCode, map[string]interface{}{
-		"phone":   helper.MaskPhone(req.Phone),
+		"channel": channel,
+		"target":  target,
 		"purpose": req.Purpose,
 	})
 
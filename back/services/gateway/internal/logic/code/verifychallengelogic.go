@@ -0,0 +1,75 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package code
+
+import (
+	"context"
+	"strings"
+
+	"SLGaming/back/services/code/codeclient"
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type VerifyChallengeLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewVerifyChallengeLogic(ctx context.Context, svcCtx *svc.ServiceContext) *VerifyChallengeLogic {
+	return &VerifyChallengeLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *VerifyChallengeLogic) VerifyChallenge(req *types.VerifyChallengeRequest) (resp *types.VerifyChallengeResponse, err error) {
+	challengeID := strings.TrimSpace(req.ChallengeId)
+	answer := strings.TrimSpace(req.Answer)
+	if challengeID == "" || answer == "" {
+		return &types.VerifyChallengeResponse{
+			BaseResp: types.BaseResp{Code: 400, Msg: "人机验证ID和答案不能为空"},
+		}, nil
+	}
+
+	if l.svcCtx.CodeRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "CodeRPC")
+		return &types.VerifyChallengeResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+
+	rpcResp, err := l.svcCtx.CodeRPC.VerifyChallenge(l.ctx, &codeclient.VerifyChallengeRequest{
+		ChallengeId: challengeID,
+		Answer:      answer,
+		ClientIp:    middleware.GetClientInfo(l.ctx).IP,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "VerifyChallenge")
+		return &types.VerifyChallengeResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+	if !rpcResp.Passed {
+		// 题目作答一次即作废，失败后需重新获取
+		return &types.VerifyChallengeResponse{
+			BaseResp: types.BaseResp{Code: 400, Msg: "人机验证未通过，请重新获取验证码图片"},
+		}, nil
+	}
+
+	return &types.VerifyChallengeResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: "success"},
+		Data: types.VerifyChallengeData{
+			Passed:        true,
+			Token:         rpcResp.Token,
+			TokenExpireAt: rpcResp.TokenExpireAt,
+		},
+	}, nil
+}
@@ -0,0 +1,58 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package follow
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BlockUserLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewBlockUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BlockUserLogic {
+	return &BlockUserLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *BlockUserLogic) BlockUser(req *types.BlockUserRequest) (resp *types.BlockUserResponse, err error) {
+	if l.svcCtx.UserRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "UserRPC")
+		return &types.BlockUserResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	userID, err := middleware.GetUserID(l.ctx)
+	if err != nil || userID == 0 {
+		return &types.BlockUserResponse{
+			BaseResp: types.BaseResp{Code: 401, Msg: "未登录或认证失败"},
+		}, nil
+	}
+
+	rpcResp, err := l.svcCtx.UserRPC.BlockUser(l.ctx, &userclient.BlockUserRequest{
+		OperatorId: userID,
+		UserId:     req.UserId,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "BlockUser")
+		return &types.BlockUserResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	return &types.BlockUserResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: "success"},
+		Data:     types.BlockUserData{Success: rpcResp.Success, Message: rpcResp.Message},
+	}, nil
+}
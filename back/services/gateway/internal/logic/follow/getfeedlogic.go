@@ -0,0 +1,83 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package follow
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetFeedLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetFeedLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetFeedLogic {
+	return &GetFeedLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetFeedLogic) GetFeed(req *types.GetFeedRequest) (resp *types.GetFeedResponse, err error) {
+	if l.svcCtx.UserRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "UserRPC")
+		return &types.GetFeedResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	userID, err := middleware.GetUserID(l.ctx)
+	if err != nil || userID == 0 {
+		return &types.GetFeedResponse{
+			BaseResp: types.BaseResp{Code: 401, Msg: "未登录或认证失败"},
+		}, nil
+	}
+
+	rpcResp, err := l.svcCtx.UserRPC.GetFeed(l.ctx, &userclient.GetFeedRequest{
+		UserId: userID,
+		Cursor: req.Cursor,
+		Limit:  int32(req.Limit),
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "GetFeed")
+		return &types.GetFeedResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	items := make([]types.FeedItem, 0, len(rpcResp.GetItems()))
+	for _, it := range rpcResp.GetItems() {
+		items = append(items, types.FeedItem{
+			Id:          it.GetId(),
+			CompanionId: it.GetCompanionId(),
+			Nickname:    it.GetNickname(),
+			AvatarUrl:   it.GetAvatarUrl(),
+			Kind:        it.GetKind(),
+			GameName:    it.GetGameName(),
+			Rating:      it.GetRating(),
+			Comment:     it.GetComment(),
+			OldPrice:    it.GetOldPrice(),
+			NewPrice:    it.GetNewPrice(),
+			CreatedAt:   it.GetCreatedAt(),
+			PostId:      it.GetPostId(),
+			Content:     it.GetContent(),
+			ImageUrls:   it.GetImageUrls(),
+		})
+	}
+
+	return &types.GetFeedResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: "success"},
+		Data: types.GetFeedData{
+			Items:      items,
+			NextCursor: rpcResp.GetNextCursor(),
+			HasMore:    rpcResp.GetHasMore(),
+		},
+	}, nil
+}
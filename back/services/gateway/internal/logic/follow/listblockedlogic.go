@@ -0,0 +1,75 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package follow
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListBlockedLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewListBlockedLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListBlockedLogic {
+	return &ListBlockedLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListBlockedLogic) ListBlocked(req *types.ListBlockedRequest) (resp *types.ListBlockedResponse, err error) {
+	if l.svcCtx.UserRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "UserRPC")
+		return &types.ListBlockedResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	userID, err := middleware.GetUserID(l.ctx)
+	if err != nil || userID == 0 {
+		return &types.ListBlockedResponse{
+			BaseResp: types.BaseResp{Code: 401, Msg: "未登录或认证失败"},
+		}, nil
+	}
+
+	rpcResp, err := l.svcCtx.UserRPC.ListBlocked(l.ctx, &userclient.ListBlockedRequest{
+		OperatorId: userID,
+		Page:       int32(req.Page),
+		PageSize:   int32(req.PageSize),
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "ListBlocked")
+		return &types.ListBlockedResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	users := make([]types.BlockedUserInfo, 0, len(rpcResp.GetUsers()))
+	for _, u := range rpcResp.GetUsers() {
+		users = append(users, types.BlockedUserInfo{
+			UserId:    u.GetUserId(),
+			Nickname:  u.GetNickname(),
+			AvatarUrl: u.GetAvatarUrl(),
+			Role:      int(u.GetRole()),
+			BlockedAt: u.GetBlockedAt(),
+		})
+	}
+
+	return &types.ListBlockedResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: "success"},
+		Data: types.ListBlockedData{
+			Users:    users,
+			Total:    int(rpcResp.GetTotal()),
+			Page:     int(rpcResp.GetPage()),
+			PageSize: int(rpcResp.GetPageSize()),
+		},
+	}, nil
+}
@@ -0,0 +1,62 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package follow
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type PublishCompanionPostLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewPublishCompanionPostLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PublishCompanionPostLogic {
+	return &PublishCompanionPostLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *PublishCompanionPostLogic) PublishCompanionPost(req *types.PublishCompanionPostRequest) (resp *types.PublishCompanionPostResponse, err error) {
+	if l.svcCtx.UserRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "UserRPC")
+		return &types.PublishCompanionPostResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	userID, err := middleware.GetUserID(l.ctx)
+	if err != nil || userID == 0 {
+		return &types.PublishCompanionPostResponse{
+			BaseResp: types.BaseResp{Code: 401, Msg: "未登录或认证失败"},
+		}, nil
+	}
+
+	rpcResp, err := l.svcCtx.UserRPC.PublishCompanionPost(l.ctx, &userclient.PublishCompanionPostRequest{
+		UserId:    userID,
+		Content:   req.Content,
+		ImageUrls: req.ImageUrls,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "PublishCompanionPost")
+		return &types.PublishCompanionPostResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	return &types.PublishCompanionPostResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: "success"},
+		Data: types.PublishCompanionPostData{
+			PostId:    rpcResp.GetPostId(),
+			CreatedAt: rpcResp.GetCreatedAt(),
+		},
+	}, nil
+}
@@ -0,0 +1,65 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package order
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/order/orderclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type DisputeOrderLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewDisputeOrderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DisputeOrderLogic {
+	return &DisputeOrderLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *DisputeOrderLogic) DisputeOrder(req *types.DisputeOrderRequest) (resp *types.DisputeOrderResponse, err error) {
+	if l.svcCtx.OrderRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "OrderRPC")
+		return &types.DisputeOrderResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+
+	// 当前登录用户作为 boss_id（由网关鉴权中间件注入）
+	bossID, _ := middleware.GetUserID(l.ctx)
+
+	rpcResp, err := l.svcCtx.OrderRPC.DisputeOrder(l.ctx, &orderclient.DisputeOrderRequest{
+		OrderId: req.OrderId,
+		BossId:  bossID,
+		Reason:  req.Reason,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "DisputeOrder")
+		return &types.DisputeOrderResponse{
+			BaseResp: types.BaseResp{
+				Code: code,
+				Msg:  msg,
+			},
+		}, nil
+	}
+
+	return &types.DisputeOrderResponse{
+		BaseResp: types.BaseResp{
+			Code: 0,
+			Msg:  "success",
+		},
+		Data: toOrderInfo(rpcResp.Order),
+	}, nil
+}
@@ -14,23 +14,26 @@ func toOrderInfo(o *orderclient.OrderInfo) types.OrderInfo {
 		return types.OrderInfo{}
 	}
 	return types.OrderInfo{
-		Id:            o.Id,
-		OrderNo:       o.OrderNo,
-		BossId:        o.BossId,
-		CompanionId:   o.CompanionId,
-		GameName:      o.GameName,
-		DurationHours: o.DurationHours,
-		PricePerHour:  o.PricePerHour,
-		TotalAmount:   o.TotalAmount,
-		Status:        o.Status,
-		CreatedAt:     o.CreatedAt,
-		PaidAt:        o.PaidAt,
-		AcceptedAt:    o.AcceptedAt,
-		StartAt:       o.StartAt,
-		CompletedAt:   o.CompletedAt,
-		CancelledAt:   o.CancelledAt,
-		Rating:        o.Rating,
-		Comment:       o.Comment,
-		CancelReason:  o.CancelReason,
+		Id:             o.Id,
+		OrderNo:        o.OrderNo,
+		BossId:         o.BossId,
+		CompanionId:    o.CompanionId,
+		GameName:       o.GameName,
+		DurationHours:  o.DurationHours,
+		PricePerHour:   o.PricePerHour,
+		TotalAmount:    o.TotalAmount,
+		Status:         o.Status,
+		CreatedAt:      o.CreatedAt,
+		PaidAt:         o.PaidAt,
+		AcceptedAt:     o.AcceptedAt,
+		StartAt:        o.StartAt,
+		CompletedAt:    o.CompletedAt,
+		CancelledAt:    o.CancelledAt,
+		Rating:         o.Rating,
+		Comment:        o.Comment,
+		CancelReason:   o.CancelReason,
+		DiscountAmount: o.DiscountAmount,
+		DisputedAt:     o.DisputedAt,
+		DisputeReason:  o.DisputeReason,
 	}
 }
@@ -16,12 +16,6 @@ import (
 	"github.com/zeromicro/go-zero/core/logx"
 )
 
-const (
-	roleBoss      = 1
-	roleCompanion = 2
-	roleAdmin     = 3
-)
-
 type ApplyCompanionLogic struct {
 	logx.Logger
 	ctx    context.Context
@@ -51,95 +45,55 @@ func (l *ApplyCompanionLogic) ApplyCompanion(req *types.ApplyCompanionRequest) (
 		}, nil
 	}
 
+	// 技能可以用 skills 一次设置多个游戏，也可以只传单个 gameSkill + pricePerHour
 	gameSkill := strings.TrimSpace(req.GameSkill)
-	if gameSkill == "" {
-		return &types.ApplyCompanionResponse{
-			BaseResp: types.BaseResp{Code: 400, Msg: "游戏技能不能为空"},
-		}, nil
-	}
-	if req.PricePerHour <= 0 {
-		return &types.ApplyCompanionResponse{
-			BaseResp: types.BaseResp{Code: 400, Msg: "每小时价格必须大于0"},
-		}, nil
-	}
-
-	currentRole, roleErr := middleware.GetUserRole(l.ctx)
-	if roleErr != nil || currentRole == 0 {
-		roleResp, err := l.svcCtx.UserRPC.GetUser(l.ctx, &userclient.GetUserRequest{Id: userID})
-		if err != nil {
-			code, msg := utils.HandleRPCError(err, l.Logger, "GetUser")
+	if len(req.Skills) == 0 {
+		if gameSkill == "" {
 			return &types.ApplyCompanionResponse{
-				BaseResp: types.BaseResp{Code: code, Msg: msg},
+				BaseResp: types.BaseResp{Code: 400, Msg: "游戏技能不能为空"},
 			}, nil
 		}
-		if roleResp != nil && roleResp.User != nil {
-			currentRole = roleResp.User.Role
-		}
-	}
-
-	if currentRole == roleAdmin {
-		return &types.ApplyCompanionResponse{
-			BaseResp: types.BaseResp{Code: 403, Msg: "管理员无需申请"},
-		}, nil
-	}
-	if currentRole != roleBoss && currentRole != roleCompanion {
-		return &types.ApplyCompanionResponse{
-			BaseResp: types.BaseResp{Code: 403, Msg: "仅老板可申请成为陪玩"},
-		}, nil
-	}
-
-	// 如果是老板，先升级为陪玩
-	if currentRole == roleBoss {
-		_, err = l.svcCtx.UserRPC.UpdateUser(l.ctx, &userclient.UpdateUserRequest{
-			Id:   userID,
-			Role: roleCompanion,
-			Bio:  req.Bio,
-		})
-		if err != nil {
-			code, msg := utils.HandleRPCError(err, l.Logger, "UpdateUser")
+		if req.PricePerHour <= 0 {
 			return &types.ApplyCompanionResponse{
-				BaseResp: types.BaseResp{Code: code, Msg: msg},
+				BaseResp: types.BaseResp{Code: 400, Msg: "每小时价格必须大于0"},
 			}, nil
 		}
-	} else if strings.TrimSpace(req.Bio) != "" {
-		_, _ = l.svcCtx.UserRPC.UpdateUser(l.ctx, &userclient.UpdateUserRequest{
-			Id:  userID,
-			Bio: req.Bio,
-		})
+	} else {
+		for _, sk := range req.Skills {
+			if sk.PricePerHour <= 0 {
+				return &types.ApplyCompanionResponse{
+					BaseResp: types.BaseResp{Code: 400, Msg: "每小时价格必须大于0"},
+				}, nil
+			}
+		}
 	}
 
-	// 设置陪玩信息（默认离线）
-	_, err = l.svcCtx.UserRPC.UpdateCompanionProfile(l.ctx, &userclient.UpdateCompanionProfileRequest{
-		UserId:       userID,
-		GameSkill:    gameSkill,
-		PricePerHour: req.PricePerHour,
-		Status:       0,
-	})
-	if err != nil {
-		code, msg := utils.HandleRPCError(err, l.Logger, "UpdateCompanionProfile")
-		return &types.ApplyCompanionResponse{
-			BaseResp: types.BaseResp{Code: code, Msg: msg},
-		}, nil
+	skills := toCompanionSkillInputs(req.Skills)
+	if len(skills) == 0 {
+		skills = []*userclient.CompanionSkill{{GameName: gameSkill, PricePerHour: req.PricePerHour}}
 	}
 
-	userResp, err := l.svcCtx.UserRPC.GetUser(l.ctx, &userclient.GetUserRequest{Id: userID})
+	// 提交入驻申请，审核通过前角色保持不变
+	rpcResp, err := l.svcCtx.UserRPC.SubmitCompanionApplication(l.ctx, &userclient.SubmitCompanionApplicationRequest{
+		UserId:         userID,
+		RealName:       strings.TrimSpace(req.RealName),
+		IdCardNo:       strings.TrimSpace(req.IdCardNo),
+		IdCardFrontUrl: strings.TrimSpace(req.IdCardFrontUrl),
+		IdCardBackUrl:  strings.TrimSpace(req.IdCardBackUrl),
+		SkillProofUrls: req.SkillProofUrls,
+		VoiceSampleUrl: strings.TrimSpace(req.VoiceSampleUrl),
+		Skills:         skills,
+		Bio:            req.Bio,
+	})
 	if err != nil {
-		code, msg := utils.HandleRPCError(err, l.Logger, "GetUser")
+		code, msg := utils.HandleRPCError(err, l.Logger, "SubmitCompanionApplication")
 		return &types.ApplyCompanionResponse{
 			BaseResp: types.BaseResp{Code: code, Msg: msg},
 		}, nil
 	}
 
 	return &types.ApplyCompanionResponse{
-		BaseResp: types.BaseResp{Code: 0, Msg: "success"},
-		Data: types.UserInfo{
-			Id:        userResp.User.Id,
-			Uid:       userResp.User.Uid,
-			Nickname:  userResp.User.Nickname,
-			Phone:     userResp.User.Phone,
-			Role:      int(userResp.User.Role),
-			AvatarUrl: userResp.User.AvatarUrl,
-			Bio:       userResp.User.Bio,
-		},
+		BaseResp: types.BaseResp{Code: 0, Msg: utils.GetSuccessMsg("SubmitCompanionApplication")},
+		Data:     toCompanionApplicationInfo(rpcResp.GetApplication()),
 	}, nil
 }
@@ -0,0 +1,115 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strings"
+
+	"SLGaming/back/services/code/codeclient"
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type BindEmailLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewBindEmailLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BindEmailLogic {
+	return &BindEmailLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *BindEmailLogic) BindEmail(req *types.BindEmailRequest) (resp *types.BindEmailResponse, err error) {
+	userID, err := middleware.GetUserID(l.ctx)
+	if err != nil {
+		return &types.BindEmailResponse{
+			BaseResp: types.BaseResp{Code: 401, Msg: "未登录或登录已过期"},
+		}, nil
+	}
+
+	email := strings.TrimSpace(req.Email)
+	code := strings.TrimSpace(req.Code)
+	if email == "" || code == "" {
+		return &types.BindEmailResponse{
+			BaseResp: types.BaseResp{Code: 400, Msg: "邮箱和验证码不能为空"},
+		}, nil
+	}
+
+	if l.svcCtx.UserRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "UserRPC")
+		return &types.BindEmailResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+
+	// 验证新邮箱验证码
+	if l.svcCtx.CodeRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "CodeRPC")
+		return &types.BindEmailResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+	verifyResp, err := l.svcCtx.CodeRPC.VerifyCode(l.ctx, &codeclient.VerifyCodeRequest{
+		Purpose: "bind_email",
+		Code:    code,
+		Channel: "email",
+		Email:   email,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "VerifyCode")
+		return &types.BindEmailResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+	if !verifyResp.Passed {
+		return &types.BindEmailResponse{
+			BaseResp: types.BaseResp{Code: 400, Msg: utils.VerifyCodeFailedMsg(verifyResp.RemainingAttempts, verifyResp.NeedResend)},
+		}, nil
+	}
+
+	rpcResp, err := l.svcCtx.UserRPC.BindEmail(l.ctx, &userclient.BindEmailRequest{
+		UserId:       userID,
+		Email:        email,
+		VerifyTicket: verifyResp.Ticket,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "BindEmail")
+		return &types.BindEmailResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+
+	u := rpcResp.GetUser()
+	return &types.BindEmailResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: utils.GetSuccessMsg("BindEmail")},
+		Data: types.UserInfo{
+			Id:             u.GetId(),
+			Uid:            u.GetUid(),
+			Nickname:       u.GetNickname(),
+			Phone:          u.GetPhone(),
+			Email:          u.GetEmail(),
+			Role:           int(u.GetRole()),
+			AvatarUrl:      u.GetAvatarUrl(),
+			Bio:            u.GetBio(),
+			FollowerCount:  u.GetFollowerCount(),
+			FollowingCount: u.GetFollowingCount(),
+			VipLevel:       u.GetVipLevel(),
+			VipBadge:       u.GetVipBadge(),
+			VipExpireAt:    u.GetVipExpireAt(),
+			Gender:         u.GetGender(),
+			Birthday:       u.GetBirthday(),
+		},
+	}, nil
+}
@@ -70,29 +70,34 @@ func (l *ChangePasswordLogic) ChangePassword(req *types.ChangePasswordRequest) (
 	}
 
 	// 验证原手机号验证码
-	if l.svcCtx.CodeRPC != nil {
-		verifyResp, err := l.svcCtx.CodeRPC.VerifyCode(l.ctx, &codeclient.VerifyCodeRequest{
-			Phone:   oldPhone,
-			Purpose: "change_password",
-			Code:    oldCode,
-		})
-		if err != nil {
-			code, msg := utils.HandleRPCError(err, l.Logger, "VerifyCode")
-			return &types.ChangePasswordResponse{
-				BaseResp: types.BaseResp{Code: code, Msg: msg},
-			}, nil
-		}
-		if !verifyResp.Passed {
-			return &types.ChangePasswordResponse{
-				BaseResp: types.BaseResp{Code: 400, Msg: "验证码错误或已过期"},
-			}, nil
-		}
+	if l.svcCtx.CodeRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "CodeRPC")
+		return &types.ChangePasswordResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+	verifyResp, err := l.svcCtx.CodeRPC.VerifyCode(l.ctx, &codeclient.VerifyCodeRequest{
+		Phone:   oldPhone,
+		Purpose: "change_password",
+		Code:    oldCode,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "VerifyCode")
+		return &types.ChangePasswordResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+	if !verifyResp.Passed {
+		return &types.ChangePasswordResponse{
+			BaseResp: types.BaseResp{Code: 400, Msg: utils.VerifyCodeFailedMsg(verifyResp.RemainingAttempts, verifyResp.NeedResend)},
+		}, nil
 	}
 
 	_, err = l.svcCtx.UserRPC.ChangePassword(l.ctx, &userclient.ChangePasswordRequest{
-		UserId:      userID,
-		OldPhone:    oldPhone,
-		NewPassword: newPassword,
+		UserId:       userID,
+		OldPhone:     oldPhone,
+		NewPassword:  newPassword,
+		VerifyTicket: verifyResp.Ticket,
 	})
 	if err != nil {
 		code, msg := utils.HandleRPCError(err, l.Logger, "ChangePassword")
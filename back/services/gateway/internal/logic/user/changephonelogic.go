@@ -76,49 +76,53 @@ func (l *ChangePhoneLogic) ChangePhone(req *types.ChangePhoneRequest) (resp *typ
 	}
 
 	// 验证原手机号验证码
-	if l.svcCtx.CodeRPC != nil {
-		verifyResp, err := l.svcCtx.CodeRPC.VerifyCode(l.ctx, &codeclient.VerifyCodeRequest{
-			Phone:   oldPhone,
-			Purpose: "change_phone",
-			Code:    oldCode,
-		})
-		if err != nil {
-			code, msg := utils.HandleRPCError(err, l.Logger, "VerifyCode")
-			return &types.ChangePhoneResponse{
-				BaseResp: types.BaseResp{Code: code, Msg: msg},
-			}, nil
-		}
-		if !verifyResp.Passed {
-			return &types.ChangePhoneResponse{
-				BaseResp: types.BaseResp{Code: 400, Msg: "验证码错误或已过期"},
-			}, nil
-		}
+	if l.svcCtx.CodeRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "CodeRPC")
+		return &types.ChangePhoneResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+	oldVerifyResp, err := l.svcCtx.CodeRPC.VerifyCode(l.ctx, &codeclient.VerifyCodeRequest{
+		Phone:   oldPhone,
+		Purpose: "change_phone",
+		Code:    oldCode,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "VerifyCode")
+		return &types.ChangePhoneResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+	if !oldVerifyResp.Passed {
+		return &types.ChangePhoneResponse{
+			BaseResp: types.BaseResp{Code: 400, Msg: utils.VerifyCodeFailedMsg(oldVerifyResp.RemainingAttempts, oldVerifyResp.NeedResend)},
+		}, nil
 	}
 
 	// 验证新手机号验证码
-	if l.svcCtx.CodeRPC != nil {
-		verifyResp, err := l.svcCtx.CodeRPC.VerifyCode(l.ctx, &codeclient.VerifyCodeRequest{
-			Phone:   newPhone,
-			Purpose: "change_phone_new",
-			Code:    newCode,
-		})
-		if err != nil {
-			code, msg := utils.HandleRPCError(err, l.Logger, "VerifyCode")
-			return &types.ChangePhoneResponse{
-				BaseResp: types.BaseResp{Code: code, Msg: msg},
-			}, nil
-		}
-		if !verifyResp.Passed {
-			return &types.ChangePhoneResponse{
-				BaseResp: types.BaseResp{Code: 400, Msg: "验证码错误或已过期"},
-			}, nil
-		}
+	newVerifyResp, err := l.svcCtx.CodeRPC.VerifyCode(l.ctx, &codeclient.VerifyCodeRequest{
+		Phone:   newPhone,
+		Purpose: "change_phone_new",
+		Code:    newCode,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "VerifyCode")
+		return &types.ChangePhoneResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+	if !newVerifyResp.Passed {
+		return &types.ChangePhoneResponse{
+			BaseResp: types.BaseResp{Code: 400, Msg: utils.VerifyCodeFailedMsg(newVerifyResp.RemainingAttempts, newVerifyResp.NeedResend)},
+		}, nil
 	}
 
 	_, err = l.svcCtx.UserRPC.ChangePhone(l.ctx, &userclient.ChangePhoneRequest{
-		UserId:   userID,
-		OldPhone: oldPhone,
-		NewPhone: newPhone,
+		UserId:         userID,
+		OldPhone:       oldPhone,
+		NewPhone:       newPhone,
+		OldPhoneTicket: oldVerifyResp.Ticket,
+		NewPhoneTicket: newVerifyResp.Ticket,
 	})
 	if err != nil {
 		code, msg := utils.HandleRPCError(err, l.Logger, "ChangePhone")
@@ -0,0 +1,66 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type CompanionHeartbeatLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewCompanionHeartbeatLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CompanionHeartbeatLogic {
+	return &CompanionHeartbeatLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// CompanionHeartbeat 陪玩心跳，只有存在陪玩资料的用户可以调用（用户服务返回 NotFound）
+func (l *CompanionHeartbeatLogic) CompanionHeartbeat() (resp *types.CompanionHeartbeatResponse, err error) {
+	userID, err := middleware.GetUserID(l.ctx)
+	if err != nil {
+		return &types.CompanionHeartbeatResponse{
+			BaseResp: types.BaseResp{Code: 401, Msg: "未登录或登录已过期"},
+		}, nil
+	}
+
+	if l.svcCtx.UserRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "UserRPC")
+		return &types.CompanionHeartbeatResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+
+	rpcResp, err := l.svcCtx.UserRPC.CompanionHeartbeat(l.ctx, &userclient.CompanionHeartbeatRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "CompanionHeartbeat")
+		return &types.CompanionHeartbeatResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+
+	return &types.CompanionHeartbeatResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: utils.GetSuccessMsg("CompanionHeartbeat")},
+		Data: types.CompanionHeartbeatData{
+			Status:          int(rpcResp.Status),
+			ExpireAt:        rpcResp.ExpireAt,
+			IntervalSeconds: int(rpcResp.IntervalSeconds),
+		},
+	}, nil
+}
@@ -0,0 +1,64 @@
+package user
+
+import (
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/user/userclient"
+)
+
+// toCompanionSkills 将 RPC 的陪玩技能列表转为网关层结构
+func toCompanionSkills(skills []*userclient.CompanionSkill) []types.CompanionSkill {
+	result := make([]types.CompanionSkill, 0, len(skills))
+	for _, s := range skills {
+		result = append(result, types.CompanionSkill{
+			GameSkillId:  s.GetGameSkillId(),
+			GameName:     s.GetGameName(),
+			Rank:         s.GetRank(),
+			PricePerHour: s.GetPricePerHour(),
+			IsVerified:   s.GetIsVerified(),
+		})
+	}
+	return result
+}
+
+// toCompanionSkillInputs 将网关层的技能设置请求转为 RPC 结构
+func toCompanionSkillInputs(inputs []types.CompanionSkillInput) []*userclient.CompanionSkill {
+	if len(inputs) == 0 {
+		return nil
+	}
+	result := make([]*userclient.CompanionSkill, 0, len(inputs))
+	for _, in := range inputs {
+		result = append(result, &userclient.CompanionSkill{
+			GameSkillId:  in.GameSkillId,
+			GameName:     in.GameName,
+			Rank:         in.Rank,
+			PricePerHour: in.PricePerHour,
+		})
+	}
+	return result
+}
+
+// toCompanionApplicationInfo 将 RPC 的入驻申请转为网关层结构
+func toCompanionApplicationInfo(a *userclient.CompanionApplicationInfo) types.CompanionApplicationInfo {
+	if a == nil {
+		return types.CompanionApplicationInfo{}
+	}
+	return types.CompanionApplicationInfo{
+		Id:             a.GetId(),
+		UserId:         a.GetUserId(),
+		RealName:       a.GetRealName(),
+		IdCardNo:       a.GetIdCardNo(),
+		IdCardFrontUrl: a.GetIdCardFrontUrl(),
+		IdCardBackUrl:  a.GetIdCardBackUrl(),
+		SkillProofUrls: a.GetSkillProofUrls(),
+		VoiceSampleUrl: a.GetVoiceSampleUrl(),
+		Skills:         toCompanionSkills(a.GetSkills()),
+		Bio:            a.GetBio(),
+		Status:         a.GetStatus(),
+		RejectReason:   a.GetRejectReason(),
+		ReviewNote:     a.GetReviewNote(),
+		ReviewerId:     a.GetReviewerId(),
+		ReviewedAt:     a.GetReviewedAt(),
+		CreatedAt:      a.GetCreatedAt(),
+		ReapplyAt:      a.GetReapplyAt(),
+	}
+}
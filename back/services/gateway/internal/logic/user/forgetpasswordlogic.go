@@ -38,35 +38,48 @@ func (l *ForgetPasswordLogic) ForgetPassword(req *types.ForgetPasswordRequest) (
 	}
 
 	// 验证验证码
-	if l.svcCtx.CodeRPC != nil {
-		verifyResp, err := l.svcCtx.CodeRPC.VerifyCode(l.ctx, &codeclient.VerifyCodeRequest{
-			Phone:   req.Phone,
-			Purpose: "forget_password",
-			Code:    req.Code,
-		})
-		if err != nil {
-			code, msg := utils.HandleRPCError(err, l.Logger, "VerifyCode")
-			return &types.ForgetPasswordResponse{
-				BaseResp: types.BaseResp{
-					Code: code,
-					Msg:  msg,
-				},
-			}, nil
-		}
-		if !verifyResp.Passed {
-			return &types.ForgetPasswordResponse{
-				BaseResp: types.BaseResp{
-					Code: 400,
-					Msg:  "验证码错误或已过期",
-				},
-			}, nil
-		}
+	if l.svcCtx.CodeRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "CodeRPC")
+		return &types.ForgetPasswordResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+	// 手机号与邮箱二选一，未填手机号时按邮箱验证
+	channel := "sms"
+	if req.Phone == "" && req.Email != "" {
+		channel = "email"
+	}
+	verifyResp, err := l.svcCtx.CodeRPC.VerifyCode(l.ctx, &codeclient.VerifyCodeRequest{
+		Phone:   req.Phone,
+		Purpose: "forget_password",
+		Code:    req.Code,
+		Channel: channel,
+		Email:   req.Email,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "VerifyCode")
+		return &types.ForgetPasswordResponse{
+			BaseResp: types.BaseResp{
+				Code: code,
+				Msg:  msg,
+			},
+		}, nil
+	}
+	if !verifyResp.Passed {
+		return &types.ForgetPasswordResponse{
+			BaseResp: types.BaseResp{
+				Code: 400,
+				Msg:  utils.VerifyCodeFailedMsg(verifyResp.RemainingAttempts, verifyResp.NeedResend),
+			},
+		}, nil
 	}
 
 	// 调用用户服务的 RPC
 	rpcResp, err := l.svcCtx.UserRPC.ForgetPassword(l.ctx, &userclient.ForgetPasswordRequest{
-		Phone:    req.Phone,
-		Password: req.Password,
+		Phone:        req.Phone,
+		Email:        req.Email,
+		Password:     req.Password,
+		VerifyTicket: verifyResp.Ticket,
 	})
 	if err != nil {
 		code, msg := utils.HandleRPCError(err, l.Logger, "ForgetPassword")
@@ -4,15 +4,12 @@
 package user
 
 import (
-	"context"
-	"encoding/json"
-	"fmt"
-	"time"
-
+	"SLGaming/back/services/gateway/internal/middleware"
 	"SLGaming/back/services/gateway/internal/svc"
 	"SLGaming/back/services/gateway/internal/types"
 	"SLGaming/back/services/gateway/internal/utils"
 	"SLGaming/back/services/user/userclient"
+	"context"
 
 	"github.com/zeromicro/go-zero/core/logx"
 )
@@ -39,27 +36,27 @@ func (l *GetCompanionListLogic) GetCompanionList(req *types.GetCompanionListRequ
 		}, nil
 	}
 
-	cacheKey := buildCompanionListCacheKey(req)
-	if l.svcCtx.CacheRedis != nil {
-		if cached, cacheErr := l.svcCtx.CacheRedis.Get(cacheKey); cacheErr == nil && cached != "" {
-			var cachedResp types.GetCompanionListResponse
-			if unmarshalErr := json.Unmarshal([]byte(cached), &cachedResp); unmarshalErr == nil {
-				return &cachedResp, nil
-			}
-		}
-	}
+	// 公开接口：携带有效令牌时按当前用户的拉黑关系过滤
+	viewerID, _ := middleware.GetUserID(l.ctx)
 
 	// 调用 User RPC 的 GetCompanionList 接口
-	// 注意：如果 status 为 0，RPC 层会将其视为"未指定"并使用默认值（在线）
-	// 如果用户明确想查询 status=0（离线），需要确保 gateway 层正确传递
+	// 列表缓存由用户服务维护（陪玩资料变更时失效），网关不再单独缓存
 	rpcResp, err := l.svcCtx.UserRPC.GetCompanionList(l.ctx, &userclient.GetCompanionListRequest{
 		GameSkill:  req.GameSkill,
 		MinPrice:   int32(req.MinPrice),
 		MaxPrice:   int32(req.MaxPrice),
 		Status:     int32(req.Status),
+		OnlineOnly: req.OnlineOnly,
 		IsVerified: req.IsVerified,
+		Gender:     int32(req.Gender),
+		MinAge:     int32(req.MinAge),
+		MaxAge:     int32(req.MaxAge),
+		MinRating:  req.MinRating,
+		Keyword:    req.Keyword,
+		SortBy:     req.SortBy,
 		Page:       int32(req.Page),
 		PageSize:   int32(req.PageSize),
+		ViewerId:   viewerID,
 	})
 	if err != nil {
 		code, msg := utils.HandleRPCError(err, l.Logger, "GetCompanionList")
@@ -78,13 +75,19 @@ func (l *GetCompanionListLogic) GetCompanionList(req *types.GetCompanionListRequ
 			UserId:       cp.UserId,
 			GameSkill:    cp.GameSkill,
 			PricePerHour: cp.PricePerHour,
+			Skills:       toCompanionSkills(cp.Skills),
 			Status:       int(cp.Status),
 			Rating:       cp.Rating,
+			RatingScore:  cp.RatingScore,
 			TotalOrders:  cp.TotalOrders,
 			IsVerified:   cp.IsVerified,
 			Nickname:     cp.Nickname,
 			AvatarUrl:    cp.AvatarUrl,
 			Bio:          cp.Bio,
+			Gender:       cp.Gender,
+			Age:          cp.Age,
+			Tier:         cp.Tier,
+			TierName:     cp.TierName,
 		})
 	}
 
@@ -101,24 +104,5 @@ func (l *GetCompanionListLogic) GetCompanionList(req *types.GetCompanionListRequ
 		},
 	}
 
-	if l.svcCtx.CacheRedis != nil {
-		if payload, marshalErr := json.Marshal(resp); marshalErr == nil {
-			_ = l.svcCtx.CacheRedis.Setex(cacheKey, string(payload), int((60 * time.Second).Seconds()))
-		}
-	}
-
 	return resp, nil
 }
-
-func buildCompanionListCacheKey(req *types.GetCompanionListRequest) string {
-	return fmt.Sprintf(
-		"cache:companions:%s:%d:%d:%d:%t:%d:%d",
-		req.GameSkill,
-		req.MinPrice,
-		req.MaxPrice,
-		req.Status,
-		req.IsVerified,
-		req.Page,
-		req.PageSize,
-	)
-}
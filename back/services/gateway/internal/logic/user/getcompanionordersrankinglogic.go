@@ -39,6 +39,8 @@ func (l *GetCompanionOrdersRankingLogic) GetCompanionOrdersRanking(req *types.Ge
 	rpcResp, err := l.svcCtx.UserRPC.GetCompanionOrdersRanking(l.ctx, &userclient.GetCompanionOrdersRankingRequest{
 		Page:     int32(req.Page),
 		PageSize: int32(req.PageSize),
+		Period:   req.Period,
+		Game:     req.Game,
 	})
 	if err != nil {
 		code, msg := utils.HandleRPCError(err, l.Logger, "GetCompanionOrdersRanking")
@@ -54,6 +56,7 @@ func (l *GetCompanionOrdersRankingLogic) GetCompanionOrdersRanking(req *types.Ge
 			Nickname:    item.Nickname,
 			AvatarUrl:   item.AvatarUrl,
 			Rating:      item.Rating,
+			RatingScore: item.RatingScore,
 			TotalOrders: item.TotalOrders,
 			Rank:        item.Rank,
 			IsVerified:  item.IsVerified,
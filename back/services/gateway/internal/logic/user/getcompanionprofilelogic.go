@@ -91,13 +91,19 @@ func (l *GetCompanionProfileLogic) GetCompanionProfile() (resp *types.GetCompani
 			UserId:       profile.UserId,
 			GameSkill:    profile.GameSkill,
 			PricePerHour: profile.PricePerHour,
+			Skills:       toCompanionSkills(profile.Skills),
 			Status:       int(profile.Status),
 			Rating:       profile.Rating,
+			RatingScore:  profile.RatingScore,
 			TotalOrders:  profile.TotalOrders,
 			IsVerified:   profile.IsVerified,
 			Nickname:     profile.Nickname,
 			AvatarUrl:    profile.AvatarUrl,
 			Bio:          profile.Bio,
+			Gender:       profile.Gender,
+			Age:          profile.Age,
+			Tier:         profile.Tier,
+			TierName:     profile.TierName,
 		},
 	}, nil
 }
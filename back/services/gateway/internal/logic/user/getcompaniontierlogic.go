@@ -0,0 +1,89 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetCompanionTierLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetCompanionTierLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCompanionTierLogic {
+	return &GetCompanionTierLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+// GetCompanionTier 查询自己的陪玩等级及变更记录，只有存在陪玩资料的用户可以查询（用户服务返回 NotFound）
+func (l *GetCompanionTierLogic) GetCompanionTier(req *types.GetCompanionTierRequest) (resp *types.GetCompanionTierResponse, err error) {
+	userID, err := middleware.GetUserID(l.ctx)
+	if err != nil {
+		return &types.GetCompanionTierResponse{
+			BaseResp: types.BaseResp{Code: 401, Msg: "未登录或登录已过期"},
+		}, nil
+	}
+
+	if l.svcCtx.UserRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "UserRPC")
+		return &types.GetCompanionTierResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+
+	rpcResp, err := l.svcCtx.UserRPC.GetCompanionTierHistory(l.ctx, &userclient.GetCompanionTierHistoryRequest{
+		UserId:   userID,
+		Page:     req.Page,
+		PageSize: req.PageSize,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "GetCompanionTierHistory")
+		return &types.GetCompanionTierResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+
+	items := make([]types.CompanionTierHistoryItem, 0, len(rpcResp.GetItems()))
+	for _, it := range rpcResp.GetItems() {
+		items = append(items, types.CompanionTierHistoryItem{
+			FromTier:        it.GetFromTier(),
+			FromTierName:    it.GetFromTierName(),
+			ToTier:          it.GetToTier(),
+			ToTierName:      it.GetToTierName(),
+			Reason:          it.GetReason(),
+			CompletedOrders: it.GetCompletedOrders(),
+			Rating:          it.GetRating(),
+			CancelRate:      it.GetCancelRate(),
+			DisputeRate:     it.GetDisputeRate(),
+			Note:            it.GetNote(),
+			CreatedAt:       it.GetCreatedAt(),
+		})
+	}
+
+	return &types.GetCompanionTierResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: utils.GetSuccessMsg("GetCompanionTier")},
+		Data: types.GetCompanionTierData{
+			Tier:       rpcResp.GetTier(),
+			TierName:   rpcResp.GetTierName(),
+			Overridden: rpcResp.GetOverridden(),
+			Items:      items,
+			Total:      rpcResp.GetTotal(),
+			Page:       rpcResp.GetPage(),
+			PageSize:   rpcResp.GetPageSize(),
+		},
+	}, nil
+}
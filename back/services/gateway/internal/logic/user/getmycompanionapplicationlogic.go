@@ -0,0 +1,66 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetMyCompanionApplicationLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetMyCompanionApplicationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetMyCompanionApplicationLogic {
+	return &GetMyCompanionApplicationLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetMyCompanionApplicationLogic) GetMyCompanionApplication() (resp *types.GetMyCompanionApplicationResponse, err error) {
+	userID, err := middleware.GetUserID(l.ctx)
+	if err != nil {
+		return &types.GetMyCompanionApplicationResponse{
+			BaseResp: types.BaseResp{Code: 401, Msg: "未登录或登录已过期"},
+		}, nil
+	}
+
+	if l.svcCtx.UserRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "UserRPC")
+		return &types.GetMyCompanionApplicationResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+
+	rpcResp, err := l.svcCtx.UserRPC.GetMyCompanionApplication(l.ctx, &userclient.GetMyCompanionApplicationRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "GetMyCompanionApplication")
+		return &types.GetMyCompanionApplicationResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+
+	resp = &types.GetMyCompanionApplicationResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: utils.GetSuccessMsg("GetMyCompanionApplication")},
+	}
+	// 从未申请过时 data 为 null
+	if rpcResp.GetApplication() != nil {
+		info := toCompanionApplicationInfo(rpcResp.GetApplication())
+		resp.Data = &info
+	}
+	return resp, nil
+}
@@ -77,11 +77,17 @@ func (l *GetUserLogic) GetUser(req *types.GetUserRequest) (resp *types.GetUserRe
 			Uid:            rpcResp.User.Uid,
 			Nickname:       rpcResp.User.Nickname,
 			Phone:          rpcResp.User.Phone,
+			Email:          rpcResp.User.Email,
 			Role:           int(rpcResp.User.Role),
 			AvatarUrl:      rpcResp.User.AvatarUrl,
 			Bio:            rpcResp.User.Bio,
 			FollowerCount:  rpcResp.User.FollowerCount,
 			FollowingCount: rpcResp.User.FollowingCount,
+			VipLevel:       rpcResp.User.VipLevel,
+			VipBadge:       rpcResp.User.VipBadge,
+			VipExpireAt:    rpcResp.User.VipExpireAt,
+			Gender:         rpcResp.User.Gender,
+			Birthday:       rpcResp.User.Birthday,
 		},
 	}, nil
 }
@@ -0,0 +1,71 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type GetVipEntitlementsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewGetVipEntitlementsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetVipEntitlementsLogic {
+	return &GetVipEntitlementsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *GetVipEntitlementsLogic) GetVipEntitlements() (resp *types.GetVipEntitlementsResponse, err error) {
+	userID, err := middleware.GetUserID(l.ctx)
+	if err != nil || userID == 0 {
+		return &types.GetVipEntitlementsResponse{
+			BaseResp: types.BaseResp{Code: 401, Msg: "未登录或登录已过期"},
+		}, nil
+	}
+
+	if l.svcCtx.UserRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "UserRPC")
+		return &types.GetVipEntitlementsResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+
+	rpcResp, err := l.svcCtx.UserRPC.GetVipEntitlements(l.ctx, &userclient.GetVipEntitlementsRequest{
+		UserId: userID,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "GetVipEntitlements")
+		return &types.GetVipEntitlementsResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+
+	return &types.GetVipEntitlementsResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: "success"},
+		Data: types.VipEntitlements{
+			IsVip:               rpcResp.GetIsVip(),
+			Level:               rpcResp.GetLevel(),
+			PlanCode:            rpcResp.GetPlanCode(),
+			Badge:               rpcResp.GetBadge(),
+			DiscountPercent:     rpcResp.GetDiscountPercent(),
+			RecommendPriority:   rpcResp.GetRecommendPriority(),
+			RateLimitMultiplier: rpcResp.GetRateLimitMultiplier(),
+			ExpireAt:            rpcResp.GetExpireAt(),
+			AutoRenew:           rpcResp.GetAutoRenew(),
+		},
+	}, nil
+}
@@ -0,0 +1,22 @@
+package user
+
+import (
+	"fmt"
+	"strings"
+)
+
+// maxGiftRequestIDLen 客户端请求ID最大长度（拼接用户ID后需落入 biz_order_id 的 64 位长度限制）
+const maxGiftRequestIDLen = 40
+
+// buildGiftBizOrderID 根据客户端请求ID生成业务单号，按用户隔离，避免不同用户的请求ID冲突
+// 未传请求ID时返回空串，由用户服务生成
+func buildGiftBizOrderID(prefix string, userID uint64, requestID string) (string, bool) {
+	requestID = strings.TrimSpace(requestID)
+	if requestID == "" {
+		return "", true
+	}
+	if len(requestID) > maxGiftRequestIDLen {
+		return "", false
+	}
+	return fmt.Sprintf("%s%d_%s", prefix, userID, requestID), true
+}
@@ -0,0 +1,62 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListGiftsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewListGiftsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListGiftsLogic {
+	return &ListGiftsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListGiftsLogic) ListGifts() (resp *types.ListGiftsResponse, err error) {
+	if l.svcCtx.UserRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "UserRPC")
+		return &types.ListGiftsResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+
+	rpcResp, err := l.svcCtx.UserRPC.ListGifts(l.ctx, &userclient.ListGiftsRequest{})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "ListGifts")
+		return &types.ListGiftsResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+
+	data := make([]types.GiftInfo, 0, len(rpcResp.GetGifts()))
+	for _, g := range rpcResp.GetGifts() {
+		data = append(data, types.GiftInfo{
+			Id:      g.GetId(),
+			Name:    g.GetName(),
+			Price:   g.GetPrice(),
+			IconUrl: g.GetIconUrl(),
+			Sort:    int(g.GetSort()),
+		})
+	}
+
+	return &types.ListGiftsResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: "success"},
+		Data:     data,
+	}, nil
+}
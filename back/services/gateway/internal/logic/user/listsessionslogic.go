@@ -0,0 +1,71 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListSessionsLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewListSessionsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListSessionsLogic {
+	return &ListSessionsLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListSessionsLogic) ListSessions() (resp *types.ListSessionsResponse, err error) {
+	userID, err := middleware.GetUserID(l.ctx)
+	if err != nil || userID == 0 {
+		return &types.ListSessionsResponse{
+			BaseResp: types.BaseResp{Code: 401, Msg: "未登录或登录已过期"},
+		}, nil
+	}
+
+	if l.svcCtx.TokenStore == nil {
+		return &types.ListSessionsResponse{
+			BaseResp: types.BaseResp{Code: 503, Msg: "会话服务暂不可用"},
+		}, nil
+	}
+
+	sessions, err := l.svcCtx.TokenStore.ListSessions(l.ctx, userID)
+	if err != nil {
+		l.Errorf("list sessions failed: user_id=%d, err=%v", userID, err)
+		return &types.ListSessionsResponse{
+			BaseResp: types.BaseResp{Code: 500, Msg: "获取登录设备失败"},
+		}, nil
+	}
+
+	current := middleware.GetSessionID(l.ctx)
+	data := make([]types.SessionInfo, 0, len(sessions))
+	for _, s := range sessions {
+		data = append(data, types.SessionInfo{
+			Id:         s.ID,
+			DeviceName: s.DeviceName,
+			UserAgent:  s.UserAgent,
+			Ip:         s.IP,
+			CreatedAt:  s.CreatedAt,
+			LastSeenAt: s.LastSeenAt,
+			ExpiresAt:  s.ExpiresAt,
+			Current:    current != "" && s.ID == current,
+		})
+	}
+
+	return &types.ListSessionsResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: "success"},
+		Data:     data,
+	}, nil
+}
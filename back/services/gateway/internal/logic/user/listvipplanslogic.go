@@ -0,0 +1,66 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListVipPlansLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewListVipPlansLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListVipPlansLogic {
+	return &ListVipPlansLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *ListVipPlansLogic) ListVipPlans() (resp *types.ListVipPlansResponse, err error) {
+	if l.svcCtx.UserRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "UserRPC")
+		return &types.ListVipPlansResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+
+	rpcResp, err := l.svcCtx.UserRPC.ListVipPlans(l.ctx, &userclient.ListVipPlansRequest{})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "ListVipPlans")
+		return &types.ListVipPlansResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+
+	data := make([]types.VipPlanInfo, 0, len(rpcResp.GetPlans()))
+	for _, p := range rpcResp.GetPlans() {
+		data = append(data, types.VipPlanInfo{
+			Code:                p.GetCode(),
+			Name:                p.GetName(),
+			Level:               p.GetLevel(),
+			DurationDays:        p.GetDurationDays(),
+			Price:               p.GetPrice(),
+			DiscountPercent:     p.GetDiscountPercent(),
+			Badge:               p.GetBadge(),
+			RecommendPriority:   p.GetRecommendPriority(),
+			RateLimitMultiplier: p.GetRateLimitMultiplier(),
+		})
+	}
+
+	return &types.ListVipPlansResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: "success"},
+		Data:     data,
+	}, nil
+}
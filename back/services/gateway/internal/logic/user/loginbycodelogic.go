@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"SLGaming/back/services/code/codeclient"
+	"SLGaming/back/services/gateway/internal/middleware"
 	"SLGaming/back/services/gateway/internal/svc"
 	"SLGaming/back/services/gateway/internal/types"
 	"SLGaming/back/services/gateway/internal/utils"
@@ -38,34 +39,50 @@ func (l *LoginByCodeLogic) LoginByCode(req *types.LoginByCodeRequest) (resp *typ
 	}
 
 	// 验证验证码
-	if l.svcCtx.CodeRPC != nil {
-		verifyResp, err := l.svcCtx.CodeRPC.VerifyCode(l.ctx, &codeclient.VerifyCodeRequest{
-			Phone:   req.Phone,
-			Purpose: "login",
-			Code:    req.Code,
-		})
-		if err != nil {
-			code, msg := utils.HandleRPCError(err, l.Logger, "VerifyCode")
-			return &types.LoginByCodeResponse{
-				BaseResp: types.BaseResp{
-					Code: code,
-					Msg:  msg,
-				},
-			}, nil
-		}
-		if !verifyResp.Passed {
-			return &types.LoginByCodeResponse{
-				BaseResp: types.BaseResp{
-					Code: 400,
-					Msg:  "验证码错误或已过期",
-				},
-			}, nil
-		}
+	if l.svcCtx.CodeRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "CodeRPC")
+		return &types.LoginByCodeResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+	// 手机号与邮箱二选一，未填手机号时按邮箱验证
+	channel := "sms"
+	if req.Phone == "" && req.Email != "" {
+		channel = "email"
+	}
+	verifyResp, err := l.svcCtx.CodeRPC.VerifyCode(l.ctx, &codeclient.VerifyCodeRequest{
+		Phone:   req.Phone,
+		Purpose: "login",
+		Code:    req.Code,
+		Channel: channel,
+		Email:   req.Email,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "VerifyCode")
+		return &types.LoginByCodeResponse{
+			BaseResp: types.BaseResp{
+				Code: code,
+				Msg:  msg,
+			},
+		}, nil
+	}
+	if !verifyResp.Passed {
+		return &types.LoginByCodeResponse{
+			BaseResp: types.BaseResp{
+				Code: 400,
+				Msg:  utils.VerifyCodeFailedMsg(verifyResp.RemainingAttempts, verifyResp.NeedResend),
+			},
+		}, nil
 	}
 
 	// 调用用户服务的 RPC
+	client := middleware.GetClientInfo(l.ctx)
 	rpcResp, err := l.svcCtx.UserRPC.LoginByCode(l.ctx, &userclient.LoginByCodeRequest{
-		Phone: req.Phone,
+		Phone:        req.Phone,
+		Email:        req.Email,
+		ClientIp:     client.IP,
+		UserAgent:    client.UserAgent,
+		VerifyTicket: verifyResp.Ticket,
 	})
 	if err != nil {
 		code, msg := utils.HandleRPCError(err, l.Logger, "LoginByCode")
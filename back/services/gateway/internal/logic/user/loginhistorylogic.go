@@ -0,0 +1,83 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type LoginHistoryLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewLoginHistoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *LoginHistoryLogic {
+	return &LoginHistoryLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *LoginHistoryLogic) LoginHistory(req *types.LoginHistoryRequest) (resp *types.LoginHistoryResponse, err error) {
+	userID, err := middleware.GetUserID(l.ctx)
+	if err != nil {
+		return &types.LoginHistoryResponse{
+			BaseResp: types.BaseResp{Code: 401, Msg: "未登录或登录已过期"},
+		}, nil
+	}
+
+	if l.svcCtx.UserRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "UserRPC")
+		return &types.LoginHistoryResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+
+	rpcResp, err := l.svcCtx.UserRPC.ListLoginEvents(l.ctx, &userclient.ListLoginEventsRequest{
+		UserId:   userID,
+		Page:     int32(req.Page),
+		PageSize: int32(req.PageSize),
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "LoginHistory")
+		return &types.LoginHistoryResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+
+	events := make([]types.LoginEventInfo, 0, len(rpcResp.Events))
+	for _, e := range rpcResp.Events {
+		events = append(events, types.LoginEventInfo{
+			Id:         e.Id,
+			Method:     e.Method,
+			Result:     e.Result,
+			Reason:     e.Reason,
+			Ip:         e.Ip,
+			IpRegion:   e.IpRegion,
+			UserAgent:  e.UserAgent,
+			NewDevice:  e.NewDevice,
+			OccurredAt: e.OccurredAt,
+		})
+	}
+
+	return &types.LoginHistoryResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: "success"},
+		Data: types.LoginHistoryData{
+			Events:   events,
+			Total:    int(rpcResp.Total),
+			Page:     int(rpcResp.Page),
+			PageSize: int(rpcResp.PageSize),
+		},
+	}, nil
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 
 	"SLGaming/back/services/gateway/internal/helper"
+	"SLGaming/back/services/gateway/internal/middleware"
 	"SLGaming/back/services/gateway/internal/svc"
 	"SLGaming/back/services/gateway/internal/types"
 	"SLGaming/back/services/gateway/internal/utils"
@@ -43,9 +44,12 @@ func (l *LoginLogic) Login(req *types.LoginRequest) (resp *types.LoginResponse,
 	}
 
 	// 调用用户服务的 RPC
+	client := middleware.GetClientInfo(l.ctx)
 	rpcResp, err := l.svcCtx.UserRPC.Login(l.ctx, &userclient.LoginRequest{
-		Phone:    req.Phone,
-		Password: req.Password,
+		Phone:     req.Phone,
+		Password:  req.Password,
+		ClientIp:  client.IP,
+		UserAgent: client.UserAgent,
 	})
 	if err != nil {
 		code, msg := utils.HandleRPCError(err, l.Logger, "Login")
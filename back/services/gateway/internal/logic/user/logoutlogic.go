@@ -52,7 +52,14 @@ func (l *LogoutLogic) Logout(req *types.LogoutRequest) (resp *types.LogoutRespon
 			}
 		}
 
-		// 2. 撤销当前的 Refresh Token（如果存在）
+		// 2. 删除当前会话（设备列表中不再显示）
+		if sessionID := middleware.GetSessionID(l.ctx); sessionID != "" {
+			if _, err := l.svcCtx.TokenStore.RevokeSession(l.ctx, userID, sessionID, l.svcCtx.JWT.GetAccessTokenDuration()); err != nil {
+				l.Errorf("revoke session failed: %v", err)
+			}
+		}
+
+		// 3. 撤销当前的 Refresh Token（如果存在）
 		refreshToken, refreshErr := middleware.GetRefreshToken(l.ctx)
 		if refreshErr == nil && refreshToken != "" {
 			// 解析 Refresh Token 获取过期时间
@@ -5,8 +5,10 @@ package user
 
 import (
 	"context"
+	"errors"
 
 	"SLGaming/back/services/gateway/internal/jwt"
+	"SLGaming/back/services/gateway/internal/middleware"
 	"SLGaming/back/services/gateway/internal/svc"
 	"SLGaming/back/services/gateway/internal/types"
 	"SLGaming/back/services/gateway/internal/utils"
@@ -37,65 +39,36 @@ func (l *RefreshTokenLogic) RefreshToken(req *types.RefreshTokenRequest) (resp *
 		}, nil
 	}
 
-	// 验证 Refresh Token
-	claims, err := l.svcCtx.JWT.VerifyToken(req.RefreshToken)
+	// 签发新的 Access Token 并轮换 Refresh Token（旧 Refresh Token 随即失效）
+	refreshed, err := middleware.RefreshTokens(l.ctx, l.svcCtx, req.RefreshToken)
 	if err != nil {
-		if err == jwt.ErrExpiredToken {
+		var msg string
+		switch {
+		case errors.Is(err, jwt.ErrExpiredToken):
+			msg = "Refresh Token 已过期"
+		case errors.Is(err, jwt.ErrInvalidToken):
+			msg = "Refresh Token 无效"
+		case errors.Is(err, middleware.ErrRefreshTokenRevoked):
+			msg = "Refresh Token 已被撤销"
+		case errors.Is(err, middleware.ErrRefreshTokenReused):
+			msg = "登录状态异常，请重新登录"
+		default:
+			code, msg := utils.HandleError(err, l.Logger, "RefreshToken")
 			return &types.RefreshTokenResponse{
 				BaseResp: types.BaseResp{
-					Code: 401,
-					Msg:  "Refresh Token 已过期",
+					Code: code,
+					Msg:  msg,
 				},
 			}, nil
 		}
-		code, msg := utils.HandleError(err, l.Logger, "VerifyToken")
 		return &types.RefreshTokenResponse{
 			BaseResp: types.BaseResp{
-				Code: code,
+				Code: 401,
 				Msg:  msg,
 			},
 		}, nil
 	}
 
-	// 验证 Refresh Token 是否在黑名单中（未被撤销）
-	if l.svcCtx.TokenStore != nil {
-		// 从 claims 中获取 token 过期时间
-		tokenExpiration := claims.ExpiresAt.Time
-		valid, err := l.svcCtx.TokenStore.VerifyRefreshToken(l.ctx, claims.UserID, req.RefreshToken, tokenExpiration)
-		if err != nil {
-			l.Errorf("verify refresh token failed: %v", err)
-			return &types.RefreshTokenResponse{
-				BaseResp: types.BaseResp{
-					Code: 500,
-					Msg:  "验证 Refresh Token 失败",
-				},
-			}, nil
-		}
-		if !valid {
-			return &types.RefreshTokenResponse{
-				BaseResp: types.BaseResp{
-					Code: 401,
-					Msg:  "Refresh Token 已被撤销",
-				},
-			}, nil
-		}
-	}
-
-	role := claims.Role
-
-	// 只生成新的 Access Token，Refresh Token 保持不变
-	accessToken, err := l.svcCtx.JWT.GenerateAccessToken(claims.UserID, role)
-	if err != nil {
-		code, msg := utils.HandleError(err, l.Logger, "GenerateAccessToken")
-		return &types.RefreshTokenResponse{
-			BaseResp: types.BaseResp{
-				Code: code,
-				Msg:  msg,
-			},
-		}, nil
-	}
-
-	// Refresh Token 不刷新，保持原样
 	// 计算 Access Token 的过期时间
 	expiresIn := int64(l.svcCtx.JWT.GetAccessTokenDuration().Seconds())
 
@@ -104,9 +77,9 @@ func (l *RefreshTokenLogic) RefreshToken(req *types.RefreshTokenRequest) (resp *
 			Code: 0,
 			Msg:  "success",
 		},
-		Data: types.LoginData{
-			AccessToken:  accessToken,
-			RefreshToken: req.RefreshToken, // 保持原 Refresh Token
+		Data: types.RefreshTokenData{
+			AccessToken:  refreshed.AccessToken,
+			RefreshToken: refreshed.RefreshToken, // 新的 Refresh Token，并发刷新的宽限期内为空（响应中省略）
 			ExpiresIn:    expiresIn,
 		},
 	}, nil
@@ -46,40 +46,45 @@ func (l *RegisterLogic) Register(req *types.RegisterRequest) (resp *types.Regist
 	}
 
 	// 验证验证码
-	if l.svcCtx.CodeRPC != nil {
-		verifyResp, err := l.svcCtx.CodeRPC.VerifyCode(l.ctx, &codeclient.VerifyCodeRequest{
-			Phone:   req.Phone,
-			Purpose: "register",
-			Code:    req.Code,
+	if l.svcCtx.CodeRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "CodeRPC")
+		return &types.RegisterResponse{
+			BaseResp: types.BaseResp{Code: code, Msg: msg},
+		}, nil
+	}
+	verifyResp, err := l.svcCtx.CodeRPC.VerifyCode(l.ctx, &codeclient.VerifyCodeRequest{
+		Phone:   req.Phone,
+		Purpose: "register",
+		Code:    req.Code,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "VerifyCode")
+		return &types.RegisterResponse{
+			BaseResp: types.BaseResp{
+				Code: code,
+				Msg:  msg,
+			},
+		}, nil
+	}
+	if !verifyResp.Passed {
+		helper.LogWarning(l.Logger, helper.OpRegister, "verify code failed", map[string]interface{}{
+			"phone": helper.MaskPhone(req.Phone),
 		})
-		if err != nil {
-			code, msg := utils.HandleRPCError(err, l.Logger, "VerifyCode")
-			return &types.RegisterResponse{
-				BaseResp: types.BaseResp{
-					Code: code,
-					Msg:  msg,
-				},
-			}, nil
-		}
-		if !verifyResp.Passed {
-			helper.LogWarning(l.Logger, helper.OpRegister, "verify code failed", map[string]interface{}{
-				"phone": helper.MaskPhone(req.Phone),
-			})
-			return &types.RegisterResponse{
-				BaseResp: types.BaseResp{
-					Code: 400,
-					Msg:  "验证码错误或已过期",
-				},
-			}, nil
-		}
+		return &types.RegisterResponse{
+			BaseResp: types.BaseResp{
+				Code: 400,
+				Msg:  utils.VerifyCodeFailedMsg(verifyResp.RemainingAttempts, verifyResp.NeedResend),
+			},
+		}, nil
 	}
 
 	// 调用用户服务的 RPC
 	rpcResp, err := l.svcCtx.UserRPC.Register(l.ctx, &userclient.RegisterRequest{
-		Phone:    req.Phone,
-		Password: req.Password,
-		Nickname: req.Nickname,
-		Role:     int32(req.Role),
+		Phone:        req.Phone,
+		Password:     req.Password,
+		Nickname:     req.Nickname,
+		Role:         int32(req.Role),
+		VerifyTicket: verifyResp.Ticket,
 	})
 	if err != nil {
 		code, msg := utils.HandleRPCError(err, l.Logger, "Register")
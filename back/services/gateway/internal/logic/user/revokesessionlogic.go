@@ -0,0 +1,77 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/helper"
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type RevokeSessionLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewRevokeSessionLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RevokeSessionLogic {
+	return &RevokeSessionLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *RevokeSessionLogic) RevokeSession(req *types.RevokeSessionRequest) (resp *types.RevokeSessionResponse, err error) {
+	userID, err := middleware.GetUserID(l.ctx)
+	if err != nil || userID == 0 {
+		return &types.RevokeSessionResponse{
+			BaseResp: types.BaseResp{Code: 401, Msg: "未登录或登录已过期"},
+		}, nil
+	}
+
+	if req.Id == "" {
+		return &types.RevokeSessionResponse{
+			BaseResp: types.BaseResp{Code: 400, Msg: "会话ID不能为空"},
+		}, nil
+	}
+
+	if l.svcCtx.TokenStore == nil {
+		return &types.RevokeSessionResponse{
+			BaseResp: types.BaseResp{Code: 503, Msg: "会话服务暂不可用"},
+		}, nil
+	}
+
+	// 会话按用户存放，只能下线自己的设备
+	found, err := l.svcCtx.TokenStore.RevokeSession(l.ctx, userID, req.Id, l.svcCtx.JWT.GetAccessTokenDuration())
+	if err != nil {
+		helper.LogError(l.Logger, helper.OpSession, "revoke session failed", err, map[string]interface{}{
+			"user_id":    userID,
+			"session_id": req.Id,
+		})
+		return &types.RevokeSessionResponse{
+			BaseResp: types.BaseResp{Code: 500, Msg: "下线设备失败"},
+		}, nil
+	}
+	if !found {
+		return &types.RevokeSessionResponse{
+			BaseResp: types.BaseResp{Code: 404, Msg: "登录设备不存在或已下线"},
+		}, nil
+	}
+
+	helper.LogSuccess(l.Logger, helper.OpSession, map[string]interface{}{
+		"user_id":    userID,
+		"session_id": req.Id,
+		"current":    req.Id == middleware.GetSessionID(l.ctx),
+	})
+
+	return &types.RevokeSessionResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: "设备已下线"},
+	}, nil
+}
@@ -0,0 +1,112 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/helper"
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// maxGiftQuantity 网关侧的数量上限，避免转换为 int32 时溢出（精确上限由用户服务按配置校验）
+const maxGiftQuantity = 9999
+
+type SendGiftLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSendGiftLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SendGiftLogic {
+	return &SendGiftLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SendGiftLogic) SendGift(req *types.SendGiftRequest) (resp *types.SendGiftResponse, err error) {
+	userID, err := middleware.GetUserID(l.ctx)
+	if err != nil || userID == 0 {
+		return &types.SendGiftResponse{
+			BaseResp: types.BaseResp{Code: 401, Msg: "未登录或登录已过期"},
+		}, nil
+	}
+
+	if req.ReceiverId == 0 || req.GiftId == 0 {
+		return &types.SendGiftResponse{
+			BaseResp: types.BaseResp{Code: 400, Msg: "请选择礼物和接收者"},
+		}, nil
+	}
+	if req.ReceiverId == userID {
+		return &types.SendGiftResponse{
+			BaseResp: types.BaseResp{Code: 400, Msg: "不能给自己送礼"},
+		}, nil
+	}
+	if req.Quantity < 0 || req.Quantity > maxGiftQuantity {
+		return &types.SendGiftResponse{
+			BaseResp: types.BaseResp{Code: 400, Msg: "礼物数量不正确"},
+		}, nil
+	}
+	bizOrderID, ok := buildGiftBizOrderID("GF", userID, req.RequestId)
+	if !ok {
+		return &types.SendGiftResponse{
+			BaseResp: types.BaseResp{Code: 400, Msg: "请求ID过长"},
+		}, nil
+	}
+
+	helper.LogRequest(l.Logger, helper.OpSendGift, map[string]interface{}{
+		"user_id":     userID,
+		"receiver_id": req.ReceiverId,
+		"gift_id":     req.GiftId,
+		"quantity":    req.Quantity,
+	})
+
+	if l.svcCtx.UserRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "UserRPC")
+		return &types.SendGiftResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	rpcResp, err := l.svcCtx.UserRPC.SendGift(l.ctx, &userclient.SendGiftRequest{
+		SenderId:   userID,
+		ReceiverId: req.ReceiverId,
+		GiftId:     req.GiftId,
+		Quantity:   int32(req.Quantity),
+		BizOrderId: bizOrderID,
+		Message:    req.Message,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "SendGift")
+		return &types.SendGiftResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	helper.LogSuccess(l.Logger, helper.OpSendGift, map[string]interface{}{
+		"user_id":      userID,
+		"receiver_id":  req.ReceiverId,
+		"gift_id":      req.GiftId,
+		"amount":       rpcResp.GetAmount(),
+		"biz_order_id": rpcResp.GetBizOrderId(),
+	})
+
+	wallet := rpcResp.GetWallet()
+	return &types.SendGiftResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: utils.GetSuccessMsg("SendGift")},
+		Data: types.SendGiftData{
+			BizOrderId: rpcResp.GetBizOrderId(),
+			Amount:     rpcResp.GetAmount(),
+			Wallet: types.WalletInfo{
+				UserId:        wallet.GetUserId(),
+				Balance:       wallet.GetBalance(),
+				FrozenBalance: wallet.GetFrozenBalance(),
+			},
+		},
+	}, nil
+}
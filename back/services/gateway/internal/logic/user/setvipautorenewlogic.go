@@ -0,0 +1,64 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/helper"
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SetVipAutoRenewLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSetVipAutoRenewLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetVipAutoRenewLogic {
+	return &SetVipAutoRenewLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SetVipAutoRenewLogic) SetVipAutoRenew(req *types.SetVipAutoRenewRequest) (resp *types.SetVipAutoRenewResponse, err error) {
+	userID, err := middleware.GetUserID(l.ctx)
+	if err != nil || userID == 0 {
+		return &types.SetVipAutoRenewResponse{
+			BaseResp: types.BaseResp{Code: 401, Msg: "未登录或登录已过期"},
+		}, nil
+	}
+
+	if l.svcCtx.UserRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "UserRPC")
+		return &types.SetVipAutoRenewResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	rpcResp, err := l.svcCtx.UserRPC.SetVipAutoRenew(l.ctx, &userclient.SetVipAutoRenewRequest{
+		UserId:    userID,
+		AutoRenew: req.AutoRenew,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "SetVipAutoRenew")
+		return &types.SetVipAutoRenewResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	helper.LogSuccess(l.Logger, helper.OpSetVipAutoRenew, map[string]interface{}{
+		"user_id":    userID,
+		"auto_renew": req.AutoRenew,
+	})
+
+	return &types.SetVipAutoRenewResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: utils.GetSuccessMsg("SetVipAutoRenew")},
+		Data:     toVipSubscriptionInfo(rpcResp.GetSubscription()),
+	}, nil
+}
@@ -0,0 +1,96 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+	"strings"
+
+	"SLGaming/back/services/gateway/internal/helper"
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type SubscribeVipLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewSubscribeVipLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SubscribeVipLogic {
+	return &SubscribeVipLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *SubscribeVipLogic) SubscribeVip(req *types.SubscribeVipRequest) (resp *types.SubscribeVipResponse, err error) {
+	userID, err := middleware.GetUserID(l.ctx)
+	if err != nil || userID == 0 {
+		return &types.SubscribeVipResponse{
+			BaseResp: types.BaseResp{Code: 401, Msg: "未登录或登录已过期"},
+		}, nil
+	}
+
+	planCode := strings.TrimSpace(req.PlanCode)
+	if planCode == "" {
+		return &types.SubscribeVipResponse{
+			BaseResp: types.BaseResp{Code: 400, Msg: "请选择会员套餐"},
+		}, nil
+	}
+	bizOrderID, ok := buildGiftBizOrderID("VIP", userID, req.RequestId)
+	if !ok {
+		return &types.SubscribeVipResponse{
+			BaseResp: types.BaseResp{Code: 400, Msg: "请求ID过长"},
+		}, nil
+	}
+
+	helper.LogRequest(l.Logger, helper.OpSubscribeVip, map[string]interface{}{
+		"user_id":    userID,
+		"plan_code":  planCode,
+		"auto_renew": req.AutoRenew,
+	})
+
+	if l.svcCtx.UserRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "UserRPC")
+		return &types.SubscribeVipResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	rpcResp, err := l.svcCtx.UserRPC.SubscribeVip(l.ctx, &userclient.SubscribeVipRequest{
+		UserId:     userID,
+		PlanCode:   planCode,
+		AutoRenew:  req.AutoRenew,
+		BizOrderId: bizOrderID,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "SubscribeVip")
+		return &types.SubscribeVipResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	sub := toVipSubscriptionInfo(rpcResp.GetSubscription())
+	helper.LogSuccess(l.Logger, helper.OpSubscribeVip, map[string]interface{}{
+		"user_id":   userID,
+		"plan_code": sub.PlanCode,
+		"end_at":    sub.EndAt,
+	})
+
+	wallet := rpcResp.GetWallet()
+	return &types.SubscribeVipResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: utils.GetSuccessMsg("SubscribeVip")},
+		Data: types.SubscribeVipData{
+			Subscription: sub,
+			Wallet: types.WalletInfo{
+				UserId:        wallet.GetUserId(),
+				Balance:       wallet.GetBalance(),
+				FrozenBalance: wallet.GetFrozenBalance(),
+			},
+		},
+	}, nil
+}
@@ -2,7 +2,12 @@ package user
 
 import (
 	"context"
+	"time"
 
+	"SLGaming/back/pkg/rbac"
+	"SLGaming/back/services/gateway/internal/helper"
+	"SLGaming/back/services/gateway/internal/jwt"
+	"SLGaming/back/services/gateway/internal/middleware"
 	"SLGaming/back/services/gateway/internal/svc"
 	"SLGaming/back/services/gateway/internal/types"
 	"SLGaming/back/services/user/userclient"
@@ -20,27 +25,35 @@ func generateTokens(ctx context.Context, svcCtx *svc.ServiceContext, userID uint
 		}
 	}
 
+	// 每次登录对应一个会话（设备），Access/Refresh Token 中携带会话 ID
+	sessionID, err := jwt.NewSessionID()
+	if err != nil {
+		logger.Errorf("generate session id failed: %v", err)
+		return nil, err
+	}
+
 	// 生成 Access Token
-	accessToken, err := svcCtx.JWT.GenerateAccessToken(userID, role)
+	accessToken, err := svcCtx.JWT.GenerateAccessToken(userID, role, sessionID)
 	if err != nil {
 		logger.Errorf("generate access token failed: %v", err)
 		return nil, err
 	}
 
-	// 生成 Refresh Token
-	refreshToken, err := svcCtx.JWT.GenerateRefreshToken(userID, role)
+	// 生成 Refresh Token（jti 作为会话内轮换的标识）
+	refreshTokenID, err := jwt.NewTokenID()
+	if err != nil {
+		logger.Errorf("generate refresh token id failed: %v", err)
+		return nil, err
+	}
+	refreshToken, err := svcCtx.JWT.GenerateRefreshToken(userID, role, sessionID, refreshTokenID)
 	if err != nil {
 		logger.Errorf("generate refresh token failed: %v", err)
 		return nil, err
 	}
 
-	// 存储 Refresh Token
+	// 记录会话，超出设备数上限时踢掉最早登录的设备
 	if svcCtx.TokenStore != nil {
-		refreshTokenDuration := svcCtx.JWT.GetRefreshTokenDuration()
-		if err := svcCtx.TokenStore.StoreRefreshToken(ctx, userID, refreshToken, refreshTokenDuration); err != nil {
-			logger.Errorf("store refresh token failed: %v", err)
-			// 不返回错误，只记录日志
-		}
+		createSession(ctx, svcCtx, userID, role, sessionID, refreshTokenID, logger)
 	}
 
 	return &types.LoginData{
@@ -49,3 +62,45 @@ func generateTokens(ctx context.Context, svcCtx *svc.ServiceContext, userID uint
 		ExpiresIn:    int64(svcCtx.JWT.GetAccessTokenDuration().Seconds()),
 	}, nil
 }
+
+// createSession 记录登录会话（失败只记录日志，不影响登录）
+func createSession(ctx context.Context, svcCtx *svc.ServiceContext, userID uint64, role int32, sessionID, refreshTokenID string, logger logx.Logger) {
+	now := time.Now()
+	client := middleware.GetClientInfo(ctx)
+	session := &jwt.Session{
+		ID:         sessionID,
+		UserID:     userID,
+		Role:       role,
+		DeviceName: client.DeviceName,
+		UserAgent:  client.UserAgent,
+		IP:         client.IP,
+		CreatedAt:  now.Unix(),
+		LastSeenAt: now.Unix(),
+		ExpiresAt:  now.Add(svcCtx.JWT.GetRefreshTokenDuration()).Unix(),
+
+		RefreshTokenID: refreshTokenID,
+	}
+
+	evicted, err := svcCtx.TokenStore.CreateSession(ctx, session, maxSessions(svcCtx, role), svcCtx.JWT.GetAccessTokenDuration())
+	if err != nil {
+		helper.LogError(logger, helper.OpSession, "create session failed", err, map[string]interface{}{
+			"user_id": userID,
+		})
+		return
+	}
+	for _, s := range evicted {
+		helper.LogInfo(logger, helper.OpSession, "session evicted by new login", map[string]interface{}{
+			"user_id":     userID,
+			"session_id":  s.ID,
+			"device_name": s.DeviceName,
+		})
+	}
+}
+
+// maxSessions 获取角色允许的最大同时登录设备数
+func maxSessions(svcCtx *svc.ServiceContext, role int32) int {
+	if n, ok := svcCtx.Config.JWT.RoleMaxSessions[rbac.RoleName(role)]; ok {
+		return n
+	}
+	return svcCtx.Config.JWT.MaxSessions
+}
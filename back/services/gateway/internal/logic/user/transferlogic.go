@@ -0,0 +1,105 @@
+// Code scaffolded by goctl. Safe to edit.
+// goctl 1.9.2
+
+package user
+
+import (
+	"context"
+
+	"SLGaming/back/services/gateway/internal/helper"
+	"SLGaming/back/services/gateway/internal/middleware"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/gateway/internal/utils"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type TransferLogic struct {
+	logx.Logger
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+}
+
+func NewTransferLogic(ctx context.Context, svcCtx *svc.ServiceContext) *TransferLogic {
+	return &TransferLogic{
+		Logger: logx.WithContext(ctx),
+		ctx:    ctx,
+		svcCtx: svcCtx,
+	}
+}
+
+func (l *TransferLogic) Transfer(req *types.TransferRequest) (resp *types.TransferResponse, err error) {
+	userID, err := middleware.GetUserID(l.ctx)
+	if err != nil || userID == 0 {
+		return &types.TransferResponse{
+			BaseResp: types.BaseResp{Code: 401, Msg: "未登录或登录已过期"},
+		}, nil
+	}
+
+	if req.ToUserId == 0 {
+		return &types.TransferResponse{
+			BaseResp: types.BaseResp{Code: 400, Msg: "请选择打赏对象"},
+		}, nil
+	}
+	if req.ToUserId == userID {
+		return &types.TransferResponse{
+			BaseResp: types.BaseResp{Code: 400, Msg: "不能给自己打赏"},
+		}, nil
+	}
+	if req.Amount <= 0 {
+		return &types.TransferResponse{
+			BaseResp: types.BaseResp{Code: 400, Msg: "打赏金额必须大于0"},
+		}, nil
+	}
+	bizOrderID, ok := buildGiftBizOrderID("TP", userID, req.RequestId)
+	if !ok {
+		return &types.TransferResponse{
+			BaseResp: types.BaseResp{Code: 400, Msg: "请求ID过长"},
+		}, nil
+	}
+
+	helper.LogRequest(l.Logger, helper.OpTransfer, map[string]interface{}{
+		"user_id":    userID,
+		"to_user_id": req.ToUserId,
+		"amount":     req.Amount,
+	})
+
+	if l.svcCtx.UserRPC == nil {
+		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "UserRPC")
+		return &types.TransferResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	rpcResp, err := l.svcCtx.UserRPC.Transfer(l.ctx, &userclient.TransferRequest{
+		FromUserId: userID,
+		ToUserId:   req.ToUserId,
+		Amount:     req.Amount,
+		BizOrderId: bizOrderID,
+		Remark:     req.Remark,
+	})
+	if err != nil {
+		code, msg := utils.HandleRPCError(err, l.Logger, "Transfer")
+		return &types.TransferResponse{BaseResp: types.BaseResp{Code: code, Msg: msg}}, nil
+	}
+
+	helper.LogSuccess(l.Logger, helper.OpTransfer, map[string]interface{}{
+		"user_id":      userID,
+		"to_user_id":   req.ToUserId,
+		"amount":       req.Amount,
+		"biz_order_id": rpcResp.GetBizOrderId(),
+	})
+
+	wallet := rpcResp.GetWallet()
+	return &types.TransferResponse{
+		BaseResp: types.BaseResp{Code: 0, Msg: utils.GetSuccessMsg("Transfer")},
+		Data: types.TransferData{
+			BizOrderId: rpcResp.GetBizOrderId(),
+			Wallet: types.WalletInfo{
+				UserId:        wallet.GetUserId(),
+				Balance:       wallet.GetBalance(),
+				FrozenBalance: wallet.GetFrozenBalance(),
+			},
+		},
+	}, nil
+}
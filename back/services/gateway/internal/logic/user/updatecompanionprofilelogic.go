@@ -66,6 +66,7 @@ func (l *UpdateCompanionProfileLogic) UpdateCompanionProfile(req *types.UpdateCo
 		UserId:       userID,
 		GameSkill:    req.GameSkill,
 		PricePerHour: req.PricePerHour,
+		Skills:       toCompanionSkillInputs(req.Skills),
 		Status:       int32(req.Status),
 	})
 	l.Infof("UpdateCompanionProfile: RPC call completed, err=%v", err)
@@ -98,13 +99,17 @@ func (l *UpdateCompanionProfileLogic) UpdateCompanionProfile(req *types.UpdateCo
 			UserId:       profile.UserId,
 			GameSkill:    profile.GameSkill,
 			PricePerHour: profile.PricePerHour,
+			Skills:       toCompanionSkills(profile.Skills),
 			Status:       int(profile.Status),
 			Rating:       profile.Rating,
+			RatingScore:  profile.RatingScore,
 			TotalOrders:  profile.TotalOrders,
 			IsVerified:   profile.IsVerified,
 			Nickname:     profile.Nickname,
 			AvatarUrl:    profile.AvatarUrl,
 			Bio:          profile.Bio,
+			Tier:         profile.Tier,
+			TierName:     profile.TierName,
 		},
 	}, nil
 }
@@ -6,6 +6,7 @@ package user
 import (
 	"context"
 
+	"SLGaming/back/pkg/rbac"
 	"SLGaming/back/services/gateway/internal/middleware"
 	"SLGaming/back/services/gateway/internal/svc"
 	"SLGaming/back/services/gateway/internal/types"
@@ -48,6 +49,11 @@ func (l *UpdateCompanionStatusLogic) UpdateCompanionStatus(req *types.UpdateComp
 			BaseResp: types.BaseResp{Code: 400, Msg: "状态参数无效"},
 		}, nil
 	}
+	if req.Status == companionStatusBusy {
+		return &types.UpdateCompanionStatusResponse{
+			BaseResp: types.BaseResp{Code: 400, Msg: "忙碌状态由订单自动维护，无需手动设置"},
+		}, nil
+	}
 
 	if l.svcCtx.UserRPC == nil {
 		code, msg := utils.HandleRPCClientUnavailable(l.Logger, "UserRPC")
@@ -69,7 +75,7 @@ func (l *UpdateCompanionStatusLogic) UpdateCompanionStatus(req *types.UpdateComp
 			role = roleResp.User.Role
 		}
 	}
-	if role != roleCompanion {
+	if role != rbac.RoleCompanion {
 		return &types.UpdateCompanionStatusResponse{
 			BaseResp: types.BaseResp{Code: 403, Msg: "仅陪玩可操作状态"},
 		}, nil
@@ -115,13 +121,17 @@ func (l *UpdateCompanionStatusLogic) UpdateCompanionStatus(req *types.UpdateComp
 			UserId:       profile.UserId,
 			GameSkill:    profile.GameSkill,
 			PricePerHour: profile.PricePerHour,
+			Skills:       toCompanionSkills(profile.Skills),
 			Status:       int(profile.Status),
 			Rating:       profile.Rating,
+			RatingScore:  profile.RatingScore,
 			TotalOrders:  profile.TotalOrders,
 			IsVerified:   profile.IsVerified,
 			Nickname:     profile.Nickname,
 			AvatarUrl:    profile.AvatarUrl,
 			Bio:          profile.Bio,
+			Tier:         profile.Tier,
+			TierName:     profile.TierName,
 		},
 	}, nil
 }
@@ -6,6 +6,7 @@ package user
 import (
 	"context"
 
+	"SLGaming/back/pkg/rbac"
 	"SLGaming/back/services/gateway/internal/middleware"
 	"SLGaming/back/services/gateway/internal/svc"
 	"SLGaming/back/services/gateway/internal/types"
@@ -68,8 +69,8 @@ func (l *UpdateUserLogic) UpdateUser(req *types.UpdateUserRequest) (resp *types.
 	if roleErr != nil {
 		currentRole = 0
 	}
-	// 非管理员仅允许修改昵称/密码/手机号/bio，忽略 role 与 avatarUrl
-	if currentRole != 3 {
+	// 非管理员仅允许修改昵称/密码/手机号/bio/性别/生日，忽略 role 与 avatarUrl
+	if !rbac.IsAdmin(currentRole) {
 		req.Role = 0
 		req.AvatarUrl = ""
 	}
@@ -83,6 +84,8 @@ func (l *UpdateUserLogic) UpdateUser(req *types.UpdateUserRequest) (resp *types.
 		Role:      int32(req.Role),
 		AvatarUrl: req.AvatarUrl,
 		Bio:       req.Bio,
+		Gender:    int32(req.Gender),
+		Birthday:  req.Birthday,
 	})
 	if err != nil {
 		code, msg := utils.HandleRPCError(err, l.Logger, "UpdateUser")
@@ -104,11 +107,17 @@ func (l *UpdateUserLogic) UpdateUser(req *types.UpdateUserRequest) (resp *types.
 			Uid:            rpcResp.User.Uid,
 			Nickname:       rpcResp.User.Nickname,
 			Phone:          rpcResp.User.Phone,
+			Email:          rpcResp.User.Email,
 			Role:           int(rpcResp.User.Role),
 			AvatarUrl:      rpcResp.User.AvatarUrl,
 			Bio:            rpcResp.User.Bio,
 			FollowerCount:  rpcResp.User.FollowerCount,
 			FollowingCount: rpcResp.User.FollowingCount,
+			VipLevel:       rpcResp.User.VipLevel,
+			VipBadge:       rpcResp.User.VipBadge,
+			VipExpireAt:    rpcResp.User.VipExpireAt,
+			Gender:         rpcResp.User.Gender,
+			Birthday:       rpcResp.User.Birthday,
 		},
 	}, nil
 }
@@ -0,0 +1,21 @@
+package user
+
+import (
+	"SLGaming/back/services/gateway/internal/types"
+	"SLGaming/back/services/user/userclient"
+)
+
+// toVipSubscriptionInfo 将 RPC 的会员订阅信息转为网关层结构
+func toVipSubscriptionInfo(s *userclient.VipSubscriptionInfo) types.VipSubscriptionInfo {
+	if s == nil {
+		return types.VipSubscriptionInfo{}
+	}
+	return types.VipSubscriptionInfo{
+		PlanCode:  s.GetPlanCode(),
+		Level:     s.GetLevel(),
+		StartAt:   s.GetStartAt(),
+		EndAt:     s.GetEndAt(),
+		AutoRenew: s.GetAutoRenew(),
+		Status:    s.GetStatus(),
+	}
+}
@@ -2,7 +2,6 @@ package middleware
 
 import (
 	"context"
-	"errors"
 	"net/http"
 	"path"
 	"strings"
@@ -20,6 +19,8 @@ import (
 // 不需要鉴权的路径白名单
 var publicPaths = map[string]bool{
 	"/api/code/send":                       true,
+	"/api/code/challenge":                  true, // 获取人机验证题目
+	"/api/code/challenge/verify":           true, // 提交人机验证答案
 	"/api/user/register":                   true,
 	"/api/user/login":                      true,
 	"/api/user/login-by-code":              true,
@@ -31,6 +32,8 @@ var publicPaths = map[string]bool{
 	"/api/user/companions/ranking/orders":  true, // 陪玩接单数排行榜
 	"/api/user/companion/profile/public":   true, // 公开获取陪玩信息
 	"/api/user/gameskills":                 true, // 获取游戏技能列表
+	"/api/user/gifts":                      true, // 获取礼物列表
+	"/api/user/vip/plans":                  true, // 获取会员套餐列表
 	"/uploads":                             true, // 静态资源访问前缀
 	"/health":                              true, // 健康检查接口
 }
@@ -74,9 +77,13 @@ func AuthMiddleware(svcCtx *svc.ServiceContext) rest.Middleware {
 				return
 			}
 
+			// 记录设备信息，登录接口据此创建会话
+			r = r.WithContext(SetClientInfo(r.Context(), clientInfoFromRequest(r)))
+
 			// 检查是否是公开接口，如果是则直接跳过鉴权
+			// 携带有效令牌时仍识别当前用户（如陪玩列表按拉黑关系过滤），令牌无效时按未登录处理
 			if isPublicPath(r.URL.Path) {
-				next.ServeHTTP(w, r)
+				next.ServeHTTP(w, r.WithContext(optionalUserContext(r, svcCtx)))
 				return
 			}
 
@@ -106,7 +113,7 @@ func AuthMiddleware(svcCtx *svc.ServiceContext) rest.Middleware {
 			}
 
 			// 验证 token
-			claims, err := svcCtx.JWT.VerifyToken(tokenString)
+			claims, err := svcCtx.JWT.VerifyAccessToken(tokenString)
 			if err != nil {
 				// 如果 Access Token 过期，尝试使用 Refresh Token 自动刷新
 				if err == jwt.ErrExpiredToken {
@@ -122,7 +129,8 @@ func AuthMiddleware(svcCtx *svc.ServiceContext) rest.Middleware {
 					}
 
 					// 尝试自动刷新 Access Token
-					newAccessToken, newRefreshToken, userID, role, refreshErr := tryAutoRefreshToken(r.Context(), svcCtx, refreshToken)
+					// 刷新时同时轮换 Refresh Token，新的 Refresh Token 通过响应头返回
+					refreshed, refreshErr := RefreshTokens(r.Context(), svcCtx, refreshToken)
 					if refreshErr != nil {
 						logx.Errorf("auto refresh token failed: %v", refreshErr)
 						httpx.WriteJsonCtx(r.Context(), w, http.StatusUnauthorized, &types.BaseResp{
@@ -133,15 +141,16 @@ func AuthMiddleware(svcCtx *svc.ServiceContext) rest.Middleware {
 					}
 
 					// 将新的 Access Token 设置到响应头
-					w.Header().Set("Authorization", "Bearer "+newAccessToken)
-					if newRefreshToken != "" {
-						w.Header().Set("X-Refresh-Token", newRefreshToken)
+					w.Header().Set("Authorization", "Bearer "+refreshed.AccessToken)
+					if refreshed.RefreshToken != "" {
+						w.Header().Set("X-Refresh-Token", refreshed.RefreshToken)
 					}
 
 					// 将用户 ID 和 Access Token 存储到 context 中
-					ctx := SetUserID(r.Context(), userID)
-					ctx = SetAccessToken(ctx, newAccessToken)
-					ctx = SetUserRole(ctx, role)
+					ctx := SetUserID(r.Context(), refreshed.UserID)
+					ctx = SetAccessToken(ctx, refreshed.AccessToken)
+					ctx = SetUserRole(ctx, refreshed.Role)
+					ctx = SetSessionID(ctx, refreshed.SessionID)
 
 					// 继续处理请求
 					next.ServeHTTP(w, r.WithContext(ctx))
@@ -161,7 +170,7 @@ func AuthMiddleware(svcCtx *svc.ServiceContext) rest.Middleware {
 			// 验证 Access Token 是否在黑名单中（已被撤销）
 			if svcCtx.TokenStore != nil {
 				tokenExpiration := claims.ExpiresAt.Time
-				valid, err := svcCtx.TokenStore.VerifyAccessToken(r.Context(), claims.UserID, tokenString, tokenExpiration)
+				valid, err := svcCtx.TokenStore.VerifyAccessToken(r.Context(), claims.UserID, claims.SessionID, tokenString, tokenExpiration)
 				if err != nil {
 					logx.Errorf("verify access token failed: %v", err)
 					httpx.WriteJsonCtx(r.Context(), w, http.StatusUnauthorized, &types.BaseResp{
@@ -183,6 +192,7 @@ func AuthMiddleware(svcCtx *svc.ServiceContext) rest.Middleware {
 			ctx := SetUserID(r.Context(), claims.UserID)
 			ctx = SetAccessToken(ctx, tokenString)
 			ctx = SetUserRole(ctx, claims.Role)
+			ctx = SetSessionID(ctx, claims.SessionID)
 
 			// 调试日志：记录提取的用户 ID
 			logx.Infof("JWT middleware: extracted user_id=%d from token for path=%s", claims.UserID, r.URL.Path)
@@ -193,40 +203,44 @@ func AuthMiddleware(svcCtx *svc.ServiceContext) rest.Middleware {
 	}
 }
 
-// tryAutoRefreshToken 尝试使用 Refresh Token 自动刷新 Access Token
-// 返回: newAccessToken, refreshToken(保持不变), userID, role, error
-func tryAutoRefreshToken(ctx context.Context, svcCtx *svc.ServiceContext, refreshToken string) (string, string, uint64, int32, error) {
-	// 验证 Refresh Token
-	claims, err := svcCtx.JWT.VerifyToken(refreshToken)
+// optionalUserContext 公开接口的可选鉴权：令牌有效且未被撤销时把用户信息写入 context，不做自动刷新
+func optionalUserContext(r *http.Request, svcCtx *svc.ServiceContext) context.Context {
+	ctx := r.Context()
+	tokenString := strings.TrimSpace(strings.TrimPrefix(r.Header.Get("Authorization"), "Bearer "))
+	if tokenString == "" || svcCtx.JWT == nil {
+		return ctx
+	}
+	claims, err := svcCtx.JWT.VerifyAccessToken(tokenString)
 	if err != nil {
-		if err == jwt.ErrExpiredToken {
-			return "", "", 0, 0, errors.New("Refresh Token 已过期")
-		}
-		return "", "", 0, 0, errors.New("Refresh Token 无效")
+		return ctx
 	}
-
-	// 验证 Refresh Token 是否在黑名单中（未被撤销）
 	if svcCtx.TokenStore != nil {
-		tokenExpiration := claims.ExpiresAt.Time
-		valid, err := svcCtx.TokenStore.VerifyRefreshToken(ctx, claims.UserID, refreshToken, tokenExpiration)
-		if err != nil {
-			logx.Errorf("verify refresh token failed: %v", err)
-			return "", "", 0, 0, errors.New("验证 Refresh Token 失败")
-		}
-		if !valid {
-			return "", "", 0, 0, errors.New("Refresh Token 已被撤销")
+		valid, err := svcCtx.TokenStore.VerifyAccessToken(ctx, claims.UserID, claims.SessionID, tokenString, claims.ExpiresAt.Time)
+		if err != nil || !valid {
+			return ctx
 		}
 	}
+	ctx = SetUserID(ctx, claims.UserID)
+	ctx = SetAccessToken(ctx, tokenString)
+	ctx = SetUserRole(ctx, claims.Role)
+	return SetSessionID(ctx, claims.SessionID)
+}
 
-	// 只生成新的 Access Token，Refresh Token 保持不变
-	accessToken, err := svcCtx.JWT.GenerateAccessToken(claims.UserID, claims.Role)
-	if err != nil {
-		logx.Errorf("generate access token failed: %v", err)
-		return "", "", 0, 0, errors.New("生成 Access Token 失败")
+// clientInfoFromRequest 从请求中提取设备信息
+func clientInfoFromRequest(r *http.Request) ClientInfo {
+	return ClientInfo{
+		IP:         getClientIP(r),
+		UserAgent:  truncate(r.UserAgent(), 256),
+		DeviceName: truncate(strings.TrimSpace(r.Header.Get("X-Device-Name")), 64),
 	}
+}
 
-	// Refresh Token 不刷新，保持原样返回
-	return accessToken, refreshToken, claims.UserID, claims.Role, nil
+// truncate 按字符截断，避免客户端上报的超长内容写入存储
+func truncate(s string, n int) string {
+	if r := []rune(s); len(r) > n {
+		return string(r[:n])
+	}
+	return s
 }
 
 // getTokenErrorMessage 根据 JWT 验证错误返回用户友好的错误信息
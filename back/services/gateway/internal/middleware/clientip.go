@@ -0,0 +1,87 @@
+package middleware
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"sync/atomic"
+)
+
+// trustedProxies 可信反向代理网段，只有直连地址在其中时才采信 X-Forwarded-For / X-Real-IP
+var trustedProxies atomic.Pointer[[]*net.IPNet]
+
+// SetTrustedProxies 设置可信反向代理（IP 或 CIDR），为空表示不信任任何转发头，直接使用 RemoteAddr
+func SetTrustedProxies(entries []string) error {
+	nets := make([]*net.IPNet, 0, len(entries))
+	for _, entry := range entries {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+		if !strings.Contains(entry, "/") {
+			ip := net.ParseIP(entry)
+			if ip == nil {
+				return fmt.Errorf("invalid trusted proxy: %s", entry)
+			}
+			if ip.To4() != nil {
+				entry += "/32"
+			} else {
+				entry += "/128"
+			}
+		}
+		_, ipNet, err := net.ParseCIDR(entry)
+		if err != nil {
+			return fmt.Errorf("invalid trusted proxy: %s", entry)
+		}
+		nets = append(nets, ipNet)
+	}
+	trustedProxies.Store(&nets)
+	return nil
+}
+
+// isTrustedProxy 判断地址是否属于可信反向代理
+func isTrustedProxy(ip net.IP) bool {
+	nets := trustedProxies.Load()
+	if ip == nil || nets == nil {
+		return false
+	}
+	for _, n := range *nets {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// getClientIP 获取客户端真实 IP
+// 直连地址不是可信代理时直接使用 RemoteAddr（客户端可任意伪造转发头）；
+// 否则从 X-Forwarded-For 右侧向左跳过可信代理，取第一个不可信的地址，即最后一个可信代理看到的对端
+func getClientIP(r *http.Request) string {
+	remote := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(remote); err == nil {
+		remote = host
+	}
+	if !isTrustedProxy(net.ParseIP(remote)) {
+		return remote
+	}
+
+	if xff := r.Header.Get("X-Forwarded-For"); xff != "" {
+		hops := strings.Split(xff, ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			ip := net.ParseIP(strings.TrimSpace(hops[i]))
+			if ip == nil {
+				// 无法解析的条目之前的内容都不可信
+				break
+			}
+			if !isTrustedProxy(ip) {
+				return ip.String()
+			}
+		}
+	}
+
+	if ip := net.ParseIP(strings.TrimSpace(r.Header.Get("X-Real-IP"))); ip != nil {
+		return ip.String()
+	}
+	return remote
+}
@@ -0,0 +1,60 @@
+package middleware
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSetTrustedProxies(t *testing.T) {
+	t.Cleanup(func() { _ = SetTrustedProxies(nil) })
+
+	require.NoError(t, SetTrustedProxies([]string{"127.0.0.1", " ::1 ", "", "10.0.0.0/8"}))
+	assert.Error(t, SetTrustedProxies([]string{"not-an-ip"}))
+	assert.Error(t, SetTrustedProxies([]string{"10.0.0.0/33"}))
+}
+
+func TestGetClientIP(t *testing.T) {
+	require.NoError(t, SetTrustedProxies([]string{"127.0.0.1", "10.0.0.0/8"}))
+	t.Cleanup(func() { _ = SetTrustedProxies(nil) })
+
+	tests := []struct {
+		name   string
+		remote string
+		xff    string
+		realIP string
+		want   string
+	}{
+		{name: "直连客户端忽略转发头", remote: "203.0.113.9:5000", xff: "1.2.3.4", realIP: "5.6.7.8", want: "203.0.113.9"},
+		{name: "可信代理转发", remote: "127.0.0.1:5000", xff: "203.0.113.9", want: "203.0.113.9"},
+		{name: "跳过链路中的可信代理", remote: "127.0.0.1:5000", xff: "203.0.113.9, 10.0.0.2", want: "203.0.113.9"},
+		{name: "客户端伪造的左侧地址被忽略", remote: "127.0.0.1:5000", xff: "1.2.3.4, 203.0.113.9, 10.0.0.2", want: "203.0.113.9"},
+		{name: "无法解析的条目之前不可信", remote: "127.0.0.1:5000", xff: "203.0.113.9, garbage, 10.0.0.2", realIP: "198.51.100.7", want: "198.51.100.7"},
+		{name: "没有 X-Forwarded-For 时使用 X-Real-IP", remote: "127.0.0.1:5000", realIP: "198.51.100.7", want: "198.51.100.7"},
+		{name: "全部是可信代理时回退到直连地址", remote: "127.0.0.1:5000", xff: "10.0.0.3, 10.0.0.2", want: "127.0.0.1"},
+		{name: "RemoteAddr 不带端口", remote: "203.0.113.9", want: "203.0.113.9"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest("GET", "/", nil)
+			r.RemoteAddr = tt.remote
+			if tt.xff != "" {
+				r.Header.Set("X-Forwarded-For", tt.xff)
+			}
+			if tt.realIP != "" {
+				r.Header.Set("X-Real-IP", tt.realIP)
+			}
+			assert.Equal(t, tt.want, getClientIP(r))
+		})
+	}
+
+	// 未配置可信代理时一律使用 RemoteAddr
+	require.NoError(t, SetTrustedProxies(nil))
+	r := httptest.NewRequest("GET", "/", nil)
+	r.RemoteAddr = "127.0.0.1:5000"
+	r.Header.Set("X-Forwarded-For", "203.0.113.9")
+	assert.Equal(t, "127.0.0.1", getClientIP(r))
+}
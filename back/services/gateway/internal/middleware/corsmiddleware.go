@@ -38,6 +38,10 @@ func DefaultCORSConfig() *CORSConfig {
 			"Authorization",
 			"X-Refresh-Token",
 			"Content-Type",
+			"RateLimit-Limit",
+			"RateLimit-Remaining",
+			"RateLimit-Reset",
+			"Retry-After",
 		},
 		AllowCredentials: false, // 不允许凭证，因为使用了 "*" 源
 		MaxAge:           86400, // 24 小时
@@ -68,7 +72,7 @@ func CORSMiddleware(config *CORSConfig) rest.Middleware {
 			// 允许所有方法和请求头
 			w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, PATCH, OPTIONS, HEAD")
 			w.Header().Set("Access-Control-Allow-Headers", "*")
-			w.Header().Set("Access-Control-Expose-Headers", "Authorization, X-Refresh-Token, Content-Type")
+			w.Header().Set("Access-Control-Expose-Headers", "Authorization, X-Refresh-Token, Content-Type, RateLimit-Limit, RateLimit-Remaining, RateLimit-Reset, Retry-After")
 
 			// 如果请求有 origin，允许 credentials
 			if origin != "" {
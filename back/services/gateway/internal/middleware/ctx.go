@@ -13,6 +13,8 @@ const (
 	userRoleKey     ctxKey = "user_role"
 	accessTokenKey  ctxKey = "access_token"
 	refreshTokenKey ctxKey = "refresh_token"
+	sessionIDKey    ctxKey = "session_id"
+	clientInfoKey   ctxKey = "client_info"
 )
 
 // SetUserID 将用户 ID 设置到 context 中
@@ -103,3 +105,32 @@ func GetRefreshToken(ctx context.Context) (string, error) {
 
 	return token, nil
 }
+
+// SetSessionID 将当前登录会话 ID 设置到 context 中
+func SetSessionID(ctx context.Context, sessionID string) context.Context {
+	return context.WithValue(ctx, sessionIDKey, sessionID)
+}
+
+// GetSessionID 从 context 中获取当前登录会话 ID（旧 token 不携带会话 ID 时返回空字符串）
+func GetSessionID(ctx context.Context) string {
+	sessionID, _ := ctx.Value(sessionIDKey).(string)
+	return sessionID
+}
+
+// ClientInfo 请求方的设备信息（用于登录会话记录）
+type ClientInfo struct {
+	IP         string
+	UserAgent  string
+	DeviceName string // 客户端通过 X-Device-Name 请求头上报，未上报时为空
+}
+
+// SetClientInfo 将设备信息设置到 context 中
+func SetClientInfo(ctx context.Context, info ClientInfo) context.Context {
+	return context.WithValue(ctx, clientInfoKey, info)
+}
+
+// GetClientInfo 从 context 中获取设备信息
+func GetClientInfo(ctx context.Context) ClientInfo {
+	info, _ := ctx.Value(clientInfoKey).(ClientInfo)
+	return info
+}
@@ -1,32 +1,44 @@
 package middleware
 
 import (
+	"context"
+	"math"
 	"net/http"
 	"strconv"
-	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"SLGaming/back/services/gateway/internal/config"
 	"SLGaming/back/services/gateway/internal/types"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
 	"github.com/zeromicro/go-zero/rest"
 	"github.com/zeromicro/go-zero/rest/httpx"
 )
 
 // TokenBucket 令牌桶限流器
 type TokenBucket struct {
-	capacity   int64      // 桶容量（令牌数）
-	refillRate int64      // 每秒补充的令牌数
-	tokens     int64      // 当前令牌数
+	capacity   float64    // 桶容量（令牌数）
+	refillRate float64    // 每秒补充的令牌数（支持小数，如每分钟 10 次）
+	tokens     float64    // 当前令牌数
 	lastRefill time.Time  // 上次补充令牌的时间
 	lastAccess time.Time  // 最后访问时间（用于清理过期限流器）
 	mu         sync.Mutex // 互斥锁
 }
 
-// NewTokenBucket 创建令牌桶
+// NewTokenBucket 创建令牌桶（refillRate 为每秒补充的令牌数）
 func NewTokenBucket(capacity, refillRate int64) *TokenBucket {
+	return newTokenBucket(float64(capacity), float64(refillRate))
+}
+
+// NewTokenBucketWithWindow 创建令牌桶：window 时间内最多 limit 次，令牌按比例连续补充
+func NewTokenBucketWithWindow(limit int64, window time.Duration) *TokenBucket {
+	return newTokenBucket(float64(limit), float64(limit)/window.Seconds())
+}
+
+func newTokenBucket(capacity, refillRate float64) *TokenBucket {
 	now := time.Now()
 	return &TokenBucket{
 		capacity:   capacity,
@@ -39,32 +51,36 @@ func NewTokenBucket(capacity, refillRate int64) *TokenBucket {
 
 // Allow 检查是否允许请求（消耗一个令牌）
 func (tb *TokenBucket) Allow() bool {
+	return tb.Take().Allowed
+}
+
+// Take 尝试消耗一个令牌，并返回剩余配额与恢复时间
+func (tb *TokenBucket) Take() *LimitResult {
 	tb.mu.Lock()
 	defer tb.mu.Unlock()
 
-	// 更新最后访问时间
-	tb.lastAccess = time.Now()
-
 	now := time.Now()
-	elapsed := now.Sub(tb.lastRefill)
-
-	// 计算应该补充的令牌数
-	refillTokens := int64(elapsed.Seconds()) * tb.refillRate
-	if refillTokens > 0 {
-		tb.tokens = tb.tokens + refillTokens
-		if tb.tokens > tb.capacity {
-			tb.tokens = tb.capacity
-		}
+	tb.lastAccess = now
+
+	// 按实际经过的时间连续补充令牌（不再按整秒取整，避免亚秒级请求永远拿不到补充）
+	if elapsed := now.Sub(tb.lastRefill).Seconds(); elapsed > 0 {
+		tb.tokens = math.Min(tb.capacity, tb.tokens+elapsed*tb.refillRate)
 		tb.lastRefill = now
 	}
 
-	// 检查是否有可用令牌
-	if tb.tokens > 0 {
+	res := &LimitResult{Limit: int(tb.capacity)}
+	if tb.tokens >= 1 {
 		tb.tokens--
-		return true
+		res.Allowed = true
+	} else if tb.refillRate > 0 {
+		res.RetryAfter = secondsToDuration((1 - tb.tokens) / tb.refillRate)
 	}
 
-	return false
+	res.Remaining = int(tb.tokens)
+	if tb.refillRate > 0 {
+		res.ResetAfter = secondsToDuration((tb.capacity - tb.tokens) / tb.refillRate)
+	}
+	return res
 }
 
 // LastAccess 获取最后访问时间（用于清理）
@@ -74,18 +90,19 @@ func (tb *TokenBucket) LastAccess() time.Time {
 	return tb.lastAccess
 }
 
+func secondsToDuration(s float64) time.Duration {
+	return time.Duration(s * float64(time.Second))
+}
+
 // RateLimiter 限流器管理器
+// 负责把路由配置拆成各个限流维度（全局/路由/IP/用户），计数交给 Limiter 后端
 type RateLimiter struct {
-	globalLimiter   *TokenBucket                          // 全局限流器
-	routeLimiters   map[string]*TokenBucket               // 路由限流器（key: path+method）
-	ipLimiters      map[string]*TokenBucket               // IP 限流器（key: ip）
-	userLimiters    map[string]*TokenBucket               // 用户限流器（key: userID）
-	routeConfigs    map[string]*config.RouteRateLimitConf // 路由配置（key: path+method）
-	mu              sync.RWMutex                          // 读写锁
-	cleanupTicker   *time.Ticker                          // 清理过期限流器的定时器
-	stopCleanup     chan struct{}                         // 停止清理协程的信号
-	cleanupInterval time.Duration                         // 清理间隔
-	limiterTTL      time.Duration                         // 限流器过期时间（超过此时间未访问将被清理）
+	globalQPS       int           // 启动时的全局 QPS（运行时以规则快照为准）
+	limiter         Limiter       // 计数后端（Redis 分布式或本地令牌桶）
+	local           *LocalLimiter // 本地后端（Redis 不可用时兜底）
+	redis           *redis.Redis  // 为空时仅使用本地后端
+	cleanupInterval time.Duration // 清理间隔
+	limiterTTL      time.Duration // 限流器过期时间（超过此时间未访问将被清理）
 }
 
 // RateLimiterOption 限流器配置选项
@@ -105,15 +122,17 @@ func WithLimiterTTL(ttl time.Duration) RateLimiterOption {
 	}
 }
 
+// WithRedis 使用 Redis 作为分布式计数后端（多副本共享配额），Redis 异常时降级到本地令牌桶
+func WithRedis(rds *redis.Redis) RateLimiterOption {
+	return func(rl *RateLimiter) {
+		rl.redis = rds
+	}
+}
+
 // NewRateLimiter 创建限流器管理器
 func NewRateLimiter(globalQPS int, opts ...RateLimiterOption) *RateLimiter {
 	rl := &RateLimiter{
-		globalLimiter:   NewTokenBucket(int64(globalQPS), int64(globalQPS)),
-		routeLimiters:   make(map[string]*TokenBucket),
-		ipLimiters:      make(map[string]*TokenBucket),
-		userLimiters:    make(map[string]*TokenBucket),
-		routeConfigs:    make(map[string]*config.RouteRateLimitConf),
-		stopCleanup:     make(chan struct{}),
+		globalQPS:       globalQPS,
 		cleanupInterval: 5 * time.Minute,  // 默认每5分钟清理一次
 		limiterTTL:      10 * time.Minute, // 默认10分钟无访问则过期
 	}
@@ -123,89 +142,36 @@ func NewRateLimiter(globalQPS int, opts ...RateLimiterOption) *RateLimiter {
 		opt(rl)
 	}
 
-	rl.cleanupTicker = time.NewTicker(rl.cleanupInterval)
-
-	// 启动清理协程
-	go rl.cleanup()
+	rl.local = NewLocalLimiter(rl.cleanupInterval, rl.limiterTTL)
+	rl.limiter = rl.local
+	if rl.redis != nil {
+		rl.limiter = NewFallbackLimiter(NewRedisLimiter(rl.redis), rl.local, 5*time.Second)
+	}
 
 	return rl
 }
 
 // Stop 停止限流器管理器（停止清理协程）
 func (rl *RateLimiter) Stop() {
-	if rl.cleanupTicker != nil {
-		rl.cleanupTicker.Stop()
-		close(rl.stopCleanup)
-	}
-}
-
-// cleanup 清理长时间未使用的限流器（防止内存泄漏）
-func (rl *RateLimiter) cleanup() {
-	for {
-		select {
-		case <-rl.cleanupTicker.C:
-			rl.doCleanup()
-		case <-rl.stopCleanup:
-			logx.Info("Rate limiter cleanup goroutine stopped")
-			return
-		}
-	}
-}
-
-// doCleanup 执行清理操作
-func (rl *RateLimiter) doCleanup() {
-	cutoff := time.Now().Add(-rl.limiterTTL)
-
-	// 清理 IP 限流器
-	rl.mu.Lock()
-	ipCount := 0
-	for key, limiter := range rl.ipLimiters {
-		if limiter.LastAccess().Before(cutoff) {
-			delete(rl.ipLimiters, key)
-			ipCount++
-		}
-	}
-	rl.mu.Unlock()
-
-	// 清理用户限流器
-	rl.mu.Lock()
-	userCount := 0
-	for key, limiter := range rl.userLimiters {
-		if limiter.LastAccess().Before(cutoff) {
-			delete(rl.userLimiters, key)
-			userCount++
-		}
-	}
-	rl.mu.Unlock()
-
-	// 清理路由限流器（路由限流器通常不会太多，可选清理）
-	rl.mu.Lock()
-	routeCount := 0
-	for key, limiter := range rl.routeLimiters {
-		if limiter.LastAccess().Before(cutoff) {
-			delete(rl.routeLimiters, key)
-			routeCount++
-		}
-	}
-	rl.mu.Unlock()
-
-	// 记录清理日志
-	if ipCount > 0 || userCount > 0 || routeCount > 0 {
-		logx.Infof("[rate_limiter] 清理过期限流器: ip=%d, user=%d, route=%d, ttl=%v",
-			ipCount, userCount, routeCount, rl.limiterTTL)
+	if rl.local != nil {
+		rl.local.Stop()
 	}
 }
 
 // Stats 获取限流器统计信息（用于监控）
 func (rl *RateLimiter) Stats() map[string]int {
-	rl.mu.RLock()
-	defer rl.mu.RUnlock()
-
-	return map[string]int{
-		"route_limiters": len(rl.routeLimiters),
-		"ip_limiters":    len(rl.ipLimiters),
-		"user_limiters":  len(rl.userLimiters),
+	stats := map[string]int{
+		"local_limiters": rl.local.Size(),
+		"distributed":    0,
+		"degraded":       0,
+	}
+	if f, ok := rl.limiter.(*FallbackLimiter); ok {
+		stats["distributed"] = 1
+		if f.Degraded() {
+			stats["degraded"] = 1
+		}
 	}
+	return stats
 }
 
 // getRouteKey 获取路由 key
@@ -213,136 +179,120 @@ func getRouteKey(path, method string) string {
 	return method + ":" + path
 }
 
-// getRouteLimiter 获取或创建路由限流器
-func (rl *RateLimiter) getRouteLimiter(path, method string, qps int) *TokenBucket {
-	if qps <= 0 {
-		return nil
-	}
-
-	key := getRouteKey(path, method)
-	rl.mu.RLock()
-	limiter, exists := rl.routeLimiters[key]
-	rl.mu.RUnlock()
-
-	if exists {
-		return limiter
-	}
-
-	rl.mu.Lock()
-	defer rl.mu.Unlock()
-
-	// 双重检查
-	if limiter, exists := rl.routeLimiters[key]; exists {
-		return limiter
-	}
-
-	limiter = NewTokenBucket(int64(qps), int64(qps))
-	rl.routeLimiters[key] = limiter
-	return limiter
+// limitDimension 一个限流维度
+type limitDimension struct {
+	name   string        // 维度名称（用于日志）
+	key    string        // 计数 key
+	limit  int           // 窗口内配额
+	window time.Duration // 窗口长度
 }
 
-// getIPLimiter 获取或创建 IP 限流器
-func (rl *RateLimiter) getIPLimiter(ip string, qps int) *TokenBucket {
-	if qps <= 0 || ip == "" {
-		return nil
-	}
-
-	rl.mu.RLock()
-	limiter, exists := rl.ipLimiters[ip]
-	rl.mu.RUnlock()
-
-	if exists {
-		return limiter
-	}
-
-	rl.mu.Lock()
-	defer rl.mu.Unlock()
-
-	// 双重检查
-	if limiter, exists := rl.ipLimiters[ip]; exists {
-		return limiter
+// take 依次检查各限流维度，遇到拒绝立即返回
+// 返回最严格（剩余配额最少）的结果用于响应头；没有任何维度生效时返回 nil
+func (rl *RateLimiter) take(ctx context.Context, dims []limitDimension) *LimitResult {
+	var strictest *LimitResult
+	for _, d := range dims {
+		if d.limit <= 0 {
+			continue
+		}
+		res, err := rl.limiter.Take(ctx, d.key, d.limit, d.window)
+		if err != nil {
+			// 后端异常时放行（限流不应成为可用性瓶颈）
+			logx.WithContext(ctx).Errorf("[rate_limiter] take failed: key=%s, err=%v", d.key, err)
+			continue
+		}
+		if !res.Allowed {
+			logx.WithContext(ctx).Infof("Rate limit exceeded: %s, key=%s", d.name, d.key)
+			return res
+		}
+		if strictest == nil || res.Remaining < strictest.Remaining {
+			strictest = res
+		}
 	}
-
-	limiter = NewTokenBucket(int64(qps), int64(qps))
-	rl.ipLimiters[ip] = limiter
-	return limiter
+	return strictest
 }
 
-// getUserLimiter 获取或创建用户限流器
-func (rl *RateLimiter) getUserLimiter(userID uint64, qps int) *TokenBucket {
-	if qps <= 0 || userID == 0 {
-		return nil
-	}
-
-	// 使用 strconv.FormatUint 将 userID 转为字符串作为 key
-	key := "user:" + strconv.FormatUint(userID, 10)
-
-	rl.mu.RLock()
-	limiter, exists := rl.userLimiters[key]
-	rl.mu.RUnlock()
-
-	if exists {
-		return limiter
+// checkLimit 检查全局、路由与 IP 维度的限流（globalQPS 来自当前生效的规则快照）
+func (rl *RateLimiter) checkLimit(ctx context.Context, ip string, globalQPS int, routeConfig *config.RouteRateLimitConf) *LimitResult {
+	dims := []limitDimension{
+		{name: "global limit", key: "global", limit: globalQPS, window: time.Second},
 	}
 
-	rl.mu.Lock()
-	defer rl.mu.Unlock()
-
-	// 双重检查
-	if limiter, exists := rl.userLimiters[key]; exists {
-		return limiter
+	if routeConfig != nil {
+		routeKey := getRouteKey(routeConfig.Path, routeMethod(routeConfig))
+		dims = append(dims, limitDimension{
+			name: "route global limit", key: "route:" + routeKey,
+			limit: routeConfig.GlobalQPS, window: time.Second,
+		})
+		if ip != "" {
+			dims = append(dims,
+				limitDimension{
+					name: "per IP QPS limit", key: "ip:" + routeKey + ":" + ip,
+					limit: routeConfig.PerIPQPS, window: time.Second,
+				},
+				limitDimension{
+					name: "per IP per minute limit", key: "ipm:" + routeKey + ":" + ip,
+					limit: routeConfig.PerIPPerMinute, window: time.Minute,
+				},
+			)
+		}
 	}
 
-	limiter = NewTokenBucket(int64(qps), int64(qps))
-	rl.userLimiters[key] = limiter
-	return limiter
+	return rl.take(ctx, dims)
 }
 
-// checkLimit 检查是否超过限流
-func (rl *RateLimiter) checkLimit(path, method, ip string, userID uint64, routeConfig *config.RouteRateLimitConf) bool {
-	// 1. 检查全局限流
-	if !rl.globalLimiter.Allow() {
-		logx.Infof("Rate limit exceeded: global limit, path=%s, method=%s", path, method)
-		return false
+// checkUserLimit 检查用户级别限流（需在鉴权之后调用，multiplier 为会员限流倍数）
+func (rl *RateLimiter) checkUserLimit(ctx context.Context, userID uint64, routeConfig *config.RouteRateLimitConf, multiplier float64) *LimitResult {
+	if routeConfig == nil || userID == 0 {
+		return nil
 	}
 
-	// 2. 检查路由级别限流
-	if routeConfig != nil {
-		// 路由全局 QPS
-		if routeConfig.GlobalQPS > 0 {
-			limiter := rl.getRouteLimiter(path, method, routeConfig.GlobalQPS)
-			if limiter != nil && !limiter.Allow() {
-				logx.Infof("Rate limit exceeded: route global limit, path=%s, method=%s", path, method)
-				return false
-			}
-		}
-
-		// 每个 IP 的 QPS
-		if routeConfig.PerIPQPS > 0 {
-			limiter := rl.getIPLimiter(ip, routeConfig.PerIPQPS)
-			if limiter != nil && !limiter.Allow() {
-				logx.Infof("Rate limit exceeded: per IP QPS limit, path=%s, method=%s, ip=%s", path, method, ip)
-				return false
-			}
+	scale := func(n int) int {
+		if multiplier > 1 {
+			return int(float64(n) * multiplier)
 		}
+		return n
+	}
+
+	routeKey := getRouteKey(routeConfig.Path, routeMethod(routeConfig))
+	uid := strconv.FormatUint(userID, 10)
+	return rl.take(ctx, []limitDimension{
+		{
+			name: "per user QPS limit", key: "user:" + routeKey + ":" + uid,
+			limit: scale(routeConfig.PerUserQPS), window: time.Second,
+		},
+		{
+			name: "per user per minute limit", key: "userm:" + routeKey + ":" + uid,
+			limit: scale(routeConfig.PerUserPerMinute), window: time.Minute,
+		},
+	})
+}
 
-		// 每个用户的 QPS（仅当用户已登录时）
-		if routeConfig.PerUserQPS > 0 && userID > 0 {
-			limiter := rl.getUserLimiter(userID, routeConfig.PerUserQPS)
-			if limiter != nil && !limiter.Allow() {
-				logx.Infof("Rate limit exceeded: per user QPS limit, path=%s, method=%s, userID=%d", path, method, userID)
-				return false
-			}
-		}
+// routeMethod 路由配置的方法（为空表示所有方法）
+func routeMethod(route *config.RouteRateLimitConf) string {
+	if route.Method == "" {
+		return "*"
 	}
+	return route.Method
+}
 
-	return true
+// UserQuotaProvider 提供用户级别的限流倍数（例如会员权益）
+type UserQuotaProvider interface {
+	RateLimitMultiplier(ctx context.Context, userID uint64) float64
 }
 
 // RateLimiterMiddleware 可管理的限流中间件
+// 规则保存在原子指针中，可通过 UpdateRules 在运行时热更新
 type RateLimiterMiddleware struct {
-	limiter *RateLimiter
-	cfg     *config.RateLimitConf
+	limiter  *RateLimiter
+	rules    atomic.Pointer[rateLimitRules]
+	counters rateLimitCounters
+	quota    UserQuotaProvider // 可选，为空时所有用户使用相同配额
+}
+
+// SetUserQuotaProvider 设置用户限流倍数来源
+func (m *RateLimiterMiddleware) SetUserQuotaProvider(p UserQuotaProvider) {
+	m.quota = p
 }
 
 // Stop 停止限流器
@@ -360,51 +310,26 @@ func (m *RateLimiterMiddleware) Stats() map[string]int {
 	return map[string]int{}
 }
 
-// Handler 返回限流中间件函数
+// Handler 返回限流中间件函数（全局/路由/IP 维度，在鉴权之前执行）
 func (m *RateLimiterMiddleware) Handler(next http.HandlerFunc) http.HandlerFunc {
 	return func(w http.ResponseWriter, r *http.Request) {
-		// OPTIONS 预检请求直接跳过限流
-		if r.Method == http.MethodOptions {
+		// 未启用限流或 OPTIONS 预检请求直接跳过
+		rules := m.rules.Load()
+		if !rules.enabled || r.Method == http.MethodOptions {
 			next.ServeHTTP(w, r)
 			return
 		}
 
-		path := r.URL.Path
-		method := r.Method
-
-		// 获取客户端 IP
-		ip := getClientIP(r)
-
-		// 尝试从 context 获取用户 ID（可能未登录）
-		userID, _ := GetUserID(r.Context())
-
-		// 构建路由配置映射
-		routeConfigMap := make(map[string]*config.RouteRateLimitConf)
-		for i := range m.cfg.Routes {
-			route := &m.cfg.Routes[i]
-			m := route.Method
-			if m == "" {
-				m = "*"
-			}
-			key := getRouteKey(route.Path, m)
-			routeConfigMap[key] = route
-		}
-
 		// 查找路由配置
-		var routeConfig *config.RouteRateLimitConf
-		key := getRouteKey(path, method)
-		routeConfig = routeConfigMap[key]
-		if routeConfig == nil {
-			key = getRouteKey(path, "*")
-			routeConfig = routeConfigMap[key]
-		}
+		routeConfig := rules.findRoute(r.URL.Path, r.Method)
 
 		// 检查限流
-		if !m.limiter.checkLimit(path, method, ip, userID, routeConfig) {
-			httpx.WriteJsonCtx(r.Context(), w, http.StatusTooManyRequests, &types.BaseResp{
-				Code: 429,
-				Msg:  "请求过于频繁，请稍后再试",
-			})
+		res := m.limiter.checkLimit(r.Context(), getClientIP(r), rules.globalQPS, routeConfig)
+		denied := res != nil && !res.Allowed
+		m.counters.record(routeConfig, denied)
+		writeRateLimitHeaders(w, res)
+		if denied {
+			writeTooManyRequests(w, r)
 			return
 		}
 
@@ -413,109 +338,94 @@ func (m *RateLimiterMiddleware) Handler(next http.HandlerFunc) http.HandlerFunc
 	}
 }
 
-// RateLimitMiddleware 限流中间件
-func RateLimitMiddleware(cfg *config.RateLimitConf) rest.Middleware {
-	if !cfg.Enabled {
-		// 如果未启用限流，返回空中间件
-		return func(next http.HandlerFunc) http.HandlerFunc {
-			return next
+// UserHandler 返回用户级别限流中间件函数
+// 需注册在鉴权中间件之后，此时 context 中才有用户 ID；会员用户按权益倍数放大配额
+func (m *RateLimiterMiddleware) UserHandler(next http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		rules := m.rules.Load()
+		if !rules.enabled || r.Method == http.MethodOptions {
+			next.ServeHTTP(w, r)
+			return
 		}
-	}
-
-	limiter := NewRateLimiter(cfg.GlobalQPS)
-
-	return func(next http.HandlerFunc) http.HandlerFunc {
-		return func(w http.ResponseWriter, r *http.Request) {
-			// OPTIONS 预检请求直接跳过限流
-			if r.Method == http.MethodOptions {
-				next.ServeHTTP(w, r)
-				return
-			}
 
-			path := r.URL.Path
-			method := r.Method
-
-			// 获取客户端 IP
-			ip := getClientIP(r)
-
-			// 尝试从 context 获取用户 ID（可能未登录）
-			userID, _ := GetUserID(r.Context())
-
-			// 构建路由配置映射
-			routeConfigMap := make(map[string]*config.RouteRateLimitConf)
-			for i := range cfg.Routes {
-				route := &cfg.Routes[i]
-				m := route.Method
-				if m == "" {
-					m = "*"
-				}
-				key := getRouteKey(route.Path, m)
-				routeConfigMap[key] = route
-			}
+		userID, _ := GetUserID(r.Context())
+		routeConfig := rules.findRoute(r.URL.Path, r.Method)
+		if userID == 0 || routeConfig == nil || (routeConfig.PerUserQPS <= 0 && routeConfig.PerUserPerMinute <= 0) {
+			next.ServeHTTP(w, r)
+			return
+		}
 
-			// 查找路由配置
-			var routeConfig *config.RouteRateLimitConf
-			key := getRouteKey(path, method)
-			routeConfig = routeConfigMap[key]
-			if routeConfig == nil {
-				key = getRouteKey(path, "*")
-				routeConfig = routeConfigMap[key]
-			}
+		multiplier := 1.0
+		if m.quota != nil {
+			multiplier = m.quota.RateLimitMultiplier(r.Context(), userID)
+		}
 
-			// 检查限流
-			if !limiter.checkLimit(path, method, ip, userID, routeConfig) {
-				httpx.WriteJsonCtx(r.Context(), w, http.StatusTooManyRequests, &types.BaseResp{
-					Code: 429,
-					Msg:  "请求过于频繁，请稍后再试",
-				})
-				return
+		res := m.limiter.checkUserLimit(r.Context(), userID, routeConfig, multiplier)
+		// 只有比前面维度更严格时才覆盖响应头
+		if res != nil {
+			prev, err := strconv.Atoi(w.Header().Get("RateLimit-Remaining"))
+			if err != nil || !res.Allowed || res.Remaining < prev {
+				writeRateLimitHeaders(w, res)
 			}
-
-			// 通过限流检查，继续处理请求
-			next.ServeHTTP(w, r)
 		}
+		if res != nil && !res.Allowed {
+			// 用户维度的拒绝只计拒绝数，命中数已在 Handler 中记录
+			m.counters.get(getRouteKey(routeConfig.Path, routeMethod(routeConfig))).denies.Add(1)
+			writeTooManyRequests(w, r)
+			return
+		}
+
+		next.ServeHTTP(w, r)
 	}
 }
 
-// NewRateLimiterMiddleware 创建可管理的限流中间件（支持优雅停止）
-func NewRateLimiterMiddleware(cfg *config.RateLimitConf) *RateLimiterMiddleware {
-	if !cfg.Enabled {
-		// 如果未启用限流，返回空管理器
-		return &RateLimiterMiddleware{
-			limiter: nil,
-			cfg:     cfg,
-		}
+// writeRateLimitHeaders 写入标准限流响应头（RateLimit-Limit / RateLimit-Remaining / RateLimit-Reset / Retry-After）
+func writeRateLimitHeaders(w http.ResponseWriter, res *LimitResult) {
+	if res == nil || res.Limit <= 0 {
+		return
 	}
-
-	return &RateLimiterMiddleware{
-		limiter: NewRateLimiter(cfg.GlobalQPS),
-		cfg:     cfg,
+	h := w.Header()
+	h.Set("RateLimit-Limit", strconv.Itoa(res.Limit))
+	h.Set("RateLimit-Remaining", strconv.Itoa(max(res.Remaining, 0)))
+	h.Set("RateLimit-Reset", strconv.Itoa(ceilSeconds(res.ResetAfter)))
+	if !res.Allowed {
+		h.Set("Retry-After", strconv.Itoa(max(ceilSeconds(res.RetryAfter), 1)))
 	}
 }
 
-// getClientIP 获取客户端真实 IP
-func getClientIP(r *http.Request) string {
-	// 1. 检查 X-Forwarded-For（代理/负载均衡器）
-	ip := r.Header.Get("X-Forwarded-For")
-	if ip != "" {
-		// X-Forwarded-For 可能包含多个 IP，取第一个
-		ips := strings.Split(ip, ",")
-		if len(ips) > 0 {
-			return strings.TrimSpace(ips[0])
-		}
-	}
+// writeTooManyRequests 返回 429 响应
+func writeTooManyRequests(w http.ResponseWriter, r *http.Request) {
+	httpx.WriteJsonCtx(r.Context(), w, http.StatusTooManyRequests, &types.BaseResp{
+		Code: 429,
+		Msg:  "请求过于频繁，请稍后再试",
+	})
+}
 
-	// 2. 检查 X-Real-IP
-	ip = r.Header.Get("X-Real-IP")
-	if ip != "" {
-		return strings.TrimSpace(ip)
-	}
+func ceilSeconds(d time.Duration) int {
+	return int(math.Ceil(d.Seconds()))
+}
 
-	// 3. 使用 RemoteAddr
-	ip = r.RemoteAddr
-	if idx := strings.LastIndex(ip, ":"); idx != -1 {
-		ip = ip[:idx]
-	}
+// RateLimitMiddleware 限流中间件（不支持优雅停止，推荐使用 NewRateLimiterMiddleware）
+func RateLimitMiddleware(cfg *config.RateLimitConf) rest.Middleware {
+	return NewRateLimiterMiddleware(cfg).Handler
+}
 
-	return ip
+// NewRateLimiterMiddleware 创建可管理的限流中间件（支持优雅停止）
+// 即使当前未启用限流也会创建计数后端，便于之后通过热更新开启
+func NewRateLimiterMiddleware(cfg *config.RateLimitConf, opts ...RateLimiterOption) *RateLimiterMiddleware {
+	m := &RateLimiterMiddleware{
+		limiter: NewRateLimiter(cfg.GlobalQPS, opts...),
+	}
+	if err := m.UpdateRules(*cfg, "local"); err != nil {
+		// 本地配置不合法时不做路由级限流，仅保留全局限流
+		logx.Errorf("[rate_limiter] invalid rate limit config, route rules ignored: %v", err)
+		m.rules.Store(&rateLimitRules{
+			enabled:   cfg.Enabled,
+			globalQPS: max(cfg.GlobalQPS, 0),
+			version:   1,
+			source:    "local",
+			updatedAt: time.Now(),
+		})
+	}
+	return m
 }
@@ -0,0 +1,127 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+const (
+	redisLimiterKeyPrefix = "gateway:ratelimit:"
+	redisLimiterTimeout   = 100 * time.Millisecond
+)
+
+// gcraScript 基于 GCRA（通用信元速率算法）的原子限流脚本
+// 只保存一个“理论到达时间”（TAT），精度到微秒，不会出现固定窗口的边界突刺
+// KEYS[1]: 限流 key
+// ARGV[1]: 突发容量（burst）  ARGV[2]: 窗口内配额  ARGV[3]: 窗口长度（秒）
+// 返回: {是否放行, 剩余配额, retry_after(秒), reset_after(秒)}
+var gcraScript = redis.NewScript(`
+redis.replicate_commands()
+
+local key = KEYS[1]
+local burst = tonumber(ARGV[1])
+local rate = tonumber(ARGV[2])
+local period = tonumber(ARGV[3])
+
+local emission_interval = period / rate
+local burst_offset = emission_interval * burst
+
+-- 使用 Redis 服务器时间，避免各网关实例时钟不一致
+local t = redis.call("TIME")
+local now = (t[1] - 1700000000) + (t[2] / 1000000)
+
+local tat = redis.call("GET", key)
+if not tat then
+  tat = now
+else
+  tat = tonumber(tat)
+end
+tat = math.max(tat, now)
+
+local new_tat = tat + emission_interval
+local allow_at = new_tat - burst_offset
+local diff = now - allow_at
+local remaining = math.floor(diff / emission_interval)
+
+if remaining < 0 then
+  return {0, 0, tostring(-diff), tostring(tat - now)}
+end
+
+local reset_after = new_tat - now
+redis.call("SET", key, new_tat, "EX", math.ceil(reset_after))
+return {1, remaining, "-1", tostring(reset_after)}
+`)
+
+// RedisLimiter 基于 Redis 的分布式限流后端，所有网关副本共享计数
+type RedisLimiter struct {
+	rds *redis.Redis
+}
+
+// NewRedisLimiter 创建 Redis 限流后端
+func NewRedisLimiter(rds *redis.Redis) *RedisLimiter {
+	return &RedisLimiter{rds: rds}
+}
+
+// Take 原子地消耗一个配额
+func (l *RedisLimiter) Take(ctx context.Context, key string, limit int, window time.Duration) (*LimitResult, error) {
+	if limit <= 0 || window <= 0 {
+		return &LimitResult{Allowed: true}, nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, redisLimiterTimeout)
+	defer cancel()
+
+	val, err := l.rds.ScriptRunCtx(ctx, gcraScript, []string{redisLimiterKeyPrefix + key},
+		limit, limit, strconv.FormatFloat(window.Seconds(), 'f', -1, 64))
+	if err != nil {
+		return nil, err
+	}
+
+	fields, ok := val.([]interface{})
+	if !ok || len(fields) != 4 {
+		return nil, fmt.Errorf("unexpected rate limit script result: %v", val)
+	}
+
+	allowed, _ := fields[0].(int64)
+	remaining, _ := fields[1].(int64)
+	retryAfter, err := parseScriptSeconds(fields[2])
+	if err != nil {
+		return nil, err
+	}
+	resetAfter, err := parseScriptSeconds(fields[3])
+	if err != nil {
+		return nil, err
+	}
+
+	res := &LimitResult{
+		Allowed:    allowed == 1,
+		Limit:      limit,
+		Remaining:  int(remaining),
+		ResetAfter: resetAfter,
+	}
+	if !res.Allowed {
+		res.RetryAfter = retryAfter
+	}
+	return res, nil
+}
+
+// parseScriptSeconds 解析脚本以字符串返回的秒数（Lua 数字返回给 Redis 时会被截断为整数）
+func parseScriptSeconds(v interface{}) (time.Duration, error) {
+	s, ok := v.(string)
+	if !ok {
+		return 0, fmt.Errorf("unexpected rate limit script value: %v", v)
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, err
+	}
+	if f < 0 {
+		return 0, nil
+	}
+	return time.Duration(math.Ceil(f * float64(time.Second))), nil
+}
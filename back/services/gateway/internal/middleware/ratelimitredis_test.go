@@ -0,0 +1,91 @@
+package middleware
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+func TestRedisLimiterTake(t *testing.T) {
+	mr := miniredis.RunT(t)
+	limiter := NewRedisLimiter(redis.New(mr.Addr()))
+	ctx := context.Background()
+
+	// 窗口 2 秒内 2 次：每秒恢复一个配额，突发容量为 2（取整秒，避免浮点误差）
+	now := time.Unix(1800000000, 0)
+	mr.SetTime(now)
+
+	steps := []struct {
+		name          string
+		advance       time.Duration
+		key           string
+		wantAllowed   bool
+		wantRemaining int
+		wantRetry     time.Duration
+		wantReset     time.Duration
+	}{
+		{name: "首次请求", key: "ip:1", wantAllowed: true, wantRemaining: 1, wantReset: time.Second},
+		{name: "用完突发容量", key: "ip:1", wantAllowed: true, wantRemaining: 0, wantReset: 2 * time.Second},
+		{name: "超出配额", key: "ip:1", wantAllowed: false, wantRetry: time.Second, wantReset: 2 * time.Second},
+		{name: "其他 key 互不影响", key: "ip:2", wantAllowed: true, wantRemaining: 1, wantReset: time.Second},
+		{name: "一秒后恢复一个配额", advance: time.Second, key: "ip:1", wantAllowed: true, wantRemaining: 0, wantReset: 2 * time.Second},
+		{name: "空闲足够久后恢复全部突发容量", advance: 3 * time.Second, key: "ip:1", wantAllowed: true, wantRemaining: 1, wantReset: time.Second},
+	}
+
+	for _, step := range steps {
+		now = now.Add(step.advance)
+		mr.SetTime(now)
+
+		res, err := limiter.Take(ctx, step.key, 2, 2*time.Second)
+		require.NoError(t, err, step.name)
+		assert.Equal(t, step.wantAllowed, res.Allowed, step.name)
+		assert.Equal(t, 2, res.Limit, step.name)
+		assert.Equal(t, step.wantRemaining, res.Remaining, step.name)
+		assert.Equal(t, step.wantRetry, res.RetryAfter, step.name)
+		assert.Equal(t, step.wantReset, res.ResetAfter, step.name)
+	}
+}
+
+func TestRedisLimiterTakeUnlimited(t *testing.T) {
+	limiter := NewRedisLimiter(nil)
+
+	for _, tt := range []struct {
+		limit  int
+		window time.Duration
+	}{{0, time.Second}, {10, 0}} {
+		res, err := limiter.Take(context.Background(), "ip:1", tt.limit, tt.window)
+		require.NoError(t, err)
+		assert.True(t, res.Allowed)
+	}
+}
+
+func TestParseScriptSeconds(t *testing.T) {
+	tests := []struct {
+		name    string
+		v       interface{}
+		want    time.Duration
+		wantErr bool
+	}{
+		{name: "向上取整到纳秒", v: "0.5", want: 500 * time.Millisecond},
+		{name: "负数视为 0", v: "-1", want: 0},
+		{name: "非字符串", v: int64(1), wantErr: true},
+		{name: "无法解析", v: "abc", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parseScriptSeconds(tt.v)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
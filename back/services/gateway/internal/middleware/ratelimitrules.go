@@ -0,0 +1,191 @@
+package middleware
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"SLGaming/back/services/gateway/internal/config"
+	"SLGaming/back/services/gateway/internal/types"
+)
+
+// globalCounterKey 未匹配任何路由规则的请求计入该 key
+const globalCounterKey = "*"
+
+// rateLimitRules 一份不可变的限流规则快照，更新时整体替换
+type rateLimitRules struct {
+	enabled   bool
+	globalQPS int
+	routes    []config.RouteRateLimitConf
+	version   int64
+	source    string
+	updatedAt time.Time
+}
+
+// findRoute 查找路由限流配置（精确方法优先，其次通配 *）
+func (rs *rateLimitRules) findRoute(path, method string) *config.RouteRateLimitConf {
+	var wildcard *config.RouteRateLimitConf
+	for i := range rs.routes {
+		route := &rs.routes[i]
+		if route.Path != path {
+			continue
+		}
+		switch route.Method {
+		case method:
+			return route
+		case "", "*":
+			wildcard = route
+		}
+	}
+	return wildcard
+}
+
+// rateLimitCounter 单条规则的命中与拒绝计数
+type rateLimitCounter struct {
+	hits   atomic.Int64
+	denies atomic.Int64
+}
+
+// rateLimitCounters 按规则统计的计数器
+type rateLimitCounters struct {
+	m sync.Map // key: method:path -> *rateLimitCounter
+}
+
+func (c *rateLimitCounters) get(key string) *rateLimitCounter {
+	if v, ok := c.m.Load(key); ok {
+		return v.(*rateLimitCounter)
+	}
+	v, _ := c.m.LoadOrStore(key, &rateLimitCounter{})
+	return v.(*rateLimitCounter)
+}
+
+// record 记录一次请求及其是否被拒绝
+func (c *rateLimitCounters) record(route *config.RouteRateLimitConf, denied bool) {
+	key := globalCounterKey
+	if route != nil {
+		key = getRouteKey(route.Path, routeMethod(route))
+	}
+	counter := c.get(key)
+	counter.hits.Add(1)
+	if denied {
+		counter.denies.Add(1)
+	}
+}
+
+// validMethods 规则允许的 HTTP 方法
+var validMethods = map[string]bool{
+	"": true, "*": true,
+	http.MethodGet: true, http.MethodPost: true, http.MethodPut: true,
+	http.MethodDelete: true, http.MethodPatch: true, http.MethodHead: true,
+}
+
+// ValidateRateLimitConf 校验限流配置，任一规则不合法则整体拒绝
+func ValidateRateLimitConf(cfg *config.RateLimitConf) error {
+	if cfg == nil {
+		return fmt.Errorf("rate limit config is nil")
+	}
+	if cfg.GlobalQPS < 0 {
+		return fmt.Errorf("GlobalQPS must not be negative")
+	}
+
+	seen := make(map[string]bool, len(cfg.Routes))
+	for i := range cfg.Routes {
+		r := &cfg.Routes[i]
+		if !strings.HasPrefix(r.Path, "/") {
+			return fmt.Errorf("route #%d: path %q must start with /", i, r.Path)
+		}
+		r.Method = strings.ToUpper(strings.TrimSpace(r.Method))
+		if !validMethods[r.Method] {
+			return fmt.Errorf("route %s: invalid method %q", r.Path, r.Method)
+		}
+		limits := []int{r.GlobalQPS, r.PerIPQPS, r.PerUserQPS, r.PerIPPerMinute, r.PerUserPerMinute}
+		active := false
+		for _, n := range limits {
+			if n < 0 {
+				return fmt.Errorf("route %s: limits must not be negative", r.Path)
+			}
+			if n > 0 {
+				active = true
+			}
+		}
+		if !active {
+			return fmt.Errorf("route %s: at least one limit must be positive", r.Path)
+		}
+		key := getRouteKey(r.Path, routeMethod(r))
+		if seen[key] {
+			return fmt.Errorf("route %s: duplicated rule", key)
+		}
+		seen[key] = true
+	}
+	return nil
+}
+
+// UpdateRules 校验并原子替换限流规则，正在处理的请求继续使用旧快照
+func (m *RateLimiterMiddleware) UpdateRules(cfg config.RateLimitConf, source string) error {
+	routes := make([]config.RouteRateLimitConf, len(cfg.Routes))
+	copy(routes, cfg.Routes)
+	cfg.Routes = routes
+
+	if err := ValidateRateLimitConf(&cfg); err != nil {
+		return err
+	}
+
+	var version int64 = 1
+	if old := m.rules.Load(); old != nil {
+		version = old.version + 1
+	}
+	m.rules.Store(&rateLimitRules{
+		enabled:   cfg.Enabled,
+		globalQPS: cfg.GlobalQPS,
+		routes:    routes,
+		version:   version,
+		source:    source,
+		updatedAt: time.Now(),
+	})
+	return nil
+}
+
+// RateLimitStatus 返回当前生效的限流规则与命中/拒绝计数
+func (m *RateLimiterMiddleware) RateLimitStatus() types.RateLimitStatusData {
+	rs := m.rules.Load()
+	data := types.RateLimitStatusData{
+		Enabled:   rs.enabled,
+		GlobalQPS: rs.globalQPS,
+		Version:   rs.version,
+		Source:    rs.source,
+		UpdatedAt: rs.updatedAt.Unix(),
+		Rules:     make([]types.RateLimitRule, 0, len(rs.routes)),
+	}
+	if m.limiter != nil {
+		stats := m.limiter.Stats()
+		data.Distributed = stats["distributed"] == 1
+		data.Degraded = stats["degraded"] == 1
+	}
+
+	m.counters.m.Range(func(_, v any) bool {
+		c := v.(*rateLimitCounter)
+		data.TotalHits += c.hits.Load()
+		data.TotalDenied += c.denies.Load()
+		return true
+	})
+
+	for i := range rs.routes {
+		r := &rs.routes[i]
+		c := m.counters.get(getRouteKey(r.Path, routeMethod(r)))
+		data.Rules = append(data.Rules, types.RateLimitRule{
+			Path:             r.Path,
+			Method:           routeMethod(r),
+			GlobalQPS:        r.GlobalQPS,
+			PerIPQPS:         r.PerIPQPS,
+			PerUserQPS:       r.PerUserQPS,
+			PerIPPerMinute:   r.PerIPPerMinute,
+			PerUserPerMinute: r.PerUserPerMinute,
+			Hits:             c.hits.Load(),
+			Denied:           c.denies.Load(),
+		})
+	}
+	return data
+}
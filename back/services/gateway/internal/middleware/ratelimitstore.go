@@ -0,0 +1,165 @@
+package middleware
+
+import (
+	"context"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// LimitResult 单次限流检查结果
+type LimitResult struct {
+	Allowed    bool          // 是否放行
+	Limit      int           // 窗口内配额
+	Remaining  int           // 窗口内剩余配额
+	ResetAfter time.Duration // 配额完全恢复所需时间
+	RetryAfter time.Duration // 被拒绝时，距离下一次可请求的时间
+}
+
+// Limiter 限流计数后端
+// key 为限流维度标识（如 ip:POST:/api/user/login:1.2.3.4），limit 为 window 内允许的请求数
+type Limiter interface {
+	Take(ctx context.Context, key string, limit int, window time.Duration) (*LimitResult, error)
+}
+
+// LocalLimiter 基于进程内令牌桶的限流后端
+// 多副本部署时每个实例独立计数，仅作为单机部署或 Redis 不可用时的兜底
+type LocalLimiter struct {
+	mu              sync.RWMutex
+	buckets         map[string]*TokenBucket
+	cleanupTicker   *time.Ticker
+	stopCleanup     chan struct{}
+	stopOnce        sync.Once
+	cleanupInterval time.Duration
+	limiterTTL      time.Duration
+}
+
+// NewLocalLimiter 创建本地限流后端，并启动过期令牌桶清理协程
+func NewLocalLimiter(cleanupInterval, limiterTTL time.Duration) *LocalLimiter {
+	l := &LocalLimiter{
+		buckets:         make(map[string]*TokenBucket),
+		stopCleanup:     make(chan struct{}),
+		cleanupInterval: cleanupInterval,
+		limiterTTL:      limiterTTL,
+	}
+	l.cleanupTicker = time.NewTicker(cleanupInterval)
+	go l.cleanup()
+	return l
+}
+
+// Take 从对应令牌桶中消耗一个令牌
+func (l *LocalLimiter) Take(_ context.Context, key string, limit int, window time.Duration) (*LimitResult, error) {
+	if limit <= 0 || window <= 0 {
+		return &LimitResult{Allowed: true}, nil
+	}
+
+	// 配额或窗口变化（如会员等级变化、规则调整）后使用新的令牌桶
+	bucketKey := key + "|" + strconv.Itoa(limit) + "|" + window.String()
+
+	l.mu.RLock()
+	bucket, ok := l.buckets[bucketKey]
+	l.mu.RUnlock()
+
+	if !ok {
+		l.mu.Lock()
+		if bucket, ok = l.buckets[bucketKey]; !ok {
+			bucket = NewTokenBucketWithWindow(int64(limit), window)
+			l.buckets[bucketKey] = bucket
+		}
+		l.mu.Unlock()
+	}
+
+	return bucket.Take(), nil
+}
+
+// Size 当前令牌桶数量
+func (l *LocalLimiter) Size() int {
+	l.mu.RLock()
+	defer l.mu.RUnlock()
+	return len(l.buckets)
+}
+
+// Stop 停止清理协程
+func (l *LocalLimiter) Stop() {
+	l.stopOnce.Do(func() {
+		l.cleanupTicker.Stop()
+		close(l.stopCleanup)
+	})
+}
+
+// cleanup 清理长时间未使用的令牌桶（防止内存泄漏）
+func (l *LocalLimiter) cleanup() {
+	for {
+		select {
+		case <-l.cleanupTicker.C:
+			l.doCleanup()
+		case <-l.stopCleanup:
+			logx.Info("Rate limiter cleanup goroutine stopped")
+			return
+		}
+	}
+}
+
+// doCleanup 执行清理操作
+func (l *LocalLimiter) doCleanup() {
+	cutoff := time.Now().Add(-l.limiterTTL)
+
+	l.mu.Lock()
+	count := 0
+	for key, bucket := range l.buckets {
+		if bucket.LastAccess().Before(cutoff) {
+			delete(l.buckets, key)
+			count++
+		}
+	}
+	l.mu.Unlock()
+
+	if count > 0 {
+		logx.Infof("[rate_limiter] 清理过期限流器: count=%d, ttl=%v", count, l.limiterTTL)
+	}
+}
+
+// FallbackLimiter 优先使用分布式后端，出错时降级到本地后端
+// 分布式后端出错后在 cooldown 内直接走本地后端，避免每个请求都等待 Redis 超时
+type FallbackLimiter struct {
+	primary   Limiter
+	fallback  Limiter
+	cooldown  time.Duration
+	downUntil atomic.Int64 // 分布式后端恢复探测时间（UnixNano）
+}
+
+// NewFallbackLimiter 创建带降级的限流后端
+func NewFallbackLimiter(primary, fallback Limiter, cooldown time.Duration) *FallbackLimiter {
+	if cooldown <= 0 {
+		cooldown = 5 * time.Second
+	}
+	return &FallbackLimiter{
+		primary:  primary,
+		fallback: fallback,
+		cooldown: cooldown,
+	}
+}
+
+// Take 尝试分布式后端，失败时使用本地后端
+func (f *FallbackLimiter) Take(ctx context.Context, key string, limit int, window time.Duration) (*LimitResult, error) {
+	if time.Now().UnixNano() >= f.downUntil.Load() {
+		res, err := f.primary.Take(ctx, key, limit, window)
+		if err == nil {
+			return res, nil
+		}
+		// 只有首个发现故障的请求记录日志
+		next := time.Now().Add(f.cooldown).UnixNano()
+		if old := f.downUntil.Load(); old < time.Now().UnixNano() && f.downUntil.CompareAndSwap(old, next) {
+			logx.Errorf("[rate_limiter] distributed limiter unavailable, fallback to local for %v: %v", f.cooldown, err)
+		}
+	}
+	return f.fallback.Take(ctx, key, limit, window)
+}
+
+// Degraded 分布式后端当前是否处于降级状态
+func (f *FallbackLimiter) Degraded() bool {
+	return time.Now().UnixNano() < f.downUntil.Load()
+}
@@ -0,0 +1,201 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+
+	"SLGaming/back/pkg/rbac"
+	"SLGaming/back/services/gateway/internal/config"
+	"SLGaming/back/services/gateway/internal/helper"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/gateway/internal/types"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/rest"
+	"github.com/zeromicro/go-zero/rest/httpx"
+)
+
+const (
+	// adminPathPrefix 管理后台接口前缀，未配置规则时默认仅管理员可访问（默认拒绝）
+	adminPathPrefix = "/api/admin/"
+
+	// rbacAuditKey 权限拒绝审计记录（Redis List，保留最近 rbacAuditMaxLen 条）
+	rbacAuditKey    = "gateway:audit:rbac_denied"
+	rbacAuditMaxLen = 10000
+)
+
+// rbacRule 预处理后的权限规则
+type rbacRule struct {
+	path   string
+	prefix bool
+	method string
+	roles  map[int32]bool
+}
+
+// match 判断规则是否匹配请求，返回匹配长度（越长越具体），不匹配返回 -1
+func (r *rbacRule) match(p, method string) int {
+	if r.method != "" && r.method != method {
+		return -1
+	}
+	if r.prefix {
+		if p == strings.TrimSuffix(r.path, "/") || strings.HasPrefix(p, r.path) {
+			return len(r.path)
+		}
+		return -1
+	}
+	if p == r.path {
+		// 精确匹配优先于同长度的前缀匹配
+		return len(r.path) + 1
+	}
+	return -1
+}
+
+// RBACAuditEntry 权限拒绝审计记录
+type RBACAuditEntry struct {
+	UserID    uint64 `json:"user_id"`
+	Role      int32  `json:"role"`
+	Method    string `json:"method"`
+	Path      string `json:"path"`
+	IP        string `json:"ip"`
+	Reason    string `json:"reason"`
+	Timestamp int64  `json:"timestamp"`
+}
+
+// buildRBACRules 解析配置中的权限规则，忽略无效配置
+func buildRBACRules(cfg *config.RBACConf) []*rbacRule {
+	rules := make([]*rbacRule, 0, len(cfg.Rules))
+	for _, rc := range cfg.Rules {
+		p := strings.TrimSpace(rc.Path)
+		if p == "" {
+			continue
+		}
+		rule := &rbacRule{
+			method: strings.ToUpper(strings.TrimSpace(rc.Method)),
+			roles:  make(map[int32]bool, len(rc.Roles)),
+		}
+		if rule.method == "*" {
+			rule.method = ""
+		}
+		if strings.HasSuffix(p, "/*") {
+			rule.prefix = true
+			p = strings.TrimSuffix(p, "*")
+		}
+		rule.path = p
+		for _, name := range rc.Roles {
+			role, ok := rbac.ParseRole(name)
+			if !ok {
+				logx.Errorf("[rbac] unknown role %q in rule path=%s, ignored", name, rc.Path)
+				continue
+			}
+			rule.roles[role] = true
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+// RBACMiddleware 基于角色的访问控制中间件
+// 必须注册在 AuthMiddleware 之后：依赖其写入 context 的用户 ID 与角色
+func RBACMiddleware(svcCtx *svc.ServiceContext, cfg *config.RBACConf) rest.Middleware {
+	rules := buildRBACRules(cfg)
+
+	return func(next http.HandlerFunc) http.HandlerFunc {
+		return func(w http.ResponseWriter, r *http.Request) {
+			if r.Method == http.MethodOptions || isPublicPath(r.URL.Path) {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			p := pathClean(r.URL.Path)
+
+			// 查找最具体的匹配规则
+			var matched *rbacRule
+			best := -1
+			if cfg.Enabled {
+				for _, rule := range rules {
+					if n := rule.match(p, r.Method); n > best {
+						best, matched = n, rule
+					}
+				}
+			}
+
+			isAdminPath := strings.HasPrefix(p+"/", adminPathPrefix)
+			if matched == nil && !isAdminPath {
+				next.ServeHTTP(w, r)
+				return
+			}
+
+			userID, _ := GetUserID(r.Context())
+			role, err := GetUserRole(r.Context())
+			if err != nil || userID == 0 {
+				denyRBAC(w, r, svcCtx, userID, 0, http.StatusUnauthorized, "role not found in context")
+				return
+			}
+
+			var allowed bool
+			if matched != nil {
+				allowed = matched.roles[role]
+			} else {
+				allowed = rbac.IsAdmin(role)
+			}
+			if !allowed {
+				denyRBAC(w, r, svcCtx, userID, role, http.StatusForbidden, "role not permitted")
+				return
+			}
+
+			next.ServeHTTP(w, r)
+		}
+	}
+}
+
+// denyRBAC 返回拒绝响应并写入审计记录
+func denyRBAC(w http.ResponseWriter, r *http.Request, svcCtx *svc.ServiceContext, userID uint64, role int32, httpStatus int, reason string) {
+	entry := &RBACAuditEntry{
+		UserID:    userID,
+		Role:      role,
+		Method:    r.Method,
+		Path:      r.URL.Path,
+		IP:        getClientIP(r),
+		Reason:    reason,
+		Timestamp: time.Now().Unix(),
+	}
+	recordRBACAudit(r.Context(), svcCtx, entry)
+
+	msg := "无权限访问该接口"
+	if httpStatus == http.StatusUnauthorized {
+		msg = "未登录或登录已过期"
+	}
+	httpx.WriteJsonCtx(r.Context(), w, httpStatus, &types.BaseResp{
+		Code: int32(httpStatus),
+		Msg:  msg,
+	})
+}
+
+// recordRBACAudit 记录权限拒绝审计：结构化日志 + Redis 最近记录
+func recordRBACAudit(ctx context.Context, svcCtx *svc.ServiceContext, entry *RBACAuditEntry) {
+	logger := logx.WithContext(ctx)
+	helper.LogWarning(logger, helper.OpAudit, "rbac access denied", map[string]interface{}{
+		"user_id": entry.UserID,
+		"role":    rbac.RoleName(entry.Role),
+		"method":  entry.Method,
+		"path":    entry.Path,
+		"ip":      entry.IP,
+		"reason":  entry.Reason,
+	})
+
+	if svcCtx == nil || svcCtx.CacheRedis == nil {
+		return
+	}
+	payload, err := json.Marshal(entry)
+	if err != nil {
+		return
+	}
+	if _, err := svcCtx.CacheRedis.LpushCtx(ctx, rbacAuditKey, string(payload)); err != nil {
+		logger.Errorf("[rbac] write audit record failed: %v", err)
+		return
+	}
+	_ = svcCtx.CacheRedis.LtrimCtx(ctx, rbacAuditKey, 0, rbacAuditMaxLen-1)
+}
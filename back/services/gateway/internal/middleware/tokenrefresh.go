@@ -0,0 +1,211 @@
+package middleware
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"time"
+
+	"SLGaming/back/services/gateway/internal/helper"
+	"SLGaming/back/services/gateway/internal/jwt"
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/threading"
+)
+
+const (
+	securityEventKey    = "gateway:security:events"
+	securityEventMaxLen = 10000
+
+	// loginEventTimeout 异步写入刷新登录记录的超时时间
+	loginEventTimeout = 3 * time.Second
+)
+
+var (
+	// ErrRefreshTokenRevoked Refresh Token 已被撤销（登出、下线设备或会话被挤掉）
+	ErrRefreshTokenRevoked = errors.New("refresh token revoked")
+	// ErrRefreshTokenReused 提交了已被轮换掉的 Refresh Token，整个会话已被撤销
+	ErrRefreshTokenReused = errors.New("refresh token reused")
+)
+
+// RefreshedTokens 刷新结果
+// RefreshToken 为空表示本次未轮换（宽限期内的并发刷新），客户端必须保留当前持有的 Refresh Token，不能用空值覆盖
+type RefreshedTokens struct {
+	AccessToken  string
+	RefreshToken string
+	UserID       uint64
+	Role         int32
+	SessionID    string
+}
+
+// SecurityEvent 安全事件记录
+type SecurityEvent struct {
+	Type      string `json:"type"`
+	UserID    uint64 `json:"user_id"`
+	SessionID string `json:"session_id,omitempty"`
+	IP        string `json:"ip,omitempty"`
+	UserAgent string `json:"user_agent,omitempty"`
+	Detail    string `json:"detail,omitempty"`
+	Time      int64  `json:"time"`
+}
+
+// RefreshTokens 使用 Refresh Token 换取新的 Access Token，并轮换 Refresh Token
+// 同一会话（Token 家族）内只有最新的 Refresh Token 有效；提交已被轮换掉的旧 token 时撤销整个会话并记录安全事件
+// /api/user/refresh-token 与鉴权中间件的自动刷新共用此逻辑
+func RefreshTokens(ctx context.Context, svcCtx *svc.ServiceContext, refreshToken string) (*RefreshedTokens, error) {
+	claims, err := svcCtx.JWT.VerifyRefreshToken(refreshToken)
+	if err != nil {
+		return nil, err
+	}
+
+	// 带会话的 Refresh Token 必须有 jti，否则无法参与轮换与重放检测
+	if claims.SessionID != "" && claims.ID == "" {
+		return nil, jwt.ErrInvalidToken
+	}
+
+	// 验证 Refresh Token 是否在黑名单中（未被撤销）
+	if svcCtx.TokenStore != nil {
+		valid, err := svcCtx.TokenStore.VerifyRefreshToken(ctx, claims.UserID, claims.SessionID, refreshToken, claims.ExpiresAt.Time)
+		if err != nil {
+			return nil, err
+		}
+		if !valid {
+			return nil, ErrRefreshTokenRevoked
+		}
+	}
+
+	accessToken, err := svcCtx.JWT.GenerateAccessToken(claims.UserID, claims.Role, claims.SessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &RefreshedTokens{
+		AccessToken: accessToken,
+		UserID:      claims.UserID,
+		Role:        claims.Role,
+		SessionID:   claims.SessionID,
+	}
+	if svcCtx.TokenStore == nil {
+		// 没有存储时无法跟踪轮换，保持原 Refresh Token
+		res.RefreshToken = refreshToken
+		return res, nil
+	}
+
+	newTokenID, err := jwt.NewTokenID()
+	if err != nil {
+		return nil, err
+	}
+	newRefreshToken, err := svcCtx.JWT.GenerateRefreshToken(claims.UserID, claims.Role, claims.SessionID, newTokenID)
+	if err != nil {
+		return nil, err
+	}
+
+	if claims.SessionID == "" {
+		// 会话上线前签发的 token 没有会话记录，直接拉黑旧 token 完成轮换
+		if err := svcCtx.TokenStore.RevokeRefreshToken(ctx, claims.UserID, refreshToken, time.Until(claims.ExpiresAt.Time)); err != nil {
+			return nil, err
+		}
+		res.RefreshToken = newRefreshToken
+		recordRefreshLogin(ctx, svcCtx, claims.UserID, "success", "")
+		return res, nil
+	}
+
+	result, err := svcCtx.TokenStore.RotateRefreshToken(ctx, claims.SessionID, claims.ID, newTokenID,
+		svcCtx.JWT.GetRefreshTokenDuration(), svcCtx.Config.JWT.RefreshReuseGrace)
+	if err != nil {
+		return nil, err
+	}
+
+	switch result {
+	case jwt.RotateOK:
+		res.RefreshToken = newRefreshToken
+		touchSession(ctx, svcCtx, claims, time.Now().Add(svcCtx.JWT.GetRefreshTokenDuration()).Unix())
+		recordRefreshLogin(ctx, svcCtx, claims.UserID, "success", "")
+	case jwt.RotateGrace:
+		// 并发请求携带同一个旧 token：只签发 Access Token，新的 Refresh Token 已由先到的请求返回
+		touchSession(ctx, svcCtx, claims, 0)
+	case jwt.RotateReused:
+		if _, err := svcCtx.TokenStore.RevokeSession(ctx, claims.UserID, claims.SessionID, svcCtx.JWT.GetAccessTokenDuration()); err != nil {
+			logx.WithContext(ctx).Errorf("revoke reused session failed: user_id=%d, session_id=%s, err=%v", claims.UserID, claims.SessionID, err)
+		}
+		RecordSecurityEvent(ctx, svcCtx, &SecurityEvent{
+			Type:      "refresh_token_reuse",
+			UserID:    claims.UserID,
+			SessionID: claims.SessionID,
+			Detail:    "rotated refresh token presented again, session revoked",
+		})
+		recordRefreshLogin(ctx, svcCtx, claims.UserID, "failed", "refresh token reused, session revoked")
+		return nil, ErrRefreshTokenReused
+	default:
+		return nil, ErrRefreshTokenRevoked
+	}
+	return res, nil
+}
+
+// touchSession 刷新 Token 时更新会话的最后活跃时间与 IP（失败不影响刷新）
+func touchSession(ctx context.Context, svcCtx *svc.ServiceContext, claims *jwt.Claims, expiresAt int64) {
+	if _, err := svcCtx.TokenStore.TouchSession(ctx, claims.UserID, claims.SessionID, GetClientInfo(ctx).IP, expiresAt); err != nil {
+		logx.WithContext(ctx).Errorf("touch session failed: user_id=%d, session_id=%s, err=%v", claims.UserID, claims.SessionID, err)
+	}
+}
+
+// recordRefreshLogin 异步写入刷新 Token 的登录记录（用户服务不可用时只丢失记录，不影响刷新）
+// 宽限期内的并发刷新没有签发新的 Refresh Token，不重复记录
+func recordRefreshLogin(ctx context.Context, svcCtx *svc.ServiceContext, userID uint64, result, reason string) {
+	if svcCtx.UserRPC == nil {
+		return
+	}
+	client := GetClientInfo(ctx)
+	req := &userclient.RecordLoginEventRequest{
+		UserId:    userID,
+		Method:    "refresh",
+		Result:    result,
+		Reason:    reason,
+		ClientIp:  client.IP,
+		UserAgent: client.UserAgent,
+	}
+	threading.GoSafe(func() {
+		rpcCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), loginEventTimeout)
+		defer cancel()
+		if _, err := svcCtx.UserRPC.RecordLoginEvent(rpcCtx, req); err != nil {
+			logx.WithContext(ctx).Errorf("record refresh login event failed: user_id=%d, err=%v", userID, err)
+		}
+	})
+}
+
+// RecordSecurityEvent 记录安全事件（写日志并保存到 Redis 列表，保留最近 10000 条）
+func RecordSecurityEvent(ctx context.Context, svcCtx *svc.ServiceContext, event *SecurityEvent) {
+	client := GetClientInfo(ctx)
+	if event.IP == "" {
+		event.IP = client.IP
+	}
+	if event.UserAgent == "" {
+		event.UserAgent = client.UserAgent
+	}
+	if event.Time == 0 {
+		event.Time = time.Now().Unix()
+	}
+
+	logger := logx.WithContext(ctx)
+	helper.LogWarning(logger, helper.OpSecurity, event.Type, map[string]interface{}{
+		"user_id":    event.UserID,
+		"session_id": event.SessionID,
+		"ip":         event.IP,
+		"detail":     event.Detail,
+	})
+
+	if svcCtx == nil || svcCtx.CacheRedis == nil {
+		return
+	}
+	payload, err := json.Marshal(event)
+	if err != nil {
+		return
+	}
+	if _, err := svcCtx.CacheRedis.LpushCtx(ctx, securityEventKey, string(payload)); err != nil {
+		logger.Errorf("[security] write security event failed: %v", err)
+		return
+	}
+	_ = svcCtx.CacheRedis.LtrimCtx(ctx, securityEventKey, 0, securityEventMaxLen-1)
+}
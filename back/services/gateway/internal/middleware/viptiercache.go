@@ -0,0 +1,79 @@
+package middleware
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"SLGaming/back/services/gateway/internal/svc"
+	"SLGaming/back/services/user/userclient"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// vipTierEntry 会员限流倍数缓存项
+type vipTierEntry struct {
+	multiplier float64
+	expireAt   time.Time
+}
+
+// VipTierCache 进程内缓存用户的会员限流倍数，避免每个请求都调用用户服务
+// 用户服务不可用时按普通用户处理（倍数 1），不影响正常请求
+type VipTierCache struct {
+	svcCtx  *svc.ServiceContext
+	ttl     time.Duration
+	timeout time.Duration
+	mu      sync.RWMutex
+	entries map[uint64]vipTierEntry
+}
+
+// NewVipTierCache 创建会员限流倍数缓存
+func NewVipTierCache(svcCtx *svc.ServiceContext, ttl time.Duration) *VipTierCache {
+	if ttl <= 0 {
+		ttl = time.Minute
+	}
+	return &VipTierCache{
+		svcCtx:  svcCtx,
+		ttl:     ttl,
+		timeout: 200 * time.Millisecond,
+		entries: make(map[uint64]vipTierEntry),
+	}
+}
+
+// RateLimitMultiplier 获取用户的限流倍数（实现 UserQuotaProvider）
+func (c *VipTierCache) RateLimitMultiplier(ctx context.Context, userID uint64) float64 {
+	now := time.Now()
+
+	c.mu.RLock()
+	entry, ok := c.entries[userID]
+	c.mu.RUnlock()
+	if ok && now.Before(entry.expireAt) {
+		return entry.multiplier
+	}
+
+	multiplier := 1.0
+	if c.svcCtx != nil && c.svcCtx.UserRPC != nil {
+		rpcCtx, cancel := context.WithTimeout(ctx, c.timeout)
+		resp, err := c.svcCtx.UserRPC.GetVipEntitlements(rpcCtx, &userclient.GetVipEntitlementsRequest{UserId: userID})
+		cancel()
+		if err != nil {
+			logx.WithContext(ctx).Infof("[rate_limiter] get vip entitlements failed: user_id=%d, err=%v", userID, err)
+		} else if resp.GetIsVip() && resp.GetRateLimitMultiplier() > 1 {
+			multiplier = resp.GetRateLimitMultiplier()
+		}
+	}
+
+	c.mu.Lock()
+	// 顺带清理过期项，防止缓存无限增长
+	if len(c.entries) > 10000 {
+		for id, e := range c.entries {
+			if now.After(e.expireAt) {
+				delete(c.entries, id)
+			}
+		}
+	}
+	c.entries[userID] = vipTierEntry{multiplier: multiplier, expireAt: now.Add(c.ttl)}
+	c.mu.Unlock()
+
+	return multiplier
+}
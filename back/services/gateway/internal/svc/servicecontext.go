@@ -9,6 +9,7 @@ import (
 	"SLGaming/back/services/code/codeclient"
 	"SLGaming/back/services/gateway/internal/config"
 	"SLGaming/back/services/gateway/internal/jwt"
+	"SLGaming/back/services/gateway/internal/types"
 	"SLGaming/back/services/order/orderclient"
 	"SLGaming/back/services/user/userclient"
 
@@ -30,10 +31,16 @@ type ServiceContext struct {
 	TokenStore    jwt.TokenStore
 	CacheRedis    *redis.Redis
 	EventProducer rocketmq.Producer
+	RateLimit     RateLimitInspector // 限流状态查询，由 main 在创建限流中间件后注入
 
 	dynamicClients []*rpc.DynamicRPCClient
 }
 
+// RateLimitInspector 查询网关当前生效的限流规则与计数
+type RateLimitInspector interface {
+	RateLimitStatus() types.RateLimitStatusData
+}
+
 func NewServiceContext(c config.Config) *ServiceContext {
 	ctx := &ServiceContext{
 		Config: c,
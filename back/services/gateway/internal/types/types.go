@@ -12,6 +12,148 @@ type AcceptOrderResponse struct {
 	Data OrderInfo `json:"data"`
 }
 
+type AdminApproveCompanionApplicationRequest struct {
+	Id   uint64 `json:"id"`            // 申请ID
+	Note string `json:"note,optional"` // 审核备注（仅管理员可见）
+}
+
+type AdminCreateGameSkillRequest struct {
+	Name        string `json:"name"`                 // 技能名称（唯一）
+	Description string `json:"description,optional"` // 技能描述
+}
+
+type AdminDeleteGameSkillRequest struct {
+	Id uint64 `form:"id"` // 技能ID
+}
+
+type AdminDeleteGameSkillResponse struct {
+	BaseResp
+}
+
+type AdminGameSkillResponse struct {
+	BaseResp
+	Data GameSkill `json:"data"`
+}
+
+type AdminGetOrderRequest struct {
+	Id      uint64 `form:"id,optional"`      // 订单ID
+	OrderNo string `form:"orderNo,optional"` // 订单号
+}
+
+type AdminGetUserRequest struct {
+	Id    uint64 `form:"id,optional"`    // 用户ID
+	Uid   uint64 `form:"uid,optional"`   // 用户唯一标识
+	Phone string `form:"phone,optional"` // 手机号
+}
+
+type AdminGetUserResponse struct {
+	BaseResp
+	Data AdminUserData `json:"data"`
+}
+
+type AdminListCompanionApplicationsData struct {
+	Applications []CompanionApplicationInfo `json:"applications"`
+	Total        int32                      `json:"total"`
+	Page         int32                      `json:"page"`
+	PageSize     int32                      `json:"pageSize"`
+}
+
+type AdminListCompanionApplicationsRequest struct {
+	Status   int32  `form:"status,optional"`   // 0=全部, 1=待审核, 2=已通过, 3=已驳回
+	UserId   uint64 `form:"userId,optional"`   // 按申请人筛选
+	Page     int32  `form:"page,optional"`     // 页码
+	PageSize int32  `form:"pageSize,optional"` // 每页数量
+}
+
+type AdminListCompanionApplicationsResponse struct {
+	BaseResp
+	Data AdminListCompanionApplicationsData `json:"data"`
+}
+
+type AdminListOrdersRequest struct {
+	BossId      uint64 `form:"bossId,optional"`      // 按老板筛选
+	CompanionId uint64 `form:"companionId,optional"` // 按陪玩筛选
+	Status      int32  `form:"status,optional"`      // 按状态筛选
+	Page        int32  `form:"page,optional"`        // 页码
+	PageSize    int32  `form:"pageSize,optional"`    // 每页数量
+}
+
+type AdminModerateUserData struct {
+	UserId         uint64         `json:"userId"`         // 用户ID
+	PreviousStatus int32          `json:"previousStatus"` // 变更前的状态
+	Moderation     UserModeration `json:"moderation"`     // 变更后的状态
+}
+
+type AdminModerateUserRequest struct {
+	UserId   uint64 `json:"userId"`            // 用户ID
+	Duration int64  `json:"duration,optional"` // 时长（秒，0=永久）
+	Reason   string `json:"reason"`            // 原因
+}
+
+type AdminModerateUserResponse struct {
+	BaseResp
+	Data AdminModerateUserData `json:"data"`
+}
+
+type AdminRejectCompanionApplicationRequest struct {
+	Id     uint64 `json:"id"`            // 申请ID
+	Reason string `json:"reason"`        // 驳回原因（通知申请人）
+	Note   string `json:"note,optional"` // 审核备注（仅管理员可见）
+}
+
+type AdminReviewCompanionApplicationResponse struct {
+	BaseResp
+	Data CompanionApplicationInfo `json:"data"`
+}
+
+type AdminSetCompanionTierData struct {
+	Tier       int32  `json:"tier"`       // 当前等级
+	TierName   string `json:"tierName"`   // 当前等级名称
+	Overridden bool   `json:"overridden"` // 是否为管理员指定
+}
+
+type AdminSetCompanionTierRequest struct {
+	UserId uint64 `json:"userId"`        // 陪玩用户ID
+	Tier   int32  `json:"tier"`          // 指定的等级；0=取消指定，恢复按表现评估
+	Note   string `json:"note,optional"` // 备注（陪玩可在等级记录中看到）
+}
+
+type AdminSetCompanionTierResponse struct {
+	BaseResp
+	Data AdminSetCompanionTierData `json:"data"`
+}
+
+type AdminUnbanUserRequest struct {
+	UserId uint64 `json:"userId"`          // 用户ID
+	Reason string `json:"reason,optional"` // 备注
+}
+
+type AdminUnlockLoginData struct {
+	WasLocked bool `json:"wasLocked"` // 解除前是否处于锁定状态
+}
+
+type AdminUnlockLoginRequest struct {
+	Phone string `json:"phone,optional"` // 解除锁定的手机号
+	Ip    string `json:"ip,optional"`    // 解除锁定的 IP
+}
+
+type AdminUnlockLoginResponse struct {
+	BaseResp
+	Data AdminUnlockLoginData `json:"data"`
+}
+
+type AdminUpdateGameSkillRequest struct {
+	Id          uint64 `json:"id"`                   // 技能ID
+	Name        string `json:"name"`                 // 技能名称（唯一）
+	Description string `json:"description,optional"` // 技能描述
+}
+
+type AdminUserData struct {
+	User       UserInfo       `json:"user"`       // 用户信息（手机号不脱敏）
+	Wallet     WalletInfo     `json:"wallet"`     // 钱包信息
+	Moderation UserModeration `json:"moderation"` // 账号状态
+}
+
 type AlipayNotifyRequest struct {
 	Payload map[string]string `json:"payload"` // 支付宝异步通知参数
 }
@@ -21,14 +163,21 @@ type AlipayNotifyResponse struct {
 }
 
 type ApplyCompanionRequest struct {
-	GameSkill    string `json:"gameSkill"`    // 游戏技能（单个游戏名称）
-	PricePerHour int64  `json:"pricePerHour"` // 每小时价格（帅币）
-	Bio          string `json:"bio,optional"` // 个人简介
+	RealName       string                `json:"realName"`              // 真实姓名
+	IdCardNo       string                `json:"idCardNo"`              // 身份证号
+	IdCardFrontUrl string                `json:"idCardFrontUrl"`        // 身份证正面照片
+	IdCardBackUrl  string                `json:"idCardBackUrl"`         // 身份证反面照片
+	SkillProofUrls []string              `json:"skillProofUrls"`        // 技能证明截图（1-9张）
+	VoiceSampleUrl string                `json:"voiceSampleUrl"`        // 语音样本
+	GameSkill      string                `json:"gameSkill,optional"`    // 游戏技能（单个游戏名称，与 skills 二选一）
+	PricePerHour   int64                 `json:"pricePerHour,optional"` // 每小时价格（帅币，与 gameSkill 一起使用）
+	Skills         []CompanionSkillInput `json:"skills,optional"`       // 提供的全部游戏（每个游戏单独设置段位和价格）
+	Bio            string                `json:"bio,optional"`          // 个人简介（审核通过后生效）
 }
 
 type ApplyCompanionResponse struct {
 	BaseResp
-	Data UserInfo `json:"data"`
+	Data CompanionApplicationInfo `json:"data"`
 }
 
 type BaseResp struct {
@@ -36,6 +185,38 @@ type BaseResp struct {
 	Msg  string `json:"msg"`
 }
 
+type BindEmailRequest struct {
+	Email string `json:"email"`
+	Code  string `json:"code"`
+}
+
+type BindEmailResponse struct {
+	BaseResp
+	Data UserInfo `json:"data"`
+}
+
+type BlockUserData struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+type BlockUserRequest struct {
+	UserId uint64 `json:"userId"` // 要拉黑的用户ID
+}
+
+type BlockUserResponse struct {
+	BaseResp
+	Data BlockUserData `json:"data"`
+}
+
+type BlockedUserInfo struct {
+	UserId    uint64 `json:"userId"`    // 用户ID
+	Nickname  string `json:"nickname"`  // 昵称
+	AvatarUrl string `json:"avatarUrl"` // 头像URL
+	Role      int    `json:"role"`      // 用户角色：1=老板, 2=陪玩
+	BlockedAt int64  `json:"blockedAt"` // 拉黑时间戳
+}
+
 type CancelOrderRequest struct {
 	OrderId uint64 `json:"orderId"`         // 订单ID
 	Reason  string `json:"reason,optional"` // 取消原因
@@ -46,6 +227,12 @@ type CancelOrderResponse struct {
 	Data OrderInfo `json:"data"`
 }
 
+type ChallengeData struct {
+	ChallengeId string `json:"challengeId"`
+	Image       string `json:"image"`    // data:image/png;base64,...
+	ExpireAt    int64  `json:"expireAt"` // 题目过期时间（Unix 秒）
+}
+
 type ChangePasswordRequest struct {
 	OldPhone    string `json:"oldPhone"`
 	OldCode     string `json:"oldCode"`
@@ -82,24 +269,62 @@ type CheckFollowStatusResponse struct {
 	Data CheckFollowStatusData `json:"data"`
 }
 
+type CompanionApplicationInfo struct {
+	Id             uint64           `json:"id"`                   // 申请ID
+	UserId         uint64           `json:"userId"`               // 申请人ID
+	RealName       string           `json:"realName"`             // 真实姓名
+	IdCardNo       string           `json:"idCardNo"`             // 身份证号（脱敏）
+	IdCardFrontUrl string           `json:"idCardFrontUrl"`       // 身份证正面照片
+	IdCardBackUrl  string           `json:"idCardBackUrl"`        // 身份证反面照片
+	SkillProofUrls []string         `json:"skillProofUrls"`       // 技能证明截图
+	VoiceSampleUrl string           `json:"voiceSampleUrl"`       // 语音样本
+	Skills         []CompanionSkill `json:"skills"`               // 申请的技能
+	Bio            string           `json:"bio"`                  // 个人简介
+	Status         int32            `json:"status"`               // 状态：1=待审核, 2=已通过, 3=已驳回
+	RejectReason   string           `json:"rejectReason"`         // 驳回原因
+	ReviewNote     string           `json:"reviewNote,omitempty"` // 审核备注（仅管理员可见）
+	ReviewerId     uint64           `json:"reviewerId,omitempty"` // 审核人ID
+	ReviewedAt     int64            `json:"reviewedAt"`           // 审核时间（Unix 秒）
+	CreatedAt      int64            `json:"createdAt"`            // 提交时间（Unix 秒）
+	ReapplyAt      int64            `json:"reapplyAt"`            // 被驳回后可再次申请的时间（Unix 秒）
+}
+
+type CompanionHeartbeatData struct {
+	Status          int   `json:"status"`          // 当前状态：0=离线, 1=在线, 2=忙碌
+	ExpireAt        int64 `json:"expireAt"`        // 本次心跳有效期截止时间（Unix 秒），离线时为 0
+	IntervalSeconds int   `json:"intervalSeconds"` // 建议的心跳间隔（秒）
+}
+
+type CompanionHeartbeatResponse struct {
+	BaseResp
+	Data CompanionHeartbeatData `json:"data"`
+}
+
 type CompanionInfo struct {
-	UserId       uint64  `json:"userId"`       // 用户ID
-	GameSkill    string  `json:"gameSkill"`    // 游戏技能（单个游戏名称）
-	PricePerHour int64   `json:"pricePerHour"` // 每小时价格（帅币）
-	Status       int     `json:"status"`       // 状态：0=离线, 1=在线, 2=忙碌
-	Rating       float64 `json:"rating"`       // 评分（0-5分）
-	TotalOrders  int64   `json:"totalOrders"`  // 总接单数
-	IsVerified   bool    `json:"isVerified"`   // 是否认证
-	Nickname     string  `json:"nickname"`     // 昵称
-	AvatarUrl    string  `json:"avatarUrl"`    // 头像URL
-	Bio          string  `json:"bio"`          // 个人简介
+	UserId       uint64           `json:"userId"`       // 用户ID
+	GameSkill    string           `json:"gameSkill"`    // 主要游戏技能（第一个游戏名称）
+	PricePerHour int64            `json:"pricePerHour"` // 每小时价格（帅币，多个游戏时为最低价格）
+	Skills       []CompanionSkill `json:"skills"`       // 提供的全部游戏
+	Status       int              `json:"status"`       // 状态：0=离线, 1=在线, 2=忙碌
+	Rating       float64          `json:"rating"`       // 评分（0-5分）
+	RatingScore  float64          `json:"ratingScore"`  // 贝叶斯评分（按接单数修正后的评分，列表按此排序）
+	TotalOrders  int64            `json:"totalOrders"`  // 总接单数
+	IsVerified   bool             `json:"isVerified"`   // 是否认证
+	Nickname     string           `json:"nickname"`     // 昵称
+	AvatarUrl    string           `json:"avatarUrl"`    // 头像URL
+	Bio          string           `json:"bio"`          // 个人简介
+	Gender       int32            `json:"gender"`       // 性别：0=未设置, 1=男, 2=女
+	Age          int32            `json:"age"`          // 年龄（未设置生日为0）
+	Tier         int32            `json:"tier"`         // 陪玩等级（按近期表现评估，决定价格上限与排序加权）
+	TierName     string           `json:"tierName"`     // 等级名称，如 青铜/钻石
 }
 
 type CompanionRankingItem struct {
 	UserId      uint64  `json:"userId"`      // 用户ID
 	Nickname    string  `json:"nickname"`    // 昵称
 	AvatarUrl   string  `json:"avatarUrl"`   // 头像URL
-	Rating      float64 `json:"rating"`      // 评分（用于评分排名）
+	Rating      float64 `json:"rating"`      // 评分
+	RatingScore float64 `json:"ratingScore"` // 贝叶斯评分（用于评分排名）
 	TotalOrders int64   `json:"totalOrders"` // 总接单数（用于接单数排名）
 	Rank        int32   `json:"rank"`        // 排名（从1开始）
 	IsVerified  bool    `json:"isVerified"`  // 是否认证
@@ -113,7 +338,38 @@ type CompanionRecommendation struct {
 	Description  string  `json:"description"`  // 描述
 	PricePerHour int64   `json:"pricePerHour"` // 每小时价格
 	Rating       float64 `json:"rating"`       // 评分
+	RatingScore  float64 `json:"ratingScore"`  // 贝叶斯评分（旧数据可能为0）
 	Similarity   float64 `json:"similarity"`   // 相似度分数（0-1）
+	Status       int     `json:"status"`       // 实时状态：0=离线, 1=在线, 2=忙碌（在线的排在前面）
+}
+
+type CompanionSkill struct {
+	GameSkillId  uint64 `json:"gameSkillId"`  // 游戏技能ID
+	GameName     string `json:"gameName"`     // 游戏名称
+	Rank         string `json:"rank"`         // 段位
+	PricePerHour int64  `json:"pricePerHour"` // 该游戏的每小时价格（帅币）
+	IsVerified   bool   `json:"isVerified"`   // 段位是否认证
+}
+
+type CompanionSkillInput struct {
+	GameSkillId  uint64 `json:"gameSkillId,optional"` // 游戏技能ID
+	GameName     string `json:"gameName,optional"`    // 游戏名称
+	Rank         string `json:"rank,optional"`        // 段位
+	PricePerHour int64  `json:"pricePerHour"`         // 该游戏的每小时价格（帅币）
+}
+
+type CompanionTierHistoryItem struct {
+	FromTier        int32   `json:"fromTier"` // 变更前等级
+	FromTierName    string  `json:"fromTierName"`
+	ToTier          int32   `json:"toTier"` // 变更后等级
+	ToTierName      string  `json:"toTierName"`
+	Reason          string  `json:"reason"`          // evaluation=定期评估, admin_override=管理员指定, override_cleared=取消指定
+	CompletedOrders int64   `json:"completedOrders"` // 评估窗口内完成单数
+	Rating          float64 `json:"rating"`          // 评估窗口内平均评分
+	CancelRate      float64 `json:"cancelRate"`      // 评估窗口内取消率（0-1）
+	DisputeRate     float64 `json:"disputeRate"`     // 评估窗口内纠纷率（0-1）
+	Note            string  `json:"note"`            // 管理员备注
+	CreatedAt       int64   `json:"createdAt"`       // 变更时间（Unix 秒）
 }
 
 type CompleteOrderRequest struct {
@@ -149,6 +405,33 @@ type DeleteOrderResponse struct {
 	Data DeleteOrderData `json:"data"`
 }
 
+type DisputeOrderRequest struct {
+	OrderId uint64 `json:"orderId"` // 订单ID
+	Reason  string `json:"reason"`  // 纠纷原因
+}
+
+type DisputeOrderResponse struct {
+	BaseResp
+	Data OrderInfo `json:"data"`
+}
+
+type FeedItem struct {
+	Id          uint64   `json:"id"`          // 动态ID
+	CompanionId uint64   `json:"companionId"` // 陪玩ID
+	Nickname    string   `json:"nickname"`    // 陪玩昵称
+	AvatarUrl   string   `json:"avatarUrl"`   // 陪玩头像
+	Kind        string   `json:"kind"`        // 动态类型：online=上线, review=新评价, price=调价, post=新帖子
+	GameName    string   `json:"gameName"`    // 评价的游戏（review）
+	Rating      float64  `json:"rating"`      // 评分（review）
+	Comment     string   `json:"comment"`     // 评价内容（review）
+	OldPrice    int64    `json:"oldPrice"`    // 原价格（price）
+	NewPrice    int64    `json:"newPrice"`    // 新价格（price）
+	CreatedAt   int64    `json:"createdAt"`   // 发生时间（Unix 秒）
+	PostId      uint64   `json:"postId"`      // 帖子ID（post）
+	Content     string   `json:"content"`     // 帖子内容（post）
+	ImageUrls   []string `json:"imageUrls"`   // 帖子图片（post）
+}
+
 type FollowUserData struct {
 	Success bool   `json:"success"`
 	Message string `json:"message"`
@@ -164,7 +447,8 @@ type FollowUserResponse struct {
 }
 
 type ForgetPasswordRequest struct {
-	Phone    string `json:"phone"`
+	Phone    string `json:"phone,optional"`
+	Email    string `json:"email,optional"`
 	Code     string `json:"code"`
 	Password string `json:"password"`
 }
@@ -180,6 +464,11 @@ type GameSkill struct {
 	Description string `json:"description"` // 技能描述
 }
 
+type GetChallengeResponse struct {
+	BaseResp
+	Data ChallengeData `json:"data"`
+}
+
 type GetCompanionListData struct {
 	Companions []CompanionInfo `json:"companions"` // 陪玩列表
 	Total      int             `json:"total"`      // 总数
@@ -188,13 +477,20 @@ type GetCompanionListData struct {
 }
 
 type GetCompanionListRequest struct {
-	GameSkill  string `form:"gameSkill,optional"`  // 游戏技能筛选（单个游戏名称）
-	MinPrice   int    `form:"minPrice,optional"`   // 最低价格
-	MaxPrice   int    `form:"maxPrice,optional"`   // 最高价格
-	Status     int    `form:"status,optional"`     // 状态筛选：0=离线, 1=在线, 2=忙碌（默认1）
-	IsVerified bool   `form:"isVerified,optional"` // 是否只返回认证陪玩
-	Page       int    `form:"page,optional"`       // 页码（从1开始）
-	PageSize   int    `form:"pageSize,optional"`   // 每页数量
+	GameSkill  string  `form:"gameSkill,optional"`  // 游戏技能筛选（匹配提供该游戏的陪玩）
+	MinPrice   int     `form:"minPrice,optional"`   // 最低价格（指定游戏时按该游戏的价格）
+	MaxPrice   int     `form:"maxPrice,optional"`   // 最高价格（指定游戏时按该游戏的价格）
+	Status     int     `form:"status,optional"`     // 状态筛选：1=在线, 2=忙碌（不传返回在线和忙碌，不返回离线）
+	OnlineOnly bool    `form:"onlineOnly,optional"` // 只看在线（可接单）陪玩
+	IsVerified bool    `form:"isVerified,optional"` // 是否只返回认证陪玩
+	Gender     int     `form:"gender,optional"`     // 性别：1=男, 2=女
+	MinAge     int     `form:"minAge,optional"`     // 最小年龄
+	MaxAge     int     `form:"maxAge,optional"`     // 最大年龄
+	MinRating  float64 `form:"minRating,optional"`  // 最低评分（0-5）
+	Keyword    string  `form:"keyword,optional"`    // 关键词（匹配昵称或个人简介）
+	SortBy     string  `form:"sortBy,optional"`     // 排序：rating（默认）、price_asc、price_desc、popularity、newest
+	Page       int     `form:"page,optional"`       // 页码（从1开始）
+	PageSize   int     `form:"pageSize,optional"`   // 每页数量
 }
 
 type GetCompanionListResponse struct {
@@ -210,8 +506,10 @@ type GetCompanionOrdersRankingData struct {
 }
 
 type GetCompanionOrdersRankingRequest struct {
-	Page     int `form:"page,optional"`     // 页码（从1开始）
-	PageSize int `form:"pageSize,optional"` // 每页数量（默认10，最大100）
+	Page     int    `form:"page,optional"`     // 页码（从1开始）
+	PageSize int    `form:"pageSize,optional"` // 每页数量（默认10，最大100）
+	Period   string `form:"period,optional"`   // 周期：day/week/month/all（默认all）
+	Game     string `form:"game,optional"`     // 游戏名称（为空时不限游戏）
 }
 
 type GetCompanionOrdersRankingResponse struct {
@@ -236,8 +534,10 @@ type GetCompanionRatingRankingData struct {
 }
 
 type GetCompanionRatingRankingRequest struct {
-	Page     int `form:"page,optional"`     // 页码（从1开始）
-	PageSize int `form:"pageSize,optional"` // 每页数量（默认10，最大100）
+	Page     int    `form:"page,optional"`     // 页码（从1开始）
+	PageSize int    `form:"pageSize,optional"` // 每页数量（默认10，最大100）
+	Period   string `form:"period,optional"`   // 周期：day/week/month/all（默认all）
+	Game     string `form:"game,optional"`     // 游戏名称（为空时不限游戏）
 }
 
 type GetCompanionRatingRankingResponse struct {
@@ -245,6 +545,42 @@ type GetCompanionRatingRankingResponse struct {
 	Data GetCompanionRatingRankingData `json:"data"`
 }
 
+type GetCompanionTierData struct {
+	Tier       int32                      `json:"tier"`       // 当前等级
+	TierName   string                     `json:"tierName"`   // 当前等级名称
+	Overridden bool                       `json:"overridden"` // 是否为管理员指定
+	Items      []CompanionTierHistoryItem `json:"items"`      // 等级变更记录（按时间倒序）
+	Total      int32                      `json:"total"`
+	Page       int32                      `json:"page"`
+	PageSize   int32                      `json:"pageSize"`
+}
+
+type GetCompanionTierRequest struct {
+	Page     int32 `form:"page,optional"`     // 页码
+	PageSize int32 `form:"pageSize,optional"` // 每页数量
+}
+
+type GetCompanionTierResponse struct {
+	BaseResp
+	Data GetCompanionTierData `json:"data"`
+}
+
+type GetFeedData struct {
+	Items      []FeedItem `json:"items"`
+	NextCursor uint64     `json:"nextCursor"` // 下一页游标
+	HasMore    bool       `json:"hasMore"`    // 是否还有更多
+}
+
+type GetFeedRequest struct {
+	Cursor uint64 `form:"cursor,optional"` // 上一页返回的 nextCursor，首页不传
+	Limit  int    `form:"limit,optional"`  // 每页数量（默认20，最大50）
+}
+
+type GetFeedResponse struct {
+	BaseResp
+	Data GetFeedData `json:"data"`
+}
+
 type GetMutualFollowListData struct {
 	Users    []UserFollowInfo `json:"users"`
 	Total    int              `json:"total"`
@@ -262,6 +598,11 @@ type GetMutualFollowListResponse struct {
 	Data GetMutualFollowListData `json:"data"`
 }
 
+type GetMyCompanionApplicationResponse struct {
+	BaseResp
+	Data *CompanionApplicationInfo `json:"data"` // 从未申请时为 null
+}
+
 type GetMyFollowersListData struct {
 	Users    []UserFollowInfo `json:"users"`
 	Total    int              `json:"total"`
@@ -326,6 +667,11 @@ type GetOrderResponse struct {
 	Data OrderInfo `json:"data"`
 }
 
+type GetRateLimitStatusResponse struct {
+	BaseResp
+	Data RateLimitStatusData `json:"data"`
+}
+
 type GetUserRequest struct {
 	Id    uint64 `form:"id,optional"`
 	Uid   uint64 `form:"uid,optional"`
@@ -337,18 +683,64 @@ type GetUserResponse struct {
 	Data UserInfo `json:"data"`
 }
 
+type GetVipEntitlementsResponse struct {
+	BaseResp
+	Data VipEntitlements `json:"data"`
+}
+
 type GetWalletResponse struct {
 	BaseResp
 	Data WalletInfo `json:"data"`
 }
 
+type GiftInfo struct {
+	Id      uint64 `json:"id"`      // 礼物ID
+	Name    string `json:"name"`    // 礼物名称
+	Price   int64  `json:"price"`   // 单价（帅币）
+	IconUrl string `json:"iconUrl"` // 图标URL
+	Sort    int    `json:"sort"`    // 排序
+}
+
+type ListBlockedData struct {
+	Users    []BlockedUserInfo `json:"users"`
+	Total    int               `json:"total"`
+	Page     int               `json:"page"`
+	PageSize int               `json:"pageSize"`
+}
+
+type ListBlockedRequest struct {
+	Page     int `form:"page,optional"`
+	PageSize int `form:"pageSize,optional"`
+}
+
+type ListBlockedResponse struct {
+	BaseResp
+	Data ListBlockedData `json:"data"`
+}
+
 type ListGameSkillsResponse struct {
 	BaseResp
 	Data []GameSkill `json:"data"`
 }
 
+type ListGiftsResponse struct {
+	BaseResp
+	Data []GiftInfo `json:"data"`
+}
+
+type ListSessionsResponse struct {
+	BaseResp
+	Data []SessionInfo `json:"data"`
+}
+
+type ListVipPlansResponse struct {
+	BaseResp
+	Data []VipPlanInfo `json:"data"`
+}
+
 type LoginByCodeRequest struct {
-	Phone string `json:"phone"`
+	Phone string `json:"phone,optional"`
+	Email string `json:"email,optional"`
 	Code  string `json:"code"`
 }
 
@@ -363,6 +755,35 @@ type LoginData struct {
 	ExpiresIn    int64  `json:"expiresIn"`    // Access Token 过期时间（秒）
 }
 
+type LoginEventInfo struct {
+	Id         uint64 `json:"id,string"`       // 记录ID
+	Method     string `json:"method"`          // 登录方式：password / code / refresh
+	Result     string `json:"result"`          // 登录结果：success / failed / delayed / locked
+	Reason     string `json:"reason,optional"` // 失败原因
+	Ip         string `json:"ip"`              // 客户端 IP
+	IpRegion   string `json:"ipRegion"`        // IP 所属网段
+	UserAgent  string `json:"userAgent"`       // User-Agent
+	NewDevice  bool   `json:"newDevice"`       // 是否为首次出现的设备或地区
+	OccurredAt int64  `json:"occurredAt"`      // 发生时间（Unix 秒）
+}
+
+type LoginHistoryData struct {
+	Events   []LoginEventInfo `json:"events"`
+	Total    int              `json:"total"`
+	Page     int              `json:"page"`
+	PageSize int              `json:"pageSize"`
+}
+
+type LoginHistoryRequest struct {
+	Page     int `form:"page,optional"`     // 页码（从1开始）
+	PageSize int `form:"pageSize,optional"` // 每页数量
+}
+
+type LoginHistoryResponse struct {
+	BaseResp
+	Data LoginHistoryData `json:"data"`
+}
+
 type LoginRequest struct {
 	Phone    string `json:"phone"`
 	Password string `json:"password"`
@@ -386,24 +807,67 @@ type LogoutResponse struct {
 }
 
 type OrderInfo struct {
-	Id            uint64  `json:"id"`            // 订单ID
-	OrderNo       string  `json:"orderNo"`       // 订单号
-	BossId        uint64  `json:"bossId"`        // 老板ID
-	CompanionId   uint64  `json:"companionId"`   // 陪玩ID
-	GameName      string  `json:"gameName"`      // 游戏名称
-	DurationHours int32   `json:"durationHours"` // 时长（小时）
-	PricePerHour  int64   `json:"pricePerHour"`  // 每小时价格（帅币）
-	TotalAmount   int64   `json:"totalAmount"`   // 订单总价（帅币）
-	Status        int32   `json:"status"`        // 状态：1=CREATED,2=PAID,3=ACCEPTED,4=IN_SERVICE,5=COMPLETED,6=CANCELLED,7=RATED
-	CreatedAt     int64   `json:"createdAt"`     // 创建时间
-	PaidAt        int64   `json:"paidAt"`        // 支付时间
-	AcceptedAt    int64   `json:"acceptedAt"`    // 接单时间
-	StartAt       int64   `json:"startAt"`       // 开始服务时间
-	CompletedAt   int64   `json:"completedAt"`   // 完成时间
-	CancelledAt   int64   `json:"cancelledAt"`   // 取消时间
-	Rating        float64 `json:"rating"`        // 评分
-	Comment       string  `json:"comment"`       // 评价内容
-	CancelReason  string  `json:"cancelReason"`  // 取消原因
+	Id             uint64  `json:"id"`             // 订单ID
+	OrderNo        string  `json:"orderNo"`        // 订单号
+	BossId         uint64  `json:"bossId"`         // 老板ID
+	CompanionId    uint64  `json:"companionId"`    // 陪玩ID
+	GameName       string  `json:"gameName"`       // 游戏名称
+	DurationHours  int32   `json:"durationHours"`  // 时长（小时）
+	PricePerHour   int64   `json:"pricePerHour"`   // 每小时价格（帅币）
+	TotalAmount    int64   `json:"totalAmount"`    // 订单总价（帅币）
+	Status         int32   `json:"status"`         // 状态：1=CREATED,2=PAID,3=ACCEPTED,4=IN_SERVICE,5=COMPLETED,6=CANCELLED,7=RATED
+	CreatedAt      int64   `json:"createdAt"`      // 创建时间
+	PaidAt         int64   `json:"paidAt"`         // 支付时间
+	AcceptedAt     int64   `json:"acceptedAt"`     // 接单时间
+	StartAt        int64   `json:"startAt"`        // 开始服务时间
+	CompletedAt    int64   `json:"completedAt"`    // 完成时间
+	CancelledAt    int64   `json:"cancelledAt"`    // 取消时间
+	Rating         float64 `json:"rating"`         // 评分
+	Comment        string  `json:"comment"`        // 评价内容
+	CancelReason   string  `json:"cancelReason"`   // 取消原因
+	DiscountAmount int64   `json:"discountAmount"` // 会员折扣减免（帅币），totalAmount 为折后实付
+	DisputedAt     int64   `json:"disputedAt"`     // 发起纠纷时间（0 表示没有纠纷）
+	DisputeReason  string  `json:"disputeReason"`  // 纠纷原因
+}
+
+type PublishCompanionPostData struct {
+	PostId    uint64 `json:"postId"`    // 帖子ID
+	CreatedAt int64  `json:"createdAt"` // 发布时间（Unix 秒）
+}
+
+type PublishCompanionPostRequest struct {
+	Content   string   `json:"content,optional"`   // 帖子内容（最多500字）
+	ImageUrls []string `json:"imageUrls,optional"` // 图片地址（最多9张），内容与图片至少有一项
+}
+
+type PublishCompanionPostResponse struct {
+	BaseResp
+	Data PublishCompanionPostData `json:"data"`
+}
+
+type RateLimitRule struct {
+	Path             string `json:"path"`             // 路由路径
+	Method           string `json:"method"`           // HTTP 方法，* 表示所有方法
+	GlobalQPS        int    `json:"globalQps"`        // 路由全局 QPS
+	PerIPQPS         int    `json:"perIpQps"`         // 每个 IP 的 QPS
+	PerUserQPS       int    `json:"perUserQps"`       // 每个用户的 QPS
+	PerIPPerMinute   int    `json:"perIpPerMinute"`   // 每个 IP 每分钟请求数
+	PerUserPerMinute int    `json:"perUserPerMinute"` // 每个用户每分钟请求数
+	Hits             int64  `json:"hits"`             // 命中次数（本实例启动以来）
+	Denied           int64  `json:"denied"`           // 拒绝次数（本实例启动以来）
+}
+
+type RateLimitStatusData struct {
+	Enabled     bool            `json:"enabled"`     // 是否启用限流
+	Distributed bool            `json:"distributed"` // 是否使用 Redis 分布式计数
+	Degraded    bool            `json:"degraded"`    // 分布式计数是否已降级为本地
+	GlobalQPS   int             `json:"globalQps"`   // 全局 QPS
+	Version     int64           `json:"version"`     // 规则版本（每次热更新递增）
+	Source      string          `json:"source"`      // 规则来源：local / nacos
+	UpdatedAt   int64           `json:"updatedAt"`   // 规则生效时间（Unix 秒）
+	TotalHits   int64           `json:"totalHits"`   // 总请求数
+	TotalDenied int64           `json:"totalDenied"` // 总拒绝数
+	Rules       []RateLimitRule `json:"rules"`       // 当前生效的路由规则
 }
 
 type RateOrderRequest struct {
@@ -494,13 +958,19 @@ type RecommendCompanionResponse struct {
 	Data RecommendCompanionData `json:"data"`
 }
 
+type RefreshTokenData struct {
+	AccessToken  string `json:"accessToken"`            // Access Token（短期，15分钟）
+	RefreshToken string `json:"refreshToken,omitempty"` // 新的 Refresh Token（未轮换时省略）
+	ExpiresIn    int64  `json:"expiresIn"`              // Access Token 过期时间（秒）
+}
+
 type RefreshTokenRequest struct {
 	RefreshToken string `json:"refreshToken"`
 }
 
 type RefreshTokenResponse struct {
 	BaseResp
-	Data LoginData `json:"data"`
+	Data RefreshTokenData `json:"data"`
 }
 
 type RegisterData struct {
@@ -522,13 +992,25 @@ type RegisterResponse struct {
 	Data RegisterData `json:"data"`
 }
 
+type RevokeSessionRequest struct {
+	Id string `path:"id"` // 会话ID
+}
+
+type RevokeSessionResponse struct {
+	BaseResp
+}
+
 type SendCodeData struct {
-	Success bool `json:"success"`
+	Success           bool   `json:"success"`
+	ChallengeRequired bool   `json:"challengeRequired"` // 为 true 时本次未发送，需先完成人机验证后携带 challengeToken 重试
+	ChallengeReason   string `json:"challengeReason"`   // 触发原因：ip_targets / global_rate / new_device / invalid_token
 }
 
 type SendCodeRequest struct {
-	Phone   string `json:"phone"`
-	Purpose string `json:"purpose"`
+	Phone          string `json:"phone,optional"` // 手机号（短信渠道）
+	Email          string `json:"email,optional"` // 邮箱（填写后走邮件渠道）
+	Purpose        string `json:"purpose"`
+	ChallengeToken string `json:"challengeToken,optional"` // 人机验证令牌，触发风控时必填
 }
 
 type SendCodeResponse struct {
@@ -536,6 +1018,45 @@ type SendCodeResponse struct {
 	Data SendCodeData `json:"data"`
 }
 
+type SendGiftData struct {
+	BizOrderId string     `json:"bizOrderId"` // 送礼单号
+	Amount     int64      `json:"amount"`     // 实际扣减帅币
+	Wallet     WalletInfo `json:"wallet"`     // 送礼后的钱包信息
+}
+
+type SendGiftRequest struct {
+	ReceiverId uint64 `json:"receiverId"`         // 接收者用户ID
+	GiftId     uint64 `json:"giftId"`             // 礼物ID
+	Quantity   int    `json:"quantity,optional"`  // 数量（默认1）
+	RequestId  string `json:"requestId,optional"` // 客户端请求ID（幂等）
+	Message    string `json:"message,optional"`   // 附言
+}
+
+type SendGiftResponse struct {
+	BaseResp
+	Data SendGiftData `json:"data"`
+}
+
+type SessionInfo struct {
+	Id         string `json:"id"`         // 会话ID
+	DeviceName string `json:"deviceName"` // 设备名称（客户端通过 X-Device-Name 请求头上报）
+	UserAgent  string `json:"userAgent"`  // User-Agent
+	Ip         string `json:"ip"`         // 最近一次使用的 IP
+	CreatedAt  int64  `json:"createdAt"`  // 登录时间（Unix 秒）
+	LastSeenAt int64  `json:"lastSeenAt"` // 最后活跃时间（Unix 秒）
+	ExpiresAt  int64  `json:"expiresAt"`  // 过期时间（Unix 秒）
+	Current    bool   `json:"current"`    // 是否为当前设备
+}
+
+type SetVipAutoRenewRequest struct {
+	AutoRenew bool `json:"autoRenew"` // 是否自动续费
+}
+
+type SetVipAutoRenewResponse struct {
+	BaseResp
+	Data VipSubscriptionInfo `json:"data"`
+}
+
 type StartOrderRequest struct {
 	OrderId uint64 `json:"orderId"` // 订单ID
 }
@@ -545,6 +1066,53 @@ type StartOrderResponse struct {
 	Data OrderInfo `json:"data"`
 }
 
+type SubscribeVipData struct {
+	Subscription VipSubscriptionInfo `json:"subscription"` // 订阅信息
+	Wallet       WalletInfo          `json:"wallet"`       // 扣款后的钱包信息
+}
+
+type SubscribeVipRequest struct {
+	PlanCode  string `json:"planCode"`           // 套餐编码
+	AutoRenew bool   `json:"autoRenew,optional"` // 是否自动续费
+	RequestId string `json:"requestId,optional"` // 客户端请求ID（幂等）
+}
+
+type SubscribeVipResponse struct {
+	BaseResp
+	Data SubscribeVipData `json:"data"`
+}
+
+type TransferData struct {
+	BizOrderId string     `json:"bizOrderId"` // 打赏单号
+	Wallet     WalletInfo `json:"wallet"`     // 打赏后的钱包信息
+}
+
+type TransferRequest struct {
+	ToUserId  uint64 `json:"toUserId"`           // 接收打赏的用户ID
+	Amount    int64  `json:"amount"`             // 打赏帅币数量
+	RequestId string `json:"requestId,optional"` // 客户端请求ID（幂等，重复提交不会重复扣款）
+	Remark    string `json:"remark,optional"`    // 留言
+}
+
+type TransferResponse struct {
+	BaseResp
+	Data TransferData `json:"data"`
+}
+
+type UnblockUserData struct {
+	Success bool   `json:"success"`
+	Message string `json:"message"`
+}
+
+type UnblockUserRequest struct {
+	UserId uint64 `json:"userId"` // 要取消拉黑的用户ID
+}
+
+type UnblockUserResponse struct {
+	BaseResp
+	Data UnblockUserData `json:"data"`
+}
+
 type UnfollowUserData struct {
 	Success bool   `json:"success"`
 	Message string `json:"message"`
@@ -560,9 +1128,10 @@ type UnfollowUserResponse struct {
 }
 
 type UpdateCompanionProfileRequest struct {
-	GameSkill    string `json:"gameSkill,optional"`    // 游戏技能（单个游戏名称）
-	PricePerHour int64  `json:"pricePerHour,optional"` // 每小时价格（帅币）
-	Status       int    `json:"status,optional"`       // 状态：0=离线, 1=在线, 2=忙碌
+	GameSkill    string                `json:"gameSkill,optional"`    // 游戏技能（单个游戏名称，会替换全部技能）
+	PricePerHour int64                 `json:"pricePerHour,optional"` // 每小时价格（帅币，单独传入时修改所有游戏的价格）
+	Skills       []CompanionSkillInput `json:"skills,optional"`       // 提供的全部游戏（非空时整体替换）
+	Status       int                   `json:"status,optional"`       // 状态：0=离线, 1=在线, 2=忙碌
 }
 
 type UpdateCompanionProfileResponse struct {
@@ -571,7 +1140,7 @@ type UpdateCompanionProfileResponse struct {
 }
 
 type UpdateCompanionStatusRequest struct {
-	Status int `json:"status"` // 状态：0=离线, 1=在线, 2=忙碌
+	Status int `json:"status"` // 状态：0=离线, 1=在线（忙碌由进行中的订单自动维护）
 }
 
 type UpdateCompanionStatusResponse struct {
@@ -587,6 +1156,8 @@ type UpdateUserRequest struct {
 	Role      int    `json:"role,optional"`      // 用户角色
 	AvatarUrl string `json:"avatarUrl,optional"` // 头像URL
 	Bio       string `json:"bio,optional"`       // 个人简介
+	Gender    int    `json:"gender,optional"`    // 性别：1=男, 2=女
+	Birthday  string `json:"birthday,optional"`  // 生日（YYYY-MM-DD）
 }
 
 type UpdateUserResponse struct {
@@ -620,6 +1191,7 @@ type UserInfo struct {
 	Uid            uint64 `json:"uid"`            // 用户唯一标识
 	Nickname       string `json:"nickname"`       // 用户昵称
 	Phone          string `json:"phone"`          // 手机号码
+	Email          string `json:"email"`          // 绑定邮箱（未绑定为空）
 	Role           int    `json:"role"`           // 用户角色：1=老板, 2=陪玩, 3=管理员
 	AvatarUrl      string `json:"avatarUrl"`      // 头像URL
 	Bio            string `json:"bio"`            // 个人简介
@@ -627,6 +1199,66 @@ type UserInfo struct {
 	FrozenBalance  int64  `json:"frozenBalance"`  // 冻结帅币余额（预留）
 	FollowerCount  int64  `json:"followerCount"`  // 粉丝数
 	FollowingCount int64  `json:"followingCount"` // 关注数
+	VipLevel       int32  `json:"vipLevel"`       // 会员等级（0=非会员）
+	VipBadge       string `json:"vipBadge"`       // 会员徽章
+	VipExpireAt    int64  `json:"vipExpireAt"`    // 会员到期时间（秒）
+	Gender         int32  `json:"gender"`         // 性别：0=未设置, 1=男, 2=女
+	Birthday       string `json:"birthday"`       // 生日（YYYY-MM-DD，未设置为空）
+}
+
+type UserModeration struct {
+	Status      int32  `json:"status"`      // 账号状态：0=正常, 1=禁言, 2=封禁
+	StatusUntil int64  `json:"statusUntil"` // 到期时间（Unix 秒，0=永久）
+	Reason      string `json:"reason"`      // 原因
+}
+
+type VerifyChallengeData struct {
+	Passed        bool   `json:"passed"`
+	Token         string `json:"token"` // 发送验证码时作为 challengeToken 携带
+	TokenExpireAt int64  `json:"tokenExpireAt"`
+}
+
+type VerifyChallengeRequest struct {
+	ChallengeId string `json:"challengeId"`
+	Answer      string `json:"answer"`
+}
+
+type VerifyChallengeResponse struct {
+	BaseResp
+	Data VerifyChallengeData `json:"data"`
+}
+
+type VipEntitlements struct {
+	IsVip               bool    `json:"isVip"`               // 是否会员
+	Level               int32   `json:"level"`               // 会员等级
+	PlanCode            string  `json:"planCode"`            // 当前套餐
+	Badge               string  `json:"badge"`               // 徽章
+	DiscountPercent     int32   `json:"discountPercent"`     // 下单折扣（百分比）
+	RecommendPriority   int32   `json:"recommendPriority"`   // 推荐优先级
+	RateLimitMultiplier float64 `json:"rateLimitMultiplier"` // 限流配额倍数
+	ExpireAt            int64   `json:"expireAt"`            // 到期时间（秒）
+	AutoRenew           bool    `json:"autoRenew"`           // 是否自动续费
+}
+
+type VipPlanInfo struct {
+	Code                string  `json:"code"`                // 套餐编码
+	Name                string  `json:"name"`                // 套餐名称
+	Level               int32   `json:"level"`               // 会员等级
+	DurationDays        int32   `json:"durationDays"`        // 有效天数
+	Price               int64   `json:"price"`               // 价格（帅币）
+	DiscountPercent     int32   `json:"discountPercent"`     // 下单折扣（百分比）
+	Badge               string  `json:"badge"`               // 徽章
+	RecommendPriority   int32   `json:"recommendPriority"`   // 推荐优先级
+	RateLimitMultiplier float64 `json:"rateLimitMultiplier"` // 限流配额倍数
+}
+
+type VipSubscriptionInfo struct {
+	PlanCode  string `json:"planCode"`  // 套餐编码
+	Level     int32  `json:"level"`     // 会员等级
+	StartAt   int64  `json:"startAt"`   // 开始时间（秒）
+	EndAt     int64  `json:"endAt"`     // 到期时间（秒）
+	AutoRenew bool   `json:"autoRenew"` // 是否自动续费
+	Status    int32  `json:"status"`    // 状态：1=生效中, 2=已过期
 }
 
 type WalletInfo struct {
@@ -12,6 +12,9 @@ import (
 
 var operationMessages = map[string]string{
 	"Login":                  "登录成功",
+	"UnlockLogin":            "解除登录锁定成功",
+	"LoginHistory":           "获取登录记录成功",
+	"ModerateUser":           "操作成功",
 	"Register":               "注册成功",
 	"Logout":                 "退出登录成功",
 	"RefreshToken":           "令牌刷新成功",
@@ -23,14 +26,28 @@ var operationMessages = map[string]string{
 	"RechargeQuery":          "查询充值记录成功",
 	"RechargeList":           "获取充值列表成功",
 	"Consume":                "消费成功",
+	"Transfer":               "打赏成功",
+	"SendGift":               "赠送礼物成功",
+	"ListGifts":              "获取礼物列表成功",
+	"ListVipPlans":           "获取会员套餐成功",
+	"GetVipEntitlements":     "获取会员权益成功",
+	"SubscribeVip":           "开通会员成功",
+	"SetVipAutoRenew":        "设置自动续费成功",
+	"CreateGameSkill":        "新增游戏技能成功",
+	"UpdateGameSkill":        "修改游戏技能成功",
+	"DeleteGameSkill":        "删除游戏技能成功",
 	"GetCompanionList":       "获取陪玩列表成功",
 	"GetCompanionProfile":    "获取陪玩资料成功",
 	"GetCompanionById":       "获取陪玩详情成功",
 	"ApplyCompanion":         "申请成为陪玩成功",
 	"UpdateCompanionProfile": "更新陪玩资料成功",
 	"UpdateCompanionStatus":  "更新陪玩状态成功",
+	"CompanionHeartbeat":     "心跳成功",
+	"GetCompanionTier":       "获取陪玩等级成功",
+	"SetCompanionTier":       "设置陪玩等级成功",
 	"ChangePassword":         "修改密码成功",
 	"ChangePhone":            "修改手机号成功",
+	"BindEmail":              "绑定邮箱成功",
 	"GetRanking":             "获取排行榜成功",
 	"CreateOrder":            "创建订单成功",
 	"GetOrder":               "获取订单成功",
@@ -47,12 +64,23 @@ var operationMessages = map[string]string{
 	"GetFollowing":           "获取关注列表成功",
 	"GetMutualFollow":        "获取互关列表成功",
 	"CheckFollowStatus":      "查询关注状态成功",
+	"GetFeed":                "获取关注动态成功",
+	"BlockUser":              "拉黑成功",
+	"UnblockUser":            "取消拉黑成功",
+	"ListBlocked":            "获取黑名单成功",
 	"ListGameSkills":         "获取游戏技能列表成功",
 	"VerifyCode":             "验证码验证成功",
 	"RecommendCompanion":     "推荐陪玩成功",
 	"GetCode":                "获取验证码成功",
 	"UploadAvatar":           "头像上传成功",
 	"AlipayNotify":           "支付宝回调处理成功",
+
+	// 陪玩入驻申请
+	"SubmitCompanionApplication":  "入驻申请已提交，请等待审核",
+	"GetMyCompanionApplication":   "获取入驻申请成功",
+	"ListCompanionApplications":   "获取入驻申请列表成功",
+	"ApproveCompanionApplication": "已通过入驻申请",
+	"RejectCompanionApplication":  "已驳回入驻申请",
 }
 
 func GetSuccessMsg(operation string) string {
@@ -64,15 +92,22 @@ func GetSuccessMsg(operation string) string {
 
 var errorMessages = map[string]map[codes.Code]string{
 	"Login": {
-		codes.InvalidArgument:  "登录失败：手机号或密码不能为空",
-		codes.NotFound:         "登录失败：用户不存在",
-		codes.PermissionDenied: "登录失败：密码错误",
-		codes.Internal:         "登录失败：服务异常，请稍后重试",
+		codes.InvalidArgument:    "登录失败：手机号或密码不能为空",
+		codes.NotFound:           "登录失败：用户不存在",
+		codes.PermissionDenied:   "登录失败：密码错误",
+		codes.ResourceExhausted:  "登录失败：密码错误次数过多，请稍后再试或使用验证码登录",
+		codes.FailedPrecondition: "登录失败：账号已被封禁",
+		codes.Internal:           "登录失败：服务异常，请稍后重试",
+	},
+	"LoginByCode": {
+		codes.FailedPrecondition: "登录失败：账号已被封禁",
+		codes.PermissionDenied:   "登录失败：验证已失效，请重新获取验证码",
 	},
 	"Register": {
-		codes.InvalidArgument: "注册失败：参数错误",
-		codes.AlreadyExists:   "注册失败：手机号已被注册",
-		codes.Internal:        "注册失败：服务异常，请稍后重试",
+		codes.InvalidArgument:  "注册失败：参数错误",
+		codes.AlreadyExists:    "注册失败：手机号已被注册",
+		codes.PermissionDenied: "注册失败：验证已失效，请重新获取验证码",
+		codes.Internal:         "注册失败：服务异常，请稍后重试",
 	},
 	"VerifyCode": {
 		codes.InvalidArgument: "验证码错误：请输入正确的验证码",
@@ -83,7 +118,8 @@ var errorMessages = map[string]map[codes.Code]string{
 		codes.InvalidArgument:    "创建订单失败：参数错误",
 		codes.NotFound:           "创建订单失败：陪玩不存在",
 		codes.FailedPrecondition: "创建订单失败：陪玩当前不可接单",
-		codes.PermissionDenied:   "创建订单失败：余额不足",
+		codes.PermissionDenied:   "创建订单失败：您与该陪玩存在拉黑关系",
+		codes.ResourceExhausted:  "创建订单失败：余额不足",
 		codes.Internal:           "创建订单失败：服务异常",
 	},
 	"GetOrder": {
@@ -122,27 +158,64 @@ var errorMessages = map[string]map[codes.Code]string{
 		codes.InvalidArgument: "更新用户信息失败：参数错误",
 		codes.Internal:        "更新用户信息失败：服务异常",
 	},
+	"GetCompanionList": {
+		codes.InvalidArgument: "筛选条件无效",
+		codes.Internal:        "获取陪玩列表失败：服务异常",
+	},
 	"GetCompanionProfile": {
 		codes.NotFound: "陪玩资料不存在",
 		codes.Internal: "获取陪玩资料失败：服务异常",
 	},
 	"UpdateCompanionProfile": {
-		codes.InvalidArgument:    "更新陪玩资料失败：参数错误",
+		codes.InvalidArgument:    "更新陪玩资料失败：参数错误或价格超过当前等级上限",
 		codes.NotFound:           "更新陪玩资料失败：陪玩资料不存在",
 		codes.FailedPrecondition: "更新陪玩资料失败：您不是陪玩用户",
 		codes.Internal:           "更新陪玩资料失败：服务异常",
 	},
+	"CompanionHeartbeat": {
+		codes.NotFound: "陪玩资料不存在",
+		codes.Internal: "心跳失败：服务异常",
+	},
+	"GetCompanionTierHistory": {
+		codes.NotFound: "陪玩资料不存在",
+		codes.Internal: "获取陪玩等级失败：服务异常",
+	},
+	"SetCompanionTierOverride": {
+		codes.InvalidArgument: "设置陪玩等级失败：等级不存在或备注过长",
+		codes.NotFound:        "设置陪玩等级失败：陪玩资料不存在",
+		codes.Internal:        "设置陪玩等级失败：服务异常",
+	},
 	"FollowUser": {
-		codes.InvalidArgument: "关注失败：参数错误",
-		codes.AlreadyExists:   "关注失败：您已关注该用户",
-		codes.NotFound:        "关注失败：用户不存在",
-		codes.Internal:        "关注失败：服务异常",
+		codes.InvalidArgument:  "关注失败：参数错误",
+		codes.AlreadyExists:    "关注失败：您已关注该用户",
+		codes.PermissionDenied: "关注失败：您与该用户存在拉黑关系",
+		codes.NotFound:         "关注失败：用户不存在",
+		codes.Internal:         "关注失败：服务异常",
 	},
 	"UnfollowUser": {
 		codes.InvalidArgument: "取消关注失败：参数错误",
 		codes.NotFound:        "取消关注失败：您未关注该用户",
 		codes.Internal:        "取消关注失败：服务异常",
 	},
+	"GetFeed": {
+		codes.InvalidArgument: "获取关注动态失败：参数错误",
+		codes.Internal:        "获取关注动态失败：服务异常",
+	},
+	"BlockUser": {
+		codes.InvalidArgument: "拉黑失败：参数错误",
+		codes.AlreadyExists:   "拉黑失败：您已拉黑该用户",
+		codes.NotFound:        "拉黑失败：用户不存在",
+		codes.Internal:        "拉黑失败：服务异常",
+	},
+	"UnblockUser": {
+		codes.InvalidArgument: "取消拉黑失败：参数错误",
+		codes.NotFound:        "取消拉黑失败：您未拉黑该用户",
+		codes.Internal:        "取消拉黑失败：服务异常",
+	},
+	"ListBlocked": {
+		codes.InvalidArgument: "获取黑名单失败：参数错误",
+		codes.Internal:        "获取黑名单失败：服务异常",
+	},
 	"GetWallet": {
 		codes.NotFound: "钱包不存在",
 		codes.Internal: "获取钱包信息失败：服务异常",
@@ -151,14 +224,108 @@ var errorMessages = map[string]map[codes.Code]string{
 		codes.InvalidArgument: "充值失败：参数错误",
 		codes.Internal:        "充值失败：服务异常",
 	},
+	"Transfer": {
+		codes.InvalidArgument:    "打赏失败：参数错误或超出单笔上限",
+		codes.NotFound:           "打赏失败：用户不存在",
+		codes.PermissionDenied:   "打赏失败：您与该用户存在拉黑关系",
+		codes.FailedPrecondition: "打赏失败：钱包不存在",
+		codes.ResourceExhausted:  "打赏失败：余额不足或超出今日打赏限额",
+		codes.AlreadyExists:      "打赏失败：请求ID重复",
+		codes.Internal:           "打赏失败：服务异常",
+	},
+	"SendGift": {
+		codes.InvalidArgument:    "赠送礼物失败：参数错误或超出单笔上限",
+		codes.NotFound:           "赠送礼物失败：用户或礼物不存在",
+		codes.PermissionDenied:   "赠送礼物失败：您与该用户存在拉黑关系",
+		codes.FailedPrecondition: "赠送礼物失败：礼物已下架或钱包不存在",
+		codes.ResourceExhausted:  "赠送礼物失败：余额不足或超出今日送礼限额",
+		codes.AlreadyExists:      "赠送礼物失败：请求ID重复",
+		codes.Internal:           "赠送礼物失败：服务异常",
+	},
+	"CreateGameSkill": {
+		codes.InvalidArgument: "新增游戏技能失败：技能名称不能为空",
+		codes.AlreadyExists:   "新增游戏技能失败：技能名称已存在",
+		codes.Internal:        "新增游戏技能失败：服务异常",
+	},
+	"UpdateGameSkill": {
+		codes.InvalidArgument: "修改游戏技能失败：参数错误",
+		codes.NotFound:        "修改游戏技能失败：技能不存在",
+		codes.AlreadyExists:   "修改游戏技能失败：技能名称已存在",
+		codes.Internal:        "修改游戏技能失败：服务异常",
+	},
+	"DeleteGameSkill": {
+		codes.NotFound: "删除游戏技能失败：技能不存在",
+		codes.Internal: "删除游戏技能失败：服务异常",
+	},
+	"SubscribeVip": {
+		codes.InvalidArgument:    "开通会员失败：参数错误",
+		codes.NotFound:           "开通会员失败：套餐不存在",
+		codes.FailedPrecondition: "开通会员失败：会员生效期间不支持降级或钱包不存在",
+		codes.ResourceExhausted:  "开通会员失败：帅币余额不足",
+		codes.Internal:           "开通会员失败：服务异常",
+	},
+	"SetVipAutoRenew": {
+		codes.NotFound:           "设置失败：尚未开通会员",
+		codes.FailedPrecondition: "设置失败：会员已过期",
+		codes.Internal:           "设置失败：服务异常",
+	},
 	"ChangePassword": {
-		codes.InvalidArgument: "修改密码失败：原密码错误",
-		codes.Internal:        "修改密码失败：服务异常",
+		codes.InvalidArgument:  "修改密码失败：原密码错误",
+		codes.PermissionDenied: "修改密码失败：验证已失效，请重新获取验证码",
+		codes.Internal:         "修改密码失败：服务异常",
+	},
+	"UnlockLogin": {
+		codes.InvalidArgument: "解除登录锁定失败：请提供手机号或IP",
+		codes.Internal:        "解除登录锁定失败：服务异常",
+	},
+	"LoginHistory": {
+		codes.InvalidArgument: "获取登录记录失败：参数错误",
+		codes.Internal:        "获取登录记录失败：服务异常",
+	},
+	"ModerateUser": {
+		codes.InvalidArgument:  "操作失败：参数错误或到期时间无效",
+		codes.NotFound:         "操作失败：用户不存在",
+		codes.PermissionDenied: "操作失败：不能处罚管理员",
+		codes.Internal:         "操作失败：服务异常",
+	},
+	"ForgetPassword": {
+		codes.NotFound:         "重置密码失败：用户不存在",
+		codes.PermissionDenied: "重置密码失败：验证已失效，请重新获取验证码",
+		codes.Internal:         "重置密码失败：服务异常",
 	},
 	"ChangePhone": {
-		codes.InvalidArgument: "修改手机号失败：参数错误",
-		codes.AlreadyExists:   "修改手机号失败：新手机号已被使用",
-		codes.Internal:        "修改手机号失败：服务异常",
+		codes.InvalidArgument:  "修改手机号失败：参数错误",
+		codes.AlreadyExists:    "修改手机号失败：新手机号已被使用",
+		codes.PermissionDenied: "修改手机号失败：验证已失效，请重新获取验证码",
+		codes.Internal:         "修改手机号失败：服务异常",
+	},
+	"GetCompanionRatingRanking": {
+		codes.InvalidArgument: "获取排行榜失败：周期须为 day、week、month 或 all，游戏名称不超过64个字符",
+		codes.Internal:        "获取排行榜失败：服务异常",
+	},
+	"GetCompanionOrdersRanking": {
+		codes.InvalidArgument: "获取排行榜失败：周期须为 day、week、month 或 all，游戏名称不超过64个字符",
+		codes.Internal:        "获取排行榜失败：服务异常",
+	},
+	"SubmitCompanionApplication": {
+		codes.InvalidArgument:    "提交申请失败：请检查身份信息、截图、语音和游戏技能是否填写正确，价格不能超过新陪玩的上限",
+		codes.NotFound:           "提交申请失败：用户不存在",
+		codes.PermissionDenied:   "提交申请失败：账号已被封禁或无权申请",
+		codes.FailedPrecondition: "提交申请失败：已有待审核的申请或已是认证陪玩",
+		codes.ResourceExhausted:  "提交申请失败：申请被驳回后需等待一段时间才能再次申请",
+		codes.Internal:           "提交申请失败：服务异常",
+	},
+	"ReviewCompanionApplication": {
+		codes.InvalidArgument:    "审核失败：参数错误，驳回时需填写原因",
+		codes.NotFound:           "审核失败：申请或申请人不存在",
+		codes.FailedPrecondition: "审核失败：申请已审核",
+		codes.Internal:           "审核失败：服务异常",
+	},
+	"BindEmail": {
+		codes.InvalidArgument:  "绑定邮箱失败：邮箱格式不正确或已绑定该邮箱",
+		codes.AlreadyExists:    "绑定邮箱失败：邮箱已被其他账号使用",
+		codes.PermissionDenied: "绑定邮箱失败：验证已失效，请重新获取验证码",
+		codes.Internal:         "绑定邮箱失败：服务异常",
 	},
 }
 
@@ -363,6 +530,14 @@ func HandleRPCClientUnavailable(logger logx.Logger, service string) (int32, stri
 	return 503, "服务暂时不可用，请稍后重试"
 }
 
+// VerifyCodeFailedMsg 验证码未通过时的提示：告知剩余可尝试次数，或提示重新获取
+func VerifyCodeFailedMsg(remainingAttempts int32, needResend bool) string {
+	if needResend || remainingAttempts <= 0 {
+		return "验证码已失效，请重新获取"
+	}
+	return fmt.Sprintf("验证码错误，还可尝试 %d 次", remainingAttempts)
+}
+
 // BuildErrorResponse 构建错误响应（用于返回给前端）
 // 返回格式：BaseResp{Code: code, Msg: message}
 func BuildErrorResponse(code int32, message string) map[string]interface{} {
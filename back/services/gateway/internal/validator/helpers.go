@@ -22,6 +22,30 @@ func ValidatePhone(phone string) error {
 	return nil
 }
 
+// ValidateEmail 验证邮箱
+func ValidateEmail(email string) error {
+	email = strings.TrimSpace(email)
+	if email == "" {
+		return fmt.Errorf("邮箱不能为空")
+	}
+	if len(email) > 128 {
+		return fmt.Errorf("邮箱长度不能超过128位")
+	}
+	matched, _ := regexp.MatchString(`^[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}$`, email)
+	if !matched {
+		return fmt.Errorf("邮箱格式不正确")
+	}
+	return nil
+}
+
+// ValidatePhoneOrEmail 验证手机号或邮箱（二选一，填写手机号时以手机号为准）
+func ValidatePhoneOrEmail(phone, email string) error {
+	if strings.TrimSpace(phone) == "" && strings.TrimSpace(email) != "" {
+		return ValidateEmail(email)
+	}
+	return ValidatePhone(phone)
+}
+
 // ValidateCode 验证验证码
 func ValidateCode(code string) error {
 	code = strings.TrimSpace(code)
@@ -83,13 +107,24 @@ func ValidatePurpose(purpose string) error {
 		"change_phone":     true,
 		"change_phone_new": true,
 		"change_password":  true,
+		"bind_email":       true,
 	}
 	if !validPurposes[purpose] {
-		return fmt.Errorf("验证码用途不正确，支持: register, login, forget_password, change_phone, change_phone_new, change_password")
+		return fmt.Errorf("验证码用途不正确，支持: register, login, forget_password, change_phone, change_phone_new, change_password, bind_email")
 	}
 	return nil
 }
 
+// ValidateEmailPurpose 验证邮件验证码用途，邮箱只用于登录、找回密码和绑定邮箱
+func ValidateEmailPurpose(purpose string) error {
+	switch strings.TrimSpace(purpose) {
+	case "login", "forget_password", "bind_email":
+		return nil
+	default:
+		return fmt.Errorf("邮箱验证码仅支持: login, forget_password, bind_email")
+	}
+}
+
 // ValidateNickname 验证昵称
 func ValidateNickname(nickname string) error {
 	if nickname == "" {
@@ -2,6 +2,7 @@ package validator
 
 import (
 	"fmt"
+	"time"
 
 	"SLGaming/back/services/gateway/internal/types"
 )
@@ -38,7 +39,7 @@ func ValidateLoginRequest(req *types.LoginRequest) error {
 
 // ValidateLoginByCodeRequest 验证验证码登录请求
 func ValidateLoginByCodeRequest(req *types.LoginByCodeRequest) error {
-	if err := ValidatePhone(req.Phone); err != nil {
+	if err := ValidatePhoneOrEmail(req.Phone, req.Email); err != nil {
 		return err
 	}
 	if err := ValidateCode(req.Code); err != nil {
@@ -49,18 +50,21 @@ func ValidateLoginByCodeRequest(req *types.LoginByCodeRequest) error {
 
 // ValidateSendCodeRequest 验证发送验证码请求
 func ValidateSendCodeRequest(req *types.SendCodeRequest) error {
-	if err := ValidatePhone(req.Phone); err != nil {
+	if err := ValidatePhoneOrEmail(req.Phone, req.Email); err != nil {
 		return err
 	}
 	if err := ValidatePurpose(req.Purpose); err != nil {
 		return err
 	}
+	if req.Phone == "" && req.Email != "" {
+		return ValidateEmailPurpose(req.Purpose)
+	}
 	return nil
 }
 
 // ValidateForgetPasswordRequest 验证忘记密码请求
 func ValidateForgetPasswordRequest(req *types.ForgetPasswordRequest) error {
-	if err := ValidatePhone(req.Phone); err != nil {
+	if err := ValidatePhoneOrEmail(req.Phone, req.Email); err != nil {
 		return err
 	}
 	if err := ValidateCode(req.Code); err != nil {
@@ -86,6 +90,17 @@ func ValidateChangePasswordRequest(req *types.ChangePasswordRequest) error {
 	return nil
 }
 
+// ValidateBindEmailRequest 验证绑定邮箱请求
+func ValidateBindEmailRequest(req *types.BindEmailRequest) error {
+	if err := ValidateEmail(req.Email); err != nil {
+		return err
+	}
+	if err := ValidateCode(req.Code); err != nil {
+		return err
+	}
+	return nil
+}
+
 // ValidateUpdateUserRequest 验证更新用户请求
 func ValidateUpdateUserRequest(req *types.UpdateUserRequest) error {
 	if req.Id == 0 {
@@ -106,5 +121,13 @@ func ValidateUpdateUserRequest(req *types.UpdateUserRequest) error {
 			return err
 		}
 	}
+	if req.Gender != 0 && req.Gender != 1 && req.Gender != 2 {
+		return fmt.Errorf("性别只能是 1（男）或 2（女）")
+	}
+	if req.Birthday != "" {
+		if _, err := time.Parse("2006-01-02", req.Birthday); err != nil {
+			return fmt.Errorf("生日格式错误，应为 YYYY-MM-DD")
+		}
+	}
 	return nil
 }
@@ -16,6 +16,7 @@ const (
 	OpCompleteOrder LogOperation = "complete_order"
 	OpStartOrder    LogOperation = "start_order"
 	OpRateOrder     LogOperation = "rate_order"
+	OpDisputeOrder  LogOperation = "dispute_order"
 	OpGetOrder      LogOperation = "get_order"
 	OpGetOrderList  LogOperation = "get_order_list"
 	OpDeleteOrder   LogOperation = "delete_order"
@@ -2,17 +2,21 @@ package logic
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strconv"
 	"time"
 
 	"SLGaming/back/pkg/lock"
 	"SLGaming/back/services/order/internal/helper"
 	"SLGaming/back/services/order/internal/metrics"
 	"SLGaming/back/services/order/internal/model"
+	orderMQ "SLGaming/back/services/order/internal/mq"
 	"SLGaming/back/services/order/internal/svc"
+	"SLGaming/back/services/order/internal/tx"
 	"SLGaming/back/services/order/order"
-	"SLGaming/back/services/user/userclient"
 
+	"github.com/apache/rocketmq-client-go/v2/primitive"
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -127,38 +131,56 @@ func (l *AcceptOrderLogic) doAcceptOrder(in *order.AcceptOrderRequest, start tim
 		return nil, status.Error(codes.FailedPrecondition, "order is not pending for accept")
 	}
 
-	now := time.Now()
-	o.Status = model.OrderStatusAccepted
-	o.AcceptedAt = &now
+	if l.svcCtx.OrderEventTxProducer == nil {
+		metrics.OrderAcceptTotal.WithLabelValues("producer_not_initialized").Inc()
+		metrics.OrderAcceptDuration.WithLabelValues().Observe(time.Since(start).Seconds())
+		return nil, status.Error(codes.FailedPrecondition, "order transaction producer not initialized")
+	}
 
-	if err := db.Save(&o).Error; err != nil {
-		helper.LogError(l.Logger, helper.OpAcceptOrder, "accept order failed", err, map[string]interface{}{
-			"order_id": in.GetOrderId(),
+	// 接单与接单事件通过事务消息保持一致：陪玩忙碌状态由用户服务根据接单/完成/取消事件维护
+	payload := &tx.OrderAcceptedPayload{
+		OrderID:     o.ID,
+		OrderNo:     o.OrderNo,
+		BossID:      o.BossID,
+		CompanionID: o.CompanionID,
+		AcceptedAt:  time.Now().Unix(),
+	}
+	msgBody, err := json.Marshal(payload)
+	if err != nil {
+		helper.LogError(l.Logger, helper.OpAcceptOrder, "marshal accepted payload failed", err, nil)
+		metrics.OrderAcceptTotal.WithLabelValues("marshal_failed").Inc()
+		metrics.OrderAcceptDuration.WithLabelValues().Observe(time.Since(start).Seconds())
+		return nil, status.Error(codes.Internal, "marshal accepted event failed")
+	}
+	msg := primitive.NewMessage(orderMQ.OrderEventTopic(), msgBody)
+	msg.WithTag(orderMQ.EventTypeAccepted())
+	msg.WithKeys([]string{strconv.FormatUint(o.ID, 10)})
+
+	txRes, err := l.svcCtx.OrderEventTxProducer.SendMessageInTransaction(l.ctx, msg)
+	if err != nil {
+		helper.LogError(l.Logger, helper.OpAcceptOrder, "send transactional message failed", err, map[string]interface{}{
+			"result": fmt.Sprintf("%+v", txRes),
 		})
-		metrics.OrderAcceptTotal.WithLabelValues("save_failed").Inc()
+		metrics.OrderAcceptTotal.WithLabelValues("tx_message_failed").Inc()
 		metrics.OrderAcceptDuration.WithLabelValues().Observe(time.Since(start).Seconds())
 		return nil, status.Error(codes.Internal, "accept order failed")
 	}
 
-	if l.svcCtx.UserRPC != nil {
-		_, err := l.svcCtx.UserRPC.UpdateCompanionProfile(l.ctx, &userclient.UpdateCompanionProfileRequest{
-			UserId: in.GetCompanionId(),
-			Status: 2,
+	// 本地事务可能因订单状态已变化而回滚，需要查询订单确认
+	if err := db.Where("id = ?", o.ID).First(&o).Error; err != nil {
+		helper.LogError(l.Logger, helper.OpAcceptOrder, "query order after transactional message failed", err, nil)
+		metrics.OrderAcceptTotal.WithLabelValues("query_failed").Inc()
+		metrics.OrderAcceptDuration.WithLabelValues().Observe(time.Since(start).Seconds())
+		return nil, status.Error(codes.Internal, "accept order failed")
+	}
+	if o.Status != model.OrderStatusAccepted {
+		helper.LogWarning(l.Logger, helper.OpAcceptOrder, "accept order transaction rolled back", map[string]interface{}{
+			"order_id": o.ID,
+			"status":   o.Status,
 		})
-		if err != nil {
-			if st, ok := status.FromError(err); ok {
-				helper.LogWarning(l.Logger, helper.OpAcceptOrder, "update companion status to busy failed", map[string]interface{}{
-					"companion_id": in.GetCompanionId(),
-					"code":         st.Code(),
-					"message":      st.Message(),
-				})
-			} else {
-				helper.LogWarning(l.Logger, helper.OpAcceptOrder, "update companion status to busy failed", map[string]interface{}{
-					"companion_id": in.GetCompanionId(),
-					"error":        err.Error(),
-				})
-			}
-		}
+		metrics.OrderAcceptTotal.WithLabelValues("tx_rolled_back").Inc()
+		metrics.OrderAcceptDuration.WithLabelValues().Observe(time.Since(start).Seconds())
+		return nil, status.Error(codes.FailedPrecondition, "order is not pending for accept")
 	}
 
 	metrics.OrderAcceptTotal.WithLabelValues("success").Inc()
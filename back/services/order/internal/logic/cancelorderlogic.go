@@ -210,6 +210,7 @@ func (l *CancelOrderLogic) doCancelOrder(in *order.CancelOrderRequest, start tim
 		BizOrderID:   o.OrderNo,
 		NeedRefund:   needRefund,
 		CancelReason: in.GetReason(),
+		OperatorID:   operatorID,
 	}
 
 	// 构造事务消息
@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"SLGaming/back/pkg/lock"
+	"SLGaming/back/pkg/rbac"
 	"SLGaming/back/services/order/internal/helper"
 	"SLGaming/back/services/order/internal/metrics"
 	"SLGaming/back/services/order/internal/model"
@@ -130,7 +131,7 @@ func (l *CompleteOrderLogic) doCompleteOrder(in *order.CompleteOrderRequest, sta
 			metrics.OrderCompleteDuration.WithLabelValues().Observe(time.Since(start).Seconds())
 			return nil, status.Error(codes.Internal, "get operator role failed")
 		}
-		if userResp.GetUser() == nil || userResp.GetUser().GetRole() != 3 {
+		if userResp.GetUser() == nil || !rbac.IsAdmin(userResp.GetUser().GetRole()) {
 			helper.LogWarning(l.Logger, helper.OpCompleteOrder, "permission denied: not boss or admin", map[string]interface{}{
 				"order_id":    in.GetOrderId(),
 				"operator_id": in.GetOperatorId(),
@@ -159,12 +160,14 @@ func (l *CompleteOrderLogic) doCompleteOrder(in *order.CompleteOrderRequest, sta
 	}
 
 	payload := &tx.OrderCompletedPayload{
-		OrderID:     o.ID,
-		OrderNo:     o.OrderNo,
-		BossID:      o.BossID,
-		CompanionID: o.CompanionID,
-		Amount:      o.TotalAmount,
-		BizOrderID:  o.OrderNo,
+		OrderID:           o.ID,
+		OrderNo:           o.OrderNo,
+		BossID:            o.BossID,
+		CompanionID:       o.CompanionID,
+		Amount:            o.TotalAmount,
+		BizOrderID:        o.OrderNo,
+		GameName:          o.GameName,
+		CommissionPercent: o.CommissionPercent,
 	}
 
 	msgBody, err := json.Marshal(payload)
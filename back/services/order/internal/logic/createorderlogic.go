@@ -112,7 +112,13 @@ func (l *CreateOrderLogic) CreateOrder(in *order.CreateOrderRequest) (*order.Cre
 
 // doCreateOrder 执行实际的订单创建逻辑（不加锁）
 func (l *CreateOrderLogic) doCreateOrder(in *order.CreateOrderRequest, start time.Time) (*order.CreateOrderResponse, error) {
-	// 1. 查询陪玩当前价格
+	// 0. 老板与陪玩任一方拉黑另一方后不能下单
+	if err := l.checkNotBlocked(in.GetBossId(), in.GetCompanionId()); err != nil {
+		metrics.OrderCreateDuration.WithLabelValues().Observe(time.Since(start).Seconds())
+		return nil, err
+	}
+
+	// 1. 查询陪玩当前价格（含各游戏技能）
 	cpResp, err := l.svcCtx.UserRPC.GetCompanionProfile(l.ctx, &userclient.GetCompanionProfileRequest{
 		UserId: in.GetCompanionId(),
 	})
@@ -130,17 +136,47 @@ func (l *CreateOrderLogic) doCreateOrder(in *order.CreateOrderRequest, start tim
 		return nil, status.Error(codes.FailedPrecondition, "companion profile not found")
 	}
 
+	// 按所选游戏的技能定价；尚未迁移技能表的陪玩沿用统一价格
 	pricePerHour := cpResp.Profile.PricePerHour
+	if skills := cpResp.Profile.GetSkills(); len(skills) > 0 {
+		offered := false
+		for _, sk := range skills {
+			if sk.GetGameName() == in.GetGameName() {
+				pricePerHour = sk.GetPricePerHour()
+				offered = true
+				break
+			}
+		}
+		if !offered {
+			metrics.OrderCreateTotal.WithLabelValues("game_not_offered").Inc()
+			metrics.OrderCreateDuration.WithLabelValues().Observe(time.Since(start).Seconds())
+			return nil, status.Error(codes.FailedPrecondition, "companion does not offer this game")
+		}
+	}
 	if pricePerHour <= 0 {
 		metrics.OrderCreateTotal.WithLabelValues("invalid_price").Inc()
 		metrics.OrderCreateDuration.WithLabelValues().Observe(time.Since(start).Seconds())
 		return nil, status.Error(codes.FailedPrecondition, "invalid companion price")
 	}
 
+	// 陪玩降级后原定价可能超过新等级的价格上限：保留陪玩的定价，超限期间不能下单，等陪玩重新定价或等级恢复
+	if maxPrice := cpResp.GetMaxPricePerHour(); maxPrice > 0 && pricePerHour > maxPrice {
+		metrics.OrderCreateTotal.WithLabelValues("price_over_tier_cap").Inc()
+		metrics.OrderCreateDuration.WithLabelValues().Observe(time.Since(start).Seconds())
+		return nil, status.Error(codes.FailedPrecondition, "companion price exceeds tier limit")
+	}
+
+	// 快照陪玩当前等级的平台抽成比例，结算时不受之后的等级变化影响
+	commissionPercent := cpResp.GetCommissionPercent()
+
 	// 金额按照小时计算
 	durationHours := in.GetDurationHours()
 	totalAmount := pricePerHour * int64(durationHours)
 
+	// 会员折扣：查询失败不影响下单，按原价处理
+	discountAmount := l.vipDiscount(in.GetBossId(), totalAmount)
+	totalAmount -= discountAmount
+
 	// 2. 检查老板钱包余额是否足够
 	walletResp, err := l.svcCtx.UserRPC.GetWallet(l.ctx, &userclient.GetWalletRequest{
 		UserId: in.GetBossId(),
@@ -183,14 +219,16 @@ func (l *CreateOrderLogic) doCreateOrder(in *order.CreateOrderRequest, start tim
 	}
 
 	payload := &tx.OrderPaymentPendingPayload{
-		OrderNo:       orderNo,
-		BossID:        in.GetBossId(),
-		Amount:        totalAmount,
-		BizOrderID:    orderNo,
-		CompanionID:   in.GetCompanionId(),
-		GameName:      in.GetGameName(),
-		DurationHours: durationHours,
-		PricePerHour:  pricePerHour,
+		OrderNo:           orderNo,
+		BossID:            in.GetBossId(),
+		Amount:            totalAmount,
+		BizOrderID:        orderNo,
+		CompanionID:       in.GetCompanionId(),
+		GameName:          in.GetGameName(),
+		DurationHours:     durationHours,
+		PricePerHour:      pricePerHour,
+		DiscountAmount:    discountAmount,
+		CommissionPercent: &commissionPercent,
 	}
 
 	// 构造事务消息
@@ -264,3 +302,43 @@ func (l *CreateOrderLogic) doCreateOrder(in *order.CreateOrderRequest, start tim
 
 	return &order.CreateOrderResponse{Order: toOrderInfo(&o)}, nil
 }
+
+// vipDiscount 根据老板的会员权益计算折扣减免金额
+// 会员服务不可用时返回 0（按原价下单），不阻断下单流程
+func (l *CreateOrderLogic) vipDiscount(bossID uint64, amount int64) int64 {
+	resp, err := l.svcCtx.UserRPC.GetVipEntitlements(l.ctx, &userclient.GetVipEntitlementsRequest{
+		UserId: bossID,
+	})
+	if err != nil {
+		helper.LogWarning(l.Logger, helper.OpCreateOrder, "get vip entitlements failed, fallback to original price", map[string]interface{}{
+			"boss_id": bossID,
+			"error":   err.Error(),
+		})
+		return 0
+	}
+	if resp == nil || !resp.IsVip || resp.DiscountPercent <= 0 || resp.DiscountPercent >= 100 {
+		return 0
+	}
+	return amount * int64(resp.DiscountPercent) / 100
+}
+
+// checkNotBlocked 检查老板与陪玩之间是否存在拉黑关系；查询失败时拒绝下单
+func (l *CreateOrderLogic) checkNotBlocked(bossID, companionID uint64) error {
+	resp, err := l.svcCtx.UserRPC.FilterBlockedUsers(l.ctx, &userclient.FilterBlockedUsersRequest{
+		UserId:  bossID,
+		UserIds: []uint64{companionID},
+	})
+	if err != nil {
+		helper.LogError(l.Logger, helper.OpCreateOrder, "check block relation failed", err, map[string]interface{}{
+			"boss_id":      bossID,
+			"companion_id": companionID,
+		})
+		metrics.OrderCreateTotal.WithLabelValues("block_check_failed").Inc()
+		return status.Error(codes.Internal, "check block relation failed")
+	}
+	if len(resp.GetBlockedUserIds()) > 0 {
+		metrics.OrderCreateTotal.WithLabelValues("blocked").Inc()
+		return status.Error(codes.PermissionDenied, "block relation exists between boss and companion")
+	}
+	return nil
+}
@@ -0,0 +1,127 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"SLGaming/back/services/order/internal/helper"
+	"SLGaming/back/services/order/internal/model"
+	orderMQ "SLGaming/back/services/order/internal/mq"
+	"SLGaming/back/services/order/internal/svc"
+	"SLGaming/back/services/order/internal/tx"
+	"SLGaming/back/services/order/order"
+
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// disputeWindow 订单完成后可发起纠纷的期限
+const disputeWindow = 7 * 24 * time.Hour
+
+type DisputeOrderLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewDisputeOrderLogic(ctx context.Context, svcCtx *svc.ServiceContext) *DisputeOrderLogic {
+	return &DisputeOrderLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// DisputeOrder 老板对已完成的订单发起纠纷：记录纠纷并通过事务消息通知用户服务计入陪玩的纠纷率
+func (l *DisputeOrderLogic) DisputeOrder(in *order.DisputeOrderRequest) (*order.DisputeOrderResponse, error) {
+	if in.GetOrderId() == 0 || in.GetBossId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "order_id and boss_id are required")
+	}
+	reason := strings.TrimSpace(in.GetReason())
+	if reason == "" {
+		return nil, status.Error(codes.InvalidArgument, "reason is required")
+	}
+	if len([]rune(reason)) > 255 {
+		return nil, status.Error(codes.InvalidArgument, "reason is too long")
+	}
+
+	db := l.svcCtx.DB.WithContext(l.ctx)
+
+	var o model.Order
+	if err := db.Where("id = ?", in.GetOrderId()).First(&o).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "order not found")
+		}
+		l.Errorf("get order failed: %v", err)
+		return nil, status.Error(codes.Internal, "get order failed")
+	}
+
+	// 只能由该订单的老板在完成后的期限内发起，每单一次
+	if o.BossID != in.GetBossId() {
+		return nil, status.Error(codes.PermissionDenied, "not allowed to dispute this order")
+	}
+	if o.Status != model.OrderStatusCompleted && o.Status != model.OrderStatusRated {
+		return nil, status.Error(codes.FailedPrecondition, "order is not completed")
+	}
+	if o.DisputedAt != nil {
+		return nil, status.Error(codes.AlreadyExists, "order already disputed")
+	}
+	if o.CompletedAt != nil && time.Since(*o.CompletedAt) > disputeWindow {
+		return nil, status.Error(codes.FailedPrecondition, "dispute window has passed")
+	}
+
+	if l.svcCtx.OrderEventTxProducer == nil {
+		return nil, status.Error(codes.FailedPrecondition, "order transaction producer not initialized")
+	}
+
+	msgBody, err := json.Marshal(&tx.OrderDisputedPayload{
+		OrderID:     o.ID,
+		OrderNo:     o.OrderNo,
+		BossID:      o.BossID,
+		CompanionID: o.CompanionID,
+		Reason:      reason,
+		DisputedAt:  time.Now().Unix(),
+	})
+	if err != nil {
+		helper.LogError(l.Logger, helper.OpDisputeOrder, "marshal disputed payload failed", err, nil)
+		return nil, status.Error(codes.Internal, "marshal disputed event failed")
+	}
+	msg := primitive.NewMessage(orderMQ.OrderEventTopic(), msgBody)
+	msg.WithTag(orderMQ.EventTypeDisputed())
+	msg.WithKeys([]string{strconv.FormatUint(o.ID, 10)})
+
+	txRes, err := l.svcCtx.OrderEventTxProducer.SendMessageInTransaction(l.ctx, msg)
+	if err != nil {
+		helper.LogError(l.Logger, helper.OpDisputeOrder, "send transactional message failed", err, map[string]interface{}{
+			"result": fmt.Sprintf("%+v", txRes),
+		})
+		return nil, status.Error(codes.Internal, "dispute order failed")
+	}
+
+	// 本地事务可能因并发发起纠纷而回滚，需要查询订单确认
+	if err := db.Where("id = ?", o.ID).First(&o).Error; err != nil {
+		helper.LogError(l.Logger, helper.OpDisputeOrder, "query order after transactional message failed", err, nil)
+		return nil, status.Error(codes.Internal, "dispute order failed")
+	}
+	if o.DisputedAt == nil {
+		return nil, status.Error(codes.Internal, "dispute order transaction rolled back")
+	}
+
+	helper.LogSuccess(l.Logger, helper.OpDisputeOrder, map[string]interface{}{
+		"order_id":     o.ID,
+		"order_no":     o.OrderNo,
+		"companion_id": o.CompanionID,
+		"boss_id":      o.BossID,
+	})
+
+	return &order.DisputeOrderResponse{
+		Order: toOrderInfo(&o),
+	}, nil
+}
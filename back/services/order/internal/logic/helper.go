@@ -15,7 +15,7 @@ func toOrderInfo(o *model.Order) *order.OrderInfo {
 		return nil
 	}
 
-	var paidAt, acceptedAt, startAt, completedAt, cancelledAt int64
+	var paidAt, acceptedAt, startAt, completedAt, cancelledAt, disputedAt int64
 	if o.PaidAt != nil {
 		paidAt = o.PaidAt.Unix()
 	}
@@ -31,26 +31,32 @@ func toOrderInfo(o *model.Order) *order.OrderInfo {
 	if o.CancelledAt != nil {
 		cancelledAt = o.CancelledAt.Unix()
 	}
+	if o.DisputedAt != nil {
+		disputedAt = o.DisputedAt.Unix()
+	}
 
 	return &order.OrderInfo{
-		Id:            o.ID,
-		OrderNo:       o.OrderNo,
-		BossId:        o.BossID,
-		CompanionId:   o.CompanionID,
-		GameName:      o.GameName,
-		DurationHours: o.DurationHours,
-		PricePerHour:  o.PricePerHour,
-		TotalAmount:   o.TotalAmount,
-		Status:        o.Status,
-		CreatedAt:     o.CreatedAt.Unix(),
-		PaidAt:        paidAt,
-		AcceptedAt:    acceptedAt,
-		StartAt:       startAt,
-		CompletedAt:   completedAt,
-		CancelledAt:   cancelledAt,
-		Rating:        o.Rating,
-		Comment:       o.Comment,
-		CancelReason:  o.CancelReason,
+		Id:             o.ID,
+		OrderNo:        o.OrderNo,
+		BossId:         o.BossID,
+		CompanionId:    o.CompanionID,
+		GameName:       o.GameName,
+		DurationHours:  o.DurationHours,
+		PricePerHour:   o.PricePerHour,
+		TotalAmount:    o.TotalAmount,
+		DiscountAmount: o.DiscountAmount,
+		Status:         o.Status,
+		CreatedAt:      o.CreatedAt.Unix(),
+		PaidAt:         paidAt,
+		AcceptedAt:     acceptedAt,
+		StartAt:        startAt,
+		CompletedAt:    completedAt,
+		CancelledAt:    cancelledAt,
+		Rating:         o.Rating,
+		Comment:        o.Comment,
+		CancelReason:   o.CancelReason,
+		DisputedAt:     disputedAt,
+		DisputeReason:  o.DisputeReason,
 	}
 }
 
@@ -0,0 +1,68 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"SLGaming/back/services/order/internal/model"
+	"SLGaming/back/services/order/internal/svc"
+	"SLGaming/back/services/order/order"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultFinishedOrdersLimit = 200
+	maxFinishedOrdersLimit     = 500
+)
+
+type ListFinishedOrdersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListFinishedOrdersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListFinishedOrdersLogic {
+	return &ListFinishedOrdersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListFinishedOrders 按订单 ID 游标分页返回窗口内结束的订单，供用户服务回填陪玩等级统计
+// 已接单的订单只有陪玩能取消，因此"接单后取消"即陪玩主动取消
+func (l *ListFinishedOrdersLogic) ListFinishedOrders(in *order.ListFinishedOrdersRequest) (*order.ListFinishedOrdersResponse, error) {
+	if in.GetSince() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "since is required")
+	}
+	limit := in.GetLimit()
+	if limit <= 0 {
+		limit = defaultFinishedOrdersLimit
+	}
+	if limit > maxFinishedOrdersLimit {
+		limit = maxFinishedOrdersLimit
+	}
+	since := time.Unix(in.GetSince(), 0)
+
+	var orders []model.Order
+	if err := l.svcCtx.DB.WithContext(l.ctx).
+		Where("id > ?", in.GetAfterId()).
+		Where("(status IN ? AND completed_at >= ?) OR (status IN ? AND accepted_at IS NOT NULL AND cancelled_at >= ?)",
+			[]int32{model.OrderStatusCompleted, model.OrderStatusRated}, since,
+			[]int32{model.OrderStatusCancelled, model.OrderStatusCancelRefunding}, since).
+		Order("id ASC").
+		Limit(int(limit)).
+		Find(&orders).Error; err != nil {
+		l.Errorf("list finished orders failed: %v", err)
+		return nil, status.Error(codes.Internal, "list finished orders failed")
+	}
+
+	list := make([]*order.OrderInfo, 0, len(orders))
+	for i := range orders {
+		list = append(list, toOrderInfo(&orders[i]))
+	}
+	return &order.ListFinishedOrdersResponse{Orders: list}, nil
+}
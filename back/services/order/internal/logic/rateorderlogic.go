@@ -2,18 +2,27 @@ package logic
 
 import (
 	"context"
+	"encoding/json"
+	"strconv"
+	"time"
 
+	"SLGaming/back/services/order/internal/helper"
 	"SLGaming/back/services/order/internal/model"
+	orderMQ "SLGaming/back/services/order/internal/mq"
 	"SLGaming/back/services/order/internal/svc"
 	"SLGaming/back/services/order/order"
 	"SLGaming/back/services/user/userclient"
 
+	"github.com/apache/rocketmq-client-go/v2/primitive"
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
 )
 
+// userStatusMuted 用户服务中的禁言状态
+const userStatusMuted = 1
+
 type RateOrderLogic struct {
 	ctx    context.Context
 	svcCtx *svc.ServiceContext
@@ -58,6 +67,11 @@ func (l *RateOrderLogic) RateOrder(in *order.RateOrderRequest) (*order.RateOrder
 		return nil, status.Error(codes.AlreadyExists, "order already rated")
 	}
 
+	// 禁言中的老板不能评价（订单本身已正常完成，不受影响）
+	if l.isMuted(in.GetBossId()) {
+		return nil, status.Error(codes.PermissionDenied, "user is muted")
+	}
+
 	o.Rating = in.GetRating()
 	o.Comment = in.GetComment()
 	o.Status = model.OrderStatusRated
@@ -73,6 +87,8 @@ func (l *RateOrderLogic) RateOrder(in *order.RateOrderRequest) (*order.RateOrder
 			UserId:      o.CompanionID,
 			DeltaOrders: 1,
 			NewRating:   in.GetRating(),
+			OrderId:     o.ID,
+			GameName:    o.GameName,
 		})
 		if err != nil {
 			if st, ok := status.FromError(err); ok {
@@ -83,7 +99,62 @@ func (l *RateOrderLogic) RateOrder(in *order.RateOrderRequest) (*order.RateOrder
 		}
 	}
 
+	l.publishRatedEvent(&o)
+
 	return &order.RateOrderResponse{
 		Order: toOrderInfo(&o),
 	}, nil
 }
+
+// publishRatedEvent 发送订单评价事件（评价已落库，发送失败只记录日志，粉丝动态中缺少这条评价）
+func (l *RateOrderLogic) publishRatedEvent(o *model.Order) {
+	if l.svcCtx.OrderEventProducer == nil {
+		return
+	}
+
+	body, err := json.Marshal(&orderMQ.OrderRatedPayload{
+		OrderID:     o.ID,
+		OrderNo:     o.OrderNo,
+		BossID:      o.BossID,
+		CompanionID: o.CompanionID,
+		GameName:    o.GameName,
+		Rating:      o.Rating,
+		Comment:     o.Comment,
+		RatedAt:     time.Now().Unix(),
+	})
+	if err != nil {
+		helper.LogError(l.Logger, helper.OpRateOrder, "marshal rated payload failed", err, map[string]interface{}{
+			"order_id": o.ID,
+		})
+		return
+	}
+
+	msg := primitive.NewMessage(orderMQ.OrderEventTopic(), body)
+	msg.WithTag(orderMQ.EventTypeRated())
+	msg.WithKeys([]string{strconv.FormatUint(o.ID, 10)})
+	if _, err := l.svcCtx.OrderEventProducer.SendSync(l.ctx, msg); err != nil {
+		helper.LogWarning(l.Logger, helper.OpRateOrder, "send rated event failed", map[string]interface{}{
+			"order_id":     o.ID,
+			"companion_id": o.CompanionID,
+			"error":        err.Error(),
+		})
+	}
+}
+
+// isMuted 查询用户是否处于禁言中（用户服务不可用时放行，不阻塞评价）
+func (l *RateOrderLogic) isMuted(userID uint64) bool {
+	if l.svcCtx.UserRPC == nil {
+		return false
+	}
+	resp, err := l.svcCtx.UserRPC.GetUser(l.ctx, &userclient.GetUserRequest{Id: userID})
+	if err != nil {
+		l.Errorf("get user status failed: user_id=%d, err=%v", userID, err)
+		return false
+	}
+	u := resp.GetUser()
+	if u == nil || u.GetStatus() != userStatusMuted {
+		return false
+	}
+	// 用户信息有缓存，按到期时间再判断一次
+	return u.GetStatusUntil() == 0 || u.GetStatusUntil() > time.Now().Unix()
+}
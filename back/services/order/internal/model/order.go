@@ -49,6 +49,10 @@ type Order struct {
 	// 金额信息（帅币）
 	PricePerHour int64 `gorm:"not null;default:0;comment:每小时价格(帅币)" json:"price_per_hour"`
 	TotalAmount  int64 `gorm:"not null;default:0;comment:订单总价(帅币)" json:"total_amount"`
+	// 会员折扣减免金额，TotalAmount 为折后实付金额
+	DiscountAmount int64 `gorm:"not null;default:0;comment:会员折扣减免(帅币)" json:"discount_amount"`
+	// 下单时快照的平台抽成百分比，结算按此比例扣除；快照上线前的订单为 NULL
+	CommissionPercent *int32 `gorm:"comment:平台抽成百分比(下单时快照)" json:"commission_percent"`
 
 	// 状态
 	Status int32 `gorm:"not null;index;comment:订单状态" json:"status"`
@@ -67,6 +71,10 @@ type Order struct {
 	// 取消信息
 	CancelReason string `gorm:"size:255;comment:取消原因" json:"cancel_reason"`
 
+	// 纠纷信息：老板在完成后一定期限内可发起一次纠纷，计入陪玩等级评估的纠纷率
+	DisputedAt    *time.Time `gorm:"index;comment:纠纷发起时间" json:"disputed_at"`
+	DisputeReason string     `gorm:"size:255;comment:纠纷原因" json:"dispute_reason"`
+
 	// 删除标记（软删除，双方独立控制）
 	BossDeletedAt      *time.Time `gorm:"index;comment:老板删除时间" json:"boss_deleted_at"`
 	CompanionDeletedAt *time.Time `gorm:"index;comment:陪玩删除时间" json:"companion_deleted_at"`
@@ -16,8 +16,25 @@ const (
 	eventTypePaymentPending = "ORDER_PAYMENT_PENDING"
 	eventTypeCancelled      = "ORDER_CANCELLED"
 	eventTypeCompleted      = "ORDER_COMPLETED"
+	eventTypeAccepted       = "ORDER_ACCEPTED"
+	eventTypeDisputed       = "ORDER_DISPUTED"
 )
 
+// 订单评价事件不走事务消息：由普通 Producer 发送，用户服务据此把新评价写入粉丝的关注动态
+const eventTypeRated = "ORDER_RATED"
+
+// OrderRatedPayload 订单评价事件负载
+type OrderRatedPayload struct {
+	OrderID     uint64  `json:"order_id"`
+	OrderNo     string  `json:"order_no"`
+	BossID      uint64  `json:"boss_id"`
+	CompanionID uint64  `json:"companion_id"`
+	GameName    string  `json:"game_name"`
+	Rating      float64 `json:"rating"`
+	Comment     string  `json:"comment"`
+	RatedAt     int64   `json:"rated_at"`
+}
+
 // OrderEventTopic 返回订单事件主题
 func OrderEventTopic() string {
 	return orderEventTopic
@@ -33,6 +50,21 @@ func EventTypeCancelled() string {
 	return eventTypeCancelled
 }
 
+// EventTypeAccepted 返回订单接单事件类型
+func EventTypeAccepted() string {
+	return eventTypeAccepted
+}
+
+// EventTypeDisputed 返回订单纠纷事件类型
+func EventTypeDisputed() string {
+	return eventTypeDisputed
+}
+
+// EventTypeRated 返回订单评价事件类型
+func EventTypeRated() string {
+	return eventTypeRated
+}
+
 // EventTypeCompleted 返回订单完成事件类型
 func EventTypeCompleted() string {
 	return eventTypeCompleted
@@ -68,6 +100,16 @@ func ExecuteOrderTx(ctx context.Context, db *gorm.DB, msg *primitive.Message) pr
 			return primitive.RollbackMessageState
 		}
 		return primitive.CommitMessageState
+	case eventTypeAccepted:
+		var payload tx.OrderAcceptedPayload
+		if err := json.Unmarshal(msg.Body, &payload); err != nil {
+			logx.Errorf("ExecuteOrderTx: unmarshal accepted payload failed: %v, body=%s", err, string(msg.Body))
+			return primitive.RollbackMessageState
+		}
+		if err := tx.ExecuteAcceptOrderTx(ctx, db, &payload); err != nil {
+			return primitive.RollbackMessageState
+		}
+		return primitive.CommitMessageState
 	case eventTypeCompleted:
 		var payload tx.OrderCompletedPayload
 		if err := json.Unmarshal(msg.Body, &payload); err != nil {
@@ -78,6 +120,16 @@ func ExecuteOrderTx(ctx context.Context, db *gorm.DB, msg *primitive.Message) pr
 			return primitive.RollbackMessageState
 		}
 		return primitive.CommitMessageState
+	case eventTypeDisputed:
+		var payload tx.OrderDisputedPayload
+		if err := json.Unmarshal(msg.Body, &payload); err != nil {
+			logx.Errorf("ExecuteOrderTx: unmarshal disputed payload failed: %v, body=%s", err, string(msg.Body))
+			return primitive.RollbackMessageState
+		}
+		if err := tx.ExecuteDisputeOrderTx(ctx, db, &payload); err != nil {
+			return primitive.RollbackMessageState
+		}
+		return primitive.CommitMessageState
 	default:
 		logx.Errorf("ExecuteOrderTx: unknown event type: %s", eventType)
 		return primitive.RollbackMessageState
@@ -120,6 +172,20 @@ func CheckOrderTx(ctx context.Context, db *gorm.DB, msg *primitive.Message) prim
 			return primitive.CommitMessageState
 		}
 		return primitive.RollbackMessageState
+	case eventTypeAccepted:
+		var payload tx.OrderAcceptedPayload
+		if err := json.Unmarshal(msg.Body, &payload); err != nil {
+			logx.Errorf("CheckOrderTx: unmarshal accepted payload failed: %v, body=%s", err, string(msg.Body))
+			return primitive.UnknowState
+		}
+		ok, err := tx.CheckAcceptOrderTx(ctx, db, &payload)
+		if err != nil {
+			return primitive.UnknowState
+		}
+		if ok {
+			return primitive.CommitMessageState
+		}
+		return primitive.RollbackMessageState
 	case eventTypeCompleted:
 		var payload tx.OrderCompletedPayload
 		if err := json.Unmarshal(msg.Body, &payload); err != nil {
@@ -134,6 +200,20 @@ func CheckOrderTx(ctx context.Context, db *gorm.DB, msg *primitive.Message) prim
 			return primitive.CommitMessageState
 		}
 		return primitive.RollbackMessageState
+	case eventTypeDisputed:
+		var payload tx.OrderDisputedPayload
+		if err := json.Unmarshal(msg.Body, &payload); err != nil {
+			logx.Errorf("CheckOrderTx: unmarshal disputed payload failed: %v, body=%s", err, string(msg.Body))
+			return primitive.UnknowState
+		}
+		ok, err := tx.CheckDisputeOrderTx(ctx, db, &payload)
+		if err != nil {
+			return primitive.UnknowState
+		}
+		if ok {
+			return primitive.CommitMessageState
+		}
+		return primitive.RollbackMessageState
 	default:
 		logx.Errorf("CheckOrderTx: unknown event type: %s", eventType)
 		return primitive.UnknowState
@@ -54,6 +54,11 @@ func (s *OrderServer) RateOrder(ctx context.Context, in *order.RateOrderRequest)
 	return l.RateOrder(in)
 }
 
+func (s *OrderServer) DisputeOrder(ctx context.Context, in *order.DisputeOrderRequest) (*order.DisputeOrderResponse, error) {
+	l := logic.NewDisputeOrderLogic(ctx, s.svcCtx)
+	return l.DisputeOrder(in)
+}
+
 // 查询相关
 func (s *OrderServer) GetOrder(ctx context.Context, in *order.GetOrderRequest) (*order.GetOrderResponse, error) {
 	l := logic.NewGetOrderLogic(ctx, s.svcCtx)
@@ -65,10 +70,13 @@ func (s *OrderServer) GetOrderList(ctx context.Context, in *order.GetOrderListRe
 	return l.GetOrderList(in)
 }
 
+func (s *OrderServer) ListFinishedOrders(ctx context.Context, in *order.ListFinishedOrdersRequest) (*order.ListFinishedOrdersResponse, error) {
+	l := logic.NewListFinishedOrdersLogic(ctx, s.svcCtx)
+	return l.ListFinishedOrders(in)
+}
+
 // 删除订单
 func (s *OrderServer) DeleteOrder(ctx context.Context, in *order.DeleteOrderRequest) (*order.DeleteOrderResponse, error) {
 	l := logic.NewDeleteOrderLogic(ctx, s.svcCtx)
 	return l.DeleteOrder(in)
 }
-
-// 获取指定陪玩的订单评价列表（仅返回已评价订单）
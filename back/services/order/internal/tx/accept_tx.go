@@ -0,0 +1,75 @@
+package tx
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"SLGaming/back/services/order/internal/model"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// ErrOrderNotAcceptable 订单已不处于待接单状态（并发取消/重复接单），本地事务需回滚
+var ErrOrderNotAcceptable = errors.New("order is not pending for accept")
+
+// OrderAcceptedPayload 订单接单事件负载，用户服务据此统计陪玩进行中的订单数并维护忙碌状态
+type OrderAcceptedPayload struct {
+	OrderID     uint64 `json:"order_id"`
+	OrderNo     string `json:"order_no"`
+	BossID      uint64 `json:"boss_id"`
+	CompanionID uint64 `json:"companion_id"`
+	AcceptedAt  int64  `json:"accepted_at"`
+}
+
+// ExecuteAcceptOrderTx 在本地事务中更新订单状态为已接单（ACCEPTED）
+// 只有仍处于待接单状态的订单才会被更新，返回 error 为 nil 表示事务可提交；非 nil 表示需要回滚事务消息。
+func ExecuteAcceptOrderTx(ctx context.Context, db *gorm.DB, p *OrderAcceptedPayload) error {
+	if db == nil || p == nil {
+		return gorm.ErrInvalidDB
+	}
+	if p.OrderNo == "" {
+		logx.Errorf("ExecuteAcceptOrderTx: invalid payload: order_no is empty")
+		return gorm.ErrInvalidData
+	}
+
+	acceptedAt := time.Unix(p.AcceptedAt, 0)
+
+	res := db.WithContext(ctx).Model(&model.Order{}).
+		Where("order_no = ? AND companion_id = ? AND status IN ?", p.OrderNo, p.CompanionID,
+			[]int32{model.OrderStatusPaid, model.OrderStatusCreated}).
+		Updates(map[string]interface{}{
+			"status":      model.OrderStatusAccepted,
+			"accepted_at": acceptedAt,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrOrderNotAcceptable
+	}
+	return nil
+}
+
+// CheckAcceptOrderTx 事务回查：订单已记录接单时间即认为本地事务成功（之后可能已进入服务/完成/取消）。
+// 返回 (true, nil) 表示应提交消息；(false, nil) 表示应回滚；error 表示保持 UNKNOW。
+func CheckAcceptOrderTx(ctx context.Context, db *gorm.DB, p *OrderAcceptedPayload) (bool, error) {
+	if db == nil || p == nil {
+		return false, gorm.ErrInvalidDB
+	}
+	if p.OrderNo == "" {
+		return false, nil
+	}
+
+	var o model.Order
+	if err := db.WithContext(ctx).Where("order_no = ?", p.OrderNo).First(&o).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return false, nil
+		}
+		logx.Errorf("CheckAcceptOrderTx: query order failed: %v", err)
+		return false, err
+	}
+
+	return o.AcceptedAt != nil, nil
+}
@@ -22,6 +22,7 @@ type OrderCancelledPayload struct {
 	// 扩展字段：用于在本地事务中更新订单状态
 	NeedRefund   bool   `json:"need_refund"`   // 是否需要退款
 	CancelReason string `json:"cancel_reason"` // 取消原因
+	OperatorID   uint64 `json:"operator_id"`   // 取消操作人（用户服务据此统计陪玩取消率）
 }
 
 // ExecuteCancelOrderTx 在本地事务中更新订单状态为取消中（CANCEL_REFUNDING）或已取消（CANCELLED）
@@ -18,6 +18,9 @@ type OrderCompletedPayload struct {
 	CompanionID uint64 `json:"companion_id"`
 	Amount      int64  `json:"amount"`
 	BizOrderID  string `json:"biz_order_id"`
+	GameName    string `json:"game_name"`
+	// 下单时快照的平台抽成百分比，快照上线前的订单为空（用户服务按不抽成结算）
+	CommissionPercent *int32 `json:"commission_percent,omitempty"`
 }
 
 // ExecuteCompleteOrderTx 在本地事务中更新订单状态为已完成（COMPLETED）
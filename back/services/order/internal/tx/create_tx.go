@@ -23,6 +23,10 @@ type OrderPaymentPendingPayload struct {
 	GameName      string `json:"game_name"`
 	DurationHours int32  `json:"duration_hours"`
 	PricePerHour  int64  `json:"price_per_hour"`
+	// 会员折扣减免金额，Amount 为折后实付金额
+	DiscountAmount int64 `json:"discount_amount"`
+	// 陪玩当前等级的平台抽成百分比（下单时快照）
+	CommissionPercent *int32 `json:"commission_percent,omitempty"`
 }
 
 // ExecuteCreateOrderTx 在本地事务中创建订单记录，如果订单已存在则幂等返回。
@@ -52,14 +56,16 @@ func ExecuteCreateOrderTx(ctx context.Context, db *gorm.DB, p *OrderPaymentPendi
 		}
 
 		o := &model.Order{
-			BossID:        p.BossID,
-			CompanionID:   p.CompanionID,
-			GameName:      p.GameName,
-			DurationHours: p.DurationHours,
-			PricePerHour:  p.PricePerHour,
-			TotalAmount:   p.Amount,
-			Status:        model.OrderStatusCreated,
-			OrderNo:       p.OrderNo,
+			BossID:            p.BossID,
+			CompanionID:       p.CompanionID,
+			GameName:          p.GameName,
+			DurationHours:     p.DurationHours,
+			PricePerHour:      p.PricePerHour,
+			TotalAmount:       p.Amount,
+			DiscountAmount:    p.DiscountAmount,
+			CommissionPercent: p.CommissionPercent,
+			Status:            model.OrderStatusCreated,
+			OrderNo:           p.OrderNo,
 		}
 
 		if err := tx.Create(o).Error; err != nil {
@@ -0,0 +1,74 @@
+package tx
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"SLGaming/back/services/order/internal/model"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// ErrOrderNotDisputable 订单已发起过纠纷或不再处于可发起纠纷的状态，本地事务需回滚
+var ErrOrderNotDisputable = errors.New("order is not disputable")
+
+// OrderDisputedPayload 订单纠纷事件负载，用户服务据此统计陪玩的纠纷率
+type OrderDisputedPayload struct {
+	OrderID     uint64 `json:"order_id"`
+	OrderNo     string `json:"order_no"`
+	BossID      uint64 `json:"boss_id"`
+	CompanionID uint64 `json:"companion_id"`
+	Reason      string `json:"reason"`
+	DisputedAt  int64  `json:"disputed_at"`
+}
+
+// ExecuteDisputeOrderTx 在本地事务中记录订单纠纷（每单一次）
+// 返回 error 为 nil 表示事务可提交；非 nil 表示需要回滚事务消息。
+func ExecuteDisputeOrderTx(ctx context.Context, db *gorm.DB, p *OrderDisputedPayload) error {
+	if db == nil || p == nil {
+		return gorm.ErrInvalidDB
+	}
+	if p.OrderNo == "" {
+		logx.Errorf("ExecuteDisputeOrderTx: invalid payload: order_no is empty")
+		return gorm.ErrInvalidData
+	}
+
+	res := db.WithContext(ctx).Model(&model.Order{}).
+		Where("order_no = ? AND boss_id = ? AND disputed_at IS NULL AND status IN ?", p.OrderNo, p.BossID,
+			[]int32{model.OrderStatusCompleted, model.OrderStatusRated}).
+		Updates(map[string]interface{}{
+			"disputed_at":    time.Unix(p.DisputedAt, 0),
+			"dispute_reason": p.Reason,
+		})
+	if res.Error != nil {
+		return res.Error
+	}
+	if res.RowsAffected == 0 {
+		return ErrOrderNotDisputable
+	}
+	return nil
+}
+
+// CheckDisputeOrderTx 事务回查：订单已记录纠纷时间即认为本地事务成功。
+// 返回 (true, nil) 表示应提交消息；(false, nil) 表示应回滚；error 表示保持 UNKNOW。
+func CheckDisputeOrderTx(ctx context.Context, db *gorm.DB, p *OrderDisputedPayload) (bool, error) {
+	if db == nil || p == nil {
+		return false, gorm.ErrInvalidDB
+	}
+	if p.OrderNo == "" {
+		return false, nil
+	}
+
+	var o model.Order
+	if err := db.WithContext(ctx).Where("order_no = ?", p.OrderNo).First(&o).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return false, nil
+		}
+		logx.Errorf("CheckDisputeOrderTx: query order failed: %v", err)
+		return false, err
+	}
+
+	return o.DisputedAt != nil, nil
+}
@@ -44,7 +44,12 @@ type OrderInfo struct {
 	Rating  float64 `protobuf:"fixed64,16,opt,name=rating,proto3" json:"rating,omitempty"` // 老板对陪玩的评分（0-5）
 	Comment string  `protobuf:"bytes,17,opt,name=comment,proto3" json:"comment,omitempty"` // 评价内容
 	// 取消相关
-	CancelReason  string `protobuf:"bytes,18,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"` // 取消原因
+	CancelReason string `protobuf:"bytes,18,opt,name=cancel_reason,json=cancelReason,proto3" json:"cancel_reason,omitempty"` // 取消原因
+	// 优惠相关
+	DiscountAmount int64 `protobuf:"varint,19,opt,name=discount_amount,json=discountAmount,proto3" json:"discount_amount,omitempty"` // 会员折扣减免金额（帅币），total_amount 为折后实付
+	// 纠纷相关
+	DisputedAt    int64  `protobuf:"varint,20,opt,name=disputed_at,json=disputedAt,proto3" json:"disputed_at,omitempty"`         // 老板发起纠纷的时间（0 表示没有纠纷）
+	DisputeReason string `protobuf:"bytes,21,opt,name=dispute_reason,json=disputeReason,proto3" json:"dispute_reason,omitempty"` // 纠纷原因
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -205,6 +210,27 @@ func (x *OrderInfo) GetCancelReason() string {
 	return ""
 }
 
+func (x *OrderInfo) GetDiscountAmount() int64 {
+	if x != nil {
+		return x.DiscountAmount
+	}
+	return 0
+}
+
+func (x *OrderInfo) GetDisputedAt() int64 {
+	if x != nil {
+		return x.DisputedAt
+	}
+	return 0
+}
+
+func (x *OrderInfo) GetDisputeReason() string {
+	if x != nil {
+		return x.DisputeReason
+	}
+	return ""
+}
+
 // CreateOrderRequest 老板创建订单
 // 前提：前端已选定陪玩、游戏和时长
 type CreateOrderRequest struct {
@@ -828,6 +854,111 @@ func (x *RateOrderResponse) GetOrder() *OrderInfo {
 	return nil
 }
 
+// DisputeOrderRequest 老板对已完成的订单发起纠纷（完成后一定期限内，每单一次），计入陪玩等级评估的纠纷率
+type DisputeOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       uint64                 `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	BossId        uint64                 `protobuf:"varint,2,opt,name=boss_id,json=bossId,proto3" json:"boss_id,omitempty"` // 老板ID
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`                // 纠纷原因（必填）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisputeOrderRequest) Reset() {
+	*x = DisputeOrderRequest{}
+	mi := &file_order_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisputeOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisputeOrderRequest) ProtoMessage() {}
+
+func (x *DisputeOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisputeOrderRequest.ProtoReflect.Descriptor instead.
+func (*DisputeOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DisputeOrderRequest) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *DisputeOrderRequest) GetBossId() uint64 {
+	if x != nil {
+		return x.BossId
+	}
+	return 0
+}
+
+func (x *DisputeOrderRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type DisputeOrderResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Order         *OrderInfo             `protobuf:"bytes,1,opt,name=order,proto3" json:"order,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisputeOrderResponse) Reset() {
+	*x = DisputeOrderResponse{}
+	mi := &file_order_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisputeOrderResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisputeOrderResponse) ProtoMessage() {}
+
+func (x *DisputeOrderResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisputeOrderResponse.ProtoReflect.Descriptor instead.
+func (*DisputeOrderResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *DisputeOrderResponse) GetOrder() *OrderInfo {
+	if x != nil {
+		return x.Order
+	}
+	return nil
+}
+
 // DeleteOrderRequest 删除订单（软删除）
 type DeleteOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -839,7 +970,7 @@ type DeleteOrderRequest struct {
 
 func (x *DeleteOrderRequest) Reset() {
 	*x = DeleteOrderRequest{}
-	mi := &file_order_proto_msgTypes[13]
+	mi := &file_order_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -851,7 +982,7 @@ func (x *DeleteOrderRequest) String() string {
 func (*DeleteOrderRequest) ProtoMessage() {}
 
 func (x *DeleteOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[13]
+	mi := &file_order_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -864,7 +995,7 @@ func (x *DeleteOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteOrderRequest.ProtoReflect.Descriptor instead.
 func (*DeleteOrderRequest) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{13}
+	return file_order_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *DeleteOrderRequest) GetOrderId() uint64 {
@@ -890,7 +1021,7 @@ type DeleteOrderResponse struct {
 
 func (x *DeleteOrderResponse) Reset() {
 	*x = DeleteOrderResponse{}
-	mi := &file_order_proto_msgTypes[14]
+	mi := &file_order_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -902,7 +1033,7 @@ func (x *DeleteOrderResponse) String() string {
 func (*DeleteOrderResponse) ProtoMessage() {}
 
 func (x *DeleteOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[14]
+	mi := &file_order_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -915,7 +1046,7 @@ func (x *DeleteOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteOrderResponse.ProtoReflect.Descriptor instead.
 func (*DeleteOrderResponse) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{14}
+	return file_order_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *DeleteOrderResponse) GetSuccess() bool {
@@ -936,7 +1067,7 @@ type GetOrderRequest struct {
 
 func (x *GetOrderRequest) Reset() {
 	*x = GetOrderRequest{}
-	mi := &file_order_proto_msgTypes[15]
+	mi := &file_order_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -948,7 +1079,7 @@ func (x *GetOrderRequest) String() string {
 func (*GetOrderRequest) ProtoMessage() {}
 
 func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[15]
+	mi := &file_order_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -961,7 +1092,7 @@ func (x *GetOrderRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderRequest.ProtoReflect.Descriptor instead.
 func (*GetOrderRequest) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{15}
+	return file_order_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetOrderRequest) GetId() uint64 {
@@ -994,7 +1125,7 @@ type GetOrderResponse struct {
 
 func (x *GetOrderResponse) Reset() {
 	*x = GetOrderResponse{}
-	mi := &file_order_proto_msgTypes[16]
+	mi := &file_order_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1006,7 +1137,7 @@ func (x *GetOrderResponse) String() string {
 func (*GetOrderResponse) ProtoMessage() {}
 
 func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[16]
+	mi := &file_order_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1019,7 +1150,7 @@ func (x *GetOrderResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderResponse.ProtoReflect.Descriptor instead.
 func (*GetOrderResponse) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{16}
+	return file_order_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *GetOrderResponse) GetOrder() *OrderInfo {
@@ -1043,7 +1174,7 @@ type GetOrderListRequest struct {
 
 func (x *GetOrderListRequest) Reset() {
 	*x = GetOrderListRequest{}
-	mi := &file_order_proto_msgTypes[17]
+	mi := &file_order_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1055,7 +1186,7 @@ func (x *GetOrderListRequest) String() string {
 func (*GetOrderListRequest) ProtoMessage() {}
 
 func (x *GetOrderListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[17]
+	mi := &file_order_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1068,7 +1199,7 @@ func (x *GetOrderListRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderListRequest.ProtoReflect.Descriptor instead.
 func (*GetOrderListRequest) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{17}
+	return file_order_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *GetOrderListRequest) GetBossId() uint64 {
@@ -1118,7 +1249,7 @@ type GetOrderListResponse struct {
 
 func (x *GetOrderListResponse) Reset() {
 	*x = GetOrderListResponse{}
-	mi := &file_order_proto_msgTypes[18]
+	mi := &file_order_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1130,7 +1261,7 @@ func (x *GetOrderListResponse) String() string {
 func (*GetOrderListResponse) ProtoMessage() {}
 
 func (x *GetOrderListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_proto_msgTypes[18]
+	mi := &file_order_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1143,7 +1274,7 @@ func (x *GetOrderListResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetOrderListResponse.ProtoReflect.Descriptor instead.
 func (*GetOrderListResponse) Descriptor() ([]byte, []int) {
-	return file_order_proto_rawDescGZIP(), []int{18}
+	return file_order_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *GetOrderListResponse) GetOrders() []*OrderInfo {
@@ -1174,11 +1305,117 @@ func (x *GetOrderListResponse) GetPageSize() int32 {
 	return 0
 }
 
+// ListFinishedOrdersRequest 按订单 ID 游标分页查询窗口内结束的订单（用户服务回填陪玩等级统计）
+// 返回已完成/已评价（completed_at >= since）与接单后被取消（cancelled_at >= since）的订单，包含双方已删除的订单
+type ListFinishedOrdersRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Since         int64                  `protobuf:"varint,1,opt,name=since,proto3" json:"since,omitempty"`                    // 窗口起点（时间戳，单位：秒）
+	AfterId       uint64                 `protobuf:"varint,2,opt,name=after_id,json=afterId,proto3" json:"after_id,omitempty"` // 游标：只返回 ID 大于该值的订单（首次传 0）
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`                    // 每页数量（最大 500）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFinishedOrdersRequest) Reset() {
+	*x = ListFinishedOrdersRequest{}
+	mi := &file_order_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFinishedOrdersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFinishedOrdersRequest) ProtoMessage() {}
+
+func (x *ListFinishedOrdersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFinishedOrdersRequest.ProtoReflect.Descriptor instead.
+func (*ListFinishedOrdersRequest) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListFinishedOrdersRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ListFinishedOrdersRequest) GetAfterId() uint64 {
+	if x != nil {
+		return x.AfterId
+	}
+	return 0
+}
+
+func (x *ListFinishedOrdersRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type ListFinishedOrdersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*OrderInfo           `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"` // 按 ID 升序
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListFinishedOrdersResponse) Reset() {
+	*x = ListFinishedOrdersResponse{}
+	mi := &file_order_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListFinishedOrdersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFinishedOrdersResponse) ProtoMessage() {}
+
+func (x *ListFinishedOrdersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFinishedOrdersResponse.ProtoReflect.Descriptor instead.
+func (*ListFinishedOrdersResponse) Descriptor() ([]byte, []int) {
+	return file_order_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListFinishedOrdersResponse) GetOrders() []*OrderInfo {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
 var File_order_proto protoreflect.FileDescriptor
 
 const file_order_proto_rawDesc = "" +
 	"\n" +
-	"\vorder.proto\x12\x05order\"\xa8\x04\n" +
+	"\vorder.proto\x12\x05order\"\x99\x05\n" +
 	"\tOrderInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x19\n" +
 	"\border_no\x18\x02 \x01(\tR\aorderNo\x12\x17\n" +
@@ -1200,7 +1437,11 @@ const file_order_proto_rawDesc = "" +
 	"\fcancelled_at\x18\x0f \x01(\x03R\vcancelledAt\x12\x16\n" +
 	"\x06rating\x18\x10 \x01(\x01R\x06rating\x12\x18\n" +
 	"\acomment\x18\x11 \x01(\tR\acomment\x12#\n" +
-	"\rcancel_reason\x18\x12 \x01(\tR\fcancelReason\"\x94\x01\n" +
+	"\rcancel_reason\x18\x12 \x01(\tR\fcancelReason\x12'\n" +
+	"\x0fdiscount_amount\x18\x13 \x01(\x03R\x0ediscountAmount\x12\x1f\n" +
+	"\vdisputed_at\x18\x14 \x01(\x03R\n" +
+	"disputedAt\x12%\n" +
+	"\x0edispute_reason\x18\x15 \x01(\tR\rdisputeReason\"\x94\x01\n" +
 	"\x12CreateOrderRequest\x12\x17\n" +
 	"\aboss_id\x18\x01 \x01(\x04R\x06bossId\x12!\n" +
 	"\fcompanion_id\x18\x02 \x01(\x04R\vcompanionId\x12\x1b\n" +
@@ -1237,6 +1478,12 @@ const file_order_proto_rawDesc = "" +
 	"\x06rating\x18\x03 \x01(\x01R\x06rating\x12\x18\n" +
 	"\acomment\x18\x04 \x01(\tR\acomment\";\n" +
 	"\x11RateOrderResponse\x12&\n" +
+	"\x05order\x18\x01 \x01(\v2\x10.order.OrderInfoR\x05order\"a\n" +
+	"\x13DisputeOrderRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x04R\aorderId\x12\x17\n" +
+	"\aboss_id\x18\x02 \x01(\x04R\x06bossId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\">\n" +
+	"\x14DisputeOrderResponse\x12&\n" +
 	"\x05order\x18\x01 \x01(\v2\x10.order.OrderInfoR\x05order\"P\n" +
 	"\x12DeleteOrderRequest\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\x04R\aorderId\x12\x1f\n" +
@@ -1261,7 +1508,13 @@ const file_order_proto_rawDesc = "" +
 	"\x06orders\x18\x01 \x03(\v2\x10.order.OrderInfoR\x06orders\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize2\xf4\x04\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"b\n" +
+	"\x19ListFinishedOrdersRequest\x12\x14\n" +
+	"\x05since\x18\x01 \x01(\x03R\x05since\x12\x19\n" +
+	"\bafter_id\x18\x02 \x01(\x04R\aafterId\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"F\n" +
+	"\x1aListFinishedOrdersResponse\x12(\n" +
+	"\x06orders\x18\x01 \x03(\v2\x10.order.OrderInfoR\x06orders2\x98\x06\n" +
 	"\x05Order\x12D\n" +
 	"\vCreateOrder\x12\x19.order.CreateOrderRequest\x1a\x1a.order.CreateOrderResponse\x12D\n" +
 	"\vAcceptOrder\x12\x19.order.AcceptOrderRequest\x1a\x1a.order.AcceptOrderResponse\x12A\n" +
@@ -1269,9 +1522,11 @@ const file_order_proto_rawDesc = "" +
 	"StartOrder\x12\x18.order.StartOrderRequest\x1a\x19.order.StartOrderResponse\x12J\n" +
 	"\rCompleteOrder\x12\x1b.order.CompleteOrderRequest\x1a\x1c.order.CompleteOrderResponse\x12D\n" +
 	"\vCancelOrder\x12\x19.order.CancelOrderRequest\x1a\x1a.order.CancelOrderResponse\x12>\n" +
-	"\tRateOrder\x12\x17.order.RateOrderRequest\x1a\x18.order.RateOrderResponse\x12;\n" +
+	"\tRateOrder\x12\x17.order.RateOrderRequest\x1a\x18.order.RateOrderResponse\x12G\n" +
+	"\fDisputeOrder\x12\x1a.order.DisputeOrderRequest\x1a\x1b.order.DisputeOrderResponse\x12;\n" +
 	"\bGetOrder\x12\x16.order.GetOrderRequest\x1a\x17.order.GetOrderResponse\x12G\n" +
-	"\fGetOrderList\x12\x1a.order.GetOrderListRequest\x1a\x1b.order.GetOrderListResponse\x12D\n" +
+	"\fGetOrderList\x12\x1a.order.GetOrderListRequest\x1a\x1b.order.GetOrderListResponse\x12Y\n" +
+	"\x12ListFinishedOrders\x12 .order.ListFinishedOrdersRequest\x1a!.order.ListFinishedOrdersResponse\x12D\n" +
 	"\vDeleteOrder\x12\x19.order.DeleteOrderRequest\x1a\x1a.order.DeleteOrderResponseB\tZ\a./orderb\x06proto3"
 
 var (
@@ -1286,27 +1541,31 @@ func file_order_proto_rawDescGZIP() []byte {
 	return file_order_proto_rawDescData
 }
 
-var file_order_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
+var file_order_proto_msgTypes = make([]protoimpl.MessageInfo, 23)
 var file_order_proto_goTypes = []any{
-	(*OrderInfo)(nil),             // 0: order.OrderInfo
-	(*CreateOrderRequest)(nil),    // 1: order.CreateOrderRequest
-	(*CreateOrderResponse)(nil),   // 2: order.CreateOrderResponse
-	(*AcceptOrderRequest)(nil),    // 3: order.AcceptOrderRequest
-	(*AcceptOrderResponse)(nil),   // 4: order.AcceptOrderResponse
-	(*StartOrderRequest)(nil),     // 5: order.StartOrderRequest
-	(*StartOrderResponse)(nil),    // 6: order.StartOrderResponse
-	(*CompleteOrderRequest)(nil),  // 7: order.CompleteOrderRequest
-	(*CompleteOrderResponse)(nil), // 8: order.CompleteOrderResponse
-	(*CancelOrderRequest)(nil),    // 9: order.CancelOrderRequest
-	(*CancelOrderResponse)(nil),   // 10: order.CancelOrderResponse
-	(*RateOrderRequest)(nil),      // 11: order.RateOrderRequest
-	(*RateOrderResponse)(nil),     // 12: order.RateOrderResponse
-	(*DeleteOrderRequest)(nil),    // 13: order.DeleteOrderRequest
-	(*DeleteOrderResponse)(nil),   // 14: order.DeleteOrderResponse
-	(*GetOrderRequest)(nil),       // 15: order.GetOrderRequest
-	(*GetOrderResponse)(nil),      // 16: order.GetOrderResponse
-	(*GetOrderListRequest)(nil),   // 17: order.GetOrderListRequest
-	(*GetOrderListResponse)(nil),  // 18: order.GetOrderListResponse
+	(*OrderInfo)(nil),                  // 0: order.OrderInfo
+	(*CreateOrderRequest)(nil),         // 1: order.CreateOrderRequest
+	(*CreateOrderResponse)(nil),        // 2: order.CreateOrderResponse
+	(*AcceptOrderRequest)(nil),         // 3: order.AcceptOrderRequest
+	(*AcceptOrderResponse)(nil),        // 4: order.AcceptOrderResponse
+	(*StartOrderRequest)(nil),          // 5: order.StartOrderRequest
+	(*StartOrderResponse)(nil),         // 6: order.StartOrderResponse
+	(*CompleteOrderRequest)(nil),       // 7: order.CompleteOrderRequest
+	(*CompleteOrderResponse)(nil),      // 8: order.CompleteOrderResponse
+	(*CancelOrderRequest)(nil),         // 9: order.CancelOrderRequest
+	(*CancelOrderResponse)(nil),        // 10: order.CancelOrderResponse
+	(*RateOrderRequest)(nil),           // 11: order.RateOrderRequest
+	(*RateOrderResponse)(nil),          // 12: order.RateOrderResponse
+	(*DisputeOrderRequest)(nil),        // 13: order.DisputeOrderRequest
+	(*DisputeOrderResponse)(nil),       // 14: order.DisputeOrderResponse
+	(*DeleteOrderRequest)(nil),         // 15: order.DeleteOrderRequest
+	(*DeleteOrderResponse)(nil),        // 16: order.DeleteOrderResponse
+	(*GetOrderRequest)(nil),            // 17: order.GetOrderRequest
+	(*GetOrderResponse)(nil),           // 18: order.GetOrderResponse
+	(*GetOrderListRequest)(nil),        // 19: order.GetOrderListRequest
+	(*GetOrderListResponse)(nil),       // 20: order.GetOrderListResponse
+	(*ListFinishedOrdersRequest)(nil),  // 21: order.ListFinishedOrdersRequest
+	(*ListFinishedOrdersResponse)(nil), // 22: order.ListFinishedOrdersResponse
 }
 var file_order_proto_depIdxs = []int32{
 	0,  // 0: order.CreateOrderResponse.order:type_name -> order.OrderInfo
@@ -1315,31 +1574,37 @@ var file_order_proto_depIdxs = []int32{
 	0,  // 3: order.CompleteOrderResponse.order:type_name -> order.OrderInfo
 	0,  // 4: order.CancelOrderResponse.order:type_name -> order.OrderInfo
 	0,  // 5: order.RateOrderResponse.order:type_name -> order.OrderInfo
-	0,  // 6: order.GetOrderResponse.order:type_name -> order.OrderInfo
-	0,  // 7: order.GetOrderListResponse.orders:type_name -> order.OrderInfo
-	1,  // 8: order.Order.CreateOrder:input_type -> order.CreateOrderRequest
-	3,  // 9: order.Order.AcceptOrder:input_type -> order.AcceptOrderRequest
-	5,  // 10: order.Order.StartOrder:input_type -> order.StartOrderRequest
-	7,  // 11: order.Order.CompleteOrder:input_type -> order.CompleteOrderRequest
-	9,  // 12: order.Order.CancelOrder:input_type -> order.CancelOrderRequest
-	11, // 13: order.Order.RateOrder:input_type -> order.RateOrderRequest
-	15, // 14: order.Order.GetOrder:input_type -> order.GetOrderRequest
-	17, // 15: order.Order.GetOrderList:input_type -> order.GetOrderListRequest
-	13, // 16: order.Order.DeleteOrder:input_type -> order.DeleteOrderRequest
-	2,  // 17: order.Order.CreateOrder:output_type -> order.CreateOrderResponse
-	4,  // 18: order.Order.AcceptOrder:output_type -> order.AcceptOrderResponse
-	6,  // 19: order.Order.StartOrder:output_type -> order.StartOrderResponse
-	8,  // 20: order.Order.CompleteOrder:output_type -> order.CompleteOrderResponse
-	10, // 21: order.Order.CancelOrder:output_type -> order.CancelOrderResponse
-	12, // 22: order.Order.RateOrder:output_type -> order.RateOrderResponse
-	16, // 23: order.Order.GetOrder:output_type -> order.GetOrderResponse
-	18, // 24: order.Order.GetOrderList:output_type -> order.GetOrderListResponse
-	14, // 25: order.Order.DeleteOrder:output_type -> order.DeleteOrderResponse
-	17, // [17:26] is the sub-list for method output_type
-	8,  // [8:17] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	0,  // 6: order.DisputeOrderResponse.order:type_name -> order.OrderInfo
+	0,  // 7: order.GetOrderResponse.order:type_name -> order.OrderInfo
+	0,  // 8: order.GetOrderListResponse.orders:type_name -> order.OrderInfo
+	0,  // 9: order.ListFinishedOrdersResponse.orders:type_name -> order.OrderInfo
+	1,  // 10: order.Order.CreateOrder:input_type -> order.CreateOrderRequest
+	3,  // 11: order.Order.AcceptOrder:input_type -> order.AcceptOrderRequest
+	5,  // 12: order.Order.StartOrder:input_type -> order.StartOrderRequest
+	7,  // 13: order.Order.CompleteOrder:input_type -> order.CompleteOrderRequest
+	9,  // 14: order.Order.CancelOrder:input_type -> order.CancelOrderRequest
+	11, // 15: order.Order.RateOrder:input_type -> order.RateOrderRequest
+	13, // 16: order.Order.DisputeOrder:input_type -> order.DisputeOrderRequest
+	17, // 17: order.Order.GetOrder:input_type -> order.GetOrderRequest
+	19, // 18: order.Order.GetOrderList:input_type -> order.GetOrderListRequest
+	21, // 19: order.Order.ListFinishedOrders:input_type -> order.ListFinishedOrdersRequest
+	15, // 20: order.Order.DeleteOrder:input_type -> order.DeleteOrderRequest
+	2,  // 21: order.Order.CreateOrder:output_type -> order.CreateOrderResponse
+	4,  // 22: order.Order.AcceptOrder:output_type -> order.AcceptOrderResponse
+	6,  // 23: order.Order.StartOrder:output_type -> order.StartOrderResponse
+	8,  // 24: order.Order.CompleteOrder:output_type -> order.CompleteOrderResponse
+	10, // 25: order.Order.CancelOrder:output_type -> order.CancelOrderResponse
+	12, // 26: order.Order.RateOrder:output_type -> order.RateOrderResponse
+	14, // 27: order.Order.DisputeOrder:output_type -> order.DisputeOrderResponse
+	18, // 28: order.Order.GetOrder:output_type -> order.GetOrderResponse
+	20, // 29: order.Order.GetOrderList:output_type -> order.GetOrderListResponse
+	22, // 30: order.Order.ListFinishedOrders:output_type -> order.ListFinishedOrdersResponse
+	16, // 31: order.Order.DeleteOrder:output_type -> order.DeleteOrderResponse
+	21, // [21:32] is the sub-list for method output_type
+	10, // [10:21] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_order_proto_init() }
@@ -1353,7 +1618,7 @@ func file_order_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_proto_rawDesc), len(file_order_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   19,
+			NumMessages:   23,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,15 +19,17 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	Order_CreateOrder_FullMethodName   = "/order.Order/CreateOrder"
-	Order_AcceptOrder_FullMethodName   = "/order.Order/AcceptOrder"
-	Order_StartOrder_FullMethodName    = "/order.Order/StartOrder"
-	Order_CompleteOrder_FullMethodName = "/order.Order/CompleteOrder"
-	Order_CancelOrder_FullMethodName   = "/order.Order/CancelOrder"
-	Order_RateOrder_FullMethodName     = "/order.Order/RateOrder"
-	Order_GetOrder_FullMethodName      = "/order.Order/GetOrder"
-	Order_GetOrderList_FullMethodName  = "/order.Order/GetOrderList"
-	Order_DeleteOrder_FullMethodName   = "/order.Order/DeleteOrder"
+	Order_CreateOrder_FullMethodName        = "/order.Order/CreateOrder"
+	Order_AcceptOrder_FullMethodName        = "/order.Order/AcceptOrder"
+	Order_StartOrder_FullMethodName         = "/order.Order/StartOrder"
+	Order_CompleteOrder_FullMethodName      = "/order.Order/CompleteOrder"
+	Order_CancelOrder_FullMethodName        = "/order.Order/CancelOrder"
+	Order_RateOrder_FullMethodName          = "/order.Order/RateOrder"
+	Order_DisputeOrder_FullMethodName       = "/order.Order/DisputeOrder"
+	Order_GetOrder_FullMethodName           = "/order.Order/GetOrder"
+	Order_GetOrderList_FullMethodName       = "/order.Order/GetOrderList"
+	Order_ListFinishedOrders_FullMethodName = "/order.Order/ListFinishedOrders"
+	Order_DeleteOrder_FullMethodName        = "/order.Order/DeleteOrder"
 )
 
 // OrderClient is the client API for Order service.
@@ -41,9 +43,11 @@ type OrderClient interface {
 	CompleteOrder(ctx context.Context, in *CompleteOrderRequest, opts ...grpc.CallOption) (*CompleteOrderResponse, error)
 	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
 	RateOrder(ctx context.Context, in *RateOrderRequest, opts ...grpc.CallOption) (*RateOrderResponse, error)
+	DisputeOrder(ctx context.Context, in *DisputeOrderRequest, opts ...grpc.CallOption) (*DisputeOrderResponse, error)
 	// 查询相关
 	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*GetOrderResponse, error)
 	GetOrderList(ctx context.Context, in *GetOrderListRequest, opts ...grpc.CallOption) (*GetOrderListResponse, error)
+	ListFinishedOrders(ctx context.Context, in *ListFinishedOrdersRequest, opts ...grpc.CallOption) (*ListFinishedOrdersResponse, error)
 	// 删除订单
 	DeleteOrder(ctx context.Context, in *DeleteOrderRequest, opts ...grpc.CallOption) (*DeleteOrderResponse, error)
 }
@@ -116,6 +120,16 @@ func (c *orderClient) RateOrder(ctx context.Context, in *RateOrderRequest, opts
 	return out, nil
 }
 
+func (c *orderClient) DisputeOrder(ctx context.Context, in *DisputeOrderRequest, opts ...grpc.CallOption) (*DisputeOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DisputeOrderResponse)
+	err := c.cc.Invoke(ctx, Order_DisputeOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderClient) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*GetOrderResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetOrderResponse)
@@ -136,6 +150,16 @@ func (c *orderClient) GetOrderList(ctx context.Context, in *GetOrderListRequest,
 	return out, nil
 }
 
+func (c *orderClient) ListFinishedOrders(ctx context.Context, in *ListFinishedOrdersRequest, opts ...grpc.CallOption) (*ListFinishedOrdersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListFinishedOrdersResponse)
+	err := c.cc.Invoke(ctx, Order_ListFinishedOrders_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *orderClient) DeleteOrder(ctx context.Context, in *DeleteOrderRequest, opts ...grpc.CallOption) (*DeleteOrderResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteOrderResponse)
@@ -157,9 +181,11 @@ type OrderServer interface {
 	CompleteOrder(context.Context, *CompleteOrderRequest) (*CompleteOrderResponse, error)
 	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
 	RateOrder(context.Context, *RateOrderRequest) (*RateOrderResponse, error)
+	DisputeOrder(context.Context, *DisputeOrderRequest) (*DisputeOrderResponse, error)
 	// 查询相关
 	GetOrder(context.Context, *GetOrderRequest) (*GetOrderResponse, error)
 	GetOrderList(context.Context, *GetOrderListRequest) (*GetOrderListResponse, error)
+	ListFinishedOrders(context.Context, *ListFinishedOrdersRequest) (*ListFinishedOrdersResponse, error)
 	// 删除订单
 	DeleteOrder(context.Context, *DeleteOrderRequest) (*DeleteOrderResponse, error)
 	mustEmbedUnimplementedOrderServer()
@@ -190,12 +216,18 @@ func (UnimplementedOrderServer) CancelOrder(context.Context, *CancelOrderRequest
 func (UnimplementedOrderServer) RateOrder(context.Context, *RateOrderRequest) (*RateOrderResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RateOrder not implemented")
 }
+func (UnimplementedOrderServer) DisputeOrder(context.Context, *DisputeOrderRequest) (*DisputeOrderResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method DisputeOrder not implemented")
+}
 func (UnimplementedOrderServer) GetOrder(context.Context, *GetOrderRequest) (*GetOrderResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetOrder not implemented")
 }
 func (UnimplementedOrderServer) GetOrderList(context.Context, *GetOrderListRequest) (*GetOrderListResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetOrderList not implemented")
 }
+func (UnimplementedOrderServer) ListFinishedOrders(context.Context, *ListFinishedOrdersRequest) (*ListFinishedOrdersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListFinishedOrders not implemented")
+}
 func (UnimplementedOrderServer) DeleteOrder(context.Context, *DeleteOrderRequest) (*DeleteOrderResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteOrder not implemented")
 }
@@ -328,6 +360,24 @@ func _Order_RateOrder_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Order_DisputeOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DisputeOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).DisputeOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_DisputeOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).DisputeOrder(ctx, req.(*DisputeOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Order_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetOrderRequest)
 	if err := dec(in); err != nil {
@@ -364,6 +414,24 @@ func _Order_GetOrderList_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Order_ListFinishedOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFinishedOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServer).ListFinishedOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Order_ListFinishedOrders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServer).ListFinishedOrders(ctx, req.(*ListFinishedOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Order_DeleteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteOrderRequest)
 	if err := dec(in); err != nil {
@@ -413,6 +481,10 @@ var Order_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RateOrder",
 			Handler:    _Order_RateOrder_Handler,
 		},
+		{
+			MethodName: "DisputeOrder",
+			Handler:    _Order_DisputeOrder_Handler,
+		},
 		{
 			MethodName: "GetOrder",
 			Handler:    _Order_GetOrder_Handler,
@@ -421,6 +493,10 @@ var Order_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetOrderList",
 			Handler:    _Order_GetOrderList_Handler,
 		},
+		{
+			MethodName: "ListFinishedOrders",
+			Handler:    _Order_ListFinishedOrders_Handler,
+		},
 		{
 			MethodName: "DeleteOrder",
 			Handler:    _Order_DeleteOrder_Handler,
@@ -14,25 +14,29 @@ import (
 )
 
 type (
-	AcceptOrderRequest    = order.AcceptOrderRequest
-	AcceptOrderResponse   = order.AcceptOrderResponse
-	CancelOrderRequest    = order.CancelOrderRequest
-	CancelOrderResponse   = order.CancelOrderResponse
-	CompleteOrderRequest  = order.CompleteOrderRequest
-	CompleteOrderResponse = order.CompleteOrderResponse
-	CreateOrderRequest    = order.CreateOrderRequest
-	CreateOrderResponse   = order.CreateOrderResponse
-	DeleteOrderRequest    = order.DeleteOrderRequest
-	DeleteOrderResponse   = order.DeleteOrderResponse
-	GetOrderListRequest   = order.GetOrderListRequest
-	GetOrderListResponse  = order.GetOrderListResponse
-	GetOrderRequest       = order.GetOrderRequest
-	GetOrderResponse      = order.GetOrderResponse
-	OrderInfo             = order.OrderInfo
-	RateOrderRequest      = order.RateOrderRequest
-	RateOrderResponse     = order.RateOrderResponse
-	StartOrderRequest     = order.StartOrderRequest
-	StartOrderResponse    = order.StartOrderResponse
+	AcceptOrderRequest         = order.AcceptOrderRequest
+	AcceptOrderResponse        = order.AcceptOrderResponse
+	CancelOrderRequest         = order.CancelOrderRequest
+	CancelOrderResponse        = order.CancelOrderResponse
+	CompleteOrderRequest       = order.CompleteOrderRequest
+	CompleteOrderResponse      = order.CompleteOrderResponse
+	CreateOrderRequest         = order.CreateOrderRequest
+	CreateOrderResponse        = order.CreateOrderResponse
+	DeleteOrderRequest         = order.DeleteOrderRequest
+	DeleteOrderResponse        = order.DeleteOrderResponse
+	DisputeOrderRequest        = order.DisputeOrderRequest
+	DisputeOrderResponse       = order.DisputeOrderResponse
+	GetOrderListRequest        = order.GetOrderListRequest
+	GetOrderListResponse       = order.GetOrderListResponse
+	GetOrderRequest            = order.GetOrderRequest
+	GetOrderResponse           = order.GetOrderResponse
+	ListFinishedOrdersRequest  = order.ListFinishedOrdersRequest
+	ListFinishedOrdersResponse = order.ListFinishedOrdersResponse
+	OrderInfo                  = order.OrderInfo
+	RateOrderRequest           = order.RateOrderRequest
+	RateOrderResponse          = order.RateOrderResponse
+	StartOrderRequest          = order.StartOrderRequest
+	StartOrderResponse         = order.StartOrderResponse
 
 	Order interface {
 		// 订单创建与流转
@@ -42,12 +46,13 @@ type (
 		CompleteOrder(ctx context.Context, in *CompleteOrderRequest, opts ...grpc.CallOption) (*CompleteOrderResponse, error)
 		CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
 		RateOrder(ctx context.Context, in *RateOrderRequest, opts ...grpc.CallOption) (*RateOrderResponse, error)
+		DisputeOrder(ctx context.Context, in *DisputeOrderRequest, opts ...grpc.CallOption) (*DisputeOrderResponse, error)
 		// 查询相关
 		GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*GetOrderResponse, error)
 		GetOrderList(ctx context.Context, in *GetOrderListRequest, opts ...grpc.CallOption) (*GetOrderListResponse, error)
+		ListFinishedOrders(ctx context.Context, in *ListFinishedOrdersRequest, opts ...grpc.CallOption) (*ListFinishedOrdersResponse, error)
 		// 删除订单
 		DeleteOrder(ctx context.Context, in *DeleteOrderRequest, opts ...grpc.CallOption) (*DeleteOrderResponse, error)
-		// 获取指定陪玩的订单评价列表（仅返回已评价订单）
 	}
 
 	defaultOrder struct {
@@ -92,6 +97,11 @@ func (m *defaultOrder) RateOrder(ctx context.Context, in *RateOrderRequest, opts
 	return client.RateOrder(ctx, in, opts...)
 }
 
+func (m *defaultOrder) DisputeOrder(ctx context.Context, in *DisputeOrderRequest, opts ...grpc.CallOption) (*DisputeOrderResponse, error) {
+	client := order.NewOrderClient(m.cli.Conn())
+	return client.DisputeOrder(ctx, in, opts...)
+}
+
 // 查询相关
 func (m *defaultOrder) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*GetOrderResponse, error) {
 	client := order.NewOrderClient(m.cli.Conn())
@@ -103,10 +113,13 @@ func (m *defaultOrder) GetOrderList(ctx context.Context, in *GetOrderListRequest
 	return client.GetOrderList(ctx, in, opts...)
 }
 
+func (m *defaultOrder) ListFinishedOrders(ctx context.Context, in *ListFinishedOrdersRequest, opts ...grpc.CallOption) (*ListFinishedOrdersResponse, error) {
+	client := order.NewOrderClient(m.cli.Conn())
+	return client.ListFinishedOrders(ctx, in, opts...)
+}
+
 // 删除订单
 func (m *defaultOrder) DeleteOrder(ctx context.Context, in *DeleteOrderRequest, opts ...grpc.CallOption) (*DeleteOrderResponse, error) {
 	client := order.NewOrderClient(m.cli.Conn())
 	return client.DeleteOrder(ctx, in, opts...)
 }
-
-// 获取指定陪玩的订单评价列表（仅返回已评价订单）
@@ -0,0 +1,66 @@
+package cache
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/zeromicro/go-zero/core/stores/redis"
+)
+
+// CompanionCache 陪玩相关缓存服务
+//
+// 陪玩列表缓存按"版本号 + 筛选条件摘要"存储：
+// 陪玩资料变更时只需递增版本号，旧版本的列表缓存不再被读取，随过期时间自然淘汰
+type CompanionCache struct {
+	manager *Manager
+}
+
+// NewCompanionCache 创建陪玩缓存服务实例
+func NewCompanionCache(manager *Manager) *CompanionCache {
+	return &CompanionCache{
+		manager: manager,
+	}
+}
+
+// ListVersion 获取当前陪玩列表缓存版本号（未初始化时为0）
+func (c *CompanionCache) ListVersion() (int64, error) {
+	val, err := c.manager.Get(CompanionListVersionKey)
+	if err != nil {
+		if err == redis.Nil {
+			return 0, nil
+		}
+		return 0, err
+	}
+	if val == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(val, 10, 64)
+}
+
+// GetList 获取指定版本下某个筛选组合的陪玩列表缓存，未命中返回空字符串
+func (c *CompanionCache) GetList(version int64, filterKey string) (string, error) {
+	val, err := c.manager.Get(companionListKey(version, filterKey))
+	if err != nil {
+		if err == redis.Nil {
+			return "", nil
+		}
+		return "", err
+	}
+	return val, nil
+}
+
+// SetList 缓存指定版本下某个筛选组合的陪玩列表
+// version 应为查询数据库之前读取的版本号，避免查询期间发生的变更被写入新版本的缓存
+func (c *CompanionCache) SetList(version int64, filterKey string, payload string) error {
+	return c.manager.Set(companionListKey(version, filterKey), payload, CompanionListExpire)
+}
+
+// InvalidateList 使所有陪玩列表缓存失效
+func (c *CompanionCache) InvalidateList() error {
+	_, err := c.manager.Incr(CompanionListVersionKey)
+	return err
+}
+
+func companionListKey(version int64, filterKey string) string {
+	return fmt.Sprintf(CompanionListKey, fmt.Sprintf("v%d:%s", version, filterKey))
+}
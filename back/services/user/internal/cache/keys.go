@@ -14,6 +14,18 @@ const (
 	CompanionListKey    = "companion:list:%s"
 	CompanionRankingKey = "companion:ranking:%s"
 
+	// 陪玩列表缓存版本号（陪玩资料变更时递增，使所有列表缓存失效）
+	CompanionListVersionKey = "companion:list:version"
+
+	// 陪玩等级统计已从订单历史回填的标记（不过期；丢失后会重新回填，回填按订单幂等）
+	CompanionTierBackfilledKey = "companion:tier:backfilled"
+
+	// 陪玩在线心跳：值为心跳时的状态，过期即视为离线
+	CompanionPresenceKey = "companion:presence:%d"
+
+	// 关注动态收件箱：Redis 列表，保存动态 ID（新的在前）
+	FeedInboxKey = "feed:inbox:%d"
+
 	// 订单相关缓存键
 	OrderInfoKey = "order:info:%d"
 	OrderListKey = "order:list:%d:%s"
@@ -21,6 +33,13 @@ const (
 	// 游戏技能相关缓存键
 	GameSkillListKey = "game:skill:list"
 
+	// 打赏/礼物相关缓存键
+	GiftDailyAmountKey = "gift:daily:amount:%d:%s" // userID, yyyymmdd
+	GiftDailyCountKey  = "gift:daily:count:%d:%s"  // userID, yyyymmdd
+
+	// 会员相关缓存键
+	VipEntitlementsKey = "vip:entitlements:%d"
+
 	// 缓存过期时间
 	UserInfoExpire         = 30 * time.Minute
 	CountCacheExpire       = 1 * time.Hour
@@ -31,4 +50,6 @@ const (
 	OrderInfoExpire        = 2 * time.Hour
 	OrderListExpire        = 15 * time.Minute
 	GameSkillListExpire    = 1 * time.Hour
+	GiftDailyExpire        = 25 * time.Hour
+	VipEntitlementsExpire  = 5 * time.Minute
 )
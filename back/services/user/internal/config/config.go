@@ -25,10 +25,90 @@ type Config struct {
 	Upstream    UpstreamConf `json:",optional"`
 	MetricsPort int          `json:",optional"`
 	RocketMQ    RocketMQConf `json:",optional"`
+	Gift        GiftConf     `json:",optional"`
+	Vip         VipConf      `json:",optional"`
+
+	LoginProtection LoginProtectionConf `json:",optional"`
+	VerifyTicket    VerifyTicketConf    `json:",optional"`
+	RatingScore     RatingScoreConf     `json:",optional"`
+
+	CompanionApplication CompanionApplicationConf `json:",optional"`
+	Presence             PresenceConf             `json:",optional"`
+	CompanionOrders      CompanionOrdersConf      `json:",optional"`
+	CompanionTier        CompanionTierConf        `json:",optional"`
+	Feed                 FeedConf                 `json:",optional"`
+}
+
+// FeedConf 关注动态配置：粉丝数不超过 FanoutMaxFollowers 的陪玩发布动态时写入每个粉丝的收件箱（Redis 列表），
+// 超过的大 V 不做写扩散，粉丝读取时从动态表拉取后合并
+type FeedConf struct {
+	FanoutMaxFollowers int64         `json:",default=2000"` // 写扩散的粉丝数上限
+	FanoutBatchSize    int           `json:",default=500"`  // 写扩散时每批处理的粉丝数
+	InboxSize          int           `json:",default=500"`  // 收件箱保留的动态条数（更早的动态不再出现在动态流中）
+	InboxTTL           time.Duration `json:",default=168h"` // 收件箱过期时间（读取时续期，过期后按关注关系重建）
+	OnlineCooldown     time.Duration `json:",default=1h"`   // 同一陪玩的上线动态在该时间段内只记录一次
+}
+
+// CompanionTierConf 陪玩等级配置：按滚动窗口内的完成单数、平均评分与取消率逐级评估，取满足条件的最高等级
+// 订单服务暂无纠纷流程，纠纷率暂不参与评估
+type CompanionTierConf struct {
+	Window           time.Duration            `json:",default=720h"` // 评估窗口
+	EvaluateInterval time.Duration            `json:",default=1h"`   // 评估间隔
+	BatchSize        int                      `json:",default=200"`  // 每批评估的陪玩数
+	Levels           []CompanionTierLevelConf `json:",optional"`     // 等级列表（未配置时使用默认的青铜~钻石五级）
+}
+
+// CompanionTierLevelConf 单个等级的门槛与权益
+type CompanionTierLevelConf struct {
+	Level              int     `json:",optional"`  // 等级（越大越高，最低等级为新陪玩的初始等级）
+	Name               string  `json:",optional"`  // 展示名称（徽章）
+	MinCompletedOrders int64   `json:",optional"`  // 窗口内最少完成单数
+	MinRating          float64 `json:",optional"`  // 窗口内最低平均评分（0=不要求）
+	MaxCancelRate      float64 `json:",default=1"` // 窗口内最高取消率（陪玩取消数 / (完成数 + 陪玩取消数)）
+	MaxDisputeRate     float64 `json:",default=1"` // 窗口内最高纠纷率（老板发起纠纷的订单数 / 完成数）
+	MaxPricePerHour    int64   `json:",optional"`  // 可设置的最高每小时价格（0=不限）
+	CommissionPercent  int     `json:",optional"`  // 订单结算时平台抽成百分比
+	RankBoost          float64 `json:",optional"`  // 列表默认排序时加到贝叶斯评分上的加权
+}
+
+// CompanionOrdersConf 陪玩接单并发配置：进行中的订单数达到 MaxConcurrent 时置为忙碌，回落后恢复原状态
+// 对账任务定期与订单服务核对进行中的订单，修正事件丢失造成的偏差（未配置订单服务时不启动）
+type CompanionOrdersConf struct {
+	MaxConcurrent     int           `json:",default=1"`    // 同时进行的订单数上限
+	ReconcileInterval time.Duration `json:",default=5m"`   // 对账间隔
+	ReconcileGrace    time.Duration `json:",default=2m"`   // 只核对早于该时长的记录，给在途事件留出处理时间
+	ReconcileBatch    int           `json:",default=200"`  // 每次对账处理的陪玩数
+	TombstoneTTL      time.Duration `json:",default=168h"` // 已释放记录的保留时长
+}
+
+// CompanionApplicationConf 陪玩入驻申请配置
+type CompanionApplicationConf struct {
+	ReapplyCooldownHours int `json:",default=72"` // 被驳回后再次申请的冷却时间（小时）
+}
+
+// PresenceConf 陪玩在线心跳配置：超过 TTL 未收到心跳的在线陪玩由扫描任务自动置为离线
+type PresenceConf struct {
+	TTL            time.Duration `json:",default=90s"` // 心跳有效期（客户端建议每 TTL/3 发送一次心跳）
+	SweepInterval  time.Duration `json:",default=30s"` // 超时扫描间隔
+	SweepBatchSize int           `json:",default=200"` // 每次扫描处理的陪玩数
+}
+
+// RatingScoreConf 陪玩贝叶斯评分配置：score = (PriorWeight*PriorRating + rating*orders) / (PriorWeight + orders)
+// 单数越少越接近先验评分，避免一两单满分的陪玩排在大量订单高分陪玩之前；修改后需执行回填命令重算已有陪玩
+type RatingScoreConf struct {
+	PriorRating float64 `json:",default=4.0"` // 先验评分（可取平台平均分）
+	PriorWeight float64 `json:",default=10"`  // 先验权重，相当于预置的虚拟订单数
+}
+
+// VerifyTicketConf 验证码票据配置，Secret 需与 code 服务一致
+// 未配置时读取环境变量 VERIFY_TICKET_SECRET，非 dev 模式下仍为空或为开发密钥时拒绝启动
+type VerifyTicketConf struct {
+	Secret string `json:",optional"`
 }
 
 type UpstreamConf struct {
 	AgentService string           `json:",optional"`
+	OrderService string           `json:",optional"`
 	RPCTimeout   time.Duration    `json:",default=10s"`
 	Retry        rpc.RetryOptions `json:",optional"`
 }
@@ -62,3 +142,45 @@ type RocketMQConf struct {
 	AccessKey   string   `json:",optional"`
 	SecretKey   string   `json:",optional"`
 }
+
+// GiftConf 打赏/送礼风控配置
+type GiftConf struct {
+	MaxSingleAmount  int64 `json:",default=100000"` // 单笔最大帅币
+	MaxQuantity      int32 `json:",default=999"`    // 单次最大礼物数量
+	DailyAmountLimit int64 `json:",default=500000"` // 每人每日转出帅币上限
+	DailyCountLimit  int64 `json:",default=200"`    // 每人每日转出次数上限
+}
+
+// VipConf 会员配置
+type VipConf struct {
+	Plans         []VipPlanConf `json:",optional"`    // 会员套餐
+	RenewAhead    time.Duration `json:",default=1h"`  // 到期前多久尝试自动续费
+	ScanInterval  time.Duration `json:",default=1m"`  // 到期扫描间隔
+	ScanBatchSize int           `json:",default=200"` // 每次扫描处理的订阅数
+}
+
+// VipPlanConf 会员套餐及权益
+type VipPlanConf struct {
+	Code                string  `json:",optional"`  // 套餐编码，如 silver_monthly
+	Name                string  `json:",optional"`  // 套餐名称
+	Level               int32   `json:",optional"`  // 会员等级（越大权益越高）
+	DurationDays        int32   `json:",optional"`  // 时长（天），月度=30，季度=90
+	Price               int64   `json:",optional"`  // 价格（帅币）
+	DiscountPercent     int32   `json:",optional"`  // 下单折扣百分比
+	Badge               string  `json:",optional"`  // 徽章
+	RecommendPriority   int32   `json:",optional"`  // 推荐优先级
+	RateLimitMultiplier float64 `json:",default=1"` // 接口限流倍数
+}
+
+// LoginProtectionConf 密码登录防暴力破解配置
+// 按手机号与 IP 分别统计失败次数：超过 DelayAfter 次后每次失败需等待指数增长的时间，达到上限后临时锁定
+type LoginProtectionConf struct {
+	Enabled          bool          `json:",default=true"`
+	FailureWindow    time.Duration `json:",default=15m"` // 失败次数统计窗口（最后一次失败后重新计时）
+	PhoneMaxFailures int64         `json:",default=5"`   // 同一手机号失败次数上限
+	IPMaxFailures    int64         `json:",default=20"`  // 同一 IP 失败次数上限（覆盖撞库式的多账号尝试）
+	LockDuration     time.Duration `json:",default=15m"` // 锁定时长
+	DelayAfter       int64         `json:",default=2"`   // 失败多少次后开始要求等待
+	BaseDelay        time.Duration `json:",default=1s"`  // 首次等待时长，之后每次失败翻倍
+	MaxDelay         time.Duration `json:",default=30s"` // 单次等待上限
+}
@@ -0,0 +1,147 @@
+package helper
+
+import (
+	"context"
+
+	"SLGaming/back/services/user/internal/metrics"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// 因拉黑关系被拒绝的操作（见 metrics.BlockRejectTotal）
+const (
+	BlockActionFollow   = "follow"
+	BlockActionTransfer = "transfer"
+	BlockActionGift     = "gift"
+)
+
+// blockBetweenSQL 两个用户之间存在任一方向的拉黑关系
+const blockBetweenSQL = "(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)"
+
+// IsBlockedBetween 两个用户之间是否存在拉黑关系（任一方拉黑另一方都算）
+func IsBlockedBetween(ctx context.Context, db *gorm.DB, a, b uint64) (bool, error) {
+	var count int64
+	if err := db.WithContext(ctx).Model(&model.BlockRelation{}).
+		Where(blockBetweenSQL, a, b, b, a).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// CheckNotBlocked 存在拉黑关系时返回 PermissionDenied，供关注、打赏、送礼等面向对方的操作使用
+func CheckNotBlocked(ctx context.Context, svcCtx *svc.ServiceContext, operatorID, targetID uint64, action string) error {
+	blocked, err := IsBlockedBetween(ctx, svcCtx.DB(), operatorID, targetID)
+	if err != nil {
+		return status.Error(codes.Internal, "check block relation failed")
+	}
+	if blocked {
+		metrics.BlockRejectTotal.WithLabelValues(action).Inc()
+		return status.Error(codes.PermissionDenied, "user is blocked")
+	}
+	return nil
+}
+
+// BlockedAmong 返回 candidates 中与 userID 存在拉黑关系（任一方向）的用户ID
+func BlockedAmong(ctx context.Context, db *gorm.DB, userID uint64, candidates []uint64) ([]uint64, error) {
+	if userID == 0 || len(candidates) == 0 {
+		return nil, nil
+	}
+	var relations []model.BlockRelation
+	if err := db.WithContext(ctx).Model(&model.BlockRelation{}).
+		Select("blocker_id, blocked_id").
+		Where("(blocker_id = ? AND blocked_id IN ?) OR (blocked_id = ? AND blocker_id IN ?)", userID, candidates, userID, candidates).
+		Find(&relations).Error; err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uint64]bool, len(relations))
+	blocked := make([]uint64, 0, len(relations))
+	for _, r := range relations {
+		other := r.BlockedID
+		if other == userID {
+			other = r.BlockerID
+		}
+		if !seen[other] {
+			seen[other] = true
+			blocked = append(blocked, other)
+		}
+	}
+	return blocked, nil
+}
+
+// HasBlockRelations 用户是否拉黑过别人或被别人拉黑（用于判断列表能否使用公共缓存）
+func HasBlockRelations(ctx context.Context, db *gorm.DB, userID uint64) (bool, error) {
+	var count int64
+	if err := db.WithContext(ctx).Model(&model.BlockRelation{}).
+		Where("blocker_id = ? OR blocked_id = ?", userID, userID).
+		Limit(1).
+		Count(&count).Error; err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+// NotBlockedWith 排除与 userID 存在拉黑关系的用户，column 为被过滤的用户ID列
+func NotBlockedWith(db *gorm.DB, userID uint64, column string) func(*gorm.DB) *gorm.DB {
+	return func(q *gorm.DB) *gorm.DB {
+		blocked := db.Model(&model.BlockRelation{}).Select("blocked_id").Where("blocker_id = ?", userID)
+		blockers := db.Model(&model.BlockRelation{}).Select("blocker_id").Where("blocked_id = ?", userID)
+		return q.Where(column+" NOT IN (?)", blocked).Where(column+" NOT IN (?)", blockers)
+	}
+}
+
+// RemoveFollowsBetween 解除两个用户之间的双向关注关系，并同步更新关注计数与收件箱
+// 只对实际删除的关系扣减计数，重复执行不会重复扣减
+func RemoveFollowsBetween(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, a, b uint64) error {
+	var removed []model.FollowRelation
+	err := svcCtx.DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var relations []model.FollowRelation
+		if err := tx.Select("id, follower_id, following_id").
+			Where("(follower_id = ? AND following_id = ?) OR (follower_id = ? AND following_id = ?)", a, b, b, a).
+			Find(&relations).Error; err != nil {
+			return err
+		}
+		for i := range relations {
+			r := relations[i]
+			res := tx.Where("id = ?", r.ID).Delete(&model.FollowRelation{})
+			if res.Error != nil {
+				return res.Error
+			}
+			if res.RowsAffected == 0 {
+				continue
+			}
+			if err := tx.Model(&model.User{}).Where("id = ?", r.FollowingID).
+				UpdateColumn("follower_count", gorm.Expr("GREATEST(follower_count - ?, 0)", 1)).Error; err != nil {
+				return err
+			}
+			if err := tx.Model(&model.User{}).Where("id = ?", r.FollowerID).
+				UpdateColumn("following_count", gorm.Expr("GREATEST(following_count - ?, 0)", 1)).Error; err != nil {
+				return err
+			}
+			removed = append(removed, r)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, r := range removed {
+		if svcCtx.UserCache != nil {
+			if err := svcCtx.UserCache.DecrFollowerCount(int64(r.FollowingID)); err != nil {
+				logger.Errorf("decr follower count cache failed: %v", err)
+			}
+			if err := svcCtx.UserCache.DecrFollowingCount(int64(r.FollowerID)); err != nil {
+				logger.Errorf("decr following count cache failed: %v", err)
+			}
+		}
+		InvalidateFeedInbox(ctx, svcCtx, logger, r.FollowerID)
+	}
+	return nil
+}
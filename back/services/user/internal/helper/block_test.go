@@ -0,0 +1,90 @@
+package helper
+
+import (
+	"context"
+	"testing"
+
+	"SLGaming/back/services/user/internal/model"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+	"gorm.io/gorm/logger"
+)
+
+// newDryRunDB 创建只生成 SQL 不连接数据库的 gorm 实例
+func newDryRunDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := gorm.Open(mysql.New(mysql.Config{
+		DSN:                       "user:pass@tcp(127.0.0.1:3306)/test",
+		SkipInitializeWithVersion: true,
+	}), &gorm.Config{DryRun: true, DisableAutomaticPing: true, Logger: logger.Discard})
+	require.NoError(t, err)
+	return db
+}
+
+// captureSQL 记录执行的查询语句与参数
+func captureSQL(t *testing.T, db *gorm.DB) *[]*gorm.Statement {
+	t.Helper()
+	var stmts []*gorm.Statement
+	require.NoError(t, db.Callback().Query().After("gorm:query").Register("test:capture", func(tx *gorm.DB) {
+		stmts = append(stmts, tx.Statement)
+	}))
+	return &stmts
+}
+
+func TestIsBlockedBetweenChecksBothDirections(t *testing.T) {
+	db := newDryRunDB(t)
+	stmts := captureSQL(t, db)
+
+	blocked, err := IsBlockedBetween(context.Background(), db, 1, 2)
+	require.NoError(t, err)
+	assert.False(t, blocked)
+
+	require.Len(t, *stmts, 1)
+	stmt := (*stmts)[0]
+	assert.Contains(t, stmt.SQL.String(), "(blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)")
+	assert.Equal(t, []interface{}{uint64(1), uint64(2), uint64(2), uint64(1)}, stmt.Vars)
+}
+
+func TestBlockedAmong(t *testing.T) {
+	tests := []struct {
+		name       string
+		userID     uint64
+		candidates []uint64
+		wantQuery  bool
+	}{
+		{name: "未登录", userID: 0, candidates: []uint64{2, 3}},
+		{name: "没有候选用户", userID: 1, candidates: nil},
+		{name: "查询双向关系", userID: 1, candidates: []uint64{2, 3}, wantQuery: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newDryRunDB(t)
+			stmts := captureSQL(t, db)
+
+			blocked, err := BlockedAmong(context.Background(), db, tt.userID, tt.candidates)
+			require.NoError(t, err)
+			assert.Empty(t, blocked)
+			if !tt.wantQuery {
+				assert.Empty(t, *stmts)
+				return
+			}
+			require.Len(t, *stmts, 1)
+			assert.Contains(t, (*stmts)[0].SQL.String(), "(blocker_id = ? AND blocked_id IN (?,?)) OR (blocked_id = ? AND blocker_id IN (?,?))")
+		})
+	}
+}
+
+func TestNotBlockedWith(t *testing.T) {
+	db := newDryRunDB(t)
+
+	sql := db.ToSQL(func(tx *gorm.DB) *gorm.DB {
+		var users []model.User
+		return tx.Model(&model.User{}).Scopes(NotBlockedWith(db, 7, "users.id")).Find(&users)
+	})
+	assert.Contains(t, sql, "users.id NOT IN (SELECT `blocked_id` FROM `block_relations` WHERE blocker_id = 7")
+	assert.Contains(t, sql, "users.id NOT IN (SELECT `blocker_id` FROM `block_relations` WHERE blocked_id = 7")
+}
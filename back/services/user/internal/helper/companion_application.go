@@ -0,0 +1,151 @@
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"SLGaming/back/services/user/internal/model"
+	userMQ "SLGaming/back/services/user/internal/mq"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// ApplicationReapplyCooldown 被驳回后再次申请的冷却时间
+func ApplicationReapplyCooldown(svcCtx *svc.ServiceContext) time.Duration {
+	return time.Duration(svcCtx.Config().CompanionApplication.ReapplyCooldownHours) * time.Hour
+}
+
+// ApplicationReapplyAt 被驳回的申请可再次提交的时间，其余状态返回零值
+func ApplicationReapplyAt(a *model.CompanionApplication, cooldown time.Duration) time.Time {
+	if a == nil || a.Status != model.CompanionApplicationRejected || a.ReviewedAt == nil {
+		return time.Time{}
+	}
+	return a.ReviewedAt.Add(cooldown)
+}
+
+// ToCompanionApplicationInfo 将入驻申请转换为 RPC 结构，身份证号始终为脱敏副本
+// forAdmin 为 false 时（申请人查询）不返回审核备注
+func ToCompanionApplicationInfo(a *model.CompanionApplication, forAdmin bool, cooldown time.Duration) *user.CompanionApplicationInfo {
+	if a == nil {
+		return nil
+	}
+	info := &user.CompanionApplicationInfo{
+		Id:             a.ID,
+		UserId:         a.UserID,
+		RealName:       a.RealName,
+		IdCardNo:       a.IDCardNoMasked,
+		IdCardFrontUrl: a.IDCardFrontURL,
+		IdCardBackUrl:  a.IDCardBackURL,
+		SkillProofUrls: DecodeStringList(a.SkillProofURLs),
+		VoiceSampleUrl: a.VoiceSampleURL,
+		Skills:         DecodeApplicationSkills(a.Skills),
+		Bio:            a.Bio,
+		Status:         int32(a.Status),
+		RejectReason:   a.RejectReason,
+		ReviewerId:     a.ReviewerID,
+		CreatedAt:      a.CreatedAt.Unix(),
+	}
+	if a.ReviewedAt != nil {
+		info.ReviewedAt = a.ReviewedAt.Unix()
+	}
+	if forAdmin {
+		info.ReviewNote = a.ReviewNote
+	} else {
+		if t := ApplicationReapplyAt(a, cooldown); !t.IsZero() {
+			info.ReapplyAt = t.Unix()
+		}
+	}
+	return info
+}
+
+// EncodeApplicationSkills 序列化申请的技能（只保留ID、名称、段位和价格）
+func EncodeApplicationSkills(skills []*user.CompanionSkill) string {
+	b, _ := json.Marshal(skills)
+	return string(b)
+}
+
+// DecodeApplicationSkills 反序列化申请的技能
+func DecodeApplicationSkills(raw string) []*user.CompanionSkill {
+	var skills []*user.CompanionSkill
+	if raw == "" {
+		return skills
+	}
+	_ = json.Unmarshal([]byte(raw), &skills)
+	return skills
+}
+
+// EncodeStringList 序列化字符串列表（如截图地址）
+func EncodeStringList(list []string) string {
+	b, _ := json.Marshal(list)
+	return string(b)
+}
+
+// DecodeStringList 反序列化 EncodeStringList 保存的字符串列表
+func DecodeStringList(raw string) []string {
+	var list []string
+	if raw == "" {
+		return list
+	}
+	_ = json.Unmarshal([]byte(raw), &list)
+	return list
+}
+
+// IsValidMediaURL 检查上传文件地址：仅允许 http/https 且长度不超过 255
+func IsValidMediaURL(raw string) bool {
+	if raw == "" || len(raw) > 255 {
+		return false
+	}
+	u, err := url.Parse(raw)
+	if err != nil {
+		return false
+	}
+	return (u.Scheme == "http" || u.Scheme == "https") && u.Host != ""
+}
+
+// MaskIDCardNo 身份证号脱敏：保留前3位和后4位
+func MaskIDCardNo(no string) string {
+	if len(no) < 8 {
+		return strings.Repeat("*", len(no))
+	}
+	return no[:3] + "***********" + no[len(no)-4:]
+}
+
+// PublishApplicationReviewed 发送入驻申请审核完成事件，供通知系统告知申请人（审核已完成，发送失败只记录日志）
+func PublishApplicationReviewed(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, a *model.CompanionApplication, reapplyAt time.Time) {
+	if svcCtx.EventProducer == nil || a == nil {
+		return
+	}
+	payload := &userMQ.ApplicationReviewedPayload{
+		ApplicationID: a.ID,
+		UserID:        a.UserID,
+		Approved:      a.Status == model.CompanionApplicationApproved,
+		RejectReason:  a.RejectReason,
+	}
+	if a.ReviewedAt != nil {
+		payload.ReviewedAt = a.ReviewedAt.Unix()
+	}
+	if !reapplyAt.IsZero() {
+		payload.ReapplyAt = reapplyAt.Unix()
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		LogError(logger, OpCompanionApplication, "marshal application reviewed event failed", err, map[string]interface{}{"application_id": a.ID})
+		return
+	}
+	msg := primitive.NewMessage(userMQ.CompanionEventTopic(), body)
+	msg.WithTag(userMQ.EventTypeApplicationReviewed())
+	msg.WithKeys([]string{strconv.FormatUint(a.ID, 10)})
+	if _, err := svcCtx.EventProducer.SendSync(ctx, msg); err != nil {
+		LogError(logger, OpCompanionApplication, "send application reviewed event failed", err, map[string]interface{}{
+			"application_id": a.ID,
+			"user_id":        a.UserID,
+		})
+	}
+}
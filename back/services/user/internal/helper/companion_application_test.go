@@ -0,0 +1,26 @@
+package helper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMaskIDCardNo(t *testing.T) {
+	tests := []struct {
+		name string
+		no   string
+		want string
+	}{
+		{name: "18位身份证号", no: "110101199003071234", want: "110***********1234"},
+		{name: "末位为X", no: "11010119900307123X", want: "110***********123X"},
+		{name: "过短时全部隐藏", no: "1234567", want: "*******"},
+		{name: "空", no: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, MaskIDCardNo(tt.no))
+		})
+	}
+}
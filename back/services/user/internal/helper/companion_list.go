@@ -0,0 +1,25 @@
+package helper
+
+import (
+	"strings"
+
+	"SLGaming/back/services/user/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// InvalidateCompanionList 陪玩资料（状态、技能价格、评分、展示信息等）变更后使列表缓存失效
+// 失败只记录日志：列表缓存有较短的过期时间，最多短暂展示旧数据
+func InvalidateCompanionList(svcCtx *svc.ServiceContext, logger logx.Logger) {
+	if svcCtx.CompanionCache == nil {
+		return
+	}
+	if err := svcCtx.CompanionCache.InvalidateList(); err != nil {
+		LogError(logger, OpCompanionList, "invalidate companion list cache failed", err, nil)
+	}
+}
+
+// EscapeLike 转义 LIKE 通配符，避免用户输入的 % 和 _ 被当作通配符
+func EscapeLike(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `%`, `\%`, `_`, `\_`).Replace(s)
+}
@@ -0,0 +1,170 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"SLGaming/back/services/user/internal/metrics"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 订单驱动的状态变更原因
+const PresenceReasonOrders = "orders"
+
+// companionStatusChange 事务提交后需要通知的状态变更
+type companionStatusChange struct {
+	userID    uint64
+	oldStatus int
+	status    int
+}
+
+// MaxConcurrentOrders 陪玩同时进行的订单数上限
+func MaxConcurrentOrders(svcCtx *svc.ServiceContext) int {
+	if n := svcCtx.Config().CompanionOrders.MaxConcurrent; n > 0 {
+		return n
+	}
+	return 1
+}
+
+// TrackCompanionOrder 记录陪玩接单：写入进行中的订单并按并发上限更新忙碌状态（按订单幂等）
+// 订单已被释放（完成/取消事件先于接单事件到达）时不会重新计入
+func TrackCompanionOrder(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, companionID, orderID uint64) error {
+	return applyCompanionOrders(ctx, svcCtx, logger, companionID, func(tx *gorm.DB) (bool, error) {
+		res := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.CompanionActiveOrder{
+			OrderID:     orderID,
+			CompanionID: companionID,
+		})
+		return res.RowsAffected > 0, res.Error
+	})
+}
+
+// ReleaseCompanionOrder 释放陪玩订单（完成/取消）：订单数回落到上限以下时恢复忙碌前的状态（按订单幂等）
+// 接单事件尚未到达时写入已释放的墓碑记录，之后到达的接单事件不再计入
+func ReleaseCompanionOrder(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, companionID, orderID uint64) error {
+	return applyCompanionOrders(ctx, svcCtx, logger, companionID, func(tx *gorm.DB) (bool, error) {
+		now := time.Now()
+		res := tx.Model(&model.CompanionActiveOrder{}).
+			Where("order_id = ? AND released_at IS NULL", orderID).
+			Update("released_at", now)
+		if res.Error != nil || res.RowsAffected > 0 {
+			return res.RowsAffected > 0, res.Error
+		}
+		err := tx.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.CompanionActiveOrder{
+			OrderID:     orderID,
+			CompanionID: companionID,
+			ReleasedAt:  &now,
+		}).Error
+		return false, err
+	})
+}
+
+// RecountCompanionOrders 按进行中的订单记录重新计算陪玩的订单数与忙碌状态（对账任务使用）
+func RecountCompanionOrders(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, companionID uint64) error {
+	return applyCompanionOrders(ctx, svcCtx, logger, companionID, func(tx *gorm.DB) (bool, error) {
+		return true, nil
+	})
+}
+
+// applyCompanionOrders 锁定陪玩资料后执行订单记录变更，有变更时重新计算订单数与状态
+// 状态变更在事务提交后刷新列表缓存并发布事件；没有陪玩资料时忽略
+func applyCompanionOrders(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, companionID uint64, mutate func(tx *gorm.DB) (bool, error)) error {
+	var change *companionStatusChange
+	err := svcCtx.DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		var profile model.CompanionProfile
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id, user_id, status, active_orders, status_before_busy").
+			Where("user_id = ?", companionID).
+			First(&profile).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil
+			}
+			return err
+		}
+
+		changed, err := mutate(tx)
+		if err != nil || !changed {
+			return err
+		}
+
+		var active int64
+		if err := tx.Model(&model.CompanionActiveOrder{}).
+			Where("companion_id = ? AND released_at IS NULL", companionID).
+			Count(&active).Error; err != nil {
+			return err
+		}
+
+		status, before := nextCompanionStatus(&profile, int(active), MaxConcurrentOrders(svcCtx), companionPresenceOnline(ctx, svcCtx, companionID))
+		if int(active) == profile.ActiveOrders && status == profile.Status && before == profile.StatusBeforeBusy {
+			return nil
+		}
+		if err := tx.Model(&model.CompanionProfile{}).
+			Where("user_id = ?", companionID).
+			Updates(map[string]any{
+				"active_orders":      int(active),
+				"status":             status,
+				"status_before_busy": before,
+			}).Error; err != nil {
+			return err
+		}
+		if status != profile.Status {
+			change = &companionStatusChange{userID: companionID, oldStatus: profile.Status, status: status}
+		}
+		return nil
+	})
+	if err != nil {
+		metrics.CompanionOrderStatusTotal.WithLabelValues("error").Inc()
+		return err
+	}
+	if change == nil {
+		return nil
+	}
+
+	metrics.CompanionOrderStatusTotal.WithLabelValues(orderStatusLabel(change.status)).Inc()
+	InvalidateCompanionList(svcCtx, logger)
+	PublishCompanionStatusChanged(ctx, svcCtx, logger, change.userID, change.oldStatus, change.status, PresenceReasonOrders)
+	LogInfo(logger, OpCompanionOrders, "companion status changed by orders", map[string]interface{}{
+		"user_id":    change.userID,
+		"old_status": change.oldStatus,
+		"status":     change.status,
+	})
+	return nil
+}
+
+// nextCompanionStatus 根据进行中的订单数计算状态：达到上限时置为忙碌并记住原状态，回落后恢复原状态
+// 忙碌前状态不是在线时无法区分"确实离线"与"上线前已忙碌、未记录原状态（列默认 0）"，按心跳判断：
+// 心跳有效则恢复为在线，否则离线；心跳不可查时恢复为在线，由心跳扫描任务兜底下线
+func nextCompanionStatus(profile *model.CompanionProfile, active, maxConcurrent int, presenceOnline func() bool) (status, before int) {
+	status, before = profile.Status, profile.StatusBeforeBusy
+	switch {
+	case active >= maxConcurrent && profile.Status != model.CompanionStatusBusy:
+		before = profile.Status
+		status = model.CompanionStatusBusy
+	case active < maxConcurrent && profile.Status == model.CompanionStatusBusy:
+		status = model.CompanionStatusOnline
+		if profile.StatusBeforeBusy != model.CompanionStatusOnline && !presenceOnline() {
+			status = model.CompanionStatusOffline
+		}
+	}
+	return status, before
+}
+
+// companionPresenceOnline 返回按心跳判断陪玩是否在线的函数，心跳不可查时视为在线
+func companionPresenceOnline(ctx context.Context, svcCtx *svc.ServiceContext, userID uint64) func() bool {
+	return func() bool {
+		live, ok := LivePresence(ctx, svcCtx, []uint64{userID})
+		return !ok || live[userID]
+	}
+}
+
+func orderStatusLabel(status int) string {
+	if status == model.CompanionStatusBusy {
+		return "busy"
+	}
+	return "restored"
+}
@@ -0,0 +1,72 @@
+package helper
+
+import (
+	"testing"
+
+	"SLGaming/back/services/user/internal/model"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNextCompanionStatus(t *testing.T) {
+	tests := []struct {
+		name          string
+		status        int
+		before        int
+		active        int
+		maxConcurrent int
+		online        bool // 心跳是否有效
+		wantStatus    int
+		wantBefore    int
+	}{
+		{
+			name:   "未达上限保持在线",
+			status: model.CompanionStatusOnline, active: 0, maxConcurrent: 1,
+			wantStatus: model.CompanionStatusOnline,
+		},
+		{
+			name:   "达到上限置为忙碌并记住原状态",
+			status: model.CompanionStatusOnline, active: 1, maxConcurrent: 1,
+			wantStatus: model.CompanionStatusBusy, wantBefore: model.CompanionStatusOnline,
+		},
+		{
+			name:   "离线陪玩达到上限也置为忙碌",
+			status: model.CompanionStatusOffline, active: 2, maxConcurrent: 2,
+			wantStatus: model.CompanionStatusBusy, wantBefore: model.CompanionStatusOffline,
+		},
+		{
+			name:   "已忙碌时不覆盖原状态",
+			status: model.CompanionStatusBusy, before: model.CompanionStatusOffline, active: 3, maxConcurrent: 2,
+			wantStatus: model.CompanionStatusBusy, wantBefore: model.CompanionStatusOffline,
+		},
+		{
+			name:   "回落后心跳已失效时恢复为离线",
+			status: model.CompanionStatusBusy, before: model.CompanionStatusOffline, active: 1, maxConcurrent: 2,
+			wantStatus: model.CompanionStatusOffline, wantBefore: model.CompanionStatusOffline,
+		},
+		{
+			name:   "忙碌前状态未记录时按心跳恢复为在线",
+			status: model.CompanionStatusBusy, before: model.CompanionStatusOffline, active: 0, maxConcurrent: 1, online: true,
+			wantStatus: model.CompanionStatusOnline, wantBefore: model.CompanionStatusOffline,
+		},
+		{
+			name:   "原状态为忙碌时按心跳恢复为在线",
+			status: model.CompanionStatusBusy, before: model.CompanionStatusBusy, active: 0, maxConcurrent: 1, online: true,
+			wantStatus: model.CompanionStatusOnline, wantBefore: model.CompanionStatusBusy,
+		},
+		{
+			name:   "回落后恢复为在线",
+			status: model.CompanionStatusBusy, before: model.CompanionStatusOnline, active: 0, maxConcurrent: 1,
+			wantStatus: model.CompanionStatusOnline, wantBefore: model.CompanionStatusOnline,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := &model.CompanionProfile{Status: tt.status, StatusBeforeBusy: tt.before}
+			status, before := nextCompanionStatus(profile, tt.active, tt.maxConcurrent, func() bool { return tt.online })
+			assert.Equal(t, tt.wantStatus, status)
+			assert.Equal(t, tt.wantBefore, before)
+		})
+	}
+}
@@ -0,0 +1,137 @@
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"SLGaming/back/services/user/internal/cache"
+	"SLGaming/back/services/user/internal/model"
+	userMQ "SLGaming/back/services/user/internal/mq"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const defaultPresenceTTL = 90 * time.Second
+
+// 在线状态变更原因
+const (
+	PresenceReasonManual  = "manual"           // 陪玩手动切换
+	PresenceReasonTimeout = "presence_timeout" // 心跳超时自动离线
+)
+
+// PresenceTTL 心跳有效期
+func PresenceTTL(svcCtx *svc.ServiceContext) time.Duration {
+	if ttl := svcCtx.Config().Presence.TTL; ttl > 0 {
+		return ttl
+	}
+	return defaultPresenceTTL
+}
+
+// TouchPresence 记录一次心跳：刷新 presence 键的有效期
+func TouchPresence(ctx context.Context, svcCtx *svc.ServiceContext, userID uint64, status int) error {
+	if svcCtx.Redis == nil {
+		return nil
+	}
+	ttl := PresenceTTL(svcCtx)
+	return svcCtx.Redis.SetexCtx(ctx, fmt.Sprintf(cache.CompanionPresenceKey, userID), strconv.Itoa(status), int(ttl.Seconds()))
+}
+
+// ClearPresence 清除心跳记录（陪玩手动下线）
+func ClearPresence(ctx context.Context, svcCtx *svc.ServiceContext, userID uint64) error {
+	if svcCtx.Redis == nil {
+		return nil
+	}
+	_, err := svcCtx.Redis.DelCtx(ctx, fmt.Sprintf(cache.CompanionPresenceKey, userID))
+	return err
+}
+
+// LivePresence 查询哪些陪玩的心跳仍在有效期内
+// Redis 不可用或查询失败时 ok 为 false，调用方应以数据库中的状态为准
+func LivePresence(ctx context.Context, svcCtx *svc.ServiceContext, userIDs []uint64) (live map[uint64]bool, ok bool) {
+	if svcCtx.Redis == nil {
+		return nil, false
+	}
+	live = make(map[uint64]bool, len(userIDs))
+	if len(userIDs) == 0 {
+		return live, true
+	}
+	keys := make([]string, 0, len(userIDs))
+	for _, id := range userIDs {
+		keys = append(keys, fmt.Sprintf(cache.CompanionPresenceKey, id))
+	}
+	vals, err := svcCtx.Redis.MgetCtx(ctx, keys...)
+	if err != nil {
+		return nil, false
+	}
+	for i, v := range vals {
+		if v != "" && i < len(userIDs) {
+			live[userIDs[i]] = true
+		}
+	}
+	return live, true
+}
+
+// DropStaleCompanions 去掉状态为在线但心跳已超时的陪玩（扫描任务尚未处理的部分）
+// 忙碌的陪玩处于订单中，状态由订单流程维护，不受心跳影响
+func DropStaleCompanions(ctx context.Context, svcCtx *svc.ServiceContext, companions []*user.CompanionInfo) []*user.CompanionInfo {
+	ids := make([]uint64, 0, len(companions))
+	for _, c := range companions {
+		if c.GetStatus() == model.CompanionStatusOnline {
+			ids = append(ids, c.GetUserId())
+		}
+	}
+	if len(ids) == 0 {
+		return companions
+	}
+	live, ok := LivePresence(ctx, svcCtx, ids)
+	if !ok {
+		return companions
+	}
+	kept := companions[:0]
+	for _, c := range companions {
+		if c.GetStatus() == model.CompanionStatusOnline && !live[c.GetUserId()] {
+			continue
+		}
+		kept = append(kept, c)
+	}
+	return kept
+}
+
+// PublishCompanionStatusChanged 发送陪玩在线状态变更事件（状态已落库，发送失败只记录日志）
+// 从离线切换为在线时同时发送上线动态
+func PublishCompanionStatusChanged(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, userID uint64, oldStatus, status int, reason string) {
+	if svcCtx.EventProducer == nil || oldStatus == status {
+		return
+	}
+	if oldStatus == model.CompanionStatusOffline && status == model.CompanionStatusOnline {
+		PublishOnlineActivity(ctx, svcCtx, logger, userID)
+	}
+	payload := &userMQ.CompanionStatusChangedPayload{
+		UserID:    userID,
+		OldStatus: oldStatus,
+		Status:    status,
+		Reason:    reason,
+		ChangedAt: time.Now().Unix(),
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		LogError(logger, OpCompanionPresence, "marshal status changed event failed", err, map[string]interface{}{"user_id": userID})
+		return
+	}
+	msg := primitive.NewMessage(userMQ.CompanionEventTopic(), body)
+	msg.WithTag(userMQ.EventTypeCompanionStatusChanged())
+	msg.WithKeys([]string{strconv.FormatUint(userID, 10)})
+	if _, err := svcCtx.EventProducer.SendSync(ctx, msg); err != nil {
+		LogError(logger, OpCompanionPresence, "send status changed event failed", err, map[string]interface{}{
+			"user_id": userID,
+			"status":  status,
+			"reason":  reason,
+		})
+	}
+}
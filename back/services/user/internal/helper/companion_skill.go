@@ -0,0 +1,322 @@
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	"SLGaming/back/pkg/snowflake"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// companionSkillRow 陪玩技能与游戏名称的联表结果
+type companionSkillRow struct {
+	CompanionID  uint64 `gorm:"column:companion_id"`
+	GameSkillID  uint64 `gorm:"column:game_skill_id"`
+	GameName     string `gorm:"column:game_name"`
+	Rank         string `gorm:"column:rank"`
+	PricePerHour int64  `gorm:"column:price_per_hour"`
+	IsVerified   bool   `gorm:"column:is_verified"`
+}
+
+// LoadCompanionSkills 批量查询陪玩的技能（已删除的游戏技能不返回），按添加顺序排列
+func LoadCompanionSkills(ctx context.Context, db *gorm.DB, companionIDs []uint64) (map[uint64][]*user.CompanionSkill, error) {
+	result := make(map[uint64][]*user.CompanionSkill, len(companionIDs))
+	if len(companionIDs) == 0 {
+		return result, nil
+	}
+
+	var rows []companionSkillRow
+	err := db.WithContext(ctx).Table("companion_skills").
+		Select("companion_skills.companion_id, companion_skills.game_skill_id, game_skills.name AS game_name, "+
+			"companion_skills.rank, companion_skills.price_per_hour, companion_skills.is_verified").
+		Joins("JOIN game_skills ON game_skills.id = companion_skills.game_skill_id AND game_skills.deleted_at IS NULL").
+		Where("companion_skills.companion_id IN ?", companionIDs).
+		Order("companion_skills.id ASC").
+		Find(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	for _, r := range rows {
+		result[r.CompanionID] = append(result[r.CompanionID], &user.CompanionSkill{
+			GameSkillId:  r.GameSkillID,
+			GameName:     r.GameName,
+			Rank:         r.Rank,
+			PricePerHour: r.PricePerHour,
+			IsVerified:   r.IsVerified,
+		})
+	}
+	return result, nil
+}
+
+// AttachCompanionSkills 为 CompanionInfo 填充技能列表
+// 尚未迁移到技能表的旧数据保持原样（game_skill 与 price_per_hour 仍可用）
+func AttachCompanionSkills(ctx context.Context, db *gorm.DB, infos ...*user.CompanionInfo) error {
+	ids := make([]uint64, 0, len(infos))
+	for _, info := range infos {
+		if info != nil {
+			ids = append(ids, info.UserId)
+		}
+	}
+	skills, err := LoadCompanionSkills(ctx, db, ids)
+	if err != nil {
+		return err
+	}
+	for _, info := range infos {
+		if info == nil {
+			continue
+		}
+		if s := skills[info.UserId]; len(s) > 0 {
+			info.Skills = s
+			info.GameSkill = s[0].GameName
+		}
+	}
+	return nil
+}
+
+// NormalizeCompanionSkills 校验技能列表并解析到技能词典，返回填充了ID和名称的技能（不含认证状态）
+// 入驻申请提交时先校验，审核通过后再写入
+func NormalizeCompanionSkills(tx *gorm.DB, inputs []*user.CompanionSkill) ([]*user.CompanionSkill, error) {
+	if len(inputs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one skill is required")
+	}
+	if len(inputs) > model.MaxCompanionSkills {
+		return nil, status.Error(codes.InvalidArgument, "too many skills")
+	}
+
+	result := make([]*user.CompanionSkill, 0, len(inputs))
+	seen := make(map[uint64]bool, len(inputs))
+	for _, in := range inputs {
+		gs, err := resolveGameSkill(tx, in.GetGameSkillId(), in.GetGameName())
+		if err != nil {
+			return nil, err
+		}
+		if seen[gs.ID] {
+			return nil, status.Error(codes.InvalidArgument, "duplicate game skill")
+		}
+		seen[gs.ID] = true
+
+		if in.GetPricePerHour() <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "price_per_hour must be positive")
+		}
+		rank := strings.TrimSpace(in.GetRank())
+		if len([]rune(rank)) > 32 {
+			return nil, status.Error(codes.InvalidArgument, "rank is too long")
+		}
+
+		result = append(result, &user.CompanionSkill{
+			GameSkillId:  gs.ID,
+			GameName:     gs.Name,
+			Rank:         rank,
+			PricePerHour: in.GetPricePerHour(),
+		})
+	}
+	return result, nil
+}
+
+// ReplaceCompanionSkills 整体替换陪玩的技能列表（需在事务中调用）
+// 游戏按ID或名称解析到技能词典；同一游戏已认证的段位在段位未变化时保留认证状态；
+// 同步更新陪玩资料中的冗余字段：game_skills 为技能名称 JSON，price_per_hour 为最低价格
+func ReplaceCompanionSkills(ctx context.Context, tx *gorm.DB, companionID uint64, inputs []*user.CompanionSkill) ([]model.CompanionSkill, error) {
+	tx = tx.WithContext(ctx)
+
+	normalized, err := NormalizeCompanionSkills(tx, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	var existing []model.CompanionSkill
+	if err := tx.Where("companion_id = ?", companionID).Find(&existing).Error; err != nil {
+		return nil, err
+	}
+	existingBySkill := make(map[uint64]model.CompanionSkill, len(existing))
+	for _, s := range existing {
+		existingBySkill[s.GameSkillID] = s
+	}
+
+	skills := make([]model.CompanionSkill, 0, len(normalized))
+	names := make([]string, 0, len(normalized))
+	for _, in := range normalized {
+		old, ok := existingBySkill[in.GameSkillId]
+		skills = append(skills, model.CompanionSkill{
+			CompanionID:  companionID,
+			GameSkillID:  in.GameSkillId,
+			Rank:         in.Rank,
+			PricePerHour: in.PricePerHour,
+			IsVerified:   ok && old.IsVerified && old.Rank == in.Rank,
+		})
+		names = append(names, in.GameName)
+	}
+
+	if err := saveCompanionSkills(tx, companionID, skills, names); err != nil {
+		return nil, err
+	}
+	return skills, nil
+}
+
+// saveCompanionSkills 写入技能列表并同步陪玩资料中的冗余字段
+func saveCompanionSkills(tx *gorm.DB, companionID uint64, skills []model.CompanionSkill, names []string) error {
+	if err := tx.Where("companion_id = ?", companionID).Delete(&model.CompanionSkill{}).Error; err != nil {
+		return err
+	}
+	if err := tx.Create(&skills).Error; err != nil {
+		return err
+	}
+
+	var minPrice int64
+	for i, sk := range skills {
+		if i == 0 || sk.PricePerHour < minPrice {
+			minPrice = sk.PricePerHour
+		}
+	}
+	namesJSON, _ := json.Marshal(names)
+	return tx.Model(&model.CompanionProfile{}).
+		Where("user_id = ?", companionID).
+		Updates(map[string]any{
+			"game_skills":    string(namesJSON),
+			"price_per_hour": minPrice,
+		}).Error
+}
+
+// SetCompanionSkillPrice 把陪玩所有技能的价格设为同一值（兼容只传 price_per_hour 的旧接口）
+func SetCompanionSkillPrice(ctx context.Context, tx *gorm.DB, companionID uint64, price int64) error {
+	tx = tx.WithContext(ctx)
+	if err := tx.Model(&model.CompanionSkill{}).
+		Where("companion_id = ?", companionID).
+		Update("price_per_hour", price).Error; err != nil {
+		return err
+	}
+	return tx.Model(&model.CompanionProfile{}).
+		Where("user_id = ?", companionID).
+		Update("price_per_hour", price).Error
+}
+
+// resolveGameSkill 按ID或名称查找游戏技能词典
+func resolveGameSkill(tx *gorm.DB, id uint64, name string) (*model.GameSkill, error) {
+	var gs model.GameSkill
+	query := tx.Model(&model.GameSkill{})
+	name = strings.TrimSpace(name)
+	switch {
+	case id > 0:
+		query = query.Where("id = ?", id)
+	case name != "":
+		query = query.Where("name = ?", name)
+	default:
+		return nil, status.Error(codes.InvalidArgument, "game_skill_id or game_name is required")
+	}
+	if err := query.First(&gs).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.InvalidArgument, "unknown game skill")
+		}
+		return nil, err
+	}
+	return &gs, nil
+}
+
+// parseLegacyGameSkills 解析旧的 game_skills 字段：JSON 数组或单个游戏名称
+func parseLegacyGameSkills(raw string) []string {
+	raw = strings.TrimSpace(raw)
+	if raw == "" || raw == "[]" {
+		return nil
+	}
+	var names []string
+	if err := json.Unmarshal([]byte(raw), &names); err != nil {
+		names = []string{raw}
+	}
+	result := make([]string, 0, len(names))
+	seen := make(map[string]bool, len(names))
+	for _, n := range names {
+		n = strings.TrimSpace(n)
+		if n != "" && !seen[n] {
+			seen[n] = true
+			result = append(result, n)
+		}
+	}
+	return result
+}
+
+// MigrateCompanionSkills 把旧的 game_skills JSON 字段迁移到 companion_skills 表
+// 已有技能记录的陪玩跳过（可重复执行）；每个游戏沿用陪玩原来的统一价格（未设置价格的仍为0，下单时会被拒绝），
+// 词典中不存在的游戏名称会补建到 game_skills；返回迁移的陪玩数量
+func MigrateCompanionSkills(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger) (int, error) {
+	db := svcCtx.DB().WithContext(ctx)
+
+	migrated := 0
+	var profiles []model.CompanionProfile
+	result := db.Select("id, user_id, game_skills, price_per_hour").
+		Where("game_skills <> '' AND game_skills <> '[]'").
+		Where("NOT EXISTS (SELECT 1 FROM companion_skills WHERE companion_skills.companion_id = companion_profiles.user_id)").
+		FindInBatches(&profiles, 200, func(_ *gorm.DB, batch int) error {
+			for i := range profiles {
+				p := &profiles[i]
+				names := parseLegacyGameSkills(p.GameSkills)
+				if len(names) == 0 {
+					continue
+				}
+				if len(names) > model.MaxCompanionSkills {
+					names = names[:model.MaxCompanionSkills]
+				}
+
+				err := db.Transaction(func(tx *gorm.DB) error {
+					skills := make([]model.CompanionSkill, 0, len(names))
+					for _, name := range names {
+						gs, err := ensureGameSkill(tx, name)
+						if err != nil {
+							return err
+						}
+						skills = append(skills, model.CompanionSkill{
+							CompanionID:  p.UserID,
+							GameSkillID:  gs.ID,
+							PricePerHour: p.PricePerHour,
+						})
+					}
+					return saveCompanionSkills(tx, p.UserID, skills, names)
+				})
+				if err != nil {
+					LogError(logger, OpUpdateCompanionProfile, "migrate companion skills failed", err, map[string]interface{}{
+						"user_id":     p.UserID,
+						"game_skills": p.GameSkills,
+					})
+					return err
+				}
+				migrated++
+			}
+			LogInfo(logger, OpUpdateCompanionProfile, "companion skills migration batch done", map[string]interface{}{
+				"batch":    batch,
+				"migrated": migrated,
+			})
+			return nil
+		})
+	if result.Error != nil {
+		return migrated, result.Error
+	}
+	return migrated, nil
+}
+
+// ensureGameSkill 查找游戏技能，名称不在词典中时补建
+func ensureGameSkill(tx *gorm.DB, name string) (*model.GameSkill, error) {
+	var gs model.GameSkill
+	err := tx.Where("name = ?", name).First(&gs).Error
+	if err == nil {
+		return &gs, nil
+	}
+	if err != gorm.ErrRecordNotFound {
+		return nil, err
+	}
+	gs = model.GameSkill{
+		BaseModel: model.BaseModel{ID: uint64(snowflake.GenID())},
+		Name:      name,
+	}
+	if err := tx.Create(&gs).Error; err != nil {
+		return nil, err
+	}
+	return &gs, nil
+}
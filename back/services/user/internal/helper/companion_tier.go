@@ -0,0 +1,430 @@
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"sort"
+	"strconv"
+	"time"
+
+	"SLGaming/back/services/order/orderclient"
+	"SLGaming/back/services/user/internal/config"
+	"SLGaming/back/services/user/internal/model"
+	userMQ "SLGaming/back/services/user/internal/mq"
+	"SLGaming/back/services/user/internal/svc"
+
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// defaultCompanionTiers 未配置等级时使用的默认等级
+var defaultCompanionTiers = []config.CompanionTierLevelConf{
+	{Level: 1, Name: "青铜", MaxCancelRate: 1, MaxDisputeRate: 1, MaxPricePerHour: 50, CommissionPercent: 20},
+	{Level: 2, Name: "白银", MinCompletedOrders: 10, MinRating: 4.0, MaxCancelRate: 0.2, MaxDisputeRate: 0.1, MaxPricePerHour: 100, CommissionPercent: 18, RankBoost: 0.05},
+	{Level: 3, Name: "黄金", MinCompletedOrders: 30, MinRating: 4.3, MaxCancelRate: 0.15, MaxDisputeRate: 0.08, MaxPricePerHour: 200, CommissionPercent: 15, RankBoost: 0.1},
+	{Level: 4, Name: "铂金", MinCompletedOrders: 60, MinRating: 4.6, MaxCancelRate: 0.1, MaxDisputeRate: 0.05, MaxPricePerHour: 500, CommissionPercent: 12, RankBoost: 0.2},
+	{Level: 5, Name: "钻石", MinCompletedOrders: 100, MinRating: 4.8, MaxCancelRate: 0.05, MaxDisputeRate: 0.03, CommissionPercent: 10, RankBoost: 0.3},
+}
+
+// CompanionTierStats 陪玩在评估窗口内的表现
+type CompanionTierStats struct {
+	CompletedOrders int64
+	RatingSum       float64
+	RatingCount     int64
+	CancelledOrders int64
+	DisputedOrders  int64
+}
+
+// Rating 窗口内平均评分（没有评价时为 0）
+func (s *CompanionTierStats) Rating() float64 {
+	if s == nil || s.RatingCount == 0 {
+		return 0
+	}
+	return s.RatingSum / float64(s.RatingCount)
+}
+
+// CancelRate 取消率：陪玩取消数 / (完成数 + 陪玩取消数)
+func (s *CompanionTierStats) CancelRate() float64 {
+	if s == nil || s.CompletedOrders+s.CancelledOrders == 0 {
+		return 0
+	}
+	return float64(s.CancelledOrders) / float64(s.CompletedOrders+s.CancelledOrders)
+}
+
+// DisputeRate 纠纷率：老板发起纠纷的订单数 / 完成数（纠纷在订单完成后发起，完成时间可能早于窗口，结果不超过 1）
+func (s *CompanionTierStats) DisputeRate() float64 {
+	if s == nil || s.DisputedOrders == 0 {
+		return 0
+	}
+	if s.DisputedOrders >= s.CompletedOrders {
+		return 1
+	}
+	return float64(s.DisputedOrders) / float64(s.CompletedOrders)
+}
+
+// CompanionTierLevels 返回按等级升序排列的等级配置
+func CompanionTierLevels(svcCtx *svc.ServiceContext) []config.CompanionTierLevelConf {
+	levels := svcCtx.Config().CompanionTier.Levels
+	if len(levels) == 0 {
+		return defaultCompanionTiers
+	}
+	sorted := make([]config.CompanionTierLevelConf, len(levels))
+	copy(sorted, levels)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Level < sorted[j].Level })
+	return sorted
+}
+
+// CompanionTierLevel 查询指定等级的配置
+func CompanionTierLevel(svcCtx *svc.ServiceContext, tier int) (config.CompanionTierLevelConf, bool) {
+	for _, lv := range CompanionTierLevels(svcCtx) {
+		if lv.Level == tier {
+			return lv, true
+		}
+	}
+	return config.CompanionTierLevelConf{}, false
+}
+
+// CompanionTierName 等级展示名称（未知等级返回空）
+func CompanionTierName(svcCtx *svc.ServiceContext, tier int) string {
+	lv, _ := CompanionTierLevel(svcCtx, tier)
+	return lv.Name
+}
+
+// EvaluateCompanionTier 取满足全部门槛的最高等级；都不满足时为最低等级
+func EvaluateCompanionTier(levels []config.CompanionTierLevelConf, stats *CompanionTierStats) int {
+	if len(levels) == 0 {
+		return 0
+	}
+	if stats == nil {
+		stats = &CompanionTierStats{}
+	}
+	tier := levels[0].Level
+	for _, lv := range levels {
+		if stats.CompletedOrders < lv.MinCompletedOrders {
+			continue
+		}
+		if lv.MinRating > 0 && stats.Rating() < lv.MinRating {
+			continue
+		}
+		if stats.CancelRate() > lv.MaxCancelRate {
+			continue
+		}
+		if stats.DisputeRate() > lv.MaxDisputeRate {
+			continue
+		}
+		tier = lv.Level
+	}
+	return tier
+}
+
+// LoadCompanionTierStats 按陪玩批量统计窗口内的完成、评价、取消与纠纷事件（来自 companion_ranking_events）
+func LoadCompanionTierStats(ctx context.Context, db *gorm.DB, companionIDs []uint64, since time.Time) (map[uint64]*CompanionTierStats, error) {
+	result := make(map[uint64]*CompanionTierStats, len(companionIDs))
+	if len(companionIDs) == 0 {
+		return result, nil
+	}
+	var rows []struct {
+		CompanionID uint64  `gorm:"column:companion_id"`
+		Kind        string  `gorm:"column:kind"`
+		Cnt         int64   `gorm:"column:cnt"`
+		RatingSum   float64 `gorm:"column:rating_sum"`
+	}
+	if err := db.WithContext(ctx).
+		Model(&model.CompanionRankingEvent{}).
+		Select("companion_id, kind, COUNT(*) AS cnt, COALESCE(SUM(rating), 0) AS rating_sum").
+		Where("companion_id IN ? AND occurred_at >= ?", companionIDs, since).
+		Group("companion_id, kind").
+		Scan(&rows).Error; err != nil {
+		return nil, err
+	}
+	for _, r := range rows {
+		s := result[r.CompanionID]
+		if s == nil {
+			s = &CompanionTierStats{}
+			result[r.CompanionID] = s
+		}
+		switch r.Kind {
+		case model.RankingEventCompleted:
+			s.CompletedOrders = r.Cnt
+		case model.RankingEventRated:
+			s.RatingCount = r.Cnt
+			s.RatingSum = r.RatingSum
+		case model.RankingEventCancelled:
+			s.CancelledOrders = r.Cnt
+		case model.RankingEventDisputed:
+			s.DisputedOrders = r.Cnt
+		}
+	}
+	return result, nil
+}
+
+// 回填时每页查询的订单数量
+const tierStatsBackfillBatch = 500
+
+// 订单服务中已评价的订单状态（见 order 服务 model.OrderStatusRated）
+const orderStatusRated = 7
+
+// BackfillTierStats 按订单服务中窗口内结束的订单补记完成、评价、纠纷与陪玩取消事件（按订单幂等），返回处理的订单数
+// companion_ranking_events 只记录上线后的订单事件，首次评估前需要先回填，否则所有陪玩都会被评为最低等级
+// 历史订单没有评价时间，评价事件按完成时间记录
+func BackfillTierStats(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, since time.Time) (int, error) {
+	if svcCtx.OrderRPC == nil {
+		return 0, errors.New("order rpc not configured")
+	}
+	processed := 0
+	var afterID uint64
+	for {
+		resp, err := svcCtx.OrderRPC.ListFinishedOrders(ctx, &orderclient.ListFinishedOrdersRequest{
+			Since:   since.Unix(),
+			AfterId: afterID,
+			Limit:   tierStatsBackfillBatch,
+		})
+		if err != nil {
+			return processed, err
+		}
+		orders := resp.GetOrders()
+		for _, o := range orders {
+			if err := backfillOrderEvents(ctx, svcCtx, logger, o); err != nil {
+				return processed, err
+			}
+			afterID = o.GetId()
+			processed++
+		}
+		LogInfo(logger, OpCompanionTier, "tier stats backfill batch done", map[string]interface{}{
+			"after_id":  afterID,
+			"processed": processed,
+		})
+		if len(orders) < tierStatsBackfillBatch {
+			return processed, nil
+		}
+	}
+}
+
+// backfillOrderEvents 补记单个订单的等级统计事件
+func backfillOrderEvents(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, o *orderclient.OrderInfo) error {
+	if o.GetCompletedAt() > 0 {
+		completedAt := time.Unix(o.GetCompletedAt(), 0)
+		if err := RecordRankingEvent(ctx, svcCtx, logger, &model.CompanionRankingEvent{
+			OrderID:     o.GetId(),
+			Kind:        model.RankingEventCompleted,
+			CompanionID: o.GetCompanionId(),
+			GameName:    o.GetGameName(),
+			OccurredAt:  completedAt,
+		}); err != nil {
+			return err
+		}
+		if o.GetStatus() == orderStatusRated && o.GetRating() > 0 {
+			if err := RecordRankingEvent(ctx, svcCtx, logger, &model.CompanionRankingEvent{
+				OrderID:     o.GetId(),
+				Kind:        model.RankingEventRated,
+				CompanionID: o.GetCompanionId(),
+				GameName:    o.GetGameName(),
+				Rating:      o.GetRating(),
+				OccurredAt:  completedAt,
+			}); err != nil {
+				return err
+			}
+		}
+		if o.GetDisputedAt() > 0 {
+			return RecordDisputeEvent(ctx, svcCtx, o.GetCompanionId(), o.GetId(), time.Unix(o.GetDisputedAt(), 0))
+		}
+		return nil
+	}
+	if o.GetCancelledAt() > 0 && o.GetAcceptedAt() > 0 {
+		return RecordCancellationEvent(ctx, svcCtx, o.GetCompanionId(), o.GetId(), time.Unix(o.GetCancelledAt(), 0))
+	}
+	return nil
+}
+
+// ChangeCompanionTier 更新陪玩等级并记录变更原因（需在事务中调用）
+// 不改动陪玩的定价：新等级的价格上限低于当前价格时返回超限的价格供通知陪玩，订单服务下单时按上限拒绝
+func ChangeCompanionTier(svcCtx *svc.ServiceContext, tx *gorm.DB, history *model.CompanionTierHistory, override *int) ([]userMQ.TierOverCapPrice, error) {
+	updates := map[string]any{
+		"tier":       history.ToTier,
+		"rank_score": gorm.Expr(rankScoreExpr, TierRankBoost(svcCtx, history.ToTier)),
+	}
+	if override != nil {
+		updates["tier_override"] = *override
+	}
+	if err := tx.Model(&model.CompanionProfile{}).
+		Where("user_id = ?", history.CompanionID).
+		Updates(updates).Error; err != nil {
+		return nil, err
+	}
+	if err := tx.Create(history).Error; err != nil {
+		return nil, err
+	}
+
+	maxPrice := TierMaxPricePerHour(svcCtx, history.ToTier)
+	if maxPrice <= 0 {
+		return nil, nil
+	}
+
+	var overCap []userMQ.TierOverCapPrice
+	var skills []model.CompanionSkill
+	if err := tx.Select("id, game_skill_id, price_per_hour").
+		Where("companion_id = ? AND price_per_hour > ?", history.CompanionID, maxPrice).
+		Find(&skills).Error; err != nil {
+		return nil, err
+	}
+	for _, sk := range skills {
+		overCap = append(overCap, userMQ.TierOverCapPrice{GameSkillID: sk.GameSkillID, PricePerHour: sk.PricePerHour})
+	}
+
+	var profile model.CompanionProfile
+	err := tx.Select("id, price_per_hour").
+		Where("user_id = ? AND price_per_hour > ?", history.CompanionID, maxPrice).
+		First(&profile).Error
+	switch {
+	case err == nil:
+		overCap = append(overCap, userMQ.TierOverCapPrice{PricePerHour: profile.PricePerHour})
+	case !errors.Is(err, gorm.ErrRecordNotFound):
+		return nil, err
+	}
+	return overCap, nil
+}
+
+// PublishCompanionTierChanged 发送陪玩等级变更事件，供通知系统告知陪玩（等级已变更，发送失败只记录日志）
+func PublishCompanionTierChanged(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, history *model.CompanionTierHistory, overCap []userMQ.TierOverCapPrice) {
+	if svcCtx.EventProducer == nil || history == nil {
+		return
+	}
+	lv, _ := CompanionTierLevel(svcCtx, history.ToTier)
+	payload := &userMQ.CompanionTierChangedPayload{
+		CompanionID:     history.CompanionID,
+		FromTier:        history.FromTier,
+		ToTier:          history.ToTier,
+		ToTierName:      lv.Name,
+		Reason:          history.Reason,
+		MaxPricePerHour: lv.MaxPricePerHour,
+		OverCapPrices:   overCap,
+		ChangedAt:       time.Now().Unix(),
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		LogError(logger, OpCompanionTier, "marshal tier changed event failed", err, map[string]interface{}{"user_id": history.CompanionID})
+		return
+	}
+	msg := primitive.NewMessage(userMQ.CompanionEventTopic(), body)
+	msg.WithTag(userMQ.EventTypeCompanionTierChanged())
+	msg.WithKeys([]string{strconv.FormatUint(history.CompanionID, 10)})
+	if _, err := svcCtx.EventProducer.SendSync(ctx, msg); err != nil {
+		LogError(logger, OpCompanionTier, "send tier changed event failed", err, map[string]interface{}{
+			"user_id":  history.CompanionID,
+			"to_tier":  history.ToTier,
+			"over_cap": len(overCap),
+		})
+	}
+}
+
+// TierMaxPricePerHour 等级的每小时价格上限（未知等级或未配置时为 0，表示不限）
+func TierMaxPricePerHour(svcCtx *svc.ServiceContext, tier int) int64 {
+	lv, ok := CompanionTierLevel(svcCtx, tier)
+	if !ok || lv.MaxPricePerHour <= 0 {
+		return 0
+	}
+	return lv.MaxPricePerHour
+}
+
+// CheckTierPriceCap 校验陪玩设置的价格是否超过等级的价格上限（已有定价在降级后超限时由下单校验拦截）
+func CheckTierPriceCap(svcCtx *svc.ServiceContext, tier int, prices ...int64) error {
+	maxPrice := TierMaxPricePerHour(svcCtx, tier)
+	if maxPrice <= 0 {
+		return nil
+	}
+	for _, p := range prices {
+		if p > maxPrice {
+			return status.Errorf(codes.InvalidArgument, "price_per_hour exceeds tier limit %d", maxPrice)
+		}
+	}
+	return nil
+}
+
+// LowestCompanionTier 新陪玩的初始等级
+func LowestCompanionTier(svcCtx *svc.ServiceContext) int {
+	levels := CompanionTierLevels(svcCtx)
+	if len(levels) == 0 {
+		return 0
+	}
+	return levels[0].Level
+}
+
+// TierCommissionPercent 等级的平台抽成百分比（未知等级或配置越界时不抽成）
+func TierCommissionPercent(svcCtx *svc.ServiceContext, tier int) int {
+	lv, ok := CompanionTierLevel(svcCtx, tier)
+	if !ok || lv.CommissionPercent <= 0 || lv.CommissionPercent >= 100 {
+		return 0
+	}
+	return lv.CommissionPercent
+}
+
+// TierRankBoost 等级的排序加权（未知等级为 0）
+func TierRankBoost(svcCtx *svc.ServiceContext, tier int) float64 {
+	lv, _ := CompanionTierLevel(svcCtx, tier)
+	return lv.RankBoost
+}
+
+// CompanionRankScore 列表默认排序得分：贝叶斯评分 + 等级加权
+func CompanionRankScore(svcCtx *svc.ServiceContext, ratingScore float64, tier int) float64 {
+	return ratingScore + TierRankBoost(svcCtx, tier)
+}
+
+// rankScoreExpr 按评分列计算排序得分的 SQL 表达式，加权按 DECIMAL 计算以便与已存储的得分精确比较
+const rankScoreExpr = "rating_score + CAST(? AS DECIMAL(6,4))"
+
+// SyncCompanionRankScores 按当前等级配置校正所有陪玩的排序得分（调整等级加权配置后也能生效），返回更新的陪玩数量
+func SyncCompanionRankScores(ctx context.Context, svcCtx *svc.ServiceContext) (int64, error) {
+	db := svcCtx.DB().WithContext(ctx)
+	levels := CompanionTierLevels(svcCtx)
+	var updated int64
+	tiers := make([]int, 0, len(levels))
+	for _, lv := range levels {
+		tiers = append(tiers, lv.Level)
+		res := db.Model(&model.CompanionProfile{}).
+			Where("tier = ? AND rank_score <> "+rankScoreExpr, lv.Level, lv.RankBoost).
+			UpdateColumn("rank_score", gorm.Expr(rankScoreExpr, lv.RankBoost))
+		if res.Error != nil {
+			return updated, res.Error
+		}
+		updated += res.RowsAffected
+	}
+
+	q := db.Model(&model.CompanionProfile{}).Where("rank_score <> rating_score")
+	if len(tiers) > 0 {
+		q = q.Where("tier NOT IN ?", tiers)
+	}
+	res := q.UpdateColumn("rank_score", gorm.Expr("rating_score"))
+	if res.Error != nil {
+		return updated, res.Error
+	}
+	return updated + res.RowsAffected, nil
+}
+
+// RecordCancellationEvent 记录陪玩取消已接订单的事件（按订单幂等），用于等级评估的取消率
+func RecordCancellationEvent(ctx context.Context, svcCtx *svc.ServiceContext, companionID, orderID uint64, occurredAt time.Time) error {
+	return svcCtx.DB().WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&model.CompanionRankingEvent{
+			OrderID:     orderID,
+			Kind:        model.RankingEventCancelled,
+			CompanionID: companionID,
+			OccurredAt:  occurredAt,
+		}).Error
+}
+
+// RecordDisputeEvent 记录老板对订单发起纠纷的事件（按订单幂等），用于等级评估的纠纷率
+func RecordDisputeEvent(ctx context.Context, svcCtx *svc.ServiceContext, companionID, orderID uint64, occurredAt time.Time) error {
+	return svcCtx.DB().WithContext(ctx).
+		Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&model.CompanionRankingEvent{
+			OrderID:     orderID,
+			Kind:        model.RankingEventDisputed,
+			CompanionID: companionID,
+			OccurredAt:  occurredAt,
+		}).Error
+}
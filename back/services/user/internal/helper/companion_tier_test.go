@@ -0,0 +1,101 @@
+package helper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCompanionTierStats(t *testing.T) {
+	tests := []struct {
+		name        string
+		stats       *CompanionTierStats
+		rating      float64
+		cancelRate  float64
+		disputeRate float64
+	}{
+		{name: "空统计", stats: nil},
+		{name: "没有订单", stats: &CompanionTierStats{}},
+		{name: "只有取消", stats: &CompanionTierStats{CancelledOrders: 2}, cancelRate: 1},
+		{
+			name:       "完成与取消",
+			stats:      &CompanionTierStats{CompletedOrders: 9, CancelledOrders: 1, RatingSum: 45, RatingCount: 10},
+			rating:     4.5,
+			cancelRate: 0.1,
+		},
+		{name: "纠纷", stats: &CompanionTierStats{CompletedOrders: 20, DisputedOrders: 1}, disputeRate: 0.05},
+		{name: "纠纷的订单完成于窗口之前", stats: &CompanionTierStats{CompletedOrders: 1, DisputedOrders: 2}, disputeRate: 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.InDelta(t, tt.rating, tt.stats.Rating(), 1e-9)
+			assert.InDelta(t, tt.cancelRate, tt.stats.CancelRate(), 1e-9)
+			assert.InDelta(t, tt.disputeRate, tt.stats.DisputeRate(), 1e-9)
+		})
+	}
+}
+
+func TestEvaluateCompanionTier(t *testing.T) {
+	tests := []struct {
+		name  string
+		stats *CompanionTierStats
+		want  int
+	}{
+		{name: "没有统计时为最低等级", stats: nil, want: 1},
+		{name: "新陪玩", stats: &CompanionTierStats{}, want: 1},
+		{
+			name:  "单数够但没有评价",
+			stats: &CompanionTierStats{CompletedOrders: 20},
+			want:  1,
+		},
+		{
+			name:  "白银",
+			stats: &CompanionTierStats{CompletedOrders: 10, RatingSum: 40, RatingCount: 10},
+			want:  2,
+		},
+		{
+			name:  "单数达到黄金但评分不足",
+			stats: &CompanionTierStats{CompletedOrders: 30, RatingSum: 42, RatingCount: 10},
+			want:  2,
+		},
+		{
+			name:  "黄金",
+			stats: &CompanionTierStats{CompletedOrders: 30, RatingSum: 43, RatingCount: 10},
+			want:  3,
+		},
+		{
+			name:  "钻石",
+			stats: &CompanionTierStats{CompletedOrders: 100, RatingSum: 490, RatingCount: 100, CancelledOrders: 2},
+			want:  5,
+		},
+		{
+			name:  "取消率过高降到满足门槛的等级",
+			stats: &CompanionTierStats{CompletedOrders: 100, RatingSum: 490, RatingCount: 100, CancelledOrders: 12},
+			want:  3,
+		},
+		{
+			name:  "纠纷率过高降到满足门槛的等级",
+			stats: &CompanionTierStats{CompletedOrders: 100, RatingSum: 490, RatingCount: 100, DisputedOrders: 6},
+			want:  3,
+		},
+		{
+			name:  "纠纷率超过所有门槛",
+			stats: &CompanionTierStats{CompletedOrders: 30, RatingSum: 150, RatingCount: 30, DisputedOrders: 4},
+			want:  1,
+		},
+		{
+			name:  "取消率超过所有门槛",
+			stats: &CompanionTierStats{CompletedOrders: 10, RatingSum: 50, RatingCount: 10, CancelledOrders: 10},
+			want:  1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, EvaluateCompanionTier(defaultCompanionTiers, tt.stats))
+		})
+	}
+
+	assert.Equal(t, 0, EvaluateCompanionTier(nil, &CompanionTierStats{CompletedOrders: 100}))
+}
@@ -0,0 +1,290 @@
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"SLGaming/back/services/user/internal/cache"
+	"SLGaming/back/services/user/internal/config"
+	"SLGaming/back/services/user/internal/metrics"
+	"SLGaming/back/services/user/internal/model"
+	userMQ "SLGaming/back/services/user/internal/mq"
+	"SLGaming/back/services/user/internal/svc"
+
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+const (
+	defaultFeedFanoutMaxFollowers = 2000
+	defaultFeedFanoutBatchSize    = 500
+	defaultFeedInboxSize          = 500
+	defaultFeedInboxTTL           = 7 * 24 * time.Hour
+	defaultFeedOnlineCooldown     = time.Hour
+)
+
+// feedInboxSentinel 收件箱占位元素：重建后即使没有动态也保留列表，避免每次读取都重建
+const feedInboxSentinel = "0"
+
+// 动态投递方式（见 metrics.FeedEventTotal）
+const (
+	feedDeliveryPush      = "push"
+	feedDeliveryPull      = "pull"
+	feedDeliveryDuplicate = "duplicate"
+)
+
+// FeedConfig 关注动态配置（未配置的项使用默认值）
+func FeedConfig(svcCtx *svc.ServiceContext) config.FeedConf {
+	cfg := svcCtx.Config().Feed
+	if cfg.FanoutMaxFollowers <= 0 {
+		cfg.FanoutMaxFollowers = defaultFeedFanoutMaxFollowers
+	}
+	if cfg.FanoutBatchSize <= 0 {
+		cfg.FanoutBatchSize = defaultFeedFanoutBatchSize
+	}
+	if cfg.InboxSize <= 0 {
+		cfg.InboxSize = defaultFeedInboxSize
+	}
+	if cfg.InboxTTL <= 0 {
+		cfg.InboxTTL = defaultFeedInboxTTL
+	}
+	if cfg.OnlineCooldown <= 0 {
+		cfg.OnlineCooldown = defaultFeedOnlineCooldown
+	}
+	return cfg
+}
+
+// PublishCompanionActivity 发送陪玩动态事件，由关注事件消费者写入粉丝的动态流（发送失败只记录日志）
+func PublishCompanionActivity(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, payload *userMQ.CompanionActivityPayload) {
+	if svcCtx.EventProducer == nil {
+		return
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		LogError(logger, OpFeed, "marshal companion activity failed", err, map[string]interface{}{"user_id": payload.CompanionID})
+		return
+	}
+	msg := primitive.NewMessage(userMQ.FollowEventTopic(), body)
+	msg.WithTag(userMQ.EventTypeCompanionActivity())
+	msg.WithKeys([]string{strconv.FormatUint(payload.CompanionID, 10)})
+	if _, err := svcCtx.EventProducer.SendSync(ctx, msg); err != nil {
+		LogError(logger, OpFeed, "send companion activity failed", err, map[string]interface{}{
+			"user_id": payload.CompanionID,
+			"kind":    payload.Kind,
+		})
+	}
+}
+
+// PublishOnlineActivity 陪玩上线动态；同一冷却时间段内重复上下线只记录一次
+func PublishOnlineActivity(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, userID uint64) {
+	now := time.Now()
+	bucket := now.Unix() / int64(FeedConfig(svcCtx).OnlineCooldown.Seconds())
+	PublishCompanionActivity(ctx, svcCtx, logger, &userMQ.CompanionActivityPayload{
+		CompanionID: userID,
+		Kind:        model.FeedEventOnline,
+		DedupKey:    strconv.FormatInt(bucket, 10),
+		OccurredAt:  now.Unix(),
+	})
+}
+
+// PublishPriceActivity 陪玩调价动态（起步价变化时发送；首次定价不算调价）
+func PublishPriceActivity(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, userID uint64, oldPrice, newPrice int64) {
+	if oldPrice <= 0 || newPrice <= 0 || oldPrice == newPrice {
+		return
+	}
+	now := time.Now()
+	PublishCompanionActivity(ctx, svcCtx, logger, &userMQ.CompanionActivityPayload{
+		CompanionID: userID,
+		Kind:        model.FeedEventPrice,
+		DedupKey:    strconv.FormatInt(now.UnixMilli(), 10),
+		OldPrice:    oldPrice,
+		NewPrice:    newPrice,
+		OccurredAt:  now.Unix(),
+	})
+}
+
+// PublishPostActivity 陪玩发布新帖子的动态（按帖子 ID 去重）
+func PublishPostActivity(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, post *model.CompanionPost) {
+	PublishCompanionActivity(ctx, svcCtx, logger, &userMQ.CompanionActivityPayload{
+		CompanionID: post.UserID,
+		Kind:        model.FeedEventPost,
+		DedupKey:    strconv.FormatUint(post.ID, 10),
+		PostID:      post.ID,
+		Content:     post.Content,
+		ImageURLs:   DecodeStringList(post.ImageURLs),
+		OccurredAt:  post.CreatedAt.Unix(),
+	})
+}
+
+// RecordFeedEvent 记录陪玩动态并写扩散到粉丝收件箱
+// 按 (actor_id, kind, dedup_key) 幂等；重复投递时补做写扩散（上次可能中途失败），读取时按动态 ID 去重
+func RecordFeedEvent(ctx context.Context, svcCtx *svc.ServiceContext, event *model.FeedEvent) error {
+	db := svcCtx.DB().WithContext(ctx)
+	res := db.Clauses(clause.OnConflict{DoNothing: true}).Create(event)
+	if res.Error != nil {
+		return res.Error
+	}
+	duplicate := res.RowsAffected == 0
+	if duplicate {
+		var existing model.FeedEvent
+		if err := db.Select("id").
+			Where("actor_id = ? AND kind = ? AND dedup_key = ?", event.ActorID, event.Kind, event.DedupKey).
+			First(&existing).Error; err != nil {
+			return err
+		}
+		event.ID = existing.ID
+	}
+
+	delivery, err := fanoutFeedEvent(ctx, svcCtx, event)
+	if err != nil {
+		return err
+	}
+	if duplicate {
+		delivery = feedDeliveryDuplicate
+	}
+	metrics.FeedEventTotal.WithLabelValues(event.Kind, delivery).Inc()
+	return nil
+}
+
+// fanoutFeedEvent 写扩散：粉丝数不超过上限时把动态 ID 推入每个粉丝已存在的收件箱
+// 不存在的收件箱（从未读取或已过期）不写入，读取时按关注关系重建；粉丝过多的陪玩由粉丝读取时拉取
+func fanoutFeedEvent(ctx context.Context, svcCtx *svc.ServiceContext, event *model.FeedEvent) (string, error) {
+	if svcCtx.Redis == nil {
+		return feedDeliveryPull, nil
+	}
+	cfg := FeedConfig(svcCtx)
+	db := svcCtx.DB().WithContext(ctx)
+
+	var actor model.User
+	if err := db.Select("id, follower_count").Where("id = ?", event.ActorID).First(&actor).Error; err != nil {
+		return "", err
+	}
+	if actor.FollowerCount > cfg.FanoutMaxFollowers {
+		return feedDeliveryPull, nil
+	}
+
+	id := strconv.FormatUint(event.ID, 10)
+	var relations []model.FollowRelation
+	err := db.Select("id, follower_id").
+		Where("following_id = ?", event.ActorID).
+		FindInBatches(&relations, cfg.FanoutBatchSize, func(tx *gorm.DB, batch int) error {
+			return svcCtx.Redis.PipelinedCtx(ctx, func(pipe redis.Pipeliner) error {
+				for i := range relations {
+					key := fmt.Sprintf(cache.FeedInboxKey, relations[i].FollowerID)
+					pipe.LPushX(ctx, key, id)
+					pipe.LTrim(ctx, key, 0, int64(cfg.InboxSize-1))
+				}
+				return nil
+			})
+		}).Error
+	if err != nil {
+		return "", err
+	}
+	return feedDeliveryPush, nil
+}
+
+// InvalidateFeedInbox 关注关系变化后删除收件箱，下次读取时按新的关注关系重建（失败只记录日志）
+func InvalidateFeedInbox(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, userID uint64) {
+	if svcCtx.Redis == nil {
+		return
+	}
+	if _, err := svcCtx.Redis.DelCtx(ctx, fmt.Sprintf(cache.FeedInboxKey, userID)); err != nil {
+		LogError(logger, OpFeed, "invalidate feed inbox failed", err, map[string]interface{}{"user_id": userID})
+	}
+}
+
+// FeedInboxIDs 读取收件箱中的动态 ID 并续期；收件箱不存在时按关注关系从动态表重建（rebuilt=true）
+func FeedInboxIDs(ctx context.Context, svcCtx *svc.ServiceContext, userID uint64) (ids []uint64, rebuilt bool, err error) {
+	cfg := FeedConfig(svcCtx)
+	key := fmt.Sprintf(cache.FeedInboxKey, userID)
+
+	values, err := svcCtx.Redis.LrangeCtx(ctx, key, 0, -1)
+	if err != nil {
+		return nil, false, err
+	}
+	if len(values) == 0 {
+		ids, err = rebuildFeedInbox(ctx, svcCtx, userID, cfg)
+		return ids, true, err
+	}
+
+	if err := svcCtx.Redis.ExpireCtx(ctx, key, int(cfg.InboxTTL.Seconds())); err != nil {
+		logx.WithContext(ctx).Errorf("refresh feed inbox ttl failed: user_id=%d, error=%v", userID, err)
+	}
+	ids = make([]uint64, 0, len(values))
+	for _, v := range values {
+		if v == feedInboxSentinel {
+			continue
+		}
+		if id, err := strconv.ParseUint(v, 10, 64); err == nil {
+			ids = append(ids, id)
+		}
+	}
+	return ids, false, nil
+}
+
+// rebuildFeedInbox 从动态表取写扩散范围内（粉丝数不超过上限）的关注对象的最新动态，写回收件箱
+func rebuildFeedInbox(ctx context.Context, svcCtx *svc.ServiceContext, userID uint64, cfg config.FeedConf) ([]uint64, error) {
+	db := svcCtx.DB().WithContext(ctx)
+
+	var ids []uint64
+	if err := db.Model(&model.FeedEvent{}).
+		Where("actor_id IN (?)", followeesQuery(db, userID).Where("users.follower_count <= ?", cfg.FanoutMaxFollowers)).
+		Order("id DESC").
+		Limit(cfg.InboxSize).
+		Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+
+	values := make([]any, 0, len(ids)+1)
+	for _, id := range ids {
+		values = append(values, strconv.FormatUint(id, 10))
+	}
+	values = append(values, feedInboxSentinel)
+
+	key := fmt.Sprintf(cache.FeedInboxKey, userID)
+	err := svcCtx.Redis.PipelinedCtx(ctx, func(pipe redis.Pipeliner) error {
+		pipe.Del(ctx, key)
+		pipe.RPush(ctx, key, values...)
+		pipe.Expire(ctx, key, cfg.InboxTTL)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// PullFeedIDs 从动态表拉取关注对象早于 cursor 的最新动态 ID（cursor=0 表示从最新开始）
+// largeOnly=true 时只拉取粉丝数超过写扩散上限的陪玩，其余已在收件箱中
+func PullFeedIDs(ctx context.Context, svcCtx *svc.ServiceContext, userID, cursor uint64, limit int, largeOnly bool) ([]uint64, error) {
+	db := svcCtx.DB().WithContext(ctx)
+
+	followees := followeesQuery(db, userID)
+	if largeOnly {
+		followees = followees.Where("users.follower_count > ?", FeedConfig(svcCtx).FanoutMaxFollowers)
+	}
+	query := db.Model(&model.FeedEvent{}).Where("actor_id IN (?)", followees)
+	if cursor > 0 {
+		query = query.Where("id < ?", cursor)
+	}
+
+	var ids []uint64
+	if err := query.Order("id DESC").Limit(limit).Pluck("id", &ids).Error; err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// followeesQuery 用户关注的人（关联 users 以便按粉丝数区分写扩散与拉取）
+func followeesQuery(db *gorm.DB, userID uint64) *gorm.DB {
+	return db.Model(&model.FollowRelation{}).
+		Select("follow_relations.following_id").
+		Joins("JOIN users ON users.id = follow_relations.following_id").
+		Where("follow_relations.follower_id = ?", userID)
+}
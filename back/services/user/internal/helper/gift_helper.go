@@ -0,0 +1,142 @@
+package helper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"SLGaming/back/pkg/snowflake"
+	"SLGaming/back/services/user/internal/cache"
+	"SLGaming/back/services/user/internal/model"
+	userMQ "SLGaming/back/services/user/internal/mq"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// NewGiftBizOrderID 生成打赏/送礼业务单号（客户端未传时使用）
+func NewGiftBizOrderID(prefix string) string {
+	return prefix + snowflake.EncodeBase36(snowflake.GenID())
+}
+
+// giftQuotaReserveScript 原子地占用当日额度与次数，超限时回滚并返回超限维度
+// KEYS[1]: 当日额度  KEYS[2]: 当日次数
+// ARGV: 本次金额, 额度上限(0=不限), 次数上限(0=不限), 过期时间(秒)
+// 返回 0=成功, 1=额度超限, 2=次数超限
+var giftQuotaReserveScript = redis.NewScript(`
+local amount = redis.call("INCRBY", KEYS[1], ARGV[1])
+local count = redis.call("INCR", KEYS[2])
+redis.call("EXPIRE", KEYS[1], ARGV[4])
+redis.call("EXPIRE", KEYS[2], ARGV[4])
+local exceeded = 0
+if tonumber(ARGV[2]) > 0 and amount > tonumber(ARGV[2]) then
+  exceeded = 1
+elseif tonumber(ARGV[3]) > 0 and count > tonumber(ARGV[3]) then
+  exceeded = 2
+end
+if exceeded > 0 then
+  redis.call("DECRBY", KEYS[1], ARGV[1])
+  redis.call("DECR", KEYS[2])
+end
+return exceeded
+`)
+
+// GiftQuota 已占用的当日打赏/送礼额度，转账失败或重复请求时需调用 Release 归还
+type GiftQuota struct {
+	svcCtx    *svc.ServiceContext
+	logger    logx.Logger
+	userID    uint64
+	amount    int64
+	amountKey string
+	countKey  string
+}
+
+// ReserveGiftQuota 打赏/送礼风控：检查单笔上限，并在转账前原子地占用每日转出额度与次数
+// 并发请求不会同时通过检查；Redis 不可用时只做单笔上限检查（降级放行，返回的 quota 为 nil）
+func ReserveGiftQuota(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, userID uint64, amount int64) (*GiftQuota, error) {
+	conf := svcCtx.Config().Gift
+	if conf.MaxSingleAmount > 0 && amount > conf.MaxSingleAmount {
+		return nil, status.Errorf(codes.InvalidArgument, "amount exceeds single limit %d", conf.MaxSingleAmount)
+	}
+	if svcCtx.Redis == nil || (conf.DailyAmountLimit <= 0 && conf.DailyCountLimit <= 0) {
+		return nil, nil
+	}
+
+	day := time.Now().Format("20060102")
+	q := &GiftQuota{
+		svcCtx:    svcCtx,
+		logger:    logger,
+		userID:    userID,
+		amount:    amount,
+		amountKey: fmt.Sprintf(cache.GiftDailyAmountKey, userID, day),
+		countKey:  fmt.Sprintf(cache.GiftDailyCountKey, userID, day),
+	}
+	val, err := svcCtx.Redis.ScriptRunCtx(ctx, giftQuotaReserveScript, []string{q.amountKey, q.countKey},
+		amount, conf.DailyAmountLimit, conf.DailyCountLimit, int(cache.GiftDailyExpire.Seconds()))
+	if err != nil {
+		LogError(logger, OpTransfer, "reserve daily quota failed", err, map[string]interface{}{"user_id": userID})
+		return nil, nil
+	}
+	switch code, _ := val.(int64); code {
+	case 1:
+		return nil, status.Error(codes.ResourceExhausted, "daily transfer amount limit exceeded")
+	case 2:
+		return nil, status.Error(codes.ResourceExhausted, "daily transfer count limit exceeded")
+	}
+	return q, nil
+}
+
+// Release 归还占用的额度与次数（转账失败或幂等重放时调用）；nil 安全
+func (q *GiftQuota) Release(ctx context.Context) {
+	if q == nil {
+		return
+	}
+	if _, err := q.svcCtx.Redis.DecrbyCtx(ctx, q.amountKey, q.amount); err != nil {
+		LogError(q.logger, OpTransfer, "release daily amount failed", err, map[string]interface{}{"user_id": q.userID})
+	}
+	if _, err := q.svcCtx.Redis.DecrCtx(ctx, q.countKey); err != nil {
+		LogError(q.logger, OpTransfer, "release daily count failed", err, map[string]interface{}{"user_id": q.userID})
+	}
+}
+
+// PublishGiftEvent 发送打赏/送礼事件（转账已提交，发送失败只记录日志）
+func PublishGiftEvent(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, tag string, payload *userMQ.GiftSentPayload) {
+	if svcCtx.EventProducer == nil || payload == nil {
+		return
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		LogError(logger, OpTransfer, "marshal gift event failed", err, map[string]interface{}{"biz_order_id": payload.BizOrderID})
+		return
+	}
+	msg := primitive.NewMessage(userMQ.GiftEventTopic(), body)
+	msg.WithTag(tag)
+	msg.WithKeys([]string{payload.BizOrderID})
+	if _, err := svcCtx.EventProducer.SendSync(ctx, msg); err != nil {
+		LogError(logger, OpTransfer, "send gift event failed", err, map[string]interface{}{
+			"biz_order_id": payload.BizOrderID,
+			"tag":          tag,
+		})
+	}
+}
+
+// ToGiftInfo 转换为 protobuf 的 GiftInfo
+func ToGiftInfo(g *model.Gift) *user.GiftInfo {
+	if g == nil {
+		return nil
+	}
+	return &user.GiftInfo{
+		Id:      g.ID,
+		Name:    g.Name,
+		Price:   g.Price,
+		IconUrl: g.IconURL,
+		Sort:    int32(g.Sort),
+		Enabled: g.Enabled,
+	}
+}
@@ -27,10 +27,29 @@ const (
 	OpUpdateCompanionStats      LogOperation = "update_companion_stats"
 	OpGetCompanionRatingRanking LogOperation = "get_companion_rating_ranking"
 	OpGetCompanionOrdersRanking LogOperation = "get_companion_orders_ranking"
+	OpRankingWindow             LogOperation = "ranking_window"
 	OpServer                    LogOperation = "server"
 	OpFollow                    LogOperation = "follow"
 	OpUnfollow                  LogOperation = "unfollow"
 	OpMQConsumer                LogOperation = "mq_consumer"
+	OpTransfer                  LogOperation = "transfer"
+	OpSendGift                  LogOperation = "send_gift"
+	OpManageGift                LogOperation = "manage_gift"
+	OpSubscribeVip              LogOperation = "subscribe_vip"
+	OpVipEntitlements           LogOperation = "vip_entitlements"
+	OpVipJob                    LogOperation = "vip_job"
+	OpUnlockLogin               LogOperation = "unlock_login"
+	OpLoginEvent                LogOperation = "login_event"
+	OpModeration                LogOperation = "moderation"
+	OpVerifyTicket              LogOperation = "verify_ticket"
+	OpBindEmail                 LogOperation = "bind_email"
+	OpCompanionApplication      LogOperation = "companion_application"
+	OpCompanionList             LogOperation = "companion_list"
+	OpCompanionPresence         LogOperation = "companion_presence"
+	OpCompanionOrders           LogOperation = "companion_orders"
+	OpCompanionTier             LogOperation = "companion_tier"
+	OpFeed                      LogOperation = "feed"
+	OpBlockUser                 LogOperation = "block_user"
 )
 
 // LogRequest 记录请求开始日志
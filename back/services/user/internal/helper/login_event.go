@@ -0,0 +1,155 @@
+package helper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"net"
+	"strconv"
+	"strings"
+
+	"SLGaming/back/services/user/internal/metrics"
+	"SLGaming/back/services/user/internal/model"
+	userMQ "SLGaming/back/services/user/internal/mq"
+	"SLGaming/back/services/user/internal/svc"
+
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+// RecordLoginEvent 写入登录记录（失败只记录日志，不影响登录结果）
+// 密码/验证码登录成功时检查设备指纹与 IP 网段是否首次出现，是则标记并发出安全提醒事件
+func RecordLoginEvent(ctx context.Context, svcCtx *svc.ServiceContext, event *model.LoginEvent) {
+	db := svcCtx.DB()
+	if db == nil {
+		return
+	}
+	logger := logx.WithContext(ctx)
+
+	if ua := []rune(event.UserAgent); len(ua) > 256 {
+		event.UserAgent = string(ua[:256])
+	}
+	event.DeviceFingerprint = DeviceFingerprint(event.UserAgent)
+	event.IPRegion = IPRegion(event.IP)
+
+	// 刷新 Token 属于已登录会话的延续，不做新设备判断（盗用由 Refresh Token 轮换检测）
+	var newDevice, newRegion bool
+	if event.UserID != 0 && event.Result == model.LoginResultSuccess && event.Method != model.LoginMethodRefresh {
+		newDevice, newRegion = detectNewDevice(ctx, svcCtx, event)
+		event.NewDevice = newDevice || newRegion
+	}
+
+	if err := db.WithContext(ctx).Create(event).Error; err != nil {
+		logger.Errorf("record login event failed: user_id=%d, method=%s, result=%s, err=%v",
+			event.UserID, event.Method, event.Result, err)
+		return
+	}
+
+	if event.NewDevice {
+		metrics.LoginNewDeviceTotal.WithLabelValues(event.Method).Inc()
+		LogInfo(logger, OpLoginEvent, "login from new device or region", map[string]interface{}{
+			"user_id":    event.UserID,
+			"ip":         event.IP,
+			"ip_region":  event.IPRegion,
+			"new_device": newDevice,
+			"new_region": newRegion,
+		})
+		publishNewDeviceLogin(ctx, svcCtx, logger, event, newDevice, newRegion)
+	}
+}
+
+// detectNewDevice 对比用户历史成功登录，判断设备指纹与 IP 网段是否首次出现
+// 用户没有任何历史成功登录（首次登录或功能上线前的老用户）时不视为新设备
+func detectNewDevice(ctx context.Context, svcCtx *svc.ServiceContext, event *model.LoginEvent) (newDevice, newRegion bool) {
+	var seen struct {
+		Total      int64
+		SameDevice int64
+		SameRegion int64
+	}
+	err := svcCtx.DB().WithContext(ctx).Model(&model.LoginEvent{}).
+		Select("COUNT(*) AS total, "+
+			"COALESCE(SUM(CASE WHEN device_fingerprint = ? THEN 1 ELSE 0 END), 0) AS same_device, "+
+			"COALESCE(SUM(CASE WHEN ip_region = ? THEN 1 ELSE 0 END), 0) AS same_region",
+			event.DeviceFingerprint, event.IPRegion).
+		Where("user_id = ? AND result = ?", event.UserID, model.LoginResultSuccess).
+		Scan(&seen).Error
+	if err != nil {
+		logx.WithContext(ctx).Errorf("query login history failed: user_id=%d, err=%v", event.UserID, err)
+		return false, false
+	}
+	if seen.Total == 0 {
+		return false, false
+	}
+	return event.DeviceFingerprint != "" && seen.SameDevice == 0, event.IPRegion != "" && seen.SameRegion == 0
+}
+
+// publishNewDeviceLogin 发送新设备/新地区登录事件（登录已完成，发送失败只记录日志）
+func publishNewDeviceLogin(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, event *model.LoginEvent, newDevice, newRegion bool) {
+	if svcCtx.EventProducer == nil {
+		return
+	}
+	body, err := json.Marshal(&userMQ.NewDeviceLoginPayload{
+		UserID:            event.UserID,
+		EventID:           event.ID,
+		Method:            event.Method,
+		IP:                event.IP,
+		IPRegion:          event.IPRegion,
+		UserAgent:         event.UserAgent,
+		DeviceFingerprint: event.DeviceFingerprint,
+		NewDevice:         newDevice,
+		NewRegion:         newRegion,
+		LoginAt:           event.OccurredAt.Unix(),
+	})
+	if err != nil {
+		LogError(logger, OpLoginEvent, "marshal new device login event failed", err, map[string]interface{}{"user_id": event.UserID})
+		return
+	}
+	msg := primitive.NewMessage(userMQ.SecurityEventTopic(), body)
+	msg.WithTag(userMQ.EventTypeNewDeviceLogin())
+	msg.WithKeys([]string{strconv.FormatUint(event.ID, 10)})
+	if _, err := svcCtx.EventProducer.SendSync(ctx, msg); err != nil {
+		LogError(logger, OpLoginEvent, "send new device login event failed", err, map[string]interface{}{
+			"user_id":  event.UserID,
+			"event_id": event.ID,
+		})
+	}
+}
+
+// DeviceFingerprint 根据 User-Agent 计算设备指纹（归一化后取 SHA-256 前 16 字节），UA 为空时返回空串
+func DeviceFingerprint(userAgent string) string {
+	ua := strings.ToLower(strings.Join(strings.Fields(userAgent), " "))
+	if ua == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(ua))
+	return hex.EncodeToString(sum[:16])
+}
+
+// IPRegion 返回 IP 所属网段（IPv4 取 /16，IPv6 取 /32），内网地址统一返回 private
+// 未接入 IP 地理库，以网段近似地区：同一运营商同一地区的出口地址通常落在同一网段
+func IPRegion(ip string) string {
+	parsed := net.ParseIP(strings.TrimSpace(ip))
+	if parsed == nil {
+		return ""
+	}
+	if parsed.IsLoopback() || parsed.IsPrivate() || parsed.IsLinkLocalUnicast() {
+		return "private"
+	}
+	if v4 := parsed.To4(); v4 != nil {
+		return (&net.IPNet{IP: v4.Mask(net.CIDRMask(16, 32)), Mask: net.CIDRMask(16, 32)}).String()
+	}
+	return (&net.IPNet{IP: parsed.Mask(net.CIDRMask(32, 128)), Mask: net.CIDRMask(32, 128)}).String()
+}
+
+// LoginLockReason 锁定原因说明（写入登录记录）
+func LoginLockReason(result string) string {
+	switch result {
+	case model.LoginResultLocked:
+		return "too many failed attempts, temporarily locked"
+	case model.LoginResultDelayed:
+		return "too many failed attempts, retry later"
+	default:
+		return result
+	}
+}
@@ -0,0 +1,209 @@
+package helper
+
+import (
+	"context"
+	"math"
+	"time"
+
+	"SLGaming/back/services/user/internal/config"
+	"SLGaming/back/services/user/internal/metrics"
+	"SLGaming/back/services/user/internal/model"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const loginGuardKeyPrefix = "login:guard:"
+
+// 统计维度
+const (
+	loginScopePhone = "phone"
+	loginScopeIP    = "ip"
+)
+
+// loginGuardCheckScript 查询锁定与等待剩余时间
+// KEYS: 依次为各维度的 lock / delay key；返回各 key 的剩余毫秒数（不存在为 0）
+var loginGuardCheckScript = redis.NewScript(`
+local res = {}
+for i, key in ipairs(KEYS) do
+  local ttl = redis.call("PTTL", key)
+  if ttl < 0 then ttl = 0 end
+  res[i] = ttl
+end
+return res
+`)
+
+// loginGuardFailScript 记录一次失败，返回 {失败次数, 是否锁定, 等待毫秒数}
+// KEYS[1]: 失败计数  KEYS[2]: 等待标记  KEYS[3]: 锁定标记
+// ARGV: 统计窗口(秒), 失败上限, 开始等待的次数, 首次等待(毫秒), 等待上限(毫秒), 锁定时长(秒)
+var loginGuardFailScript = redis.NewScript(`
+local n = redis.call("INCR", KEYS[1])
+redis.call("EXPIRE", KEYS[1], ARGV[1])
+if n >= tonumber(ARGV[2]) then
+  redis.call("SET", KEYS[3], n, "EX", ARGV[6])
+  redis.call("DEL", KEYS[1], KEYS[2])
+  return {n, 1, 0}
+end
+local delay = 0
+if n > tonumber(ARGV[3]) then
+  delay = math.floor(math.min(tonumber(ARGV[4]) * 2 ^ (n - tonumber(ARGV[3]) - 1), tonumber(ARGV[5])))
+  if delay > 0 then
+    redis.call("SET", KEYS[2], 1, "PX", delay)
+  end
+end
+return {n, 0, delay}
+`)
+
+// LoginGuard 密码登录防暴力破解
+// 按手机号与 IP 分别统计失败次数：失败若干次后每次需等待指数增长的时间，达到上限后临时锁定
+// Redis 不可用时放行（不应因风控组件故障导致无法登录）
+type LoginGuard struct {
+	rds  *redis.Redis
+	conf config.LoginProtectionConf
+}
+
+// NewLoginGuard 创建防暴力破解组件，rds 为空或未启用时所有检查直接放行
+func NewLoginGuard(rds *redis.Redis, conf config.LoginProtectionConf) *LoginGuard {
+	return &LoginGuard{rds: rds, conf: conf}
+}
+
+func (g *LoginGuard) enabled() bool {
+	return g != nil && g.rds != nil && g.conf.Enabled
+}
+
+type loginScope struct {
+	name  string
+	value string
+	max   int64
+}
+
+func (g *LoginGuard) scopes(phone, ip string) []loginScope {
+	scopes := make([]loginScope, 0, 2)
+	if phone != "" {
+		scopes = append(scopes, loginScope{name: loginScopePhone, value: phone, max: g.conf.PhoneMaxFailures})
+	}
+	if ip != "" {
+		scopes = append(scopes, loginScope{name: loginScopeIP, value: ip, max: g.conf.IPMaxFailures})
+	}
+	return scopes
+}
+
+func loginGuardKey(kind string, s loginScope) string {
+	return loginGuardKeyPrefix + kind + ":" + s.name + ":" + s.value
+}
+
+// Check 登录前检查手机号与 IP 是否处于锁定或等待期
+// 返回非空 result（model.LoginResultLocked / model.LoginResultDelayed）时 err 为需要返回给调用方的错误
+func (g *LoginGuard) Check(ctx context.Context, phone, ip string) (string, error) {
+	if !g.enabled() {
+		return "", nil
+	}
+
+	scopes := g.scopes(phone, ip)
+	keys := make([]string, 0, len(scopes)*2)
+	for _, s := range scopes {
+		keys = append(keys, loginGuardKey("lock", s), loginGuardKey("delay", s))
+	}
+
+	val, err := g.rds.ScriptRunCtx(ctx, loginGuardCheckScript, keys)
+	if err != nil {
+		logx.WithContext(ctx).Errorf("[login_guard] check failed, skip: %v", err)
+		return "", nil
+	}
+	ttls, ok := val.([]interface{})
+	if !ok || len(ttls) != len(keys) {
+		return "", nil
+	}
+
+	for i, s := range scopes {
+		if lockMs, _ := ttls[i*2].(int64); lockMs > 0 {
+			metrics.LoginProtectionTotal.WithLabelValues("blocked", s.name).Inc()
+			return model.LoginResultLocked, status.Errorf(codes.ResourceExhausted,
+				"too many failed login attempts, %s locked for %ds", s.name, ceilSeconds(lockMs))
+		}
+	}
+	for i, s := range scopes {
+		if delayMs, _ := ttls[i*2+1].(int64); delayMs > 0 {
+			metrics.LoginProtectionTotal.WithLabelValues("delayed", s.name).Inc()
+			return model.LoginResultDelayed, status.Errorf(codes.ResourceExhausted,
+				"too many failed login attempts, retry after %ds", ceilSeconds(delayMs))
+		}
+	}
+	return "", nil
+}
+
+// RecordFailure 记录一次失败的密码登录，返回是否因此触发锁定
+func (g *LoginGuard) RecordFailure(ctx context.Context, phone, ip string) bool {
+	if !g.enabled() {
+		return false
+	}
+
+	locked := false
+	for _, s := range g.scopes(phone, ip) {
+		if s.max <= 0 {
+			continue
+		}
+		val, err := g.rds.ScriptRunCtx(ctx, loginGuardFailScript,
+			[]string{loginGuardKey("fail", s), loginGuardKey("delay", s), loginGuardKey("lock", s)},
+			int(g.conf.FailureWindow.Seconds()), s.max, g.conf.DelayAfter,
+			g.conf.BaseDelay.Milliseconds(), g.conf.MaxDelay.Milliseconds(), int(g.conf.LockDuration.Seconds()))
+		if err != nil {
+			logx.WithContext(ctx).Errorf("[login_guard] record failure failed: scope=%s, err=%v", s.name, err)
+			continue
+		}
+
+		metrics.LoginProtectionTotal.WithLabelValues("failure", s.name).Inc()
+		if res, ok := val.([]interface{}); ok && len(res) == 3 {
+			if isLocked, _ := res[1].(int64); isLocked == 1 {
+				locked = true
+				metrics.LoginProtectionTotal.WithLabelValues("locked", s.name).Inc()
+				logx.WithContext(ctx).Infof("[login_guard] %s locked after %v failures: %s", s.name, res[0], s.value)
+			}
+		}
+	}
+	return locked
+}
+
+// Reset 密码登录成功后清空手机号的失败计数（IP 计数保留，避免撞库者用自己的账号重置）
+func (g *LoginGuard) Reset(ctx context.Context, phone string) {
+	if !g.enabled() || phone == "" {
+		return
+	}
+	s := loginScope{name: loginScopePhone, value: phone}
+	if _, err := g.rds.DelCtx(ctx, loginGuardKey("fail", s), loginGuardKey("delay", s)); err != nil {
+		logx.WithContext(ctx).Errorf("[login_guard] reset failed: %v", err)
+	}
+}
+
+// Unlock 解除手机号和/或 IP 的锁定并清空失败计数，返回此前是否处于锁定状态
+func (g *LoginGuard) Unlock(ctx context.Context, phone, ip string) (bool, error) {
+	if !g.enabled() {
+		return false, nil
+	}
+
+	keys := make([]string, 0, 6)
+	var lockKeys []string
+	for _, s := range g.scopes(phone, ip) {
+		lockKey := loginGuardKey("lock", s)
+		lockKeys = append(lockKeys, lockKey)
+		keys = append(keys, lockKey, loginGuardKey("fail", s), loginGuardKey("delay", s))
+	}
+	if len(keys) == 0 {
+		return false, nil
+	}
+
+	locked, err := g.rds.ExistsManyCtx(ctx, lockKeys...)
+	if err != nil {
+		return false, err
+	}
+	if _, err := g.rds.DelCtx(ctx, keys...); err != nil {
+		return false, err
+	}
+	return locked > 0, nil
+}
+
+func ceilSeconds(ms int64) int64 {
+	return int64(math.Ceil(float64(ms) / float64(time.Second/time.Millisecond)))
+}
@@ -0,0 +1,196 @@
+package helper
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"SLGaming/back/services/user/internal/config"
+	"SLGaming/back/services/user/internal/model"
+
+	"github.com/alicebob/miniredis/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	testPhone = "13800000000"
+	testIP    = "203.0.113.9"
+)
+
+func newTestLoginGuard(t *testing.T) (*LoginGuard, *miniredis.Miniredis) {
+	t.Helper()
+	mr := miniredis.RunT(t)
+	return NewLoginGuard(redis.New(mr.Addr()), config.LoginProtectionConf{
+		Enabled:          true,
+		FailureWindow:    15 * time.Minute,
+		PhoneMaxFailures: 5,
+		IPMaxFailures:    8,
+		LockDuration:     15 * time.Minute,
+		DelayAfter:       2,
+		BaseDelay:        time.Second,
+		MaxDelay:         3 * time.Second,
+	}), mr
+}
+
+func TestLoginGuardRecordFailure(t *testing.T) {
+	g, mr := newTestLoginGuard(t)
+	ctx := context.Background()
+	phone := loginScope{name: loginScopePhone, value: testPhone}
+
+	// 第 n 次失败后手机号维度的等待时长：前 2 次不等待，之后 1s、2s 翻倍，不超过 3s，第 5 次锁定
+	steps := []struct {
+		wantLocked bool
+		wantDelay  time.Duration
+	}{
+		{wantDelay: 0},
+		{wantDelay: 0},
+		{wantDelay: time.Second},
+		{wantDelay: 2 * time.Second},
+		{wantLocked: true},
+	}
+
+	for i, step := range steps {
+		locked := g.RecordFailure(ctx, testPhone, testIP)
+		assert.Equal(t, step.wantLocked, locked, "failure %d", i+1)
+		if step.wantLocked {
+			assert.True(t, mr.Exists(loginGuardKey("lock", phone)), "failure %d", i+1)
+			assert.False(t, mr.Exists(loginGuardKey("fail", phone)), "failure %d", i+1)
+			continue
+		}
+		assert.Equal(t, step.wantDelay, mr.TTL(loginGuardKey("delay", phone)), "failure %d", i+1)
+	}
+}
+
+func TestLoginGuardMaxDelay(t *testing.T) {
+	g, mr := newTestLoginGuard(t)
+	g.conf.PhoneMaxFailures = 10
+	ctx := context.Background()
+	phone := loginScope{name: loginScopePhone, value: testPhone}
+
+	for range 6 {
+		g.RecordFailure(ctx, testPhone, "")
+	}
+	assert.Equal(t, 3*time.Second, mr.TTL(loginGuardKey("delay", phone)))
+}
+
+func TestLoginGuardCheck(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name       string
+		setup      func(g *LoginGuard, mr *miniredis.Miniredis)
+		phone, ip  string
+		wantResult string
+	}{
+		{name: "没有失败记录", phone: testPhone, ip: testIP},
+		{
+			name: "处于等待期",
+			setup: func(g *LoginGuard, mr *miniredis.Miniredis) {
+				for range 3 {
+					g.RecordFailure(ctx, testPhone, testIP)
+				}
+			},
+			phone: testPhone, ip: testIP, wantResult: model.LoginResultDelayed,
+		},
+		{
+			name: "等待期结束",
+			setup: func(g *LoginGuard, mr *miniredis.Miniredis) {
+				for range 3 {
+					g.RecordFailure(ctx, testPhone, testIP)
+				}
+				mr.FastForward(time.Second)
+			},
+			phone: testPhone, ip: testIP,
+		},
+		{
+			name: "手机号被锁定",
+			setup: func(g *LoginGuard, mr *miniredis.Miniredis) {
+				for range 5 {
+					g.RecordFailure(ctx, testPhone, testIP)
+				}
+			},
+			phone: testPhone, ip: testIP, wantResult: model.LoginResultLocked,
+		},
+		{
+			name: "IP 被锁定时换手机号也被拒绝",
+			setup: func(g *LoginGuard, mr *miniredis.Miniredis) {
+				for range 8 {
+					g.RecordFailure(ctx, "", testIP)
+				}
+			},
+			phone: "13900000000", ip: testIP, wantResult: model.LoginResultLocked,
+		},
+		{
+			name: "锁定期结束",
+			setup: func(g *LoginGuard, mr *miniredis.Miniredis) {
+				for range 5 {
+					g.RecordFailure(ctx, testPhone, testIP)
+				}
+				mr.FastForward(15 * time.Minute)
+			},
+			phone: testPhone, ip: testIP,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, mr := newTestLoginGuard(t)
+			if tt.setup != nil {
+				tt.setup(g, mr)
+			}
+			result, err := g.Check(ctx, tt.phone, tt.ip)
+			assert.Equal(t, tt.wantResult, result)
+			if tt.wantResult == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		})
+	}
+}
+
+func TestLoginGuardResetAndUnlock(t *testing.T) {
+	g, mr := newTestLoginGuard(t)
+	ctx := context.Background()
+
+	for range 3 {
+		g.RecordFailure(ctx, testPhone, testIP)
+	}
+	// 登录成功只清空手机号计数，IP 计数保留
+	g.Reset(ctx, testPhone)
+	assert.False(t, mr.Exists(loginGuardKey("fail", loginScope{name: loginScopePhone, value: testPhone})))
+	assert.True(t, mr.Exists(loginGuardKey("fail", loginScope{name: loginScopeIP, value: testIP})))
+
+	locked, err := g.Unlock(ctx, testPhone, "")
+	require.NoError(t, err)
+	assert.False(t, locked)
+
+	for range 5 {
+		g.RecordFailure(ctx, testPhone, "")
+	}
+	locked, err = g.Unlock(ctx, testPhone, "")
+	require.NoError(t, err)
+	assert.True(t, locked)
+
+	result, err := g.Check(ctx, testPhone, "")
+	require.NoError(t, err)
+	assert.Empty(t, result)
+}
+
+func TestLoginGuardDisabled(t *testing.T) {
+	ctx := context.Background()
+
+	for _, g := range []*LoginGuard{
+		nil,
+		NewLoginGuard(nil, config.LoginProtectionConf{Enabled: true, PhoneMaxFailures: 1}),
+	} {
+		assert.False(t, g.RecordFailure(ctx, testPhone, testIP))
+		result, err := g.Check(ctx, testPhone, testIP)
+		assert.NoError(t, err)
+		assert.Empty(t, result)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"SLGaming/back/services/user/user"
 
 	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -55,7 +56,7 @@ type RankingItemBuilder interface {
 	GetRatingFromProfile(profile *model.CompanionProfile) float64
 }
 
-// RatingRankingBuilder 评分排名构建器
+// RatingRankingBuilder 评分排名构建器（ZSet 分数为贝叶斯评分×10000）
 type RatingRankingBuilder struct{}
 
 func (b *RatingRankingBuilder) BuildRating(score int64) float64 {
@@ -157,6 +158,28 @@ func QueryRankingWithProfiles(
 		}, nil
 	}
 
+	rankings, err := buildRankingItems(ctx, svcCtx, logger, members, int(start), builder)
+	if err != nil {
+		return nil, err
+	}
+
+	return &RankingQueryResult{
+		Rankings: rankings,
+		Total:    int32(total),
+		Page:     page,
+		PageSize: pageSize,
+	}, nil
+}
+
+// buildRankingItems 根据 ZSet 成员（按名次排列）查询陪玩资料并组装排名项，start 为第一个成员的名次偏移
+func buildRankingItems(
+	ctx context.Context,
+	svcCtx *svc.ServiceContext,
+	logger logx.Logger,
+	members []redis.Pair,
+	start int,
+	builder RankingItemBuilder,
+) ([]*user.CompanionRankingItem, error) {
 	// 收集user_ids
 	userIDs := make([]uint64, 0, len(members))
 	for _, member := range members {
@@ -168,12 +191,7 @@ func QueryRankingWithProfiles(
 	}
 
 	if len(userIDs) == 0 {
-		return &RankingQueryResult{
-			Rankings: []*user.CompanionRankingItem{},
-			Total:    int32(total),
-			Page:     page,
-			PageSize: pageSize,
-		}, nil
+		return []*user.CompanionRankingItem{}, nil
 	}
 
 	// 使用JOIN一次性查询（避免多次查询）
@@ -182,6 +200,7 @@ func QueryRankingWithProfiles(
 		Nickname    string  `gorm:"column:nickname"`
 		AvatarURL   string  `gorm:"column:avatar_url"`
 		Rating      float64 `gorm:"column:rating"`
+		RatingScore float64 `gorm:"column:rating_score"`
 		TotalOrders int64   `gorm:"column:total_orders"`
 		IsVerified  bool    `gorm:"column:is_verified"`
 	}
@@ -191,7 +210,7 @@ func QueryRankingWithProfiles(
 
 	queryErr := db.Table("users").
 		Select("users.id, users.nickname, users.avatar_url, "+
-			"companion_profiles.rating, companion_profiles.total_orders, companion_profiles.is_verified").
+			"companion_profiles.rating, companion_profiles.rating_score, companion_profiles.total_orders, companion_profiles.is_verified").
 		Joins("INNER JOIN companion_profiles ON users.id = companion_profiles.user_id").
 		Where("users.id IN ?", userIDs).
 		Find(&rankingUsers).Error
@@ -211,16 +230,17 @@ func QueryRankingWithProfiles(
 	rankings := make([]*user.CompanionRankingItem, 0, len(members))
 	for i, member := range members {
 		userID, _ := strconv.ParseUint(member.Key, 10, 64)
-		rank := int32(int(start) + i + 1)
+		rank := int32(start + i + 1)
 
 		u, ok := userMap[userID]
 		if !ok {
 			continue
 		}
 
-		rating := builder.BuildRating(member.Score)
-		if rating == 0 {
-			rating = u.Rating
+		// 展示原始评分；评分榜的贝叶斯评分取自 ZSet 分数（周期榜为窗口内的评分）
+		ratingScore := builder.BuildRating(member.Score)
+		if ratingScore == 0 {
+			ratingScore = u.RatingScore
 		}
 
 		totalOrders := int64(member.Score)
@@ -232,7 +252,8 @@ func QueryRankingWithProfiles(
 			UserId:      userID,
 			Nickname:    u.Nickname,
 			AvatarUrl:   u.AvatarURL,
-			Rating:      rating,
+			Rating:      u.Rating,
+			RatingScore: ratingScore,
 			TotalOrders: totalOrders,
 			Rank:        rank,
 			IsVerified:  u.IsVerified,
@@ -241,12 +262,7 @@ func QueryRankingWithProfiles(
 		rankings = append(rankings, item)
 	}
 
-	return &RankingQueryResult{
-		Rankings: rankings,
-		Total:    int32(total),
-		Page:     page,
-		PageSize: pageSize,
-	}, nil
+	return rankings, nil
 }
 
 // queryRankingFromMySQL Redis故障时从MySQL查询（降级方案）
@@ -262,8 +278,8 @@ func queryRankingFromMySQL(
 		"ranking_type": rankingType,
 	})
 
-	// 确定排序字段
-	orderBy := "rating"
+	// 确定排序字段（评分榜按贝叶斯评分）
+	orderBy := "rating_score"
 	if rankingType == "ranking:orders" {
 		orderBy = "total_orders"
 	}
@@ -276,6 +292,7 @@ func queryRankingFromMySQL(
 		Nickname    string  `gorm:"column:nickname"`
 		AvatarURL   string  `gorm:"column:avatar_url"`
 		Rating      float64 `gorm:"column:rating"`
+		RatingScore float64 `gorm:"column:rating_score"`
 		TotalOrders int64   `gorm:"column:total_orders"`
 		IsVerified  bool    `gorm:"column:is_verified"`
 	}
@@ -283,7 +300,7 @@ func queryRankingFromMySQL(
 	var rankingUsers []RankingUser
 	err := db.Table("users").
 		Select("users.id, users.nickname, users.avatar_url, " +
-			"companion_profiles.rating, companion_profiles.total_orders, companion_profiles.is_verified").
+			"companion_profiles.rating, companion_profiles.rating_score, companion_profiles.total_orders, companion_profiles.is_verified").
 		Joins("INNER JOIN companion_profiles ON users.id = companion_profiles.user_id").
 		Where("companion_profiles.total_orders > 0"). // 只查有订单的陪玩
 		Order(orderBy + " DESC").
@@ -337,6 +354,7 @@ func queryRankingFromMySQL(
 			Nickname:    u.Nickname,
 			AvatarUrl:   u.AvatarURL,
 			Rating:      rating,
+			RatingScore: u.RatingScore,
 			TotalOrders: totalOrders,
 			Rank:        rank,
 			IsVerified:  u.IsVerified,
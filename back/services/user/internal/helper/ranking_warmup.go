@@ -81,7 +81,7 @@ func WarmupRankingFromMySQLAsync(svcCtx *svc.ServiceContext, logger logx.Logger)
 		ctx := context.Background()
 
 		// 预热评分榜
-		if err := warmupRanking(ctx, svcCtx, logger, "ranking:rating", "rating"); err != nil {
+		if err := warmupRanking(ctx, svcCtx, logger, "ranking:rating", "rating_score"); err != nil {
 			warmupMu.Lock()
 			warmupError = err
 			warmupMu.Unlock()
@@ -97,14 +97,32 @@ func WarmupRankingFromMySQLAsync(svcCtx *svc.ServiceContext, logger logx.Logger)
 			LogError(logger, "warmup", "warmup orders ranking failed", err, nil)
 			return
 		}
+
+		// 重建当前的日/周/月榜（分游戏的窗口在首次查询时按需重建）
+		warmupRankingWindows(ctx, svcCtx, logger)
 	}()
 }
 
+// warmupRankingWindows 从事件表重建当前周期的日/周/月榜，单个窗口失败只记日志
+func warmupRankingWindows(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger) {
+	now := time.Now()
+	for _, rankingType := range []string{RankingTypeRating, RankingTypeOrders} {
+		for _, period := range []string{RankingPeriodDay, RankingPeriodWeek, RankingPeriodMonth} {
+			w := RankingWindow{Type: rankingType, Period: period}
+			if err := RebuildRankingWindow(ctx, svcCtx, logger, w, now); err != nil {
+				LogError(logger, "warmup", "warmup ranking window failed", err, map[string]interface{}{
+					"key": w.Key(now),
+				})
+			}
+		}
+	}
+}
+
 // warmupRanking 预热单个排行榜
 func warmupRanking(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, redisKey, orderBy string) error {
 	type RankingUser struct {
 		UserID      uint64  `gorm:"column:user_id"`
-		Rating      float64 `gorm:"column:rating"`
+		RatingScore float64 `gorm:"column:rating_score"`
 		TotalOrders int64   `gorm:"column:total_orders"`
 	}
 
@@ -113,7 +131,7 @@ func warmupRanking(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.
 
 	// 从MySQL查询前100名
 	err := db.Table("companion_profiles").
-		Select("user_id, rating, total_orders").
+		Select("user_id, rating_score, total_orders").
 		Where("total_orders > 0").
 		Order(orderBy + " DESC").
 		Limit(100).
@@ -138,7 +156,8 @@ func warmupRanking(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.
 		var score int64
 
 		if redisKey == "ranking:rating" {
-			score = int64(u.Rating * 10000)
+			// 评分榜按贝叶斯评分排序
+			score = int64(u.RatingScore * 10000)
 		} else {
 			score = u.TotalOrders
 		}
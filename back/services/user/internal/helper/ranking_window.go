@@ -0,0 +1,441 @@
+package helper
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"SLGaming/back/services/user/internal/config"
+	"SLGaming/back/services/user/internal/metrics"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"github.com/zeromicro/go-zero/core/stores/redis"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm/clause"
+)
+
+// 排行榜类型
+const (
+	RankingTypeRating = "rating"
+	RankingTypeOrders = "orders"
+)
+
+// 排行榜周期
+const (
+	RankingPeriodAll   = "all"
+	RankingPeriodDay   = "day"
+	RankingPeriodWeek  = "week"
+	RankingPeriodMonth = "month"
+)
+
+// 周期榜只返回前100名，与总榜一致
+const rankingWindowLimit = 100
+
+// RankingWindow 按周期和游戏划分的排行榜
+// 周期为 all 且不限游戏时即原有的总榜（ranking:rating / ranking:orders），由陪玩资料维护；
+// 其余窗口由 companion_ranking_events 增量累加，键丢失时从 MySQL 重建
+type RankingWindow struct {
+	Type   string
+	Period string
+	Game   string
+}
+
+// ParseRankingWindow 校验并规范化周期与游戏参数，周期为空时为总榜
+func ParseRankingWindow(rankingType, period, game string) (RankingWindow, error) {
+	period = strings.ToLower(strings.TrimSpace(period))
+	if period == "" {
+		period = RankingPeriodAll
+	}
+	switch period {
+	case RankingPeriodAll, RankingPeriodDay, RankingPeriodWeek, RankingPeriodMonth:
+	default:
+		return RankingWindow{}, status.Error(codes.InvalidArgument, "period must be one of all, day, week, month")
+	}
+	game = strings.TrimSpace(game)
+	if len([]rune(game)) > 64 {
+		return RankingWindow{}, status.Error(codes.InvalidArgument, "game is too long")
+	}
+	return RankingWindow{Type: rankingType, Period: period, Game: game}, nil
+}
+
+// IsGlobal 是否为原有的总榜
+func (w RankingWindow) IsGlobal() bool {
+	return w.Period == RankingPeriodAll && w.Game == ""
+}
+
+// Key 窗口在 now 所在周期的 ZSet 键，如 ranking:orders:week:2026W42:game:王者荣耀
+func (w RankingWindow) Key(now time.Time) string {
+	if w.IsGlobal() {
+		return "ranking:" + w.Type
+	}
+	key := "ranking:" + w.Type + ":" + w.Period
+	switch w.Period {
+	case RankingPeriodDay:
+		key += ":" + now.Format("20060102")
+	case RankingPeriodWeek:
+		year, week := now.ISOWeek()
+		key += fmt.Sprintf(":%dW%02d", year, week)
+	case RankingPeriodMonth:
+		key += ":" + now.Format("200601")
+	}
+	if w.Game != "" {
+		key += ":game:" + w.Game
+	}
+	return key
+}
+
+// Start now 所在周期的起始时间（周从周一开始），总榜返回零值
+func (w RankingWindow) Start(now time.Time) time.Time {
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, now.Location())
+	switch w.Period {
+	case RankingPeriodDay:
+		return day
+	case RankingPeriodWeek:
+		offset := (int(day.Weekday()) + 6) % 7
+		return day.AddDate(0, 0, -offset)
+	case RankingPeriodMonth:
+		return time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location())
+	default:
+		return time.Time{}
+	}
+}
+
+// ttl 窗口键的过期时间（秒）：覆盖整个周期并留出余量，分游戏的总榜每次更新时续期
+func (w RankingWindow) ttl() int {
+	switch w.Period {
+	case RankingPeriodDay:
+		return 2 * 86400
+	case RankingPeriodWeek:
+		return 8 * 86400
+	case RankingPeriodMonth:
+		return 32 * 86400
+	default:
+		return 7 * 86400
+	}
+}
+
+func (w RankingWindow) eventKind() string {
+	if w.Type == RankingTypeRating {
+		return model.RankingEventRated
+	}
+	return model.RankingEventCompleted
+}
+
+// 窗口附属键：built 标记窗口已从 MySQL 构建（未构建的窗口不做增量更新，避免半截数据），
+// stats 保存评分榜每个陪玩的评分总和与次数，用于增量计算平均分
+func rankingBuiltKey(key string) string { return key + ":built" }
+func rankingStatsKey(key string) string { return key + ":stats" }
+
+// incrOrdersScript 窗口已构建时接单数 +1，并续期
+const incrOrdersScript = `
+if redis.call("EXISTS", KEYS[2]) == 0 then
+	return 0
+end
+redis.call("ZINCRBY", KEYS[1], 1, ARGV[1])
+redis.call("EXPIRE", KEYS[1], ARGV[2])
+redis.call("EXPIRE", KEYS[2], ARGV[2])
+return 1
+`
+
+// addRatingScript 窗口已构建时累加评分，按贝叶斯评分（×10000）更新 ZSet，并续期
+// ARGV[4]、ARGV[5] 为先验评分与先验权重，与 RatingScoreFromSum 的计算一致
+const addRatingScript = `
+if redis.call("EXISTS", KEYS[2]) == 0 then
+	return 0
+end
+local sum = tonumber(redis.call("HINCRBYFLOAT", KEYS[3], ARGV[1] .. ":sum", ARGV[2]))
+local cnt = redis.call("HINCRBY", KEYS[3], ARGV[1] .. ":cnt", 1)
+local prior, weight = tonumber(ARGV[4]), tonumber(ARGV[5])
+redis.call("ZADD", KEYS[1], math.floor((weight * prior + sum) / (weight + cnt) * 10000), ARGV[1])
+redis.call("EXPIRE", KEYS[1], ARGV[3])
+redis.call("EXPIRE", KEYS[2], ARGV[3])
+redis.call("EXPIRE", KEYS[3], ARGV[3])
+return 1
+`
+
+// eventWindows 一个事件影响的所有窗口：日/周/月/全部周期 × 不限游戏/该游戏（不含由陪玩资料维护的总榜）
+func eventWindows(rankingType, game string) []RankingWindow {
+	games := []string{""}
+	if game != "" {
+		games = append(games, game)
+	}
+	windows := make([]RankingWindow, 0, 8)
+	for _, g := range games {
+		for _, period := range []string{RankingPeriodDay, RankingPeriodWeek, RankingPeriodMonth, RankingPeriodAll} {
+			w := RankingWindow{Type: rankingType, Period: period, Game: g}
+			if !w.IsGlobal() {
+				windows = append(windows, w)
+			}
+		}
+	}
+	return windows
+}
+
+// RecordRankingEvent 记录订单完成/评价事件并增量更新周期榜与游戏榜
+// 同一订单的同类事件只记录一次（消息重投、重复调用幂等）；Redis 更新失败只记日志，窗口可从事件表重建
+func RecordRankingEvent(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, event *model.CompanionRankingEvent) error {
+	if event.OccurredAt.IsZero() {
+		event.OccurredAt = time.Now()
+	}
+	event.GameName = strings.TrimSpace(event.GameName)
+
+	result := svcCtx.DB().WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(event)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		// 已记录过，跳过
+		return nil
+	}
+	if svcCtx.Redis == nil {
+		return nil
+	}
+
+	rankingType := RankingTypeOrders
+	if event.Kind == model.RankingEventRated {
+		rankingType = RankingTypeRating
+	}
+	member := strconv.FormatUint(event.CompanionID, 10)
+	scoreCfg := svcCtx.Config().RatingScore
+	for _, w := range eventWindows(rankingType, event.GameName) {
+		key := w.Key(event.OccurredAt)
+		var err error
+		if rankingType == RankingTypeRating {
+			_, err = svcCtx.Redis.EvalCtx(ctx, addRatingScript,
+				[]string{key, rankingBuiltKey(key), rankingStatsKey(key)},
+				member, strconv.FormatFloat(event.Rating, 'f', -1, 64), w.ttl(),
+				strconv.FormatFloat(scoreCfg.PriorRating, 'f', -1, 64), strconv.FormatFloat(scoreCfg.PriorWeight, 'f', -1, 64))
+		} else {
+			_, err = svcCtx.Redis.EvalCtx(ctx, incrOrdersScript,
+				[]string{key, rankingBuiltKey(key)}, member, w.ttl())
+		}
+		if err != nil {
+			LogError(logger, OpRankingWindow, "update ranking window failed", err, map[string]interface{}{
+				"key":      key,
+				"order_id": event.OrderID,
+			})
+		}
+	}
+	return nil
+}
+
+// windowScore 窗口内一个陪玩的聚合结果
+type windowScore struct {
+	CompanionID uint64  `gorm:"column:companion_id"`
+	Orders      int64   `gorm:"column:orders"`
+	RatingSum   float64 `gorm:"column:rating_sum"`
+	RatingCount int64   `gorm:"column:rating_count"`
+}
+
+// score 窗口 ZSet 中的分数：接单榜为单数，评分榜为窗口内评价的贝叶斯评分×10000
+func (s windowScore) score(rankingType string, cfg config.RatingScoreConf) int64 {
+	if rankingType == RankingTypeRating {
+		return int64(math.Floor(RatingScoreFromSum(cfg, s.RatingSum, s.RatingCount) * 10000))
+	}
+	return s.Orders
+}
+
+// aggregateRankingWindow 从事件表聚合窗口 now 所在周期的数据
+func aggregateRankingWindow(ctx context.Context, svcCtx *svc.ServiceContext, w RankingWindow, now time.Time) ([]windowScore, error) {
+	query := svcCtx.DB().WithContext(ctx).Model(&model.CompanionRankingEvent{}).
+		Select("companion_id, COUNT(*) AS orders, COALESCE(SUM(rating), 0) AS rating_sum, COUNT(*) AS rating_count").
+		Where("kind = ?", w.eventKind())
+	if start := w.Start(now); !start.IsZero() {
+		query = query.Where("occurred_at >= ?", start)
+	}
+	if w.Game != "" {
+		query = query.Where("game_name = ?", w.Game)
+	}
+
+	var scores []windowScore
+	if err := query.Group("companion_id").Find(&scores).Error; err != nil {
+		return nil, err
+	}
+	return scores, nil
+}
+
+// RebuildRankingWindow 从 MySQL 重建窗口 now 所在周期的 ZSet（Redis 数据丢失或窗口首次被查询时）
+func RebuildRankingWindow(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, w RankingWindow, now time.Time) error {
+	scores, err := aggregateRankingWindow(ctx, svcCtx, w, now)
+	if err != nil {
+		metrics.RankingWindowRebuildTotal.WithLabelValues(w.Type, w.Period, "error").Inc()
+		return err
+	}
+
+	key := w.Key(now)
+	ttl := w.ttl()
+	if _, err := svcCtx.Redis.DelCtx(ctx, key, rankingStatsKey(key)); err != nil {
+		metrics.RankingWindowRebuildTotal.WithLabelValues(w.Type, w.Period, "error").Inc()
+		return err
+	}
+
+	if len(scores) > 0 {
+		pairs := make([]redis.Pair, 0, len(scores))
+		stats := make(map[string]string, len(scores)*2)
+		for _, s := range scores {
+			member := strconv.FormatUint(s.CompanionID, 10)
+			pairs = append(pairs, redis.Pair{Key: member, Score: s.score(w.Type, svcCtx.Config().RatingScore)})
+			if w.Type == RankingTypeRating {
+				stats[member+":sum"] = strconv.FormatFloat(s.RatingSum, 'f', -1, 64)
+				stats[member+":cnt"] = strconv.FormatInt(s.RatingCount, 10)
+			}
+		}
+		if _, err := svcCtx.Redis.ZaddsCtx(ctx, key, pairs...); err != nil {
+			metrics.RankingWindowRebuildTotal.WithLabelValues(w.Type, w.Period, "error").Inc()
+			return err
+		}
+		_ = svcCtx.Redis.ExpireCtx(ctx, key, ttl)
+		if len(stats) > 0 {
+			if err := svcCtx.Redis.HmsetCtx(ctx, rankingStatsKey(key), stats); err != nil {
+				metrics.RankingWindowRebuildTotal.WithLabelValues(w.Type, w.Period, "error").Inc()
+				return err
+			}
+			_ = svcCtx.Redis.ExpireCtx(ctx, rankingStatsKey(key), ttl)
+		}
+	}
+
+	// 最后写入构建标记，之后的事件才会增量累加到该窗口
+	if err := svcCtx.Redis.SetexCtx(ctx, rankingBuiltKey(key), "1", ttl); err != nil {
+		metrics.RankingWindowRebuildTotal.WithLabelValues(w.Type, w.Period, "error").Inc()
+		return err
+	}
+
+	metrics.RankingWindowRebuildTotal.WithLabelValues(w.Type, w.Period, "success").Inc()
+	LogInfo(logger, OpRankingWindow, "ranking window rebuilt", map[string]interface{}{
+		"key":   key,
+		"count": len(scores),
+	})
+	return nil
+}
+
+// QueryRankingWindow 查询周期榜/游戏榜：窗口未构建时先从 MySQL 重建；Redis 不可用时直接按 MySQL 聚合结果返回
+func QueryRankingWindow(
+	ctx context.Context,
+	svcCtx *svc.ServiceContext,
+	logger logx.Logger,
+	w RankingWindow,
+	page, pageSize int32,
+	builder RankingItemBuilder,
+) (*RankingQueryResult, error) {
+	p := NormalizePagination(page, pageSize)
+	result := &RankingQueryResult{
+		Rankings: []*user.CompanionRankingItem{},
+		Page:     int32(p.Page),
+		PageSize: int32(p.PageSize),
+	}
+
+	now := time.Now()
+	key := w.Key(now)
+
+	members, total, err := queryRankingWindowFromRedis(ctx, svcCtx, logger, w, key, now, p)
+	if err != nil {
+		LogError(logger, OpRankingWindow, "query ranking window from redis failed, fallback to mysql", err, map[string]interface{}{
+			"key": key,
+		})
+		members, total, err = queryRankingWindowFromMySQL(ctx, svcCtx, w, now, p)
+		if err != nil {
+			LogError(logger, OpRankingWindow, "query ranking window from mysql failed", err, map[string]interface{}{
+				"key": key,
+			})
+			return nil, status.Error(codes.Internal, "query ranking failed")
+		}
+	}
+
+	result.Total = int32(total)
+	if len(members) == 0 {
+		return result, nil
+	}
+	rankings, err := buildRankingItems(ctx, svcCtx, logger, members, p.Start, builder)
+	if err != nil {
+		return nil, err
+	}
+	result.Rankings = rankings
+	return result, nil
+}
+
+func queryRankingWindowFromRedis(
+	ctx context.Context,
+	svcCtx *svc.ServiceContext,
+	logger logx.Logger,
+	w RankingWindow,
+	key string,
+	now time.Time,
+	p PaginationParams,
+) ([]redis.Pair, int64, error) {
+	built, err := svcCtx.Redis.ExistsCtx(ctx, rankingBuiltKey(key))
+	if err != nil {
+		return nil, 0, err
+	}
+	if !built {
+		if err := RebuildRankingWindow(ctx, svcCtx, logger, w, now); err != nil {
+			return nil, 0, err
+		}
+	}
+
+	total, err := svcCtx.Redis.ZcardCtx(ctx, key)
+	if err != nil {
+		return nil, 0, err
+	}
+	if total > rankingWindowLimit {
+		total = rankingWindowLimit
+	}
+	if p.Start >= rankingWindowLimit {
+		return nil, int64(total), nil
+	}
+	end := p.End
+	if end >= rankingWindowLimit {
+		end = rankingWindowLimit - 1
+	}
+	members, err := svcCtx.Redis.ZrevrangeWithScoresCtx(ctx, key, int64(p.Start), int64(end))
+	if err != nil {
+		return nil, 0, err
+	}
+	return members, int64(total), nil
+}
+
+// queryRankingWindowFromMySQL Redis 不可用时的降级：直接聚合事件表并在内存中排序分页
+func queryRankingWindowFromMySQL(
+	ctx context.Context,
+	svcCtx *svc.ServiceContext,
+	w RankingWindow,
+	now time.Time,
+	p PaginationParams,
+) ([]redis.Pair, int64, error) {
+	scores, err := aggregateRankingWindow(ctx, svcCtx, w, now)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	pairs := make([]redis.Pair, 0, len(scores))
+	for _, s := range scores {
+		pairs = append(pairs, redis.Pair{Key: strconv.FormatUint(s.CompanionID, 10), Score: s.score(w.Type, svcCtx.Config().RatingScore)})
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].Score != pairs[j].Score {
+			return pairs[i].Score > pairs[j].Score
+		}
+		// 与 ZREVRANGE 一致：同分按成员倒序
+		return pairs[i].Key > pairs[j].Key
+	})
+	if len(pairs) > rankingWindowLimit {
+		pairs = pairs[:rankingWindowLimit]
+	}
+
+	total := int64(len(pairs))
+	if p.Start >= len(pairs) {
+		return nil, total, nil
+	}
+	end := p.End + 1
+	if end > len(pairs) {
+		end = len(pairs)
+	}
+	return pairs[p.Start:end], total, nil
+}
@@ -0,0 +1,84 @@
+package helper
+
+import (
+	"context"
+	"math"
+
+	"SLGaming/back/services/user/internal/config"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+// 回填时每批处理的陪玩数量
+const ratingScoreBackfillBatch = 500
+
+// RatingScore 计算贝叶斯评分：把平均评分按单数向先验评分收缩，单数越多越接近真实评分
+// 没有订单的陪玩返回 0，保证排在有评价的陪玩之后；结果保留4位小数，与数据库精度一致
+func RatingScore(cfg config.RatingScoreConf, rating float64, orders int64) float64 {
+	if orders <= 0 {
+		return 0
+	}
+	n := float64(orders)
+	score := (cfg.PriorWeight*cfg.PriorRating + rating*n) / (cfg.PriorWeight + n)
+	return math.Round(score*10000) / 10000
+}
+
+// RatingScoreFromSum 按评分总和与次数计算贝叶斯评分（周期榜/游戏榜按窗口内的评价累加）
+func RatingScoreFromSum(cfg config.RatingScoreConf, sum float64, count int64) float64 {
+	if count <= 0 {
+		return 0
+	}
+	return RatingScore(cfg, sum/float64(count), count)
+}
+
+// BackfillRatingScores 按当前配置重算所有陪玩的贝叶斯评分，并重建评分排行榜
+// 用于上线该字段后初始化历史数据，或调整先验参数后重算；返回更新的陪玩数量
+func BackfillRatingScores(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger) (int, error) {
+	cfg := svcCtx.Config().RatingScore
+	db := svcCtx.DB().WithContext(ctx)
+
+	updated := 0
+	var profiles []model.CompanionProfile
+	result := db.Select("id, user_id, rating, total_orders, rating_score, tier, rank_score").
+		FindInBatches(&profiles, ratingScoreBackfillBatch, func(tx *gorm.DB, batch int) error {
+			for i := range profiles {
+				p := &profiles[i]
+				score := RatingScore(cfg, p.Rating, p.TotalOrders)
+				rankScore := CompanionRankScore(svcCtx, score, p.Tier)
+				if score == p.RatingScore && rankScore == p.RankScore {
+					continue
+				}
+				if err := db.Model(&model.CompanionProfile{}).
+					Where("id = ?", p.ID).
+					UpdateColumns(map[string]interface{}{
+						"rating_score": score,
+						"rank_score":   rankScore,
+					}).Error; err != nil {
+					return err
+				}
+				updated++
+			}
+			LogInfo(logger, OpUpdateCompanionStats, "rating score backfill batch done", map[string]interface{}{
+				"batch":   batch,
+				"updated": updated,
+			})
+			return nil
+		})
+	if result.Error != nil {
+		return updated, result.Error
+	}
+
+	if svcCtx.Redis != nil {
+		if err := warmupRanking(ctx, svcCtx, logger, "ranking:rating", "rating_score"); err != nil {
+			return updated, err
+		}
+		warmupRankingWindows(ctx, svcCtx, logger)
+	}
+	if updated > 0 {
+		InvalidateCompanionList(svcCtx, logger)
+	}
+	return updated, nil
+}
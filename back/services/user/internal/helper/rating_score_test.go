@@ -0,0 +1,43 @@
+package helper
+
+import (
+	"testing"
+
+	"SLGaming/back/services/user/internal/config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRatingScore(t *testing.T) {
+	cfg := config.RatingScoreConf{PriorRating: 4.0, PriorWeight: 10}
+
+	tests := []struct {
+		name   string
+		rating float64
+		orders int64
+		want   float64
+	}{
+		{name: "没有订单", rating: 5, orders: 0, want: 0},
+		{name: "单数为负", rating: 5, orders: -1, want: 0},
+		{name: "一单满分向先验收缩", rating: 5, orders: 1, want: 4.0909},
+		{name: "单数等于先验权重", rating: 5, orders: 10, want: 4.5},
+		{name: "单数多时接近真实评分", rating: 5, orders: 990, want: 4.99},
+		{name: "低于先验评分", rating: 3, orders: 10, want: 3.5},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, RatingScore(cfg, tt.rating, tt.orders))
+		})
+	}
+
+	// 大量高分订单的陪玩排在少量满分订单的陪玩之前
+	assert.Greater(t, RatingScore(cfg, 4.8, 200), RatingScore(cfg, 5, 2))
+}
+
+func TestRatingScoreFromSum(t *testing.T) {
+	cfg := config.RatingScoreConf{PriorRating: 4.0, PriorWeight: 10}
+
+	assert.Equal(t, 0.0, RatingScoreFromSum(cfg, 0, 0))
+	assert.Equal(t, RatingScore(cfg, 4.5, 10), RatingScoreFromSum(cfg, 45, 10))
+}
@@ -1,12 +1,16 @@
 package helper
 
 import (
+	"net/mail"
 	"strings"
+	"time"
 
 	"SLGaming/back/services/user/internal/model"
 	"SLGaming/back/services/user/user"
 
 	"golang.org/x/crypto/bcrypt"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func HashPassword(password string) (string, error) {
@@ -39,7 +43,7 @@ func ToUserInfo(u *model.User) *user.UserInfo {
 	if u == nil {
 		return nil
 	}
-	return &user.UserInfo{
+	info := &user.UserInfo{
 		Id:             u.ID,
 		Uid:            u.UID,
 		Nickname:       u.Nickname,
@@ -49,7 +53,57 @@ func ToUserInfo(u *model.User) *user.UserInfo {
 		Bio:            u.Bio,
 		FollowerCount:  u.FollowerCount,
 		FollowingCount: u.FollowingCount,
+		Gender:         int32(u.Gender),
 	}
+	if u.Birthday != nil {
+		info.Birthday = u.Birthday.Format(BirthdayLayout)
+	}
+	if u.Email != nil {
+		info.Email = *u.Email
+	}
+	if u.IsVipActive() {
+		info.VipLevel = int32(u.VipLevel)
+		info.VipBadge = u.VipBadge
+		info.VipExpireAt = u.VipExpireAt.Unix()
+	}
+	if status := u.EffectiveStatus(time.Now()); status != model.UserStatusActive {
+		info.Status = int32(status)
+		info.StatusReason = u.StatusReason
+		if u.StatusUntil != nil {
+			info.StatusUntil = u.StatusUntil.Unix()
+		}
+	}
+	return info
+}
+
+// BirthdayLayout 生日的传输格式
+const BirthdayLayout = "2006-01-02"
+
+// ParseBirthday 解析生日（YYYY-MM-DD），不允许晚于今天或早于 1900 年
+func ParseBirthday(raw string, now time.Time) (time.Time, bool) {
+	t, err := time.ParseInLocation(BirthdayLayout, strings.TrimSpace(raw), time.Local)
+	if err != nil || t.After(now) || t.Year() < 1900 {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// NormalizeEmail 校验并规范化邮箱（只接受纯地址，统一小写），格式不合法时返回 false
+func NormalizeEmail(email string) (string, bool) {
+	email = strings.TrimSpace(email)
+	parsed, err := mail.ParseAddress(email)
+	if err != nil || parsed.Address != email || len(email) > 128 {
+		return "", false
+	}
+	return strings.ToLower(email), true
+}
+
+// BannedError 封禁中的账号登录时返回的错误
+func BannedError(u *model.User) error {
+	if u.StatusUntil != nil {
+		return status.Errorf(codes.FailedPrecondition, "account is banned until %s", u.StatusUntil.Format(time.RFC3339))
+	}
+	return status.Error(codes.FailedPrecondition, "account is banned")
 }
 
 func ToCompanionInfo(p *model.CompanionProfile) *user.CompanionInfo {
@@ -57,7 +111,7 @@ func ToCompanionInfo(p *model.CompanionProfile) *user.CompanionInfo {
 }
 
 // ToCompanionInfoWithUser 将 CompanionProfile 和 User 转换为 CompanionInfo
-// 如果 u 为 nil，则只填充陪玩信息，不填充 bio 和 avatar_url
+// 如果 u 为 nil，则只填充陪玩信息，不填充昵称、头像、简介、性别和年龄
 func ToCompanionInfoWithUser(p *model.CompanionProfile, u *model.User) *user.CompanionInfo {
 	if p == nil {
 		return nil
@@ -68,14 +122,18 @@ func ToCompanionInfoWithUser(p *model.CompanionProfile, u *model.User) *user.Com
 		PricePerHour: p.PricePerHour,
 		Status:       int32(p.Status),
 		Rating:       p.Rating,
+		RatingScore:  p.RatingScore,
 		TotalOrders:  p.TotalOrders,
 		IsVerified:   p.IsVerified,
+		Tier:         int32(p.Tier),
 	}
-	// 如果提供了用户信息，填充 bio 和 avatar_url
+	// 如果提供了用户信息，填充展示字段
 	if u != nil {
 		info.Nickname = u.Nickname
 		info.AvatarUrl = u.AvatarURL
 		info.Bio = u.Bio
+		info.Gender = int32(u.Gender)
+		info.Age = int32(u.Age(time.Now()))
 	}
 	return info
 }
@@ -0,0 +1,73 @@
+package helper
+
+import (
+	"context"
+	"errors"
+
+	"SLGaming/back/pkg/verifyticket"
+	"SLGaming/back/services/user/internal/metrics"
+	"SLGaming/back/services/user/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ConsumeVerifyTicket 校验并消费 code 服务签发的验证码票据
+// 票据必须由 code 服务为同一手机号（或邮箱）、同一用途签发，未过期且未被使用过；校验通过后立即作废
+func ConsumeVerifyTicket(ctx context.Context, svcCtx *svc.ServiceContext, ticket, target, purpose string) error {
+	return ConsumeVerifyTickets(ctx, svcCtx, verifyticket.Spec{Ticket: ticket, Target: target, Purpose: purpose})
+}
+
+// ConsumeVerifyTickets 一次消费多张票据：全部有效才一起作废，任一无效时其他票据仍可继续使用
+func ConsumeVerifyTickets(ctx context.Context, svcCtx *svc.ServiceContext, specs ...verifyticket.Spec) error {
+	_, err := verifyticket.ConsumeAll(ctx, svcCtx.Redis, svcCtx.Config().VerifyTicket.Secret, specs...)
+	if err == nil {
+		for _, spec := range specs {
+			metrics.VerifyTicketTotal.WithLabelValues(spec.Purpose, "consumed").Inc()
+		}
+		return nil
+	}
+
+	// 定位失败的票据；Redis 错误不属于某张票据，按第一张记录
+	var target, purpose string
+	if len(specs) > 0 {
+		target, purpose = specs[0].Target, specs[0].Purpose
+	}
+	var se *verifyticket.SpecError
+	if errors.As(err, &se) {
+		target, purpose = se.Spec.Target, se.Spec.Purpose
+	}
+
+	result := "invalid"
+	switch {
+	case errors.Is(err, verifyticket.ErrMissing):
+		result = "missing"
+	case errors.Is(err, verifyticket.ErrExpired):
+		result = "expired"
+	case errors.Is(err, verifyticket.ErrMismatch):
+		result = "mismatch"
+	case errors.Is(err, verifyticket.ErrUsed):
+		result = "used"
+	case errors.Is(err, verifyticket.ErrNoSecret), errors.Is(err, verifyticket.ErrNoStorage):
+		result = "error"
+	case !errors.Is(err, verifyticket.ErrMalformed) && !errors.Is(err, verifyticket.ErrSignature):
+		// Redis 错误
+		result = "error"
+	}
+	metrics.VerifyTicketTotal.WithLabelValues(purpose, result).Inc()
+
+	if result == "error" {
+		LogError(logx.WithContext(ctx), OpVerifyTicket, "consume verify ticket failed", err, map[string]interface{}{
+			"target":  target,
+			"purpose": purpose,
+		})
+		return status.Error(codes.Unavailable, "verify ticket check unavailable")
+	}
+	LogWarning(logx.WithContext(ctx), OpVerifyTicket, "verify ticket rejected", map[string]interface{}{
+		"target":  target,
+		"purpose": purpose,
+		"result":  result,
+	})
+	return status.Error(codes.PermissionDenied, "invalid or expired verify ticket")
+}
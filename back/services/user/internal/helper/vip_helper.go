@@ -0,0 +1,192 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"SLGaming/back/services/user/internal/cache"
+	"SLGaming/back/services/user/internal/config"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// FindVipPlan 按编码查找会员套餐
+func FindVipPlan(conf config.VipConf, code string) (config.VipPlanConf, bool) {
+	for _, p := range conf.Plans {
+		if p.Code == code {
+			return p, true
+		}
+	}
+	return config.VipPlanConf{}, false
+}
+
+// VipPurchaseRequest 会员购买/续费请求
+type VipPurchaseRequest struct {
+	UserID     uint64
+	Plan       config.VipPlanConf
+	AutoRenew  bool
+	BizOrderID string
+	Logger     logx.Logger
+}
+
+// PurchaseVip 从钱包扣款并延长会员有效期（扣款与订阅更新在同一事务中）
+// 规则：到期时间在 max(当前时间, 原到期时间) 基础上顺延套餐时长；生效期内不允许降级
+// 生效期内升级时，原等级剩余时长按两个套餐的日均价格折算为新等级时长后再顺延
+// 同一 BizOrderID 重复调用只扣款一次
+func PurchaseVip(ctx context.Context, svcCtx *svc.ServiceContext, req *VipPurchaseRequest) (*model.VipSubscription, *WalletUpdateResult, error) {
+	plan := req.Plan
+	if plan.DurationDays <= 0 || plan.Price <= 0 || plan.Level <= 0 {
+		return nil, nil, status.Error(codes.FailedPrecondition, "vip plan is misconfigured")
+	}
+
+	walletService := NewWalletServiceWithLock(svcCtx.DB(), svcCtx.DistributedLock)
+	result, err := walletService.UpdateBalance(ctx, &WalletUpdateRequest{
+		UserID:     req.UserID,
+		Amount:     plan.Price,
+		Type:       WalletOpVipPurchase,
+		BizOrderID: req.BizOrderID,
+		Remark:     "vip:" + plan.Code,
+		Logger:     req.Logger,
+		AfterTransaction: func(tx *gorm.DB) error {
+			return applyVipSubscription(tx, svcCtx.Config().Vip, req)
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var sub model.VipSubscription
+	if err := svcCtx.DB().WithContext(ctx).Where("user_id = ?", req.UserID).First(&sub).Error; err != nil {
+		LogError(req.Logger, OpSubscribeVip, "read subscription after purchase failed", err, map[string]interface{}{
+			"user_id": req.UserID,
+		})
+		return nil, nil, status.Error(codes.Internal, "failed to read vip subscription")
+	}
+
+	InvalidateVipCache(svcCtx, req.Logger, req.UserID)
+	return &sub, result, nil
+}
+
+// applyVipSubscription 在钱包事务内创建或延长订阅，并同步用户表冗余字段
+func applyVipSubscription(tx *gorm.DB, conf config.VipConf, req *VipPurchaseRequest) error {
+	now := time.Now()
+	plan := req.Plan
+	duration := time.Duration(plan.DurationDays) * 24 * time.Hour
+
+	var sub model.VipSubscription
+	err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+		Where("user_id = ?", req.UserID).
+		First(&sub).Error
+	switch {
+	case errors.Is(err, gorm.ErrRecordNotFound):
+		sub = model.VipSubscription{
+			UserID:  req.UserID,
+			StartAt: now,
+			EndAt:   now,
+		}
+	case err != nil:
+		return err
+	}
+
+	if sub.ID != 0 && sub.IsActive() && int32(sub.Level) > plan.Level {
+		return status.Error(codes.FailedPrecondition, "cannot downgrade vip while current subscription is active")
+	}
+
+	// 已过期则从当前时间重新计算
+	base := sub.EndAt
+	switch {
+	case !sub.IsActive():
+		base = now
+		sub.StartAt = now
+	case int32(sub.Level) < plan.Level:
+		// 升级：剩余时长按价值折算，避免低等级叠加的时长按高等级计算
+		current, ok := FindVipPlan(conf, sub.PlanCode)
+		if !ok {
+			return status.Error(codes.FailedPrecondition, "cannot upgrade vip: current plan is no longer available")
+		}
+		base = now.Add(ProrateVipRemaining(sub.EndAt.Sub(now), current, plan))
+	}
+
+	sub.PlanCode = plan.Code
+	sub.Level = int(plan.Level)
+	sub.EndAt = base.Add(duration)
+	sub.AutoRenew = req.AutoRenew
+	sub.Status = model.VipStatusActive
+	sub.LastBizOrderID = req.BizOrderID
+
+	if err := tx.Save(&sub).Error; err != nil {
+		return err
+	}
+
+	return tx.Model(&model.User{}).Where("id = ?", req.UserID).Updates(map[string]interface{}{
+		"vip_level":     sub.Level,
+		"vip_badge":     plan.Badge,
+		"vip_expire_at": sub.EndAt,
+	}).Error
+}
+
+// ProrateVipRemaining 把 from 套餐的剩余时长按日均价格折算为 to 套餐的时长（向下取整到秒）
+func ProrateVipRemaining(remaining time.Duration, from, to config.VipPlanConf) time.Duration {
+	if remaining <= 0 || from.DurationDays <= 0 || to.DurationDays <= 0 || from.Price <= 0 || to.Price <= 0 {
+		return 0
+	}
+	// 时长 × (原日均价格 / 新日均价格)
+	ratio := (float64(from.Price) / float64(from.DurationDays)) / (float64(to.Price) / float64(to.DurationDays))
+	return time.Duration(float64(remaining) * ratio).Truncate(time.Second)
+}
+
+// InvalidateVipCache 清除会员权益缓存与用户信息缓存
+func InvalidateVipCache(svcCtx *svc.ServiceContext, logger logx.Logger, userID uint64) {
+	keys := []string{
+		fmt.Sprintf(cache.VipEntitlementsKey, userID),
+		fmt.Sprintf(cache.UserInfoKey, userID),
+	}
+	if err := svcCtx.CacheManager.DeleteMultiple(keys); err != nil && logger != nil {
+		logger.Errorf("delete vip cache failed: user_id=%d, err=%v", userID, err)
+	}
+}
+
+// ToVipPlanInfo 转换为 protobuf 的 VipPlanInfo
+func ToVipPlanInfo(p config.VipPlanConf) *user.VipPlanInfo {
+	return &user.VipPlanInfo{
+		Code:                p.Code,
+		Name:                p.Name,
+		Level:               p.Level,
+		DurationDays:        p.DurationDays,
+		Price:               p.Price,
+		DiscountPercent:     p.DiscountPercent,
+		Badge:               p.Badge,
+		RecommendPriority:   p.RecommendPriority,
+		RateLimitMultiplier: p.RateLimitMultiplier,
+	}
+}
+
+// ToVipSubscriptionInfo 转换为 protobuf 的 VipSubscriptionInfo
+func ToVipSubscriptionInfo(s *model.VipSubscription) *user.VipSubscriptionInfo {
+	if s == nil {
+		return nil
+	}
+	st := int32(s.Status)
+	if st == model.VipStatusActive && !s.IsActive() {
+		// 已到期但定时任务尚未处理
+		st = model.VipStatusExpired
+	}
+	return &user.VipSubscriptionInfo{
+		UserId:    s.UserID,
+		PlanCode:  s.PlanCode,
+		Level:     int32(s.Level),
+		StartAt:   s.StartAt.Unix(),
+		EndAt:     s.EndAt.Unix(),
+		AutoRenew: s.AutoRenew,
+		Status:    st,
+	}
+}
@@ -0,0 +1,37 @@
+package helper
+
+import (
+	"testing"
+	"time"
+
+	"SLGaming/back/services/user/internal/config"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProrateVipRemaining(t *testing.T) {
+	monthly := config.VipPlanConf{DurationDays: 30, Price: 30}
+	quarterly := config.VipPlanConf{DurationDays: 90, Price: 60}
+	gold := config.VipPlanConf{DurationDays: 30, Price: 60}
+
+	tests := []struct {
+		name      string
+		remaining time.Duration
+		from, to  config.VipPlanConf
+		want      time.Duration
+	}{
+		{name: "没有剩余时长", remaining: 0, from: monthly, to: gold, want: 0},
+		{name: "同价套餐时长不变", remaining: 10 * 24 * time.Hour, from: monthly, to: monthly, want: 10 * 24 * time.Hour},
+		{name: "升级到日均更贵的套餐时长减半", remaining: 10 * 24 * time.Hour, from: monthly, to: gold, want: 5 * 24 * time.Hour},
+		{name: "换到日均更便宜的套餐时长增加", remaining: 10 * 24 * time.Hour, from: monthly, to: quarterly, want: 15 * 24 * time.Hour},
+		{name: "按秒截断", remaining: 3 * time.Second, from: monthly, to: gold, want: time.Second},
+		{name: "套餐价格未配置", remaining: time.Hour, from: monthly, to: config.VipPlanConf{DurationDays: 30}, want: 0},
+		{name: "套餐时长未配置", remaining: time.Hour, from: config.VipPlanConf{Price: 30}, to: gold, want: 0},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, ProrateVipRemaining(tt.remaining, tt.from, tt.to))
+		})
+	}
+}
@@ -25,8 +25,17 @@ const (
 	WalletOpConsume  WalletOperationType = "CONSUME"  // 消费
 	WalletOpRecharge WalletOperationType = "RECHARGE" // 充值
 	WalletOpRefund   WalletOperationType = "REFUND"   // 退款
+
+	WalletOpTransferOut WalletOperationType = "TRANSFER_OUT" // 转出（打赏/送礼）
+	WalletOpTransferIn  WalletOperationType = "TRANSFER_IN"  // 转入（收到打赏/礼物）
+	WalletOpVipPurchase WalletOperationType = "VIP_PURCHASE" // 购买/续费会员
 )
 
+// isDebit 是否为扣款类操作（要求钱包存在且余额充足）
+func (t WalletOperationType) isDebit() bool {
+	return t == WalletOpConsume || t == WalletOpVipPurchase
+}
+
 // AfterTransactionCallback 事务成功后的回调函数
 // 在事务内执行，如果返回错误，整个事务会回滚
 type AfterTransactionCallback func(tx *gorm.DB) error
@@ -145,8 +154,8 @@ func (s *WalletService) updateBalanceInTx(ctx context.Context, req *WalletUpdate
 
 		// 处理钱包不存在的情况
 		if errors.Is(err, gorm.ErrRecordNotFound) {
-			// 消费操作要求钱包必须存在
-			if req.Type == WalletOpConsume {
+			// 扣款操作要求钱包必须存在
+			if req.Type.isDebit() {
 				if req.Logger != nil {
 					LogError(req.Logger, LogOperation(req.Type), "wallet not found", nil, map[string]interface{}{
 						"user_id": req.UserID,
@@ -178,8 +187,8 @@ func (s *WalletService) updateBalanceInTx(ctx context.Context, req *WalletUpdate
 			return status.Error(codes.Internal, "failed to read wallet")
 		}
 
-		// 3. 余额检查（仅消费操作需要）
-		if req.Type == WalletOpConsume {
+		// 3. 余额检查（仅扣款操作需要）
+		if req.Type.isDebit() {
 			if wallet.Balance < req.Amount {
 				if req.Logger != nil {
 					LogWarning(req.Logger, LogOperation(req.Type), "insufficient balance", map[string]interface{}{
@@ -200,8 +209,8 @@ func (s *WalletService) updateBalanceInTx(ctx context.Context, req *WalletUpdate
 		var changeAmount int64
 
 		switch req.Type {
-		case WalletOpConsume:
-			// 消费：余额减少，变动金额为负数
+		case WalletOpConsume, WalletOpVipPurchase:
+			// 消费/购买会员：余额减少，变动金额为负数
 			after = before - req.Amount
 			changeAmount = -req.Amount
 		case WalletOpRecharge, WalletOpRefund:
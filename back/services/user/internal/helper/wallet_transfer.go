@@ -0,0 +1,290 @@
+package helper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"SLGaming/back/pkg/lock"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// errDuplicateTransfer 并发情况下流水唯一索引冲突，说明同一 BizOrderID 已被其它请求处理
+var errDuplicateTransfer = errors.New("duplicate transfer")
+
+// WalletTransferRequest 用户间转账请求（打赏、送礼）
+type WalletTransferRequest struct {
+	FromUserID uint64
+	ToUserID   uint64
+	Amount     int64  // 金额（正数）
+	BizOrderID string // 业务单号，转出/转入两条流水共用，用于幂等控制
+	Remark     string
+	Logger     logx.Logger
+	// AfterTransaction 事务内回调（例如写入打赏记录），返回错误会回滚整个转账
+	AfterTransaction AfterTransactionCallback
+}
+
+// WalletTransferResult 转账结果
+type WalletTransferResult struct {
+	FromWallet *model.UserWallet
+	ToWallet   *model.UserWallet
+	// Duplicated 为 true 表示该 BizOrderID 之前已处理过（幂等返回）
+	Duplicated bool
+}
+
+// Transfer 在同一个本地事务中完成转出方扣款和转入方加款
+// 两条流水（TRANSFER_OUT / TRANSFER_IN）共用一个 BizOrderID；
+// 分布式锁和行锁都按用户ID升序获取，避免互相转账时死锁
+func (s *WalletService) Transfer(ctx context.Context, req *WalletTransferRequest) (*WalletTransferResult, error) {
+	if req.FromUserID == 0 || req.ToUserID == 0 {
+		return nil, status.Error(codes.InvalidArgument, "from_user_id and to_user_id are required")
+	}
+	if req.FromUserID == req.ToUserID {
+		return nil, status.Error(codes.InvalidArgument, "cannot transfer to yourself")
+	}
+	if req.Amount <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+	if req.BizOrderID == "" {
+		return nil, status.Error(codes.InvalidArgument, "biz_order_id is required")
+	}
+
+	if s.lock == nil {
+		// 没有分布式锁，直接执行（降级处理，依赖数据库行锁）
+		return s.transferInTx(ctx, req)
+	}
+
+	firstID, secondID := req.FromUserID, req.ToUserID
+	if firstID > secondID {
+		firstID, secondID = secondID, firstID
+	}
+	lockOpts := &lock.LockOptions{
+		TTL:           30 * time.Second,
+		RetryInterval: 100 * time.Millisecond,
+		MaxWaitTime:   10 * time.Second,
+	}
+
+	var result *WalletTransferResult
+	err := s.lock.WithLock(ctx, fmt.Sprintf("wallet:%d", firstID), lockOpts, func() error {
+		return s.lock.WithLock(ctx, fmt.Sprintf("wallet:%d", secondID), lockOpts, func() error {
+			var err error
+			result, err = s.transferInTx(ctx, req)
+			return err
+		})
+	})
+	return result, err
+}
+
+// transferInTx 在事务中完成转账
+func (s *WalletService) transferInTx(ctx context.Context, req *WalletTransferRequest) (*WalletTransferResult, error) {
+	result := &WalletTransferResult{}
+	logFields := map[string]interface{}{
+		"from_user_id": req.FromUserID,
+		"to_user_id":   req.ToUserID,
+		"amount":       req.Amount,
+		"biz_order_id": req.BizOrderID,
+	}
+
+	err := s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		// 1. 幂等检查：转出流水已存在说明之前已成功，但转出方、转入方和金额必须与之前一致
+		duplicated, err := checkTransferReplay(tx, req)
+		if err != nil {
+			if _, ok := status.FromError(err); !ok {
+				LogError(req.Logger, OpTransfer, "idempotent check failed", err, logFields)
+				return status.Error(codes.Internal, "failed to check idempotency")
+			}
+			LogWarning(req.Logger, OpTransfer, "biz_order_id replayed with different transfer", logFields)
+			return err
+		}
+		if duplicated {
+			result.Duplicated = true
+			return nil
+		}
+
+		// 2. 按用户ID升序加行锁读取双方钱包
+		var wallets []model.UserWallet
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("user_id IN ?", []uint64{req.FromUserID, req.ToUserID}).
+			Order("user_id asc").
+			Find(&wallets).Error; err != nil {
+			LogError(req.Logger, OpTransfer, "read wallets failed", err, logFields)
+			return status.Error(codes.Internal, "failed to read wallet")
+		}
+
+		var from, to *model.UserWallet
+		for i := range wallets {
+			switch wallets[i].UserID {
+			case req.FromUserID:
+				from = &wallets[i]
+			case req.ToUserID:
+				to = &wallets[i]
+			}
+		}
+
+		// 转出方钱包必须存在且余额充足
+		if from == nil {
+			LogWarning(req.Logger, OpTransfer, "sender wallet not found", logFields)
+			return status.Error(codes.FailedPrecondition, "wallet not found, please create wallet first")
+		}
+		if from.Balance < req.Amount {
+			LogWarning(req.Logger, OpTransfer, "insufficient balance", map[string]interface{}{
+				"from_user_id":    req.FromUserID,
+				"current_balance": from.Balance,
+				"required_amount": req.Amount,
+				"biz_order_id":    req.BizOrderID,
+			})
+			return status.Error(codes.ResourceExhausted,
+				"insufficient handsome coins, current balance is insufficient for this transaction")
+		}
+
+		// 转入方钱包不存在则创建
+		if to == nil {
+			to = &model.UserWallet{UserID: req.ToUserID}
+			if err := tx.Create(to).Error; err != nil {
+				LogError(req.Logger, OpTransfer, "create receiver wallet failed", err, logFields)
+				return status.Error(codes.Internal, "failed to create wallet")
+			}
+		}
+
+		// 3. 更新双方余额并写入成对流水
+		fromBefore, toBefore := from.Balance, to.Balance
+		from.Balance -= req.Amount
+		to.Balance += req.Amount
+
+		if err := tx.Save(from).Error; err != nil {
+			LogError(req.Logger, OpTransfer, "update sender balance failed", err, logFields)
+			return status.Error(codes.Internal, "failed to update wallet balance")
+		}
+		if err := tx.Save(to).Error; err != nil {
+			LogError(req.Logger, OpTransfer, "update receiver balance failed", err, logFields)
+			return status.Error(codes.Internal, "failed to update wallet balance")
+		}
+
+		trs := []*model.WalletTransaction{
+			{
+				UserID:        req.FromUserID,
+				WalletID:      from.ID,
+				ChangeAmount:  -req.Amount,
+				BeforeBalance: fromBefore,
+				AfterBalance:  from.Balance,
+				Type:          string(WalletOpTransferOut),
+				BizOrderID:    req.BizOrderID,
+				Remark:        req.Remark,
+			},
+			{
+				UserID:        req.ToUserID,
+				WalletID:      to.ID,
+				ChangeAmount:  req.Amount,
+				BeforeBalance: toBefore,
+				AfterBalance:  to.Balance,
+				Type:          string(WalletOpTransferIn),
+				BizOrderID:    req.BizOrderID,
+				Remark:        req.Remark,
+			},
+		}
+		for _, tr := range trs {
+			if err := tx.Create(tr).Error; err != nil {
+				if strings.Contains(err.Error(), "Duplicate entry") {
+					// 回滚本次变更，交由外层按幂等处理
+					return errDuplicateTransfer
+				}
+				LogError(req.Logger, OpTransfer, "create transaction record failed", err, logFields)
+				return status.Error(codes.Internal, "failed to create transaction record")
+			}
+		}
+
+		// 4. 事务内回调
+		if req.AfterTransaction != nil {
+			if err := req.AfterTransaction(tx); err != nil {
+				LogError(req.Logger, OpTransfer, "after-transaction callback failed", err, logFields)
+				return err
+			}
+		}
+
+		result.FromWallet = from
+		result.ToWallet = to
+		return nil
+	})
+
+	if errors.Is(err, errDuplicateTransfer) {
+		// 并发请求已写入同一 BizOrderID 的流水，同样要求与本次请求一致
+		LogInfo(req.Logger, OpTransfer, "idempotent: duplicate transaction detected", logFields)
+		if _, err = checkTransferReplay(s.db.WithContext(ctx), req); err == nil {
+			result.Duplicated = true
+		}
+	}
+	if err != nil {
+		if st, ok := status.FromError(err); ok {
+			return nil, st.Err()
+		}
+		LogError(req.Logger, OpTransfer, "transaction failed", err, logFields)
+		return nil, status.Error(codes.Internal, "transaction failed, please try again later")
+	}
+
+	if result.Duplicated {
+		// 幂等返回：读取转出方当前钱包
+		var wallet model.UserWallet
+		if err := s.db.WithContext(ctx).Where("user_id = ?", req.FromUserID).First(&wallet).Error; err != nil {
+			LogError(req.Logger, OpTransfer, "idempotent check failed to get wallet", err, logFields)
+			return nil, status.Error(codes.Internal, "failed to get wallet after idempotent check")
+		}
+		result.FromWallet = &wallet
+		return result, nil
+	}
+
+	LogSuccess(req.Logger, OpTransfer, map[string]interface{}{
+		"from_user_id":   req.FromUserID,
+		"to_user_id":     req.ToUserID,
+		"amount":         req.Amount,
+		"biz_order_id":   req.BizOrderID,
+		"sender_after":   result.FromWallet.Balance,
+		"receiver_after": result.ToWallet.Balance,
+	})
+	return result, nil
+}
+
+// checkTransferReplay 检查 BizOrderID 是否已经转账过：已存在时返回 true，
+// 已存在但转出方、转入方或金额与本次请求不同时返回错误，避免把不同的转账当作幂等成功
+func checkTransferReplay(db *gorm.DB, req *WalletTransferRequest) (bool, error) {
+	var trs []model.WalletTransaction
+	if err := db.Select("user_id, change_amount, type").
+		Where("type IN ? AND biz_order_id = ?",
+			[]string{string(WalletOpTransferOut), string(WalletOpTransferIn)}, req.BizOrderID).
+		Find(&trs).Error; err != nil {
+		return false, err
+	}
+
+	var out, in *model.WalletTransaction
+	for i := range trs {
+		switch trs[i].Type {
+		case string(WalletOpTransferOut):
+			out = &trs[i]
+		case string(WalletOpTransferIn):
+			in = &trs[i]
+		}
+	}
+	if out == nil {
+		return false, nil
+	}
+	if out.UserID != req.FromUserID {
+		return false, status.Error(codes.AlreadyExists, "biz_order_id already used by another user")
+	}
+	if out.ChangeAmount != -req.Amount || in == nil || in.UserID != req.ToUserID || in.ChangeAmount != req.Amount {
+		return false, status.Error(codes.InvalidArgument, "biz_order_id already used for a different receiver or amount")
+	}
+	return true, nil
+}
+
+// ToWalletInfo 转换为 protobuf 的 WalletInfo（转出方）
+func (r *WalletTransferResult) ToWalletInfo() *user.WalletInfo {
+	return (&WalletUpdateResult{Wallet: r.FromWallet}).ToWalletInfo()
+}
@@ -0,0 +1,121 @@
+package job
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	pkgIoc "SLGaming/back/pkg/ioc"
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/svc"
+
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+const (
+	eventTypeOrderAccepted = "ORDER_ACCEPTED"
+	eventTypeOrderDisputed = "ORDER_DISPUTED"
+)
+
+// companionOrderEventPayload 接单/完成/取消/纠纷事件中与陪玩相关的公共字段
+type companionOrderEventPayload struct {
+	OrderID     uint64 `json:"order_id"`
+	OrderNo     string `json:"order_no"`
+	CompanionID uint64 `json:"companion_id"`
+	OperatorID  uint64 `json:"operator_id"` // 仅取消事件携带
+}
+
+// StartCompanionOrderConsumer 启动陪玩订单数消费者：接单时计入进行中的订单，完成/取消时释放，并据此维护忙碌状态
+// 同时记录陪玩主动取消与老板发起纠纷的事件，供等级评估统计取消率与纠纷率
+// 使用独立的消费组，与退款/结算消费者互不影响
+func StartCompanionOrderConsumer(ctx context.Context, svcCtx *svc.ServiceContext) {
+	cfg := svcCtx.Config().RocketMQ
+	if len(cfg.NameServers) == 0 {
+		helper.LogInfo(logx.WithContext(ctx), helper.OpMQConsumer, "companion order consumer not started: rocketmq not configured", nil)
+		return
+	}
+
+	mqCfg := &pkgIoc.RocketMQConfigAdapter{
+		NameServers: cfg.NameServers,
+		Namespace:   cfg.Namespace,
+		AccessKey:   cfg.AccessKey,
+		SecretKey:   cfg.SecretKey,
+	}
+
+	consumer, err := pkgIoc.InitRocketMQConsumerWithSelector(
+		mqCfg,
+		"user-companion-order-consumer",
+		[]string{orderEventTopic},
+		eventTypeOrderAccepted+"||"+eventTypeOrderCompleted+"||"+eventTypeOrderCancelled+"||"+eventTypeOrderDisputed,
+		func(c context.Context, msg *primitive.MessageExt) error {
+			return handleCompanionOrderEvent(c, svcCtx, msg)
+		},
+	)
+	if err != nil {
+		helper.LogError(logx.WithContext(ctx), helper.OpMQConsumer, "init companion order consumer failed", err, nil)
+		return
+	}
+
+	// 确保进程退出时关闭 consumer
+	go func() {
+		<-ctx.Done()
+		pkgIoc.ShutdownRocketMQConsumer(consumer)
+	}()
+
+	helper.LogSuccess(logx.WithContext(ctx), helper.OpMQConsumer, map[string]interface{}{
+		"consumer": "companion_order",
+		"topic":    orderEventTopic,
+	})
+}
+
+// handleCompanionOrderEvent 处理陪玩订单事件（TrackCompanionOrder/ReleaseCompanionOrder 按订单幂等，重复消费无副作用）
+func handleCompanionOrderEvent(ctx context.Context, svcCtx *svc.ServiceContext, msg *primitive.MessageExt) error {
+	logger := logx.WithContext(ctx)
+	eventType := msg.GetTags()
+
+	var payload companionOrderEventPayload
+	if err := json.Unmarshal(msg.Body, &payload); err != nil {
+		helper.LogError(logger, helper.OpCompanionOrders, "unmarshal order event payload failed", err, map[string]interface{}{
+			"event": eventType,
+			"body":  string(msg.Body),
+		})
+		return nil // 丢弃这条，避免一直重试
+	}
+	if payload.OrderID == 0 || payload.CompanionID == 0 {
+		helper.LogError(logger, helper.OpCompanionOrders, "invalid order event payload", nil, map[string]interface{}{
+			"event":        eventType,
+			"order_id":     payload.OrderID,
+			"companion_id": payload.CompanionID,
+		})
+		return nil
+	}
+
+	var err error
+	switch eventType {
+	case eventTypeOrderAccepted:
+		err = helper.TrackCompanionOrder(ctx, svcCtx, logger, payload.CompanionID, payload.OrderID)
+	case eventTypeOrderCompleted:
+		err = helper.ReleaseCompanionOrder(ctx, svcCtx, logger, payload.CompanionID, payload.OrderID)
+	case eventTypeOrderCancelled:
+		err = helper.ReleaseCompanionOrder(ctx, svcCtx, logger, payload.CompanionID, payload.OrderID)
+		if err == nil && payload.OperatorID == payload.CompanionID {
+			// 陪玩主动取消计入等级评估的取消率
+			err = helper.RecordCancellationEvent(ctx, svcCtx, payload.CompanionID, payload.OrderID, time.UnixMilli(msg.BornTimestamp))
+		}
+	case eventTypeOrderDisputed:
+		// 纠纷计入等级评估的纠纷率，不影响进行中的订单数
+		err = helper.RecordDisputeEvent(ctx, svcCtx, payload.CompanionID, payload.OrderID, time.UnixMilli(msg.BornTimestamp))
+	default:
+		return nil
+	}
+	if err != nil {
+		helper.LogError(logger, helper.OpCompanionOrders, "apply order event failed", err, map[string]interface{}{
+			"event":        eventType,
+			"order_no":     payload.OrderNo,
+			"companion_id": payload.CompanionID,
+		})
+		return err
+	}
+	return nil
+}
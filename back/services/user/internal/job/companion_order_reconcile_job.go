@@ -0,0 +1,224 @@
+package job
+
+import (
+	"context"
+	"time"
+
+	"SLGaming/back/pkg/lock"
+	"SLGaming/back/services/order/orderclient"
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/metrics"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+const (
+	companionOrdersLockKey        = "companion:orders:reconcile"
+	defaultCompanionReconcileTick = 5 * time.Minute
+	defaultCompanionReconcileWait = 2 * time.Minute
+	defaultCompanionReconcileSize = 200
+)
+
+// 订单服务中进行中的订单状态（见 order 服务 model.OrderStatusAccepted / OrderStatusInService）
+const (
+	orderStatusAccepted  = 3
+	orderStatusInService = 4
+)
+
+// StartCompanionOrderReconcileJob 启动陪玩进行中订单的对账任务
+// 以订单服务为准修正事件丢失造成的偏差：补记漏掉的接单、释放已结束的订单，并按订单数重新计算忙碌状态
+// 多实例部署时通过分布式锁保证同一时刻只有一个实例在处理；未配置订单服务时不启动
+func StartCompanionOrderReconcileJob(ctx context.Context, svcCtx *svc.ServiceContext) {
+	logger := logx.WithContext(ctx)
+
+	if svcCtx.OrderRPC == nil {
+		helper.LogInfo(logger, helper.OpCompanionOrders, "companion order reconcile job disabled: order rpc not configured", nil)
+		return
+	}
+
+	interval := svcCtx.Config().CompanionOrders.ReconcileInterval
+	if interval <= 0 {
+		interval = defaultCompanionReconcileTick
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		helper.LogInfo(logger, helper.OpCompanionOrders, "companion order reconcile job started", map[string]interface{}{
+			"interval": interval.String(),
+		})
+
+		for {
+			select {
+			case <-ctx.Done():
+				helper.LogInfo(logger, helper.OpCompanionOrders, "companion order reconcile job stopped", nil)
+				return
+			case <-ticker.C:
+				runCompanionOrderReconcileOnce(ctx, svcCtx, logger, interval)
+			}
+		}
+	}()
+}
+
+// runCompanionOrderReconcileOnce 执行一轮对账
+func runCompanionOrderReconcileOnce(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, interval time.Duration) {
+	if svcCtx.DistributedLock != nil {
+		handle, err := svcCtx.DistributedLock.TryLock(ctx, companionOrdersLockKey, &lock.LockOptions{
+			TTL:           interval,
+			RetryInterval: 100 * time.Millisecond,
+		})
+		if err != nil {
+			helper.LogError(logger, helper.OpCompanionOrders, "acquire job lock failed", err, nil)
+			return
+		}
+		if handle == nil {
+			// 其它实例正在处理
+			return
+		}
+		defer func() { _ = handle.Unlock(ctx) }()
+	}
+
+	purgeReleasedOrders(ctx, svcCtx, logger)
+	reconcileCompanionOrders(ctx, svcCtx, logger)
+}
+
+// purgeReleasedOrders 删除超过保留时长的已释放记录
+func purgeReleasedOrders(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger) {
+	ttl := svcCtx.Config().CompanionOrders.TombstoneTTL
+	if ttl <= 0 {
+		return
+	}
+	if err := svcCtx.DB().WithContext(ctx).
+		Where("released_at IS NOT NULL AND released_at < ?", time.Now().Add(-ttl)).
+		Delete(&model.CompanionActiveOrder{}).Error; err != nil {
+		helper.LogError(logger, helper.OpCompanionOrders, "purge released orders failed", err, nil)
+	}
+}
+
+// reconcileCompanionOrders 分批核对在线、忙碌或有进行中订单的陪玩
+func reconcileCompanionOrders(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger) {
+	cfg := svcCtx.Config().CompanionOrders
+	batchSize := cfg.ReconcileBatch
+	if batchSize <= 0 {
+		batchSize = defaultCompanionReconcileSize
+	}
+	grace := cfg.ReconcileGrace
+	if grace <= 0 {
+		grace = defaultCompanionReconcileWait
+	}
+	cutoff := time.Now().Add(-grace)
+
+	var fixed int
+	var profiles []model.CompanionProfile
+	result := svcCtx.DB().WithContext(ctx).
+		Select("id, user_id").
+		Where("status IN ? OR active_orders > 0", []int{model.CompanionStatusOnline, model.CompanionStatusBusy}).
+		FindInBatches(&profiles, batchSize, func(tx *gorm.DB, batch int) error {
+			for i := range profiles {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				n, err := reconcileCompanion(ctx, svcCtx, logger, profiles[i].UserID, cutoff)
+				if err != nil {
+					helper.LogError(logger, helper.OpCompanionOrders, "reconcile companion failed", err, map[string]interface{}{
+						"user_id": profiles[i].UserID,
+					})
+					continue
+				}
+				fixed += n
+			}
+			return nil
+		})
+	if result.Error != nil {
+		helper.LogError(logger, helper.OpCompanionOrders, "reconcile companion orders failed", result.Error, nil)
+	}
+
+	if fixed > 0 {
+		metrics.CompanionOrderStatusTotal.WithLabelValues("reconciled").Add(float64(fixed))
+		helper.LogInfo(logger, helper.OpCompanionOrders, "companion orders reconciled", map[string]interface{}{
+			"fixed": fixed,
+		})
+	}
+}
+
+// reconcileCompanion 核对单个陪玩：只处理早于 cutoff 的记录与订单，避免与在途事件冲突；返回修正的订单数
+func reconcileCompanion(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, companionID uint64, cutoff time.Time) (int, error) {
+	actual := make(map[uint64]bool)
+	for _, st := range []int32{orderStatusAccepted, orderStatusInService} {
+		resp, err := svcCtx.OrderRPC.GetOrderList(ctx, &orderclient.GetOrderListRequest{
+			CompanionId: companionID,
+			Status:      st,
+			Page:        1,
+			PageSize:    100,
+		})
+		if err != nil {
+			return 0, err
+		}
+		for _, o := range resp.GetOrders() {
+			if o.GetAcceptedAt() > 0 && o.GetAcceptedAt() <= cutoff.Unix() {
+				actual[o.GetId()] = true
+			}
+		}
+	}
+
+	var tracked []model.CompanionActiveOrder
+	if err := svcCtx.DB().WithContext(ctx).
+		Select("order_id, created_at").
+		Where("companion_id = ? AND released_at IS NULL", companionID).
+		Find(&tracked).Error; err != nil {
+		return 0, err
+	}
+
+	fixed := 0
+	known := make(map[uint64]bool, len(tracked))
+	for _, t := range tracked {
+		known[t.OrderID] = true
+		if actual[t.OrderID] || t.CreatedAt.After(cutoff) {
+			continue
+		}
+		// 订单已结束但完成/取消事件丢失
+		if err := helper.ReleaseCompanionOrder(ctx, svcCtx, logger, companionID, t.OrderID); err != nil {
+			return fixed, err
+		}
+		fixed++
+	}
+	missing := make([]uint64, 0)
+	for orderID := range actual {
+		if !known[orderID] {
+			missing = append(missing, orderID)
+		}
+	}
+	if len(missing) > 0 {
+		// 已释放的订单（完成/取消事件先到）不重新计入
+		var released []uint64
+		if err := svcCtx.DB().WithContext(ctx).
+			Model(&model.CompanionActiveOrder{}).
+			Where("order_id IN ?", missing).
+			Pluck("order_id", &released).Error; err != nil {
+			return fixed, err
+		}
+		for _, id := range released {
+			known[id] = true
+		}
+		for _, orderID := range missing {
+			if known[orderID] {
+				continue
+			}
+			// 接单事件丢失
+			if err := helper.TrackCompanionOrder(ctx, svcCtx, logger, companionID, orderID); err != nil {
+				return fixed, err
+			}
+			fixed++
+		}
+	}
+
+	// 状态与订单数不一致（如手动置为忙碌后没有订单）时按记录重新计算
+	if err := helper.RecountCompanionOrders(ctx, svcCtx, logger, companionID); err != nil {
+		return fixed, err
+	}
+	return fixed, nil
+}
@@ -0,0 +1,230 @@
+package job
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"SLGaming/back/pkg/lock"
+	"SLGaming/back/services/user/internal/cache"
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/metrics"
+	"SLGaming/back/services/user/internal/model"
+	userMQ "SLGaming/back/services/user/internal/mq"
+	"SLGaming/back/services/user/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+const (
+	companionTierLockKey       = "companion:tier:evaluate"
+	defaultCompanionTierEvery  = time.Hour
+	defaultCompanionTierWindow = 30 * 24 * time.Hour
+	defaultCompanionTierBatch  = 200
+)
+
+// StartCompanionTierJob 启动陪玩等级评估任务
+// 按滚动窗口内的完成单数、平均评分、取消率与纠纷率重新计算等级，变更时记录原因；管理员指定等级的陪玩不参与评估
+// 每轮结束后按当前等级配置校正排序得分（rank_score）；首次评估前先从订单历史回填统计事件
+// 多实例部署时通过分布式锁保证同一时刻只有一个实例在处理
+func StartCompanionTierJob(ctx context.Context, svcCtx *svc.ServiceContext) {
+	logger := logx.WithContext(ctx)
+
+	interval := svcCtx.Config().CompanionTier.EvaluateInterval
+	if interval <= 0 {
+		interval = defaultCompanionTierEvery
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		helper.LogInfo(logger, helper.OpCompanionTier, "companion tier job started", map[string]interface{}{
+			"interval": interval.String(),
+		})
+
+		for {
+			select {
+			case <-ctx.Done():
+				helper.LogInfo(logger, helper.OpCompanionTier, "companion tier job stopped", nil)
+				return
+			case <-ticker.C:
+				runCompanionTierOnce(ctx, svcCtx, logger, interval)
+			}
+		}
+	}()
+}
+
+// runCompanionTierOnce 执行一轮评估
+func runCompanionTierOnce(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, interval time.Duration) {
+	if svcCtx.DistributedLock != nil {
+		handle, err := svcCtx.DistributedLock.TryLock(ctx, companionTierLockKey, &lock.LockOptions{
+			TTL:           interval,
+			RetryInterval: 100 * time.Millisecond,
+		})
+		if err != nil {
+			helper.LogError(logger, helper.OpCompanionTier, "acquire job lock failed", err, nil)
+			return
+		}
+		if handle == nil {
+			// 其它实例正在处理
+			return
+		}
+		defer func() { _ = handle.Unlock(ctx) }()
+	}
+
+	if !ensureTierStatsBackfilled(ctx, svcCtx, logger) {
+		return
+	}
+	evaluateCompanionTiers(ctx, svcCtx, logger)
+}
+
+// tierStatsBackfilled 本实例已确认回填完成，之后不再查询标记
+var tierStatsBackfilled atomic.Bool
+
+// ensureTierStatsBackfilled 首次评估前从订单历史回填等级统计；回填失败时跳过本轮评估，避免按空统计把陪玩降为最低等级
+func ensureTierStatsBackfilled(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger) bool {
+	if tierStatsBackfilled.Load() {
+		return true
+	}
+	if svcCtx.Redis != nil {
+		done, err := svcCtx.Redis.ExistsCtx(ctx, cache.CompanionTierBackfilledKey)
+		if err != nil {
+			helper.LogError(logger, helper.OpCompanionTier, "check tier stats backfill marker failed", err, nil)
+			return false
+		}
+		if done {
+			tierStatsBackfilled.Store(true)
+			return true
+		}
+	}
+
+	processed, err := helper.BackfillTierStats(ctx, svcCtx, logger, time.Now().Add(-companionTierWindow(svcCtx)))
+	if err != nil {
+		helper.LogError(logger, helper.OpCompanionTier, "backfill tier stats failed, skip evaluation", err, map[string]interface{}{
+			"processed": processed,
+		})
+		return false
+	}
+	if svcCtx.Redis != nil {
+		if err := svcCtx.Redis.SetCtx(ctx, cache.CompanionTierBackfilledKey, "1"); err != nil {
+			helper.LogError(logger, helper.OpCompanionTier, "set tier stats backfill marker failed", err, nil)
+		}
+	}
+	tierStatsBackfilled.Store(true)
+	helper.LogInfo(logger, helper.OpCompanionTier, "tier stats backfilled from order history", map[string]interface{}{
+		"processed": processed,
+	})
+	return true
+}
+
+// companionTierWindow 等级评估的统计窗口
+func companionTierWindow(svcCtx *svc.ServiceContext) time.Duration {
+	if window := svcCtx.Config().CompanionTier.Window; window > 0 {
+		return window
+	}
+	return defaultCompanionTierWindow
+}
+
+// evaluateCompanionTiers 分批评估所有未被管理员指定等级的陪玩
+func evaluateCompanionTiers(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger) {
+	cfg := svcCtx.Config().CompanionTier
+	batchSize := cfg.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultCompanionTierBatch
+	}
+	since := time.Now().Add(-companionTierWindow(svcCtx))
+	levels := helper.CompanionTierLevels(svcCtx)
+	db := svcCtx.DB().WithContext(ctx)
+
+	changed := 0
+	var profiles []model.CompanionProfile
+	result := db.Select("id, user_id, tier").
+		Where("tier_override = 0").
+		FindInBatches(&profiles, batchSize, func(tx *gorm.DB, batch int) error {
+			ids := make([]uint64, 0, len(profiles))
+			for i := range profiles {
+				ids = append(ids, profiles[i].UserID)
+			}
+			stats, err := helper.LoadCompanionTierStats(ctx, svcCtx.DB(), ids, since)
+			if err != nil {
+				return err
+			}
+
+			for i := range profiles {
+				p := &profiles[i]
+				s := stats[p.UserID]
+				if s == nil {
+					s = &helper.CompanionTierStats{}
+				}
+				tier := helper.EvaluateCompanionTier(levels, s)
+				if tier == p.Tier {
+					continue
+				}
+				history := &model.CompanionTierHistory{
+					CompanionID:     p.UserID,
+					FromTier:        p.Tier,
+					ToTier:          tier,
+					Reason:          model.TierReasonEvaluation,
+					CompletedOrders: s.CompletedOrders,
+					Rating:          s.Rating(),
+					CancelRate:      s.CancelRate(),
+					DisputeRate:     s.DisputeRate(),
+				}
+				applied := false
+				var overCap []userMQ.TierOverCapPrice
+				err := db.Transaction(func(tx *gorm.DB) error {
+					// 条件更新：评估期间管理员可能已指定等级
+					res := tx.Model(&model.CompanionProfile{}).
+						Where("user_id = ? AND tier = ? AND tier_override = 0", p.UserID, p.Tier).
+						Update("tier", tier)
+					if res.Error != nil || res.RowsAffected == 0 {
+						return res.Error
+					}
+					applied = true
+					var err error
+					overCap, err = helper.ChangeCompanionTier(svcCtx, tx, history, nil)
+					return err
+				})
+				if err != nil {
+					metrics.CompanionTierChangeTotal.WithLabelValues("evaluation", "error").Inc()
+					helper.LogError(logger, helper.OpCompanionTier, "change companion tier failed", err, map[string]interface{}{
+						"user_id": p.UserID,
+						"from":    p.Tier,
+						"to":      tier,
+					})
+					continue
+				}
+				if !applied {
+					continue
+				}
+				direction := "upgrade"
+				if tier < p.Tier {
+					direction = "downgrade"
+				}
+				metrics.CompanionTierChangeTotal.WithLabelValues("evaluation", direction).Inc()
+				helper.PublishCompanionTierChanged(ctx, svcCtx, logger, history, overCap)
+				changed++
+			}
+			return nil
+		})
+	if result.Error != nil {
+		helper.LogError(logger, helper.OpCompanionTier, "evaluate companion tiers failed", result.Error, nil)
+	}
+
+	// 校正排序得分：评分更新或等级加权配置调整后，rank_score 与当前配置保持一致
+	synced, err := helper.SyncCompanionRankScores(ctx, svcCtx)
+	if err != nil {
+		helper.LogError(logger, helper.OpCompanionTier, "sync companion rank scores failed", err, nil)
+	}
+
+	if changed > 0 || synced > 0 {
+		// 等级影响列表排序与价格
+		helper.InvalidateCompanionList(svcCtx, logger)
+		helper.LogInfo(logger, helper.OpCompanionTier, "companion tiers evaluated", map[string]interface{}{
+			"changed":            changed,
+			"rank_scores_synced": synced,
+		})
+	}
+}
@@ -3,6 +3,7 @@ package job
 import (
 	"context"
 	"encoding/json"
+	"strconv"
 
 	pkgIoc "SLGaming/back/pkg/ioc"
 	"SLGaming/back/services/user/internal/helper"
@@ -15,7 +16,22 @@ import (
 	"gorm.io/gorm"
 )
 
-// StartFollowEventConsumer 启动关注和取消关注事件的消费者
+// 订单评价事件（order_events topic），陪玩收到的新评价写入粉丝的动态流
+const eventTypeOrderRated = "ORDER_RATED"
+
+// feedCommentMaxLen 动态中保留的评价内容长度
+const feedCommentMaxLen = 500
+
+// orderRatedEventPayload 订单评价事件负载（与订单服务中构造的 payload 对应）
+type orderRatedEventPayload struct {
+	OrderID     uint64  `json:"order_id"`
+	CompanionID uint64  `json:"companion_id"`
+	GameName    string  `json:"game_name"`
+	Rating      float64 `json:"rating"`
+	Comment     string  `json:"comment"`
+}
+
+// StartFollowEventConsumer 启动关注事件的消费者：维护关注计数、拉黑后解除双方关注，并把陪玩动态（上线、调价、新评价）写入粉丝的动态流
 func StartFollowEventConsumer(ctx context.Context, svcCtx *svc.ServiceContext) {
 	cfg := svcCtx.Config().RocketMQ
 	if len(cfg.NameServers) == 0 {
@@ -30,10 +46,11 @@ func StartFollowEventConsumer(ctx context.Context, svcCtx *svc.ServiceContext) {
 		SecretKey:   cfg.SecretKey,
 	}
 
-	consumer, err := pkgIoc.InitRocketMQConsumer(
+	consumer, err := pkgIoc.InitRocketMQConsumerWithSelector(
 		mqCfg,
 		"user-follow-consumer",
-		[]string{mq.FollowEventTopic()},
+		[]string{mq.FollowEventTopic(), orderEventTopic},
+		mq.EventTypeFollowUser()+"||"+mq.EventTypeUnfollowUser()+"||"+mq.EventTypeCompanionActivity()+"||"+mq.EventTypeBlockUser()+"||"+eventTypeOrderRated,
 		func(c context.Context, msg *primitive.MessageExt) error {
 			return handleFollowEvent(c, svcCtx, msg)
 		},
@@ -55,7 +72,7 @@ func StartFollowEventConsumer(ctx context.Context, svcCtx *svc.ServiceContext) {
 	})
 }
 
-// handleFollowEvent 处理关注和取消关注事件
+// handleFollowEvent 处理关注、取消关注、拉黑与陪玩动态事件
 func handleFollowEvent(ctx context.Context, svcCtx *svc.ServiceContext, msg *primitive.MessageExt) error {
 	logger := logx.WithContext(ctx)
 
@@ -81,9 +98,15 @@ func handleFollowEvent(ctx context.Context, svcCtx *svc.ServiceContext, msg *pri
 	var handleErr error
 	switch msg.GetTags() {
 	case mq.EventTypeFollowUser():
-		handleErr = handleFollowUser(ctx, db, msg)
+		handleErr = handleFollowUser(ctx, svcCtx, db, msg)
 	case mq.EventTypeUnfollowUser():
-		handleErr = handleUnfollowUser(ctx, db, msg)
+		handleErr = handleUnfollowUser(ctx, svcCtx, db, msg)
+	case mq.EventTypeBlockUser():
+		handleErr = handleBlockUser(ctx, svcCtx, msg)
+	case mq.EventTypeCompanionActivity():
+		handleErr = handleCompanionActivity(ctx, svcCtx, msg)
+	case eventTypeOrderRated:
+		handleErr = handleOrderRated(ctx, svcCtx, msg)
 	default:
 		// 忽略其他事件类型
 		return nil
@@ -109,7 +132,7 @@ func handleFollowEvent(ctx context.Context, svcCtx *svc.ServiceContext, msg *pri
 }
 
 // handleFollowUser 处理关注用户事件
-func handleFollowUser(ctx context.Context, db *gorm.DB, msg *primitive.MessageExt) error {
+func handleFollowUser(ctx context.Context, svcCtx *svc.ServiceContext, db *gorm.DB, msg *primitive.MessageExt) error {
 	logger := logx.WithContext(ctx)
 
 	var payload mq.FollowUserPayload
@@ -158,6 +181,9 @@ func handleFollowUser(ctx context.Context, db *gorm.DB, msg *primitive.MessageEx
 		return err
 	}
 
+	// 关注对象变化，收件箱按新的关注关系重建
+	helper.InvalidateFeedInbox(ctx, svcCtx, logger, payload.FollowerID)
+
 	helper.LogSuccess(logger, helper.OpMQConsumer, map[string]interface{}{
 		"event":        "follow_user",
 		"follower_id":  payload.FollowerID,
@@ -167,7 +193,7 @@ func handleFollowUser(ctx context.Context, db *gorm.DB, msg *primitive.MessageEx
 }
 
 // handleUnfollowUser 处理取消关注用户事件
-func handleUnfollowUser(ctx context.Context, db *gorm.DB, msg *primitive.MessageExt) error {
+func handleUnfollowUser(ctx context.Context, svcCtx *svc.ServiceContext, db *gorm.DB, msg *primitive.MessageExt) error {
 	logger := logx.WithContext(ctx)
 
 	var payload mq.UnfollowUserPayload
@@ -216,6 +242,9 @@ func handleUnfollowUser(ctx context.Context, db *gorm.DB, msg *primitive.Message
 		return err
 	}
 
+	// 收件箱中不再需要已取消关注的陪玩的动态
+	helper.InvalidateFeedInbox(ctx, svcCtx, logger, payload.FollowerID)
+
 	helper.LogSuccess(logger, helper.OpMQConsumer, map[string]interface{}{
 		"event":        "unfollow_user",
 		"follower_id":  payload.FollowerID,
@@ -223,3 +252,122 @@ func handleUnfollowUser(ctx context.Context, db *gorm.DB, msg *primitive.Message
 	})
 	return nil
 }
+
+// handleBlockUser 处理拉黑用户事件：解除双方的关注关系（只扣减实际删除的关系，重复投递不会重复扣减）
+func handleBlockUser(ctx context.Context, svcCtx *svc.ServiceContext, msg *primitive.MessageExt) error {
+	logger := logx.WithContext(ctx)
+
+	var payload mq.BlockUserPayload
+	if err := json.Unmarshal(msg.Body, &payload); err != nil {
+		helper.LogError(logger, helper.OpMQConsumer, "unmarshal block user payload failed", err, map[string]interface{}{
+			"body": string(msg.Body),
+		})
+		return nil // 丢弃这条，避免一直重试
+	}
+
+	if payload.BlockerID == 0 || payload.BlockedID == 0 {
+		helper.LogError(logger, helper.OpMQConsumer, "invalid block user payload", nil, map[string]interface{}{
+			"blocker_id": payload.BlockerID,
+			"blocked_id": payload.BlockedID,
+		})
+		return nil
+	}
+
+	if err := helper.RemoveFollowsBetween(ctx, svcCtx, logger, payload.BlockerID, payload.BlockedID); err != nil {
+		helper.LogError(logger, helper.OpMQConsumer, "remove follows for block failed", err, map[string]interface{}{
+			"blocker_id": payload.BlockerID,
+			"blocked_id": payload.BlockedID,
+		})
+		return err
+	}
+
+	helper.LogSuccess(logger, helper.OpMQConsumer, map[string]interface{}{
+		"event":      "block_user",
+		"blocker_id": payload.BlockerID,
+		"blocked_id": payload.BlockedID,
+	})
+	return nil
+}
+
+// handleCompanionActivity 处理陪玩动态事件（上线、调价、新帖子），写入动态表并写扩散到粉丝收件箱
+func handleCompanionActivity(ctx context.Context, svcCtx *svc.ServiceContext, msg *primitive.MessageExt) error {
+	logger := logx.WithContext(ctx)
+
+	var payload mq.CompanionActivityPayload
+	if err := json.Unmarshal(msg.Body, &payload); err != nil {
+		helper.LogError(logger, helper.OpFeed, "unmarshal companion activity payload failed", err, map[string]interface{}{
+			"body": string(msg.Body),
+		})
+		return nil // 丢弃这条，避免一直重试
+	}
+	if payload.CompanionID == 0 || payload.DedupKey == "" ||
+		(payload.Kind != model.FeedEventOnline && payload.Kind != model.FeedEventPrice && payload.Kind != model.FeedEventPost) {
+		helper.LogError(logger, helper.OpFeed, "invalid companion activity payload", nil, map[string]interface{}{
+			"companion_id": payload.CompanionID,
+			"kind":         payload.Kind,
+		})
+		return nil
+	}
+
+	event := &model.FeedEvent{
+		ActorID:  payload.CompanionID,
+		Kind:     payload.Kind,
+		DedupKey: payload.DedupKey,
+		OldPrice: payload.OldPrice,
+		NewPrice: payload.NewPrice,
+	}
+	if payload.Kind == model.FeedEventPost {
+		event.PostID = payload.PostID
+		event.Content = payload.Content
+		event.ImageURLs = helper.EncodeStringList(payload.ImageURLs)
+	}
+	if err := helper.RecordFeedEvent(ctx, svcCtx, event); err != nil {
+		helper.LogError(logger, helper.OpFeed, "record companion activity failed", err, map[string]interface{}{
+			"companion_id": payload.CompanionID,
+			"kind":         payload.Kind,
+		})
+		return err
+	}
+	return nil
+}
+
+// handleOrderRated 处理订单评价事件，陪玩收到的新评价写入粉丝的动态流（按订单幂等）
+func handleOrderRated(ctx context.Context, svcCtx *svc.ServiceContext, msg *primitive.MessageExt) error {
+	logger := logx.WithContext(ctx)
+
+	var payload orderRatedEventPayload
+	if err := json.Unmarshal(msg.Body, &payload); err != nil {
+		helper.LogError(logger, helper.OpFeed, "unmarshal ORDER_RATED payload failed", err, map[string]interface{}{
+			"body": string(msg.Body),
+		})
+		return nil // 丢弃这条，避免一直重试
+	}
+	if payload.OrderID == 0 || payload.CompanionID == 0 {
+		helper.LogError(logger, helper.OpFeed, "invalid ORDER_RATED payload", nil, map[string]interface{}{
+			"order_id":     payload.OrderID,
+			"companion_id": payload.CompanionID,
+		})
+		return nil
+	}
+
+	comment := []rune(payload.Comment)
+	if len(comment) > feedCommentMaxLen {
+		comment = comment[:feedCommentMaxLen]
+	}
+	event := &model.FeedEvent{
+		ActorID:  payload.CompanionID,
+		Kind:     model.FeedEventReview,
+		DedupKey: strconv.FormatUint(payload.OrderID, 10),
+		GameName: payload.GameName,
+		Rating:   payload.Rating,
+		Comment:  string(comment),
+	}
+	if err := helper.RecordFeedEvent(ctx, svcCtx, event); err != nil {
+		helper.LogError(logger, helper.OpFeed, "record review activity failed", err, map[string]interface{}{
+			"order_id":     payload.OrderID,
+			"companion_id": payload.CompanionID,
+		})
+		return err
+	}
+	return nil
+}
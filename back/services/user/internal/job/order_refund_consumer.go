@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	"time"
 
 	pkgIoc "SLGaming/back/pkg/ioc"
 	"SLGaming/back/services/user/internal/helper"
@@ -51,6 +52,9 @@ type orderCompletedEventPayload struct {
 	CompanionID uint64 `json:"companion_id"`
 	Amount      int64  `json:"amount"`
 	BizOrderID  string `json:"biz_order_id"`
+	GameName    string `json:"game_name"`
+	// 下单时快照的平台抽成百分比，快照上线前创建的订单没有该字段
+	CommissionPercent *int32 `json:"commission_percent,omitempty"`
 }
 
 // orderPaymentPendingEventPayload 订单支付待处理事件负载（与订单服务中构造的 payload 对应）
@@ -197,11 +201,22 @@ func handleOrderCompleted(ctx context.Context, svcCtx *svc.ServiceContext, msg *
 		return nil
 	}
 
+	// 按下单时快照的抽成比例扣除平台抽成；快照上线前的订单下单时未约定抽成，不抽成
+	var percent int
+	if payload.CommissionPercent != nil {
+		percent = int(*payload.CommissionPercent)
+	}
+	if percent < 0 || percent >= 100 {
+		percent = 0
+	}
+	commission := payload.Amount * int64(percent) / 100
+	payout := payload.Amount - commission
+
 	// 使用充值逻辑给陪玩加钱（Amount 为正数，BizOrderID 用于幂等控制）
 	l := logic.NewRechargeLogic(ctx, svcCtx)
 	_, err := l.Recharge(&user.RechargeRequest{
 		UserId:     payload.CompanionID,
-		Amount:     payload.Amount,
+		Amount:     payout,
 		BizOrderId: payload.BizOrderID,
 		Remark:     "order completed payment",
 	})
@@ -209,16 +224,36 @@ func handleOrderCompleted(ctx context.Context, svcCtx *svc.ServiceContext, msg *
 		helper.LogError(logger, helper.OpMQConsumer, "recharge wallet failed", err, map[string]interface{}{
 			"order_no":     payload.OrderNo,
 			"companion_id": payload.CompanionID,
-			"amount":       payload.Amount,
+			"amount":       payout,
 		})
 		return err
 	}
 
+	// 记录订单完成事件，计入周期榜/游戏榜的接单排名（按订单幂等，失败时重试消息）
+	if payload.OrderID > 0 {
+		event := &model.CompanionRankingEvent{
+			OrderID:     payload.OrderID,
+			Kind:        model.RankingEventCompleted,
+			CompanionID: payload.CompanionID,
+			GameName:    payload.GameName,
+			OccurredAt:  time.UnixMilli(msg.BornTimestamp),
+		}
+		if err := helper.RecordRankingEvent(ctx, svcCtx, logger, event); err != nil {
+			helper.LogError(logger, helper.OpMQConsumer, "record completed ranking event failed", err, map[string]interface{}{
+				"order_no":     payload.OrderNo,
+				"companion_id": payload.CompanionID,
+			})
+			return err
+		}
+	}
+
 	helper.LogSuccess(logger, helper.OpMQConsumer, map[string]interface{}{
 		"event":        "order_completed",
 		"order_no":     payload.OrderNo,
 		"companion_id": payload.CompanionID,
 		"amount":       payload.Amount,
+		"commission":   commission,
+		"payout":       payout,
 	})
 	return nil
 }
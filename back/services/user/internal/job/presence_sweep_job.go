@@ -0,0 +1,144 @@
+package job
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"SLGaming/back/pkg/lock"
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/metrics"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+const (
+	presenceJobLockKey        = "companion:presence:sweep"
+	defaultPresenceSweepEvery = 30 * time.Second
+	defaultPresenceBatchSize  = 200
+)
+
+var errPresenceUnavailable = errors.New("presence store unavailable")
+
+// StartPresenceSweepJob 启动陪玩心跳超时扫描任务
+// 在线陪玩超过心跳有效期未发送心跳（或上线后从未发送心跳）时自动置为离线，并发布状态变更事件
+// 忙碌的陪玩处于订单中，由订单流程维护状态，不在扫描范围内
+// 多实例部署时通过分布式锁保证同一时刻只有一个实例在处理；未配置 Redis 时不启动
+func StartPresenceSweepJob(ctx context.Context, svcCtx *svc.ServiceContext) {
+	logger := logx.WithContext(ctx)
+
+	if svcCtx.Redis == nil {
+		helper.LogInfo(logger, helper.OpCompanionPresence, "presence sweep job disabled: redis not configured", nil)
+		return
+	}
+
+	interval := svcCtx.Config().Presence.SweepInterval
+	if interval <= 0 {
+		interval = defaultPresenceSweepEvery
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		helper.LogInfo(logger, helper.OpCompanionPresence, "presence sweep job started", map[string]interface{}{
+			"interval": interval.String(),
+			"ttl":      helper.PresenceTTL(svcCtx).String(),
+		})
+
+		for {
+			select {
+			case <-ctx.Done():
+				helper.LogInfo(logger, helper.OpCompanionPresence, "presence sweep job stopped", nil)
+				return
+			case <-ticker.C:
+				runPresenceSweepOnce(ctx, svcCtx, logger, interval)
+			}
+		}
+	}()
+}
+
+// runPresenceSweepOnce 执行一轮扫描
+func runPresenceSweepOnce(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, interval time.Duration) {
+	if svcCtx.DistributedLock != nil {
+		handle, err := svcCtx.DistributedLock.TryLock(ctx, presenceJobLockKey, &lock.LockOptions{
+			TTL:           interval,
+			RetryInterval: 100 * time.Millisecond,
+		})
+		if err != nil {
+			helper.LogError(logger, helper.OpCompanionPresence, "acquire job lock failed", err, nil)
+			return
+		}
+		if handle == nil {
+			// 其它实例正在处理
+			return
+		}
+		defer func() { _ = handle.Unlock(ctx) }()
+	}
+
+	sweepStalePresence(ctx, svcCtx, logger)
+}
+
+// sweepStalePresence 分批检查在线陪玩的心跳，将心跳已过期的置为离线
+// 只检查状态更新时间早于一个有效期的陪玩，给刚上线的陪玩留出发送第一次心跳的时间
+func sweepStalePresence(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger) {
+	batchSize := svcCtx.Config().Presence.SweepBatchSize
+	if batchSize <= 0 {
+		batchSize = defaultPresenceBatchSize
+	}
+	cutoff := time.Now().Add(-helper.PresenceTTL(svcCtx))
+	db := svcCtx.DB().WithContext(ctx)
+
+	var offline []uint64
+	var profiles []model.CompanionProfile
+	result := db.Select("id, user_id").
+		Where("status = ? AND updated_at <= ?", model.CompanionStatusOnline, cutoff).
+		FindInBatches(&profiles, batchSize, func(tx *gorm.DB, batch int) error {
+			ids := make([]uint64, 0, len(profiles))
+			for i := range profiles {
+				ids = append(ids, profiles[i].UserID)
+			}
+			live, ok := helper.LivePresence(ctx, svcCtx, ids)
+			if !ok {
+				// Redis 异常时无法判断心跳，本轮不做处理，避免误将所有陪玩置为离线
+				return errPresenceUnavailable
+			}
+			for _, id := range ids {
+				if live[id] {
+					continue
+				}
+				// 条件更新：期间陪玩可能已手动切换状态
+				res := db.Model(&model.CompanionProfile{}).
+					Where("user_id = ? AND status = ?", id, model.CompanionStatusOnline).
+					Update("status", model.CompanionStatusOffline)
+				if res.Error != nil {
+					helper.LogError(logger, helper.OpCompanionPresence, "mark companion offline failed", res.Error, map[string]interface{}{
+						"user_id": id,
+					})
+					continue
+				}
+				if res.RowsAffected > 0 {
+					offline = append(offline, id)
+				}
+			}
+			return nil
+		})
+	if result.Error != nil {
+		helper.LogError(logger, helper.OpCompanionPresence, "sweep stale presence failed", result.Error, nil)
+	}
+
+	if len(offline) == 0 {
+		return
+	}
+	helper.InvalidateCompanionList(svcCtx, logger)
+	for _, id := range offline {
+		helper.PublishCompanionStatusChanged(ctx, svcCtx, logger, id, model.CompanionStatusOnline, model.CompanionStatusOffline, helper.PresenceReasonTimeout)
+	}
+	metrics.CompanionPresenceTotal.WithLabelValues("timeout", "success").Add(float64(len(offline)))
+	helper.LogInfo(logger, helper.OpCompanionPresence, "stale companions marked offline", map[string]interface{}{
+		"count": len(offline),
+	})
+}
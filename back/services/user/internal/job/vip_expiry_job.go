@@ -0,0 +1,216 @@
+package job
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"SLGaming/back/pkg/lock"
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/metrics"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"gorm.io/gorm"
+)
+
+const (
+	vipJobLockKey       = "vip:expiry:job"
+	defaultVipScanEvery = time.Minute
+	defaultVipBatchSize = 200
+)
+
+// StartVipExpiryJob 启动会员到期处理定时任务
+// 1. 到期前 RenewAhead 内且开启自动续费的订阅：从钱包扣款续费
+// 2. 已到期的订阅：标记过期并回收用户表上的会员徽章
+// 多实例部署时通过分布式锁保证同一时刻只有一个实例在处理
+func StartVipExpiryJob(ctx context.Context, svcCtx *svc.ServiceContext) {
+	logger := logx.WithContext(ctx)
+
+	interval := svcCtx.Config().Vip.ScanInterval
+	if interval <= 0 {
+		interval = defaultVipScanEvery
+	}
+
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		helper.LogInfo(logger, helper.OpVipJob, "vip expiry job started", map[string]interface{}{
+			"interval": interval.String(),
+		})
+
+		for {
+			select {
+			case <-ctx.Done():
+				helper.LogInfo(logger, helper.OpVipJob, "vip expiry job stopped", nil)
+				return
+			case <-ticker.C:
+				runVipExpiryOnce(ctx, svcCtx, logger, interval)
+			}
+		}
+	}()
+}
+
+// runVipExpiryOnce 执行一轮续费与过期处理
+func runVipExpiryOnce(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, interval time.Duration) {
+	if svcCtx.DistributedLock != nil {
+		handle, err := svcCtx.DistributedLock.TryLock(ctx, vipJobLockKey, &lock.LockOptions{
+			TTL:           interval,
+			RetryInterval: 100 * time.Millisecond,
+		})
+		if err != nil {
+			helper.LogError(logger, helper.OpVipJob, "acquire job lock failed", err, nil)
+			return
+		}
+		if handle == nil {
+			// 其它实例正在处理
+			return
+		}
+		defer func() { _ = handle.Unlock(ctx) }()
+	}
+
+	renewVipSubscriptions(ctx, svcCtx, logger)
+	expireVipSubscriptions(ctx, svcCtx, logger)
+}
+
+// renewVipSubscriptions 为即将到期且开启自动续费的订阅扣款续费
+func renewVipSubscriptions(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger) {
+	cfg := svcCtx.Config().Vip
+	batchSize := cfg.ScanBatchSize
+	if batchSize <= 0 {
+		batchSize = defaultVipBatchSize
+	}
+
+	deadline := time.Now().Add(cfg.RenewAhead)
+	var subs []model.VipSubscription
+	if err := svcCtx.DB().WithContext(ctx).
+		Where("status = ? AND auto_renew = ? AND end_at <= ?", model.VipStatusActive, true, deadline).
+		Order("end_at asc").
+		Limit(batchSize).
+		Find(&subs).Error; err != nil {
+		helper.LogError(logger, helper.OpVipJob, "query renewable subscriptions failed", err, nil)
+		return
+	}
+
+	for i := range subs {
+		sub := &subs[i]
+		plan, ok := helper.FindVipPlan(cfg, sub.PlanCode)
+		if !ok {
+			// 套餐已下线，关闭自动续费，等待自然过期
+			disableVipAutoRenew(ctx, svcCtx, logger, sub, "plan not found")
+			continue
+		}
+
+		// 以“订阅ID + 本轮到期时间”作为业务单号，保证每个周期最多扣款一次
+		bizOrderID := fmt.Sprintf("VIPR%d_%d", sub.ID, sub.EndAt.Unix())
+		_, _, err := helper.PurchaseVip(ctx, svcCtx, &helper.VipPurchaseRequest{
+			UserID:     sub.UserID,
+			Plan:       plan,
+			AutoRenew:  true,
+			BizOrderID: bizOrderID,
+			Logger:     logger,
+		})
+		if err != nil {
+			metrics.VipSubscribeTotal.WithLabelValues("error", "renew").Inc()
+			helper.LogWarning(logger, helper.OpVipJob, "auto renew failed", map[string]interface{}{
+				"user_id":      sub.UserID,
+				"plan_code":    sub.PlanCode,
+				"biz_order_id": bizOrderID,
+				"error":        err.Error(),
+			})
+			// 已到期仍续费失败（例如余额不足）：关闭自动续费，交由过期流程处理
+			if !sub.EndAt.After(time.Now()) {
+				disableVipAutoRenew(ctx, svcCtx, logger, sub, err.Error())
+			}
+			continue
+		}
+
+		metrics.VipSubscribeTotal.WithLabelValues("success", "renew").Inc()
+		helper.LogSuccess(logger, helper.OpVipJob, map[string]interface{}{
+			"action":       "renew",
+			"user_id":      sub.UserID,
+			"plan_code":    sub.PlanCode,
+			"biz_order_id": bizOrderID,
+		})
+	}
+}
+
+// expireVipSubscriptions 将已到期的订阅标记为过期，并回收用户表上的会员字段
+func expireVipSubscriptions(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger) {
+	batchSize := svcCtx.Config().Vip.ScanBatchSize
+	if batchSize <= 0 {
+		batchSize = defaultVipBatchSize
+	}
+
+	now := time.Now()
+	var subs []model.VipSubscription
+	if err := svcCtx.DB().WithContext(ctx).
+		Where("status = ? AND end_at <= ?", model.VipStatusActive, now).
+		Order("end_at asc").
+		Limit(batchSize).
+		Find(&subs).Error; err != nil {
+		helper.LogError(logger, helper.OpVipJob, "query expired subscriptions failed", err, nil)
+		return
+	}
+
+	for i := range subs {
+		sub := &subs[i]
+		var affected int64
+		err := svcCtx.DB().WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			// 条件更新：避免覆盖并发续费（续费会把 end_at 推后）
+			res := tx.Model(&model.VipSubscription{}).
+				Where("id = ? AND status = ? AND end_at <= ?", sub.ID, model.VipStatusActive, now).
+				Updates(map[string]interface{}{
+					"status":     model.VipStatusExpired,
+					"auto_renew": false,
+				})
+			if res.Error != nil {
+				return res.Error
+			}
+			affected = res.RowsAffected
+			if affected == 0 {
+				return nil
+			}
+			return tx.Model(&model.User{}).Where("id = ?", sub.UserID).Updates(map[string]interface{}{
+				"vip_level": 0,
+				"vip_badge": "",
+			}).Error
+		})
+		if err != nil {
+			helper.LogError(logger, helper.OpVipJob, "expire subscription failed", err, map[string]interface{}{
+				"user_id": sub.UserID,
+			})
+			continue
+		}
+		if affected == 0 {
+			continue
+		}
+
+		helper.InvalidateVipCache(svcCtx, logger, sub.UserID)
+		metrics.VipExpiredTotal.Inc()
+		helper.LogInfo(logger, helper.OpVipJob, "vip subscription expired", map[string]interface{}{
+			"user_id":   sub.UserID,
+			"plan_code": sub.PlanCode,
+			"end_at":    sub.EndAt,
+		})
+	}
+}
+
+// disableVipAutoRenew 关闭自动续费
+func disableVipAutoRenew(ctx context.Context, svcCtx *svc.ServiceContext, logger logx.Logger, sub *model.VipSubscription, reason string) {
+	if err := svcCtx.DB().WithContext(ctx).Model(&model.VipSubscription{}).
+		Where("id = ?", sub.ID).
+		Update("auto_renew", false).Error; err != nil {
+		helper.LogError(logger, helper.OpVipJob, "disable auto renew failed", err, map[string]interface{}{
+			"user_id": sub.UserID,
+		})
+		return
+	}
+	helper.InvalidateVipCache(svcCtx, logger, sub.UserID)
+	helper.LogInfo(logger, helper.OpVipJob, "auto renew disabled", map[string]interface{}{
+		"user_id": sub.UserID,
+		"reason":  reason,
+	})
+}
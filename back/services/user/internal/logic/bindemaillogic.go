@@ -0,0 +1,90 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"SLGaming/back/pkg/verifyticket"
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type BindEmailLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBindEmailLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BindEmailLogic {
+	return &BindEmailLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// BindEmail 绑定（或更换）邮箱，需要持有该邮箱 bind_email 用途的验证码票据
+// 绑定后邮箱可用于验证码登录和找回密码
+func (l *BindEmailLogic) BindEmail(in *user.BindEmailRequest) (*user.BindEmailResponse, error) {
+	userID := in.GetUserId()
+	if userID == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	email, ok := helper.NormalizeEmail(in.GetEmail())
+	if !ok {
+		return nil, status.Error(codes.InvalidArgument, "invalid email")
+	}
+
+	db := l.svcCtx.DB().WithContext(l.ctx)
+	var u model.User
+	if err := db.Where("id = ?", userID).First(&u).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if u.Email != nil && *u.Email == email {
+		return nil, status.Error(codes.InvalidArgument, "email already bound")
+	}
+
+	var count int64
+	if err := db.Model(&model.User{}).Where("email = ? AND id <> ?", email, u.ID).Count(&count).Error; err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if count > 0 {
+		return nil, status.Error(codes.AlreadyExists, "email already used")
+	}
+
+	// 票据证明调用方能收到该邮箱的验证码
+	if err := helper.ConsumeVerifyTicket(l.ctx, l.svcCtx, in.GetVerifyTicket(), email, verifyticket.PurposeBindEmail); err != nil {
+		return nil, err
+	}
+
+	// 并发绑定同一邮箱时由唯一索引兜底
+	if err := db.Model(&u).Update("email", email).Error; err != nil {
+		helper.LogError(l.Logger, helper.OpBindEmail, "update email failed", err, map[string]interface{}{
+			"user_id": userID,
+		})
+		return nil, status.Error(codes.Internal, "bind email failed")
+	}
+	u.Email = &email
+
+	if l.svcCtx.Redis != nil {
+		if _, err := l.svcCtx.Redis.DelCtx(l.ctx, GetUserCacheKey(int64(userID))); err != nil {
+			l.Logger.Errorf("delete user cache failed: %v", err)
+		}
+	}
+
+	helper.LogInfo(l.Logger, helper.OpBindEmail, "email bound", map[string]interface{}{
+		"user_id": userID,
+	})
+
+	return &user.BindEmailResponse{User: helper.ToUserInfo(&u)}, nil
+}
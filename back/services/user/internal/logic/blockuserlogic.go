@@ -0,0 +1,121 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/metrics"
+	"SLGaming/back/services/user/internal/model"
+	userMQ "SLGaming/back/services/user/internal/mq"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/apache/rocketmq-client-go/v2/primitive"
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm/clause"
+)
+
+type BlockUserLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewBlockUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *BlockUserLogic {
+	return &BlockUserLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// BlockUser 拉黑用户：记录拉黑关系后通过关注事件消费者解除双方的关注关系
+func (l *BlockUserLogic) BlockUser(in *user.BlockUserRequest) (*user.BlockUserResponse, error) {
+	// 1. 验证参数
+	if in.OperatorId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "operator_id is required")
+	}
+	if in.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if in.OperatorId == in.UserId {
+		metrics.BlockTotal.WithLabelValues("error", "block").Inc()
+		return nil, status.Error(codes.InvalidArgument, "cannot block yourself")
+	}
+
+	db := l.svcCtx.DB().WithContext(l.ctx)
+
+	// 2. 验证目标用户存在
+	var count int64
+	if err := db.Model(&model.User{}).Where("id = ?", in.UserId).Count(&count).Error; err != nil {
+		l.Errorf("check block target failed: %v", err)
+		metrics.BlockTotal.WithLabelValues("error", "block").Inc()
+		return nil, status.Error(codes.Internal, "check target user failed")
+	}
+	if count == 0 {
+		metrics.BlockTotal.WithLabelValues("not_found", "block").Inc()
+		return nil, status.Error(codes.NotFound, "target user not found")
+	}
+
+	// 3. 创建拉黑关系（唯一索引保证幂等）
+	res := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&model.BlockRelation{
+		BlockerID: in.OperatorId,
+		BlockedID: in.UserId,
+	})
+	if res.Error != nil {
+		l.Errorf("create block relation failed: %v", res.Error)
+		metrics.BlockTotal.WithLabelValues("error", "block").Inc()
+		return nil, status.Error(codes.Internal, "create block relation failed")
+	}
+	if res.RowsAffected == 0 {
+		metrics.BlockTotal.WithLabelValues("duplicate", "block").Inc()
+		return nil, status.Error(codes.AlreadyExists, "you have already blocked this user")
+	}
+
+	// 4. 发送拉黑事件，由关注事件消费者解除双方的关注关系
+	// 降级策略：如果MQ发送失败，直接同步解除关注关系
+	if !l.publishBlockEvent(in.OperatorId, in.UserId) {
+		l.Infof("mq send failed or producer not available, fallback to direct unfollow for block: blocker_id=%d, blocked_id=%d", in.OperatorId, in.UserId)
+		if err := helper.RemoveFollowsBetween(l.ctx, l.svcCtx, l.Logger, in.OperatorId, in.UserId); err != nil {
+			helper.LogError(l.Logger, helper.OpBlockUser, "fallback remove follows failed", err, map[string]interface{}{
+				"blocker_id": in.OperatorId,
+				"blocked_id": in.UserId,
+			})
+		}
+	}
+
+	helper.LogSuccess(l.Logger, helper.OpBlockUser, map[string]interface{}{
+		"blocker_id": in.OperatorId,
+		"blocked_id": in.UserId,
+	})
+	metrics.BlockTotal.WithLabelValues("success", "block").Inc()
+
+	return &user.BlockUserResponse{
+		Success: true,
+		Message: "block user success",
+	}, nil
+}
+
+// publishBlockEvent 发送拉黑事件，返回是否发送成功
+func (l *BlockUserLogic) publishBlockEvent(blockerID, blockedID uint64) bool {
+	if l.svcCtx.EventProducer == nil {
+		return false
+	}
+	payloadJSON, err := json.Marshal(userMQ.BlockUserPayload{
+		BlockerID: blockerID,
+		BlockedID: blockedID,
+	})
+	if err != nil {
+		return false
+	}
+	msg := primitive.NewMessage(userMQ.FollowEventTopic(), payloadJSON)
+	msg.WithTag(userMQ.EventTypeBlockUser())
+	if _, err := l.svcCtx.EventProducer.SendSync(l.ctx, msg); err != nil {
+		l.Errorf("send block user event failed: %v, will fallback to direct unfollow", err)
+		return false
+	}
+	return true
+}
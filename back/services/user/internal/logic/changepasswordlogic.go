@@ -5,6 +5,7 @@ import (
 	"errors"
 	"strings"
 
+	"SLGaming/back/pkg/verifyticket"
 	"SLGaming/back/services/user/internal/helper"
 	"SLGaming/back/services/user/internal/model"
 	"SLGaming/back/services/user/internal/svc"
@@ -51,6 +52,11 @@ func (l *ChangePasswordLogic) ChangePassword(in *user.ChangePasswordRequest) (*u
 		return nil, status.Error(codes.InvalidArgument, "old_phone mismatch")
 	}
 
+	// 校验并消费验证码票据（purpose=change_password）
+	if err := helper.ConsumeVerifyTicket(l.ctx, l.svcCtx, in.GetVerifyTicket(), oldPhone, verifyticket.PurposeChangePassword); err != nil {
+		return nil, err
+	}
+
 	hashed, err := helper.HashPassword(newPassword)
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
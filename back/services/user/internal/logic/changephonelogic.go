@@ -5,6 +5,8 @@ import (
 	"errors"
 	"strings"
 
+	"SLGaming/back/pkg/verifyticket"
+	"SLGaming/back/services/user/internal/helper"
 	"SLGaming/back/services/user/internal/model"
 	"SLGaming/back/services/user/internal/svc"
 	"SLGaming/back/services/user/user"
@@ -53,6 +55,14 @@ func (l *ChangePhoneLogic) ChangePhone(in *user.ChangePhoneRequest) (*user.Chang
 		return nil, status.Error(codes.InvalidArgument, "old_phone mismatch")
 	}
 
+	// 原手机号与新手机号都必须持有对应用途的验证码票据，两张都有效才一起消费，避免新手机号票据无效时白白作废原手机号票据
+	if err := helper.ConsumeVerifyTickets(l.ctx, l.svcCtx,
+		verifyticket.Spec{Ticket: in.GetOldPhoneTicket(), Target: oldPhone, Purpose: verifyticket.PurposeChangePhone},
+		verifyticket.Spec{Ticket: in.GetNewPhoneTicket(), Target: newPhone, Purpose: verifyticket.PurposeChangePhoneNew},
+	); err != nil {
+		return nil, err
+	}
+
 	var count int64
 	if err := db.Model(&model.User{}).Where("phone = ? AND id <> ?", newPhone, u.ID).Count(&count).Error; err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -0,0 +1,78 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/metrics"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type CompanionHeartbeatLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCompanionHeartbeatLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CompanionHeartbeatLogic {
+	return &CompanionHeartbeatLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// CompanionHeartbeat 陪玩心跳：在线/忙碌时刷新心跳有效期；离线状态下只返回当前状态，不会自动上线
+func (l *CompanionHeartbeatLogic) CompanionHeartbeat(in *user.CompanionHeartbeatRequest) (*user.CompanionHeartbeatResponse, error) {
+	userID := in.GetUserId()
+	if userID == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	ttl := helper.PresenceTTL(l.svcCtx)
+	resp := &user.CompanionHeartbeatResponse{
+		IntervalSeconds: int32(ttl.Seconds() / 3),
+	}
+
+	var profile model.CompanionProfile
+	if err := l.svcCtx.DB().WithContext(l.ctx).
+		Select("user_id, status").
+		Where("user_id = ?", userID).
+		First(&profile).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "companion profile not found")
+		}
+		metrics.CompanionPresenceTotal.WithLabelValues("heartbeat", "error").Inc()
+		helper.LogError(l.Logger, helper.OpCompanionPresence, "query companion status failed", err, map[string]interface{}{
+			"user_id": userID,
+		})
+		return nil, status.Error(codes.Internal, "query companion status failed")
+	}
+	resp.Status = int32(profile.Status)
+
+	if profile.Status == model.CompanionStatusOffline {
+		metrics.CompanionPresenceTotal.WithLabelValues("heartbeat", "offline").Inc()
+		return resp, nil
+	}
+
+	if err := helper.TouchPresence(l.ctx, l.svcCtx, userID, profile.Status); err != nil {
+		metrics.CompanionPresenceTotal.WithLabelValues("heartbeat", "error").Inc()
+		helper.LogError(l.Logger, helper.OpCompanionPresence, "record heartbeat failed", err, map[string]interface{}{
+			"user_id": userID,
+		})
+		return nil, status.Error(codes.Internal, "record heartbeat failed")
+	}
+	resp.ExpireAt = time.Now().Add(ttl).Unix()
+
+	metrics.CompanionPresenceTotal.WithLabelValues("heartbeat", "success").Inc()
+	return resp, nil
+}
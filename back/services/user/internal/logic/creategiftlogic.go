@@ -0,0 +1,68 @@
+package logic
+
+import (
+	"context"
+	"strings"
+
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type CreateGiftLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewCreateGiftLogic(ctx context.Context, svcCtx *svc.ServiceContext) *CreateGiftLogic {
+	return &CreateGiftLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+func (l *CreateGiftLogic) CreateGift(in *user.CreateGiftRequest) (*user.CreateGiftResponse, error) {
+	name := strings.TrimSpace(in.GetName())
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if in.GetPrice() <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "price must be positive")
+	}
+
+	db := l.svcCtx.DB().WithContext(l.ctx)
+
+	// 检查重名
+	var count int64
+	if err := db.Model(&model.Gift{}).Where("name = ?", name).Count(&count).Error; err != nil {
+		l.Errorf("check gift duplicate failed: %v", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if count > 0 {
+		return nil, status.Error(codes.AlreadyExists, "gift already exists")
+	}
+
+	gift := model.Gift{
+		Name:    name,
+		Price:   in.GetPrice(),
+		IconURL: strings.TrimSpace(in.GetIconUrl()),
+		Sort:    int(in.GetSort()),
+		Enabled: true,
+	}
+	if err := db.Create(&gift).Error; err != nil {
+		l.Errorf("create gift failed: %v", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	helper.LogSuccess(l.Logger, helper.OpManageGift, map[string]interface{}{
+		"action":  "create",
+		"gift_id": gift.ID,
+		"name":    gift.Name,
+		"price":   gift.Price,
+	})
+
+	return &user.CreateGiftResponse{Gift: helper.ToGiftInfo(&gift)}, nil
+}
@@ -0,0 +1,53 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// filterBannedUsersMaxIDs 单次最多检查的用户数
+const filterBannedUsersMaxIDs = 200
+
+type FilterBannedUsersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewFilterBannedUsersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *FilterBannedUsersLogic {
+	return &FilterBannedUsersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// FilterBannedUsers 返回给定用户中处于封禁期内的用户ID
+func (l *FilterBannedUsersLogic) FilterBannedUsers(in *user.FilterBannedUsersRequest) (*user.FilterBannedUsersResponse, error) {
+	ids := in.GetUserIds()
+	if len(ids) == 0 {
+		return &user.FilterBannedUsersResponse{}, nil
+	}
+	if len(ids) > filterBannedUsersMaxIDs {
+		return nil, status.Error(codes.InvalidArgument, "too many user_ids")
+	}
+
+	var banned []uint64
+	err := l.svcCtx.DB().WithContext(l.ctx).Model(&model.User{}).
+		Where("id IN ? AND status = ?", ids, model.UserStatusBanned).
+		Where("status_until IS NULL OR status_until > ?", time.Now()).
+		Pluck("id", &banned).Error
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &user.FilterBannedUsersResponse{BannedUserIds: banned}, nil
+}
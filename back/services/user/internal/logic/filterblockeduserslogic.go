@@ -0,0 +1,51 @@
+package logic
+
+import (
+	"context"
+
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// filterBlockedUsersMaxIDs 单次最多检查的用户数
+const filterBlockedUsersMaxIDs = 200
+
+type FilterBlockedUsersLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewFilterBlockedUsersLogic(ctx context.Context, svcCtx *svc.ServiceContext) *FilterBlockedUsersLogic {
+	return &FilterBlockedUsersLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// FilterBlockedUsers 返回给定用户中与 user_id 存在拉黑关系（任一方向）的用户ID
+func (l *FilterBlockedUsersLogic) FilterBlockedUsers(in *user.FilterBlockedUsersRequest) (*user.FilterBlockedUsersResponse, error) {
+	if in.GetUserId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	ids := in.GetUserIds()
+	if len(ids) == 0 {
+		return &user.FilterBlockedUsersResponse{}, nil
+	}
+	if len(ids) > filterBlockedUsersMaxIDs {
+		return nil, status.Error(codes.InvalidArgument, "too many user_ids")
+	}
+
+	blocked, err := helper.BlockedAmong(l.ctx, l.svcCtx.DB(), in.GetUserId(), ids)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &user.FilterBlockedUsersResponse{BlockedUserIds: blocked}, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 
+	"SLGaming/back/services/user/internal/helper"
 	"SLGaming/back/services/user/internal/metrics"
 	"SLGaming/back/services/user/internal/model"
 	userMQ "SLGaming/back/services/user/internal/mq"
@@ -80,16 +81,18 @@ func (l *FollowUserLogic) FollowUser(in *user.FollowUserRequest) (*user.FollowUs
 		// 如果存在，需要查数据库确认（布隆过滤器有假阳性）
 	}
 
-	// 4. 合并查询：验证用户是否存在 + 是否已关注（减少数据库调用）
+	// 4. 合并查询：验证用户是否存在 + 是否已关注 + 是否存在拉黑关系（减少数据库调用）
 	var result struct {
 		UserExists bool
 		Followed   bool
+		Blocked    bool
 	}
 	if err := l.svcCtx.DB().Raw(`
 		SELECT 
 			EXISTS(SELECT 1 FROM users WHERE id = ?) as user_exists,
-			EXISTS(SELECT 1 FROM follow_relations WHERE follower_id = ? AND following_id = ?) as followed
-	`, in.UserId, in.OperatorId, in.UserId).Scan(&result).Error; err != nil {
+			EXISTS(SELECT 1 FROM follow_relations WHERE follower_id = ? AND following_id = ?) as followed,
+			EXISTS(SELECT 1 FROM block_relations WHERE (blocker_id = ? AND blocked_id = ?) OR (blocker_id = ? AND blocked_id = ?)) as blocked
+	`, in.UserId, in.OperatorId, in.UserId, in.OperatorId, in.UserId, in.UserId, in.OperatorId).Scan(&result).Error; err != nil {
 		l.Errorf("check user and follow status failed: %v", err)
 		metrics.FollowTotal.WithLabelValues("error", "follow").Inc()
 		return nil, status.Error(codes.Internal, "check follow status failed")
@@ -100,6 +103,13 @@ func (l *FollowUserLogic) FollowUser(in *user.FollowUserRequest) (*user.FollowUs
 		return nil, status.Error(codes.NotFound, "target user not found")
 	}
 
+	// 任一方拉黑另一方后都不能关注
+	if result.Blocked {
+		metrics.FollowTotal.WithLabelValues("blocked", "follow").Inc()
+		metrics.BlockRejectTotal.WithLabelValues(helper.BlockActionFollow).Inc()
+		return nil, status.Error(codes.PermissionDenied, "cannot follow this user")
+	}
+
 	if result.Followed {
 		metrics.FollowTotal.WithLabelValues("duplicate", "follow").Inc()
 		return nil, status.Error(codes.AlreadyExists, "you have already followed this user")
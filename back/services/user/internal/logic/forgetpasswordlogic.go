@@ -5,6 +5,7 @@ import (
 	"errors"
 	"strings"
 
+	"SLGaming/back/pkg/verifyticket"
 	"SLGaming/back/services/user/internal/helper"
 	"SLGaming/back/services/user/internal/model"
 	"SLGaming/back/services/user/internal/svc"
@@ -34,10 +35,30 @@ func (l *ForgetPasswordLogic) ForgetPassword(in *user.ForgetPasswordRequest) (*u
 	phone := strings.TrimSpace(in.GetPhone())
 	newPassword := strings.TrimSpace(in.GetPassword())
 
+	// 通过邮箱验证码找回密码
+	if phone == "" && strings.TrimSpace(in.GetEmail()) != "" {
+		email, ok := helper.NormalizeEmail(in.GetEmail())
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "invalid email")
+		}
+		if newPassword == "" {
+			return nil, status.Error(codes.InvalidArgument, "password is required")
+		}
+		if err := helper.ConsumeVerifyTicket(l.ctx, l.svcCtx, in.GetVerifyTicket(), email, verifyticket.PurposeForgetPassword); err != nil {
+			return nil, err
+		}
+		return l.resetPassword(l.svcCtx.DB().WithContext(l.ctx).Where("email = ?", email), newPassword)
+	}
+
 	if phone == "" || newPassword == "" {
 		return nil, status.Error(codes.InvalidArgument, "phone and password are required")
 	}
 
+	// 校验并消费验证码票据（purpose=forget_password）
+	if err := helper.ConsumeVerifyTicket(l.ctx, l.svcCtx, in.GetVerifyTicket(), phone, verifyticket.PurposeForgetPassword); err != nil {
+		return nil, err
+	}
+
 	// 步骤1：布隆过滤器快速检查手机号是否存在
 	// 如果布隆过滤器说"不存在"，那手机号一定不存在，直接返回（省去数据库查询）
 	if l.svcCtx.BloomFilter != nil {
@@ -53,9 +74,13 @@ func (l *ForgetPasswordLogic) ForgetPassword(in *user.ForgetPasswordRequest) (*u
 		// 如果存在，需要查数据库确认（布隆过滤器有假阳性）
 	}
 
-	db := l.svcCtx.DB().WithContext(l.ctx)
+	return l.resetPassword(l.svcCtx.DB().WithContext(l.ctx).Where("phone = ?", phone), newPassword)
+}
+
+// resetPassword 按查询条件找到用户并重置密码
+func (l *ForgetPasswordLogic) resetPassword(query *gorm.DB, newPassword string) (*user.ForgetPasswordResponse, error) {
 	var u model.User
-	if err := db.Where("phone = ?", phone).First(&u).Error; err != nil {
+	if err := query.First(&u).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, status.Error(codes.NotFound, "user not found")
 		}
@@ -67,7 +92,7 @@ func (l *ForgetPasswordLogic) ForgetPassword(in *user.ForgetPasswordRequest) (*u
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	if err := db.Model(&u).Update("password", hashed).Error; err != nil {
+	if err := l.svcCtx.DB().WithContext(l.ctx).Model(&u).Update("password", hashed).Error; err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
@@ -2,10 +2,15 @@ package logic
 
 import (
 	"context"
+	"crypto/sha1"
+	"encoding/hex"
 	"encoding/json"
 	"strings"
+	"time"
+	"unicode/utf8"
 
 	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/metrics"
 	"SLGaming/back/services/user/internal/model"
 	"SLGaming/back/services/user/internal/svc"
 	"SLGaming/back/services/user/user"
@@ -13,6 +18,7 @@ import (
 	"github.com/zeromicro/go-zero/core/logx"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"gorm.io/gorm/clause"
 )
 
 type GetCompanionListLogic struct {
@@ -29,42 +35,186 @@ func NewGetCompanionListLogic(ctx context.Context, svcCtx *svc.ServiceContext) *
 	}
 }
 
+// 列表排序方式
+const (
+	companionSortRating     = "rating"     // 贝叶斯评分（默认）
+	companionSortPriceAsc   = "price_asc"  // 价格从低到高
+	companionSortPriceDesc  = "price_desc" // 价格从高到低
+	companionSortPopularity = "popularity" // 接单数
+	companionSortNewest     = "newest"     // 入驻时间
+)
+
+const (
+	// 只缓存前几页且不带关键词的筛选组合（热门组合），关键词搜索组合太分散，直接查库
+	companionListCachePages = 3
+	// 关键词最大长度（字符）
+	companionKeywordMaxLen = 32
+	// 年龄筛选上限
+	companionMaxAge = 120
+)
+
+// companionListFilter 规范化后的筛选条件，同时作为缓存键的摘要来源
+type companionListFilter struct {
+	GameSkill  string  `json:"g"`
+	MinPrice   int64   `json:"pmin"`
+	MaxPrice   int64   `json:"pmax"`
+	Status     int     `json:"s"`
+	IsVerified bool    `json:"v"`
+	Gender     int     `json:"sex"`
+	MinAge     int     `json:"amin"`
+	MaxAge     int     `json:"amax"`
+	MinRating  float64 `json:"r"`
+	Keyword    string  `json:"kw"`
+	SortBy     string  `json:"sort"`
+	Page       int     `json:"p"`
+	PageSize   int     `json:"ps"`
+}
+
 func (l *GetCompanionListLogic) GetCompanionList(in *user.GetCompanionListRequest) (*user.GetCompanionListResponse, error) {
+	filter, err := normalizeCompanionListFilter(in)
+	if err != nil {
+		return nil, err
+	}
+
+	// 与当前用户存在拉黑关系的陪玩不返回；这类用户的结果因人而异，不读写公共缓存
+	viewerID, err := l.blockViewer(in.GetViewerId())
+	if err != nil {
+		return nil, err
+	}
+
+	// 热门筛选组合优先读缓存；版本号在查库前读取，查询期间资料变更不会被写入新版本
+	cacheable := l.svcCtx.CompanionCache != nil && filter.Keyword == "" && filter.Page <= companionListCachePages && viewerID == 0
+	var cacheVersion int64
+	var cacheKey string
+	if cacheable {
+		cacheVersion, err = l.svcCtx.CompanionCache.ListVersion()
+		if err != nil {
+			l.Errorf("[GetCompanionList] read cache version failed: %v", err)
+			cacheable = false
+		} else {
+			cacheKey = filter.digest()
+			if cached, err := l.svcCtx.CompanionCache.GetList(cacheVersion, cacheKey); err == nil && cached != "" {
+				var resp user.GetCompanionListResponse
+				if err := json.Unmarshal([]byte(cached), &resp); err == nil {
+					metrics.CompanionListCacheTotal.WithLabelValues("hit").Inc()
+					resp.Companions = helper.DropStaleCompanions(l.ctx, l.svcCtx, resp.Companions)
+					return &resp, nil
+				}
+			}
+			metrics.CompanionListCacheTotal.WithLabelValues("miss").Inc()
+		}
+	}
+	if !cacheable {
+		metrics.CompanionListCacheTotal.WithLabelValues("skip").Inc()
+	}
+
+	resp, err := l.queryCompanionList(filter, viewerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if cacheable {
+		if data, err := json.Marshal(resp); err == nil {
+			if err := l.svcCtx.CompanionCache.SetList(cacheVersion, cacheKey, string(data)); err != nil {
+				l.Errorf("[GetCompanionList] set cache failed: %v", err)
+			}
+		}
+	}
+
+	// 按实时心跳过滤：扫描任务尚未处理的超时陪玩不返回（缓存中保存的是过滤前的结果）
+	resp.Companions = helper.DropStaleCompanions(l.ctx, l.svcCtx, resp.Companions)
+	return resp, nil
+}
+
+// blockViewer 当前用户存在拉黑关系时返回其ID，列表需按拉黑关系过滤；否则返回0
+func (l *GetCompanionListLogic) blockViewer(viewerID uint64) (uint64, error) {
+	if viewerID == 0 {
+		return 0, nil
+	}
+	has, err := helper.HasBlockRelations(l.ctx, l.svcCtx.DB(), viewerID)
+	if err != nil {
+		l.Errorf("[GetCompanionList] check block relations failed: %v", err)
+		return 0, status.Error(codes.Internal, err.Error())
+	}
+	if !has {
+		return 0, nil
+	}
+	return viewerID, nil
+}
+
+// queryCompanionList 按筛选条件查库；viewerID 非0时排除与其存在拉黑关系的陪玩
+func (l *GetCompanionListLogic) queryCompanionList(filter *companionListFilter, viewerID uint64) (*user.GetCompanionListResponse, error) {
 	db := l.svcCtx.DB().WithContext(l.ctx)
+	now := time.Now()
 
 	// 构建查询
 	query := db.Model(&model.CompanionProfile{}).
 		Joins("JOIN users ON companion_profiles.user_id = users.id").
 		Where("users.role = ?", model.RoleCompanion).
-		Where("users.deleted_at IS NULL")
-
-	// 状态筛选（默认只返回在线）
-	// 如果 status <= 0，表示未指定或无效值，使用默认值（在线）
-	statusFilter := int(in.GetStatus())
-	if statusFilter <= 0 {
-		statusFilter = model.CompanionStatusOnline // 默认只返回在线
+		Where("users.deleted_at IS NULL").
+		Scopes(model.NotBanned(now)) // 封禁中的陪玩不出现在列表中
+	if viewerID > 0 {
+		query = query.Scopes(helper.NotBlockedWith(db, viewerID, "companion_profiles.user_id"))
 	}
-	query = query.Where("companion_profiles.status = ?", statusFilter)
-	l.Infof("[GetCompanionList] status filter: %d", statusFilter)
 
-	// 价格筛选
-	if in.GetMinPrice() > 0 {
-		query = query.Where("companion_profiles.price_per_hour >= ?", in.GetMinPrice())
-	}
-	if in.GetMaxPrice() > 0 {
-		query = query.Where("companion_profiles.price_per_hour <= ?", in.GetMaxPrice())
+	// 状态筛选：未指定时返回在线和忙碌的陪玩（不返回离线）
+	if filter.Status > 0 {
+		query = query.Where("companion_profiles.status = ?", filter.Status)
+	} else {
+		query = query.Where("companion_profiles.status <> ?", model.CompanionStatusOffline)
 	}
 
 	// 认证筛选
-	if in.GetIsVerified() {
+	if filter.IsVerified {
 		query = query.Where("companion_profiles.is_verified = ?", true)
 	}
 
-	// 游戏技能筛选
-	if gameSkill := strings.TrimSpace(in.GetGameSkill()); gameSkill != "" {
-		l.Infof("[GetCompanionList] game skill filter: %s", gameSkill)
-		// 匹配单个游戏技能
-		query = query.Where("companion_profiles.game_skills = ?", gameSkill)
+	// 游戏技能筛选：匹配陪玩技能表中的任意一个游戏，价格按该游戏的价格筛选
+	if filter.GameSkill != "" {
+		sub := db.Table("companion_skills").
+			Select("1").
+			Joins("JOIN game_skills ON game_skills.id = companion_skills.game_skill_id AND game_skills.deleted_at IS NULL").
+			Where("companion_skills.companion_id = companion_profiles.user_id").
+			Where("game_skills.name = ?", filter.GameSkill)
+		if filter.MinPrice > 0 {
+			sub = sub.Where("companion_skills.price_per_hour >= ?", filter.MinPrice)
+		}
+		if filter.MaxPrice > 0 {
+			sub = sub.Where("companion_skills.price_per_hour <= ?", filter.MaxPrice)
+		}
+		query = query.Where("EXISTS (?)", sub)
+	} else {
+		// 未指定游戏时按最低价格筛选
+		if filter.MinPrice > 0 {
+			query = query.Where("companion_profiles.price_per_hour >= ?", filter.MinPrice)
+		}
+		if filter.MaxPrice > 0 {
+			query = query.Where("companion_profiles.price_per_hour <= ?", filter.MaxPrice)
+		}
+	}
+
+	// 性别筛选
+	if filter.Gender > 0 {
+		query = query.Where("users.gender = ?", filter.Gender)
+	}
+
+	// 年龄筛选：换算为生日区间，未设置生日的陪玩不参与年龄筛选
+	if filter.MinAge > 0 {
+		query = query.Where("users.birthday <= ?", now.AddDate(-filter.MinAge, 0, 0))
+	}
+	if filter.MaxAge > 0 {
+		query = query.Where("users.birthday > ?", now.AddDate(-(filter.MaxAge+1), 0, 0))
+	}
+
+	// 最低评分
+	if filter.MinRating > 0 {
+		query = query.Where("companion_profiles.rating >= ?", filter.MinRating)
+	}
+
+	// 关键词：匹配昵称或个人简介
+	if filter.Keyword != "" {
+		like := "%" + helper.EscapeLike(filter.Keyword) + "%"
+		query = query.Where("(users.nickname LIKE ? OR users.bio LIKE ?)", like, like)
 	}
 
 	// 获取总数
@@ -73,31 +223,29 @@ func (l *GetCompanionListLogic) GetCompanionList(in *user.GetCompanionListReques
 		l.Errorf("[GetCompanionList] count failed: %v", err)
 		return nil, status.Error(codes.Internal, err.Error())
 	}
-	l.Infof("[GetCompanionList] total count: %d", total)
 
-	// 规范化分页参数（默认每页20条）
-	pagination := helper.NormalizePaginationWithDefault(in.GetPage(), in.GetPageSize(), 20)
-	offset := (pagination.Page - 1) * pagination.PageSize
+	offset := (filter.Page - 1) * filter.PageSize
 
 	// 查询列表
 	var profiles []model.CompanionProfile
 	if err := query.Select("companion_profiles.*").
-		Order("companion_profiles.rating DESC, companion_profiles.total_orders DESC").
+		Order(companionListOrder(filter)).
 		Offset(offset).
-		Limit(pagination.PageSize).
+		Limit(filter.PageSize).
 		Find(&profiles).Error; err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
 	}
 
-	// 收集所有用户ID，批量查询用户的 bio 和 avatar_url
+	companions := make([]*user.CompanionInfo, 0, len(profiles))
 	if len(profiles) > 0 {
+		// 收集所有用户ID，批量查询用户的展示信息
 		userIDs := make([]uint64, 0, len(profiles))
 		for i := range profiles {
 			userIDs = append(userIDs, profiles[i].UserID)
 		}
 
 		var users []model.User
-		if err := db.Select("id, nickname, avatar_url, bio").Where("id IN ?", userIDs).Find(&users).Error; err != nil {
+		if err := db.Select("id, nickname, avatar_url, bio, gender, birthday").Where("id IN ?", userIDs).Find(&users).Error; err != nil {
 			l.Errorf("[GetCompanionList] query users failed: %v", err)
 			return nil, status.Error(codes.Internal, err.Error())
 		}
@@ -109,29 +257,118 @@ func (l *GetCompanionListLogic) GetCompanionList(in *user.GetCompanionListReques
 		}
 
 		// 转换为响应格式
-		companions := make([]*user.CompanionInfo, 0, len(profiles))
 		for i := range profiles {
 			u := userMap[profiles[i].UserID]
-			companions = append(companions, helper.ToCompanionInfoWithUser(&profiles[i], u))
+			info := helper.ToCompanionInfoWithUser(&profiles[i], u)
+			info.TierName = helper.CompanionTierName(l.svcCtx, profiles[i].Tier)
+			companions = append(companions, info)
+		}
+		if err := helper.AttachCompanionSkills(l.ctx, db, companions...); err != nil {
+			l.Errorf("[GetCompanionList] query companion skills failed: %v", err)
+			return nil, status.Error(codes.Internal, err.Error())
 		}
-
-		return &user.GetCompanionListResponse{
-			Companions: companions,
-			Total:      int32(total),
-			Page:       int32(pagination.Page),
-			PageSize:   int32(pagination.PageSize),
-		}, nil
 	}
 
-	// 如果没有数据，返回空列表
 	return &user.GetCompanionListResponse{
-		Companions: []*user.CompanionInfo{},
+		Companions: companions,
 		Total:      int32(total),
-		Page:       int32(pagination.Page),
-		PageSize:   int32(pagination.PageSize),
+		Page:       int32(filter.Page),
+		PageSize:   int32(filter.PageSize),
 	}, nil
 }
 
+// normalizeCompanionListFilter 校验并规范化筛选条件
+func normalizeCompanionListFilter(in *user.GetCompanionListRequest) (*companionListFilter, error) {
+	pagination := helper.NormalizePaginationWithDefault(in.GetPage(), in.GetPageSize(), 20)
+	f := &companionListFilter{
+		GameSkill:  strings.TrimSpace(in.GetGameSkill()),
+		MinPrice:   int64(in.GetMinPrice()),
+		MaxPrice:   int64(in.GetMaxPrice()),
+		IsVerified: in.GetIsVerified(),
+		Gender:     int(in.GetGender()),
+		MinAge:     int(in.GetMinAge()),
+		MaxAge:     int(in.GetMaxAge()),
+		MinRating:  in.GetMinRating(),
+		Keyword:    strings.TrimSpace(in.GetKeyword()),
+		SortBy:     strings.TrimSpace(in.GetSortBy()),
+		Page:       pagination.Page,
+		PageSize:   pagination.PageSize,
+	}
+
+	// 只看在线优先于 status
+	switch {
+	case in.GetOnlineOnly():
+		f.Status = model.CompanionStatusOnline
+	case in.GetStatus() == model.CompanionStatusOnline || in.GetStatus() == model.CompanionStatusBusy:
+		f.Status = int(in.GetStatus())
+	case in.GetStatus() > 0:
+		return nil, status.Error(codes.InvalidArgument, "invalid status")
+	}
+
+	if f.MinPrice < 0 || f.MaxPrice < 0 || (f.MaxPrice > 0 && f.MinPrice > f.MaxPrice) {
+		return nil, status.Error(codes.InvalidArgument, "invalid price range")
+	}
+	if f.Gender != 0 && f.Gender != model.GenderMale && f.Gender != model.GenderFemale {
+		return nil, status.Error(codes.InvalidArgument, "invalid gender")
+	}
+	if f.MinAge < 0 || f.MaxAge < 0 || f.MinAge > companionMaxAge || f.MaxAge > companionMaxAge ||
+		(f.MaxAge > 0 && f.MinAge > f.MaxAge) {
+		return nil, status.Error(codes.InvalidArgument, "invalid age range")
+	}
+	if f.MinRating < 0 || f.MinRating > 5 {
+		return nil, status.Error(codes.InvalidArgument, "invalid min rating")
+	}
+	if utf8.RuneCountInString(f.Keyword) > companionKeywordMaxLen {
+		return nil, status.Error(codes.InvalidArgument, "keyword too long")
+	}
+
+	switch f.SortBy {
+	case "":
+		f.SortBy = companionSortRating
+	case companionSortRating, companionSortPriceAsc, companionSortPriceDesc, companionSortPopularity, companionSortNewest:
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid sort_by")
+	}
+
+	return f, nil
+}
+
+// digest 筛选条件摘要，用作缓存键
+func (f *companionListFilter) digest() string {
+	data, _ := json.Marshal(f)
+	sum := sha1.Sum(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// companionListOrder 列表排序；指定游戏时价格排序按该游戏的价格，否则按起步价
+// 默认排序使用已叠加等级加权的 rank_score（走 idx_companion_status_rank）；每种排序都以 user_id 兜底，保证分页稳定
+func companionListOrder(f *companionListFilter) clause.OrderBy {
+	var sql string
+	var vars []interface{}
+	switch f.SortBy {
+	case companionSortPriceAsc, companionSortPriceDesc:
+		direction := "ASC"
+		if f.SortBy == companionSortPriceDesc {
+			direction = "DESC"
+		}
+		price := "companion_profiles.price_per_hour"
+		if f.GameSkill != "" {
+			price = "(SELECT MIN(cs.price_per_hour) FROM companion_skills cs " +
+				"JOIN game_skills gs ON gs.id = cs.game_skill_id AND gs.deleted_at IS NULL " +
+				"WHERE cs.companion_id = companion_profiles.user_id AND gs.name = ?)"
+			vars = append(vars, f.GameSkill)
+		}
+		sql = price + " " + direction + ", companion_profiles.rating_score DESC"
+	case companionSortPopularity:
+		sql = "companion_profiles.total_orders DESC, companion_profiles.rating_score DESC"
+	case companionSortNewest:
+		sql = "companion_profiles.created_at DESC"
+	default:
+		sql = "companion_profiles.rank_score DESC, companion_profiles.total_orders DESC"
+	}
+	return clause.OrderBy{Expression: clause.Expr{SQL: sql + ", companion_profiles.user_id DESC", Vars: vars, WithoutParentheses: true}}
+}
+
 // 辅助函数：检查游戏技能是否匹配（用于更精确的筛选）
 func matchGameSkills(gameSkillsJSON string, targetSkills []string) bool {
 	if len(targetSkills) == 0 {
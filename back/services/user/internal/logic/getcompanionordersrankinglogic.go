@@ -40,16 +40,36 @@ func (l *GetCompanionOrdersRankingLogic) GetCompanionOrdersRanking(in *user.GetC
 		return nil, status.Error(codes.FailedPrecondition, "redis not configured")
 	}
 
-	// 使用公共排名查询逻辑（ZSet只存前100名）
-	result, err := helper.QueryRankingWithProfiles(
-		l.ctx,
-		l.svcCtx,
-		l.Logger,
-		"ranking:orders",
-		in.GetPage(),
-		in.GetPageSize(),
-		&helper.OrdersRankingBuilder{},
-	)
+	window, err := helper.ParseRankingWindow(helper.RankingTypeOrders, in.GetPeriod(), in.GetGame())
+	if err != nil {
+		metrics.RankingQueryTotal.WithLabelValues("orders", "invalid").Inc()
+		return nil, err
+	}
+
+	var result *helper.RankingQueryResult
+	if window.IsGlobal() {
+		// 总榜：使用公共排名查询逻辑（ZSet只存前100名）
+		result, err = helper.QueryRankingWithProfiles(
+			l.ctx,
+			l.svcCtx,
+			l.Logger,
+			window.Key(time.Now()),
+			in.GetPage(),
+			in.GetPageSize(),
+			&helper.OrdersRankingBuilder{},
+		)
+	} else {
+		// 周期榜/游戏榜
+		result, err = helper.QueryRankingWindow(
+			l.ctx,
+			l.svcCtx,
+			l.Logger,
+			window,
+			in.GetPage(),
+			in.GetPageSize(),
+			&helper.OrdersRankingBuilder{},
+		)
+	}
 	if err != nil {
 		metrics.RankingQueryTotal.WithLabelValues("orders", "error").Inc()
 		return nil, err
@@ -0,0 +1,77 @@
+package logic
+
+import (
+	"context"
+
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// 单次最多查询的陪玩数
+const maxPresenceQueryIDs = 100
+
+type GetCompanionPresenceLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetCompanionPresenceLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCompanionPresenceLogic {
+	return &GetCompanionPresenceLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetCompanionPresence 批量查询陪玩的实时状态：数据库状态为在线但心跳已超时的按离线返回
+// 不存在陪玩资料的用户不出现在结果中
+func (l *GetCompanionPresenceLogic) GetCompanionPresence(in *user.GetCompanionPresenceRequest) (*user.GetCompanionPresenceResponse, error) {
+	ids := in.GetUserIds()
+	if len(ids) == 0 {
+		return &user.GetCompanionPresenceResponse{}, nil
+	}
+	if len(ids) > maxPresenceQueryIDs {
+		return nil, status.Error(codes.InvalidArgument, "too many user_ids")
+	}
+
+	var profiles []model.CompanionProfile
+	if err := l.svcCtx.DB().WithContext(l.ctx).
+		Select("user_id, status").
+		Where("user_id IN ?", ids).
+		Find(&profiles).Error; err != nil {
+		helper.LogError(l.Logger, helper.OpCompanionPresence, "query companion status failed", err, map[string]interface{}{
+			"count": len(ids),
+		})
+		return nil, status.Error(codes.Internal, "query companion status failed")
+	}
+
+	online := make([]uint64, 0, len(profiles))
+	for i := range profiles {
+		if profiles[i].Status == model.CompanionStatusOnline {
+			online = append(online, profiles[i].UserID)
+		}
+	}
+	live, ok := helper.LivePresence(l.ctx, l.svcCtx, online)
+
+	presences := make([]*user.CompanionPresence, 0, len(profiles))
+	for i := range profiles {
+		p := &profiles[i]
+		st := p.Status
+		if ok && st == model.CompanionStatusOnline && !live[p.UserID] {
+			st = model.CompanionStatusOffline
+		}
+		presences = append(presences, &user.CompanionPresence{
+			UserId: p.UserID,
+			Status: int32(st),
+		})
+	}
+
+	return &user.GetCompanionPresenceResponse{Presences: presences}, nil
+}
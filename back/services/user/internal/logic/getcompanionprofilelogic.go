@@ -90,7 +90,16 @@ func (l *GetCompanionProfileLogic) GetCompanionProfile(in *user.GetCompanionProf
 		}
 	}
 
+	info := helper.ToCompanionInfoWithUser(&profile, &u)
+	info.TierName = helper.CompanionTierName(l.svcCtx, profile.Tier)
+	if err := helper.AttachCompanionSkills(l.ctx, db, info); err != nil {
+		l.Errorf("load companion skills failed: user_id=%d, error=%v", userID, err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
 	return &user.GetCompanionProfileResponse{
-		Profile: helper.ToCompanionInfoWithUser(&profile, &u),
+		Profile:           info,
+		CommissionPercent: int32(helper.TierCommissionPercent(l.svcCtx, profile.Tier)),
+		MaxPricePerHour:   helper.TierMaxPricePerHour(l.svcCtx, profile.Tier),
 	}, nil
 }
@@ -41,16 +41,36 @@ func (l *GetCompanionRatingRankingLogic) GetCompanionRatingRanking(in *user.GetC
 		return nil, status.Error(codes.FailedPrecondition, "redis not configured")
 	}
 
-	// 使用公共排名查询逻辑（ZSet只存前100名）
-	result, err := helper.QueryRankingWithProfiles(
-		l.ctx,
-		l.svcCtx,
-		l.Logger,
-		"ranking:rating",
-		in.GetPage(),
-		in.GetPageSize(),
-		&helper.RatingRankingBuilder{},
-	)
+	window, err := helper.ParseRankingWindow(helper.RankingTypeRating, in.GetPeriod(), in.GetGame())
+	if err != nil {
+		metrics.RankingQueryTotal.WithLabelValues("rating", "invalid").Inc()
+		return nil, err
+	}
+
+	var result *helper.RankingQueryResult
+	if window.IsGlobal() {
+		// 总榜：使用公共排名查询逻辑（ZSet只存前100名）
+		result, err = helper.QueryRankingWithProfiles(
+			l.ctx,
+			l.svcCtx,
+			l.Logger,
+			window.Key(time.Now()),
+			in.GetPage(),
+			in.GetPageSize(),
+			&helper.RatingRankingBuilder{},
+		)
+	} else {
+		// 周期榜/游戏榜
+		result, err = helper.QueryRankingWindow(
+			l.ctx,
+			l.svcCtx,
+			l.Logger,
+			window,
+			in.GetPage(),
+			in.GetPageSize(),
+			&helper.RatingRankingBuilder{},
+		)
+	}
 	if err != nil {
 		metrics.RankingQueryTotal.WithLabelValues("rating", "error").Inc()
 		return nil, err
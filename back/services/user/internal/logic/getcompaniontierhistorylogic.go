@@ -0,0 +1,92 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type GetCompanionTierHistoryLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetCompanionTierHistoryLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetCompanionTierHistoryLogic {
+	return &GetCompanionTierHistoryLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// 陪玩等级相关接口
+// GetCompanionTierHistory 查询陪玩当前等级及等级变更记录（按时间倒序）
+func (l *GetCompanionTierHistoryLogic) GetCompanionTierHistory(in *user.GetCompanionTierHistoryRequest) (*user.GetCompanionTierHistoryResponse, error) {
+	if in.GetUserId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	db := l.svcCtx.DB().WithContext(l.ctx)
+
+	var profile model.CompanionProfile
+	if err := db.Select("id, tier, tier_override").
+		Where("user_id = ?", in.GetUserId()).
+		First(&profile).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "companion profile not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	query := db.Model(&model.CompanionTierHistory{}).Where("companion_id = ?", in.GetUserId())
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pagination := helper.NormalizePaginationWithDefault(in.GetPage(), in.GetPageSize(), 20)
+	var histories []model.CompanionTierHistory
+	if err := query.Order("created_at DESC, id DESC").
+		Offset((pagination.Page - 1) * pagination.PageSize).
+		Limit(pagination.PageSize).
+		Find(&histories).Error; err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	items := make([]*user.CompanionTierHistoryItem, 0, len(histories))
+	for i := range histories {
+		h := &histories[i]
+		items = append(items, &user.CompanionTierHistoryItem{
+			FromTier:        int32(h.FromTier),
+			FromTierName:    helper.CompanionTierName(l.svcCtx, h.FromTier),
+			ToTier:          int32(h.ToTier),
+			ToTierName:      helper.CompanionTierName(l.svcCtx, h.ToTier),
+			Reason:          h.Reason,
+			CompletedOrders: h.CompletedOrders,
+			Rating:          h.Rating,
+			CancelRate:      h.CancelRate,
+			DisputeRate:     h.DisputeRate,
+			Note:            h.Note,
+			CreatedAt:       h.CreatedAt.Unix(),
+		})
+	}
+
+	return &user.GetCompanionTierHistoryResponse{
+		Tier:       int32(profile.Tier),
+		TierName:   helper.CompanionTierName(l.svcCtx, profile.Tier),
+		Overridden: profile.TierOverride != 0,
+		Items:      items,
+		Total:      int32(total),
+		Page:       int32(pagination.Page),
+		PageSize:   int32(pagination.PageSize),
+	}, nil
+}
@@ -0,0 +1,184 @@
+package logic
+
+import (
+	"context"
+	"sort"
+
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/metrics"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultFeedLimit = 20
+	maxFeedLimit     = 50
+)
+
+type GetFeedLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetFeedLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetFeedLogic {
+	return &GetFeedLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetFeed 关注动态流：收件箱（写扩散）与粉丝数过多的陪玩的动态（读时拉取）按动态 ID 合并
+// Redis 不可用时全部从动态表拉取；收件箱只保留最近 InboxSize 条，更早的动态不再返回
+func (l *GetFeedLogic) GetFeed(in *user.GetFeedRequest) (*user.GetFeedResponse, error) {
+	userID := in.GetUserId()
+	if userID == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	limit := int(clamp(int64(in.GetLimit()), 1, maxFeedLimit))
+	if in.GetLimit() <= 0 {
+		limit = defaultFeedLimit
+	}
+	cursor := in.GetCursor()
+
+	ids, err := l.collectFeedIDs(userID, cursor, limit)
+	if err != nil {
+		metrics.FeedReadTotal.WithLabelValues("error").Inc()
+		helper.LogError(l.Logger, helper.OpFeed, "load feed failed", err, map[string]interface{}{
+			"user_id": userID,
+			"cursor":  cursor,
+		})
+		return nil, status.Error(codes.Internal, "load feed failed")
+	}
+
+	hasMore := len(ids) > limit
+	if hasMore {
+		ids = ids[:limit]
+	}
+	resp := &user.GetFeedResponse{
+		Items:   []*user.FeedItem{},
+		HasMore: hasMore,
+	}
+	if len(ids) == 0 {
+		return resp, nil
+	}
+	resp.NextCursor = ids[len(ids)-1]
+
+	items, err := l.loadFeedItems(ids)
+	if err != nil {
+		l.Errorf("load feed items failed: user_id=%d, error=%v", userID, err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	resp.Items = items
+	return resp, nil
+}
+
+// collectFeedIDs 合并收件箱与拉取的动态 ID，按 ID 倒序去重，最多返回 limit+1 个（多出的一个用于判断是否还有下一页）
+func (l *GetFeedLogic) collectFeedIDs(userID, cursor uint64, limit int) ([]uint64, error) {
+	var ids []uint64
+	if l.svcCtx.Redis == nil {
+		metrics.FeedReadTotal.WithLabelValues("fallback").Inc()
+		return helper.PullFeedIDs(l.ctx, l.svcCtx, userID, cursor, limit+1, false)
+	}
+
+	inbox, rebuilt, err := helper.FeedInboxIDs(l.ctx, l.svcCtx, userID)
+	if err != nil {
+		// Redis 异常时降级为全部拉取
+		l.Errorf("read feed inbox failed, fallback to pull: user_id=%d, error=%v", userID, err)
+		metrics.FeedReadTotal.WithLabelValues("fallback").Inc()
+		return helper.PullFeedIDs(l.ctx, l.svcCtx, userID, cursor, limit+1, false)
+	}
+	if rebuilt {
+		metrics.FeedReadTotal.WithLabelValues("rebuild").Inc()
+	} else {
+		metrics.FeedReadTotal.WithLabelValues("hit").Inc()
+	}
+	for _, id := range inbox {
+		if cursor == 0 || id < cursor {
+			ids = append(ids, id)
+		}
+	}
+
+	pulled, err := helper.PullFeedIDs(l.ctx, l.svcCtx, userID, cursor, limit+1, true)
+	if err != nil {
+		return nil, err
+	}
+	ids = append(ids, pulled...)
+
+	sort.Slice(ids, func(i, j int) bool { return ids[i] > ids[j] })
+	unique := ids[:0]
+	for i, id := range ids {
+		if i > 0 && id == ids[i-1] {
+			continue
+		}
+		unique = append(unique, id)
+		if len(unique) > limit {
+			break
+		}
+	}
+	return unique, nil
+}
+
+// loadFeedItems 按 ID 顺序加载动态及陪玩展示信息，跳过已删除的动态与封禁中的陪玩
+func (l *GetFeedLogic) loadFeedItems(ids []uint64) ([]*user.FeedItem, error) {
+	db := l.svcCtx.DB().WithContext(l.ctx)
+
+	var events []model.FeedEvent
+	if err := db.Where("id IN ?", ids).Find(&events).Error; err != nil {
+		return nil, err
+	}
+	eventMap := make(map[uint64]*model.FeedEvent, len(events))
+	actorIDs := make([]uint64, 0, len(events))
+	for i := range events {
+		eventMap[events[i].ID] = &events[i]
+		actorIDs = append(actorIDs, events[i].ActorID)
+	}
+
+	var actors []model.User
+	if len(actorIDs) > 0 {
+		if err := db.Select("id, nickname, avatar_url, status, status_until").
+			Where("id IN ?", actorIDs).
+			Find(&actors).Error; err != nil {
+			return nil, err
+		}
+	}
+	actorMap := make(map[uint64]*model.User, len(actors))
+	for i := range actors {
+		actorMap[actors[i].ID] = &actors[i]
+	}
+
+	items := make([]*user.FeedItem, 0, len(ids))
+	for _, id := range ids {
+		e := eventMap[id]
+		if e == nil {
+			continue
+		}
+		u := actorMap[e.ActorID]
+		if u == nil || u.IsBanned() {
+			continue
+		}
+		items = append(items, &user.FeedItem{
+			Id:          e.ID,
+			CompanionId: e.ActorID,
+			Nickname:    u.Nickname,
+			AvatarUrl:   u.AvatarURL,
+			Kind:        e.Kind,
+			GameName:    e.GameName,
+			Rating:      e.Rating,
+			Comment:     e.Comment,
+			OldPrice:    e.OldPrice,
+			NewPrice:    e.NewPrice,
+			CreatedAt:   e.CreatedAt.Unix(),
+			PostId:      e.PostID,
+			Content:     e.Content,
+			ImageUrls:   helper.DecodeStringList(e.ImageURLs),
+		})
+	}
+	return items, nil
+}
@@ -0,0 +1,53 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type GetMyCompanionApplicationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetMyCompanionApplicationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetMyCompanionApplicationLogic {
+	return &GetMyCompanionApplicationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetMyCompanionApplication 查询自己最近一次的入驻申请（身份证号脱敏，驳回时返回原因和可再次申请的时间）
+func (l *GetMyCompanionApplicationLogic) GetMyCompanionApplication(in *user.GetMyCompanionApplicationRequest) (*user.GetMyCompanionApplicationResponse, error) {
+	if in.GetUserId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	var app model.CompanionApplication
+	err := l.svcCtx.DB().WithContext(l.ctx).
+		Where("user_id = ?", in.GetUserId()).
+		Order("created_at DESC").
+		First(&app).Error
+	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return &user.GetMyCompanionApplicationResponse{}, nil
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &user.GetMyCompanionApplicationResponse{
+		Application: helper.ToCompanionApplicationInfo(&app, false, helper.ApplicationReapplyCooldown(l.svcCtx)),
+	}, nil
+}
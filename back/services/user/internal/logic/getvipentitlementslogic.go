@@ -0,0 +1,87 @@
+package logic
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"SLGaming/back/services/user/internal/cache"
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type GetVipEntitlementsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewGetVipEntitlementsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *GetVipEntitlementsLogic {
+	return &GetVipEntitlementsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// GetVipEntitlements 查询会员权益（订单折扣、推荐优先级、限流倍数等），供网关/订单/推荐服务调用
+func (l *GetVipEntitlementsLogic) GetVipEntitlements(in *user.GetVipEntitlementsRequest) (*user.GetVipEntitlementsResponse, error) {
+	userID := in.GetUserId()
+	if userID == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	// 1. 优先读缓存
+	cacheKey := fmt.Sprintf(cache.VipEntitlementsKey, userID)
+	if cached, err := l.svcCtx.CacheManager.Get(cacheKey); err == nil && cached != "" {
+		var resp user.GetVipEntitlementsResponse
+		if err := json.Unmarshal([]byte(cached), &resp); err == nil {
+			return &resp, nil
+		}
+	}
+
+	// 2. 查询订阅
+	resp := &user.GetVipEntitlementsResponse{RateLimitMultiplier: 1}
+	var sub model.VipSubscription
+	err := l.svcCtx.DB().WithContext(l.ctx).Where("user_id = ?", userID).First(&sub).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		helper.LogError(l.Logger, helper.OpVipEntitlements, "query subscription failed", err, map[string]interface{}{
+			"user_id": userID,
+		})
+		return nil, status.Error(codes.Internal, "query vip subscription failed")
+	}
+
+	if err == nil && sub.IsActive() {
+		resp.IsVip = true
+		resp.Level = int32(sub.Level)
+		resp.PlanCode = sub.PlanCode
+		resp.ExpireAt = sub.EndAt.Unix()
+		resp.AutoRenew = sub.AutoRenew
+		// 权益按当前配置计算；套餐已下线时仅保留等级，不再提供其它权益
+		if plan, ok := helper.FindVipPlan(l.svcCtx.Config().Vip, sub.PlanCode); ok {
+			resp.Badge = plan.Badge
+			resp.DiscountPercent = plan.DiscountPercent
+			resp.RecommendPriority = plan.RecommendPriority
+			if plan.RateLimitMultiplier > 0 {
+				resp.RateLimitMultiplier = plan.RateLimitMultiplier
+			}
+		}
+	}
+
+	// 3. 回写缓存（会员到期前缓存不会跨越到期时间太久，TTL 较短）
+	if data, err := json.Marshal(resp); err == nil {
+		if err := l.svcCtx.CacheManager.Set(cacheKey, string(data), cache.VipEntitlementsExpire); err != nil {
+			l.Errorf("set vip entitlements cache failed: %v", err)
+		}
+	}
+
+	return resp, nil
+}
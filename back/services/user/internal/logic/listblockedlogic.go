@@ -0,0 +1,89 @@
+package logic
+
+import (
+	"context"
+	"time"
+
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListBlockedLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListBlockedLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListBlockedLogic {
+	return &ListBlockedLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// BlockedWithUser JOIN 查询结果结构
+type BlockedWithUser struct {
+	BlockedID uint64    `gorm:"column:blocked_id"`
+	BlockedAt time.Time `gorm:"column:blocked_at"`
+	Nickname  string    `gorm:"column:nickname"`
+	AvatarURL string    `gorm:"column:avatar_url"`
+	Role      int       `gorm:"column:role"`
+}
+
+// ListBlocked 我拉黑的用户，按拉黑时间倒序
+func (l *ListBlockedLogic) ListBlocked(in *user.ListBlockedRequest) (*user.ListBlockedResponse, error) {
+	if in.OperatorId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "operator_id is required")
+	}
+
+	page := max(int64(in.Page), 1)
+	pageSize := clamp(int64(in.PageSize), 1, 100)
+	offset := (page - 1) * pageSize
+
+	db := l.svcCtx.DB().WithContext(l.ctx)
+
+	var total int64
+	if err := db.Model(&model.BlockRelation{}).Where("blocker_id = ?", in.OperatorId).Count(&total).Error; err != nil {
+		l.Errorf("count blocked users failed: %v", err)
+		return nil, status.Error(codes.Internal, "get blocked list failed")
+	}
+
+	var rows []BlockedWithUser
+	if total > 0 {
+		if err := db.Table("block_relations").
+			Select("block_relations.blocked_id, block_relations.blocked_at, users.nickname, users.avatar_url, users.role").
+			Joins("JOIN users ON users.id = block_relations.blocked_id").
+			Where("block_relations.blocker_id = ? AND block_relations.deleted_at IS NULL", in.OperatorId).
+			Order("block_relations.blocked_at DESC").
+			Offset(int(offset)).
+			Limit(int(pageSize)).
+			Scan(&rows).Error; err != nil {
+			l.Errorf("get blocked list failed: %v", err)
+			return nil, status.Error(codes.Internal, "get blocked list failed")
+		}
+	}
+
+	users := make([]*user.BlockedUserInfo, 0, len(rows))
+	for _, r := range rows {
+		users = append(users, &user.BlockedUserInfo{
+			UserId:    r.BlockedID,
+			Nickname:  r.Nickname,
+			AvatarUrl: r.AvatarURL,
+			Role:      int32(r.Role),
+			BlockedAt: r.BlockedAt.Unix(),
+		})
+	}
+
+	return &user.ListBlockedResponse{
+		Users:    users,
+		Total:    int32(total),
+		Page:     int32(page),
+		PageSize: int32(pageSize),
+	}, nil
+}
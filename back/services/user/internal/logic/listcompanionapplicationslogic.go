@@ -0,0 +1,79 @@
+package logic
+
+import (
+	"context"
+
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListCompanionApplicationsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListCompanionApplicationsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListCompanionApplicationsLogic {
+	return &ListCompanionApplicationsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListCompanionApplications 管理员查询审核队列（权限由网关 RBAC 控制）
+// 待审核按提交时间正序（先到先审），其余按更新时间倒序
+func (l *ListCompanionApplicationsLogic) ListCompanionApplications(in *user.ListCompanionApplicationsRequest) (*user.ListCompanionApplicationsResponse, error) {
+	statusFilter := int(in.GetStatus())
+	switch statusFilter {
+	case 0, model.CompanionApplicationPending, model.CompanionApplicationApproved, model.CompanionApplicationRejected:
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid status")
+	}
+
+	query := l.svcCtx.DB().WithContext(l.ctx).Model(&model.CompanionApplication{})
+	if statusFilter != 0 {
+		query = query.Where("status = ?", statusFilter)
+	}
+	if in.GetUserId() > 0 {
+		query = query.Where("user_id = ?", in.GetUserId())
+	}
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pagination := helper.NormalizePaginationWithDefault(in.GetPage(), in.GetPageSize(), 20)
+	order := "updated_at DESC"
+	if statusFilter == model.CompanionApplicationPending {
+		order = "created_at ASC"
+	}
+
+	var apps []model.CompanionApplication
+	if err := query.Order(order).
+		Offset((pagination.Page - 1) * pagination.PageSize).
+		Limit(pagination.PageSize).
+		Find(&apps).Error; err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	cooldown := helper.ApplicationReapplyCooldown(l.svcCtx)
+	items := make([]*user.CompanionApplicationInfo, 0, len(apps))
+	for i := range apps {
+		items = append(items, helper.ToCompanionApplicationInfo(&apps[i], true, cooldown))
+	}
+
+	return &user.ListCompanionApplicationsResponse{
+		Applications: items,
+		Total:        int32(total),
+		Page:         int32(pagination.Page),
+		PageSize:     int32(pagination.PageSize),
+	}, nil
+}
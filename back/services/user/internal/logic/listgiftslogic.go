@@ -0,0 +1,44 @@
+package logic
+
+import (
+	"context"
+
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListGiftsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListGiftsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListGiftsLogic {
+	return &ListGiftsLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+func (l *ListGiftsLogic) ListGifts(in *user.ListGiftsRequest) (*user.ListGiftsResponse, error) {
+	db := l.svcCtx.DB().WithContext(l.ctx)
+	if !in.GetIncludeDisabled() {
+		db = db.Where("enabled = ?", true)
+	}
+
+	var gifts []model.Gift
+	if err := db.Order("sort asc, price asc").Find(&gifts).Error; err != nil {
+		l.Errorf("list gifts failed: %v", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	resp := make([]*user.GiftInfo, 0, len(gifts))
+	for i := range gifts {
+		resp = append(resp, helper.ToGiftInfo(&gifts[i]))
+	}
+
+	return &user.ListGiftsResponse{Gifts: resp}, nil
+}
@@ -0,0 +1,74 @@
+package logic
+
+import (
+	"context"
+
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type ListLoginEventsLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListLoginEventsLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListLoginEventsLogic {
+	return &ListLoginEventsLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListLoginEvents 分页查询用户的登录记录（按时间倒序）
+func (l *ListLoginEventsLogic) ListLoginEvents(in *user.ListLoginEventsRequest) (*user.ListLoginEventsResponse, error) {
+	userID := in.GetUserId()
+	if userID == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	query := l.svcCtx.DB().WithContext(l.ctx).Model(&model.LoginEvent{}).Where("user_id = ?", userID)
+
+	var total int64
+	if err := query.Count(&total).Error; err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pagination := helper.NormalizePaginationWithDefault(in.GetPage(), in.GetPageSize(), 20)
+	offset := (pagination.Page - 1) * pagination.PageSize
+
+	var events []model.LoginEvent
+	if err := query.Order("occurred_at DESC").Offset(offset).Limit(pagination.PageSize).Find(&events).Error; err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	respEvents := make([]*user.LoginEventInfo, 0, len(events))
+	for i := range events {
+		e := &events[i]
+		respEvents = append(respEvents, &user.LoginEventInfo{
+			Id:         e.ID,
+			Method:     e.Method,
+			Result:     e.Result,
+			Reason:     e.Reason,
+			Ip:         e.IP,
+			IpRegion:   e.IPRegion,
+			UserAgent:  e.UserAgent,
+			NewDevice:  e.NewDevice,
+			OccurredAt: e.OccurredAt.Unix(),
+		})
+	}
+
+	return &user.ListLoginEventsResponse{
+		Events:   respEvents,
+		Total:    int32(total),
+		Page:     int32(pagination.Page),
+		PageSize: int32(pagination.PageSize),
+	}, nil
+}
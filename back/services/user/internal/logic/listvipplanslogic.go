@@ -0,0 +1,37 @@
+package logic
+
+import (
+	"context"
+
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+)
+
+type ListVipPlansLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewListVipPlansLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ListVipPlansLogic {
+	return &ListVipPlansLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ListVipPlans 会员套餐列表（来自配置，支持 Nacos 热更新）
+func (l *ListVipPlansLogic) ListVipPlans(in *user.ListVipPlansRequest) (*user.ListVipPlansResponse, error) {
+	plans := l.svcCtx.Config().Vip.Plans
+
+	resp := make([]*user.VipPlanInfo, 0, len(plans))
+	for _, p := range plans {
+		resp = append(resp, helper.ToVipPlanInfo(p))
+	}
+
+	return &user.ListVipPlansResponse{Plans: resp}, nil
+}
@@ -6,6 +6,8 @@ import (
 	"strings"
 	"time"
 
+	"SLGaming/back/pkg/verifyticket"
+	"SLGaming/back/services/user/internal/helper"
 	"SLGaming/back/services/user/internal/metrics"
 	"SLGaming/back/services/user/internal/model"
 	"SLGaming/back/services/user/internal/svc"
@@ -39,8 +41,74 @@ func (l *LoginByCodeLogic) LoginByCode(in *user.LoginByCodeRequest) (*user.Login
 	}()
 
 	phone := strings.TrimSpace(in.GetPhone())
+	var (
+		u   model.User
+		err error
+	)
+	if phone == "" && strings.TrimSpace(in.GetEmail()) != "" {
+		u, err = l.findByEmail(in)
+	} else {
+		u, err = l.findByPhone(in, phone)
+	}
+	if err != nil {
+		return nil, err
+	}
+	// 邮箱登录时登录记录与锁定解除仍按账号手机号处理
+	phone = u.Phone
+
+	if u.IsBanned() {
+		metrics.UserLoginTotal.WithLabelValues(model.LoginResultBanned, "code").Inc()
+		helper.RecordLoginEvent(l.ctx, l.svcCtx, &model.LoginEvent{
+			UserID:    u.ID,
+			Phone:     phone,
+			Method:    model.LoginMethodCode,
+			Result:    model.LoginResultBanned,
+			Reason:    u.StatusReason,
+			IP:        strings.TrimSpace(in.GetClientIp()),
+			UserAgent: in.GetUserAgent(),
+		})
+		return nil, helper.BannedError(&u)
+	}
+
+	// 验证码登录证明了手机号归属，解除该手机号的密码登录锁定
+	guard := helper.NewLoginGuard(l.svcCtx.Redis, l.svcCtx.Config().LoginProtection)
+	if wasLocked, err := guard.Unlock(l.ctx, phone, ""); err != nil {
+		l.Logger.Errorf("clear login lock failed: phone=%s, err=%v", phone, err)
+	} else if wasLocked {
+		metrics.LoginProtectionTotal.WithLabelValues("unlocked_by_code", "phone").Inc()
+		helper.LogInfo(l.Logger, helper.OpLoginByCode, "login lock cleared by code login", map[string]interface{}{
+			"user_id": u.ID,
+		})
+	}
+
+	helper.RecordLoginEvent(l.ctx, l.svcCtx, &model.LoginEvent{
+		UserID:    u.ID,
+		Phone:     phone,
+		Method:    model.LoginMethodCode,
+		Result:    model.LoginResultSuccess,
+		IP:        strings.TrimSpace(in.GetClientIp()),
+		UserAgent: in.GetUserAgent(),
+	})
+
+	metrics.UserLoginTotal.WithLabelValues("success", "code").Inc()
+
+	return &user.LoginByCodeResponse{
+		Id:  u.ID,
+		Uid: u.UID,
+	}, nil
+}
+
+// findByPhone 手机号验证码登录：消费票据后按手机号查找用户
+func (l *LoginByCodeLogic) findByPhone(in *user.LoginByCodeRequest, phone string) (model.User, error) {
+	var u model.User
 	if phone == "" {
-		return nil, status.Error(codes.InvalidArgument, "phone is required")
+		return u, status.Error(codes.InvalidArgument, "phone is required")
+	}
+
+	// 步骤0：校验并消费验证码票据，证明调用方确实通过了该手机号的登录验证码
+	if err := helper.ConsumeVerifyTicket(l.ctx, l.svcCtx, in.GetVerifyTicket(), phone, verifyticket.PurposeLogin); err != nil {
+		metrics.UserLoginTotal.WithLabelValues("invalid_ticket", "code").Inc()
+		return u, err
 	}
 
 	// 步骤1：布隆过滤器快速检查手机号是否存在
@@ -53,26 +121,37 @@ func (l *LoginByCodeLogic) LoginByCode(in *user.LoginByCodeRequest) (*user.Login
 		} else if !exists {
 			// 手机号肯定不存在，直接返回
 			l.Logger.Info("[LoginByCode] user not found (bloom filter), phone: " + phone)
-			return nil, status.Error(codes.NotFound, "user not found")
+			return u, status.Error(codes.NotFound, "user not found")
 		}
 		// 如果存在，需要查数据库确认（布隆过滤器有假阳性）
 	}
 
-	db := l.svcCtx.DB().WithContext(l.ctx)
+	return l.first(l.svcCtx.DB().WithContext(l.ctx).Where("phone = ?", phone))
+}
+
+// findByEmail 邮箱验证码登录：消费票据后按已绑定的邮箱查找用户
+func (l *LoginByCodeLogic) findByEmail(in *user.LoginByCodeRequest) (model.User, error) {
+	email, ok := helper.NormalizeEmail(in.GetEmail())
+	if !ok {
+		return model.User{}, status.Error(codes.InvalidArgument, "invalid email")
+	}
+	if err := helper.ConsumeVerifyTicket(l.ctx, l.svcCtx, in.GetVerifyTicket(), email, verifyticket.PurposeLogin); err != nil {
+		metrics.UserLoginTotal.WithLabelValues("invalid_ticket", "code").Inc()
+		return model.User{}, err
+	}
+	return l.first(l.svcCtx.DB().WithContext(l.ctx).Where("email = ?", email))
+}
+
+// first 执行查询并统一处理未找到与数据库错误
+func (l *LoginByCodeLogic) first(query *gorm.DB) (model.User, error) {
 	var u model.User
-	if err := db.Where("phone = ?", phone).First(&u).Error; err != nil {
+	if err := query.First(&u).Error; err != nil {
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			metrics.UserLoginTotal.WithLabelValues("not_found", "code").Inc()
-			return nil, status.Error(codes.NotFound, "user not found")
+			return u, status.Error(codes.NotFound, "user not found")
 		}
 		metrics.UserLoginTotal.WithLabelValues("error", "code").Inc()
-		return nil, status.Error(codes.Internal, err.Error())
+		return u, status.Error(codes.Internal, err.Error())
 	}
-
-	metrics.UserLoginTotal.WithLabelValues("success", "code").Inc()
-
-	return &user.LoginByCodeResponse{
-		Id:  u.ID,
-		Uid: u.UID,
-	}, nil
+	return u, nil
 }
@@ -49,6 +49,20 @@ func (l *LoginLogic) Login(in *user.LoginRequest) (*user.LoginResponse, error) {
 		return nil, status.Error(codes.InvalidArgument, "phone and password are required")
 	}
 
+	// 步骤0：防暴力破解，手机号或 IP 处于锁定/等待期时直接拒绝（不校验密码）
+	ip := strings.TrimSpace(in.GetClientIp())
+	guard := helper.NewLoginGuard(l.svcCtx.Redis, l.svcCtx.Config().LoginProtection)
+	if result, err := guard.Check(l.ctx, phone, ip); err != nil {
+		helper.LogWarning(l.Logger, helper.OpLogin, "login rejected by brute-force protection", map[string]interface{}{
+			"phone":  phone,
+			"ip":     ip,
+			"result": result,
+		})
+		metrics.UserLoginTotal.WithLabelValues(result, "password").Inc()
+		l.recordEvent(in, l.lookupUserID(phone), result, helper.LoginLockReason(result))
+		return nil, err
+	}
+
 	// 步骤1：布隆过滤器快速检查手机号是否存在
 	// 如果布隆过滤器说"不存在"，那手机号一定不存在，直接返回（省去数据库查询）
 	if l.svcCtx.BloomFilter != nil {
@@ -61,6 +75,8 @@ func (l *LoginLogic) Login(in *user.LoginRequest) (*user.LoginResponse, error) {
 			helper.LogWarning(l.Logger, helper.OpLogin, "user not found (bloom filter)", map[string]interface{}{
 				"phone": phone,
 			})
+			// 不存在的手机号同样计入 IP 失败次数（撞库通常会尝试大量不存在的手机号）
+			guard.RecordFailure(l.ctx, "", ip)
 			return nil, status.Error(codes.NotFound, "user not found")
 		}
 		// 如果存在，需要查数据库确认（布隆过滤器有假阳性）
@@ -73,6 +89,7 @@ func (l *LoginLogic) Login(in *user.LoginRequest) (*user.LoginResponse, error) {
 			helper.LogWarning(l.Logger, helper.OpLogin, "user not found", map[string]interface{}{
 				"phone": phone,
 			})
+			guard.RecordFailure(l.ctx, "", ip)
 			return nil, status.Error(codes.NotFound, "user not found")
 		}
 		helper.LogError(l.Logger, helper.OpLogin, "get user failed", err, map[string]interface{}{
@@ -87,9 +104,25 @@ func (l *LoginLogic) Login(in *user.LoginRequest) (*user.LoginResponse, error) {
 			"user_id": u.ID,
 		})
 		metrics.UserLoginTotal.WithLabelValues("error", "password").Inc()
+
+		reason := "invalid password"
+		if guard.RecordFailure(l.ctx, phone, ip) {
+			reason = "invalid password, temporarily locked"
+		}
+		l.recordEvent(in, u.ID, model.LoginResultFailed, reason)
 		return nil, status.Error(codes.PermissionDenied, "invalid credentials")
 	}
 
+	guard.Reset(l.ctx, phone)
+
+	// 密码正确后再检查封禁，避免通过封禁提示探测账号
+	if u.IsBanned() {
+		metrics.UserLoginTotal.WithLabelValues(model.LoginResultBanned, "password").Inc()
+		l.recordEvent(in, u.ID, model.LoginResultBanned, u.StatusReason)
+		return nil, helper.BannedError(&u)
+	}
+	l.recordEvent(in, u.ID, model.LoginResultSuccess, "")
+
 	// 记录成功日志
 	helper.LogSuccess(l.Logger, helper.OpLogin, map[string]interface{}{
 		"user_id": u.ID,
@@ -104,3 +137,28 @@ func (l *LoginLogic) Login(in *user.LoginRequest) (*user.LoginResponse, error) {
 		Uid: u.UID,
 	}, nil
 }
+
+// recordEvent 写入密码登录记录（手机号不存在时不记录，避免为任意手机号写入数据）
+func (l *LoginLogic) recordEvent(in *user.LoginRequest, userID uint64, result, reason string) {
+	if userID == 0 {
+		return
+	}
+	helper.RecordLoginEvent(l.ctx, l.svcCtx, &model.LoginEvent{
+		UserID:    userID,
+		Phone:     strings.TrimSpace(in.GetPhone()),
+		Method:    model.LoginMethodPassword,
+		Result:    result,
+		Reason:    reason,
+		IP:        strings.TrimSpace(in.GetClientIp()),
+		UserAgent: in.GetUserAgent(),
+	})
+}
+
+// lookupUserID 按手机号查询用户ID（被锁定时仍需把拒绝记录写入该用户的登录记录）
+func (l *LoginLogic) lookupUserID(phone string) uint64 {
+	var u model.User
+	if err := l.svcCtx.DB().WithContext(l.ctx).Select("id").Where("phone = ?", phone).First(&u).Error; err != nil {
+		return 0
+	}
+	return u.ID
+}
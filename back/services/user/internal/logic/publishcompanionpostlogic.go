@@ -0,0 +1,102 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+const (
+	maxPostContentLen = 500
+	maxPostImages     = 9
+)
+
+type PublishCompanionPostLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewPublishCompanionPostLogic(ctx context.Context, svcCtx *svc.ServiceContext) *PublishCompanionPostLogic {
+	return &PublishCompanionPostLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// PublishCompanionPost 陪玩发布帖子，保存后发送"新帖子"动态，由关注事件消费者写入粉丝的动态流
+func (l *PublishCompanionPostLogic) PublishCompanionPost(in *user.PublishCompanionPostRequest) (*user.PublishCompanionPostResponse, error) {
+	userID := in.GetUserId()
+	if userID == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	content := strings.TrimSpace(in.GetContent())
+	if len([]rune(content)) > maxPostContentLen {
+		return nil, status.Error(codes.InvalidArgument, "content is too long")
+	}
+	if len(in.GetImageUrls()) > maxPostImages {
+		return nil, status.Error(codes.InvalidArgument, "at most 9 images")
+	}
+	for _, u := range in.GetImageUrls() {
+		if !helper.IsValidMediaURL(u) {
+			return nil, status.Error(codes.InvalidArgument, "invalid image url")
+		}
+	}
+	if content == "" && len(in.GetImageUrls()) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "content or images is required")
+	}
+
+	db := l.svcCtx.DB().WithContext(l.ctx)
+
+	var u model.User
+	if err := db.Select("id, role, status, status_until").Where("id = ?", userID).First(&u).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if u.IsBanned() {
+		return nil, status.Error(codes.PermissionDenied, "user is banned")
+	}
+	if u.IsMuted() {
+		return nil, status.Error(codes.PermissionDenied, "user is muted")
+	}
+	if !u.IsCompanion() {
+		return nil, status.Error(codes.FailedPrecondition, "user is not a companion")
+	}
+
+	post := &model.CompanionPost{
+		UserID:    userID,
+		Content:   content,
+		ImageURLs: helper.EncodeStringList(in.GetImageUrls()),
+	}
+	if err := db.Create(post).Error; err != nil {
+		helper.LogError(l.Logger, helper.OpFeed, "create companion post failed", err, map[string]interface{}{
+			"user_id": userID,
+		})
+		return nil, status.Error(codes.Internal, "publish post failed")
+	}
+
+	helper.PublishPostActivity(l.ctx, l.svcCtx, l.Logger, post)
+	helper.LogSuccess(l.Logger, helper.OpFeed, map[string]interface{}{
+		"action":  "publish_post",
+		"user_id": userID,
+		"post_id": post.ID,
+	})
+
+	return &user.PublishCompanionPostResponse{
+		PostId:    post.ID,
+		CreatedAt: post.CreatedAt.Unix(),
+	}, nil
+}
@@ -0,0 +1,54 @@
+package logic
+
+import (
+	"context"
+	"strings"
+
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type RecordLoginEventLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewRecordLoginEventLogic(ctx context.Context, svcCtx *svc.ServiceContext) *RecordLoginEventLogic {
+	return &RecordLoginEventLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// RecordLoginEvent 记录网关侧完成的登录（目前为刷新 Token），密码/验证码登录由对应接口自行记录
+func (l *RecordLoginEventLogic) RecordLoginEvent(in *user.RecordLoginEventRequest) (*user.RecordLoginEventResponse, error) {
+	if in.GetUserId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	if in.GetMethod() != model.LoginMethodRefresh {
+		return nil, status.Error(codes.InvalidArgument, "invalid login method")
+	}
+	result := in.GetResult()
+	if result != model.LoginResultSuccess && result != model.LoginResultFailed {
+		return nil, status.Error(codes.InvalidArgument, "invalid login result")
+	}
+
+	helper.RecordLoginEvent(l.ctx, l.svcCtx, &model.LoginEvent{
+		UserID:    in.GetUserId(),
+		Method:    in.GetMethod(),
+		Result:    result,
+		Reason:    in.GetReason(),
+		IP:        strings.TrimSpace(in.GetClientIp()),
+		UserAgent: in.GetUserAgent(),
+	})
+
+	return &user.RecordLoginEventResponse{}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"strings"
 
+	"SLGaming/back/pkg/verifyticket"
 	"SLGaming/back/services/user/internal/helper"
 	"SLGaming/back/services/user/internal/metrics"
 	"SLGaming/back/services/user/internal/model"
@@ -43,6 +44,11 @@ func (l *RegisterLogic) Register(in *user.RegisterRequest) (*user.RegisterRespon
 		return nil, status.Error(codes.InvalidArgument, "password is required")
 	}
 
+	// 校验并消费验证码票据（purpose=register）
+	if err := helper.ConsumeVerifyTicket(l.ctx, l.svcCtx, in.GetVerifyTicket(), phone, verifyticket.PurposeRegister); err != nil {
+		return nil, err
+	}
+
 	// 步骤1：布隆过滤器快速检查手机号是否可能存在
 	phoneExists := true // 默认为true，表示需要查数据库确认
 	if l.svcCtx.BloomFilter != nil {
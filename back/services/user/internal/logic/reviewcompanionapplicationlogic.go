@@ -0,0 +1,194 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"SLGaming/back/pkg/snowflake"
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/metrics"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 驳回原因、审核备注最大长度
+const (
+	rejectReasonMaxLen = 255
+	reviewNoteMaxLen   = 512
+)
+
+type ReviewCompanionApplicationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewReviewCompanionApplicationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *ReviewCompanionApplicationLogic {
+	return &ReviewCompanionApplicationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// ReviewCompanionApplication 管理员审核入驻申请（权限由网关 RBAC 控制）
+// 通过：在一个事务中授予陪玩角色、写入申请的技能并标记认证；驳回：记录原因；审核结果通过 MQ 通知申请人
+func (l *ReviewCompanionApplicationLogic) ReviewCompanionApplication(in *user.ReviewCompanionApplicationRequest) (*user.ReviewCompanionApplicationResponse, error) {
+	if in.GetApplicationId() == 0 || in.GetReviewerId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "application_id and reviewer_id are required")
+	}
+	rejectReason := strings.TrimSpace(in.GetRejectReason())
+	note := strings.TrimSpace(in.GetNote())
+	if !in.GetApprove() && rejectReason == "" {
+		return nil, status.Error(codes.InvalidArgument, "reject_reason is required")
+	}
+	if len([]rune(rejectReason)) > rejectReasonMaxLen || len([]rune(note)) > reviewNoteMaxLen {
+		return nil, status.Error(codes.InvalidArgument, "reject_reason or note is too long")
+	}
+
+	action := "reject"
+	if in.GetApprove() {
+		action = "approve"
+	}
+
+	now := time.Now()
+	var app model.CompanionApplication
+	err := l.svcCtx.DB().WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where("id = ?", in.GetApplicationId()).
+			First(&app).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return status.Error(codes.NotFound, "application not found")
+			}
+			return err
+		}
+		if app.Status != model.CompanionApplicationPending {
+			return status.Error(codes.FailedPrecondition, "application already reviewed")
+		}
+
+		if in.GetApprove() {
+			if err := l.grantCompanion(tx, &app); err != nil {
+				return err
+			}
+			app.Status = model.CompanionApplicationApproved
+			app.RejectReason = ""
+		} else {
+			app.Status = model.CompanionApplicationRejected
+			app.RejectReason = rejectReason
+		}
+		app.ReviewNote = note
+		app.ReviewerID = in.GetReviewerId()
+		app.ReviewedAt = &now
+		return tx.Model(&app).Updates(map[string]interface{}{
+			"status":        app.Status,
+			"reject_reason": app.RejectReason,
+			"review_note":   app.ReviewNote,
+			"reviewer_id":   app.ReviewerID,
+			"reviewed_at":   app.ReviewedAt,
+		}).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			metrics.CompanionApplicationTotal.WithLabelValues(action, "rejected").Inc()
+			return nil, err
+		}
+		helper.LogError(l.Logger, helper.OpCompanionApplication, "review companion application failed", err, map[string]interface{}{
+			"application_id": in.GetApplicationId(),
+			"action":         action,
+		})
+		metrics.CompanionApplicationTotal.WithLabelValues(action, "error").Inc()
+		return nil, status.Error(codes.Internal, "review companion application failed")
+	}
+
+	// 角色和简介已变更，清除用户缓存
+	if in.GetApprove() && l.svcCtx.Redis != nil {
+		if _, err := l.svcCtx.Redis.DelCtx(l.ctx, GetUserCacheKey(int64(app.UserID))); err != nil {
+			l.Logger.Errorf("delete user cache failed: %v", err)
+		}
+	}
+	if in.GetApprove() {
+		helper.InvalidateCompanionList(l.svcCtx, l.Logger)
+	}
+
+	cooldown := helper.ApplicationReapplyCooldown(l.svcCtx)
+	helper.PublishApplicationReviewed(l.ctx, l.svcCtx, l.Logger, &app, helper.ApplicationReapplyAt(&app, cooldown))
+
+	metrics.CompanionApplicationTotal.WithLabelValues(action, "success").Inc()
+	helper.LogSuccess(l.Logger, helper.OpCompanionApplication, map[string]interface{}{
+		"action":         action,
+		"application_id": app.ID,
+		"user_id":        app.UserID,
+		"reviewer_id":    app.ReviewerID,
+	})
+
+	return &user.ReviewCompanionApplicationResponse{
+		Application: helper.ToCompanionApplicationInfo(&app, true, cooldown),
+	}, nil
+}
+
+// grantCompanion 审核通过：老板升级为陪玩，创建或更新陪玩资料，写入申请的技能并标记认证
+func (l *ReviewCompanionApplicationLogic) grantCompanion(tx *gorm.DB, app *model.CompanionApplication) error {
+	var u model.User
+	if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", app.UserID).First(&u).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return status.Error(codes.NotFound, "user not found")
+		}
+		return err
+	}
+	if u.IsAdmin() {
+		return status.Error(codes.FailedPrecondition, "applicant is an admin")
+	}
+
+	userUpdates := map[string]interface{}{}
+	if !u.IsCompanion() {
+		userUpdates["role"] = model.RoleCompanion
+	}
+	if app.Bio != "" {
+		userUpdates["bio"] = app.Bio
+	}
+	if len(userUpdates) > 0 {
+		if err := tx.Model(&u).Updates(userUpdates).Error; err != nil {
+			return err
+		}
+	}
+
+	var profile model.CompanionProfile
+	err := tx.Where("user_id = ?", app.UserID).First(&profile).Error
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		profile = model.CompanionProfile{
+			BaseModel: model.BaseModel{
+				ID: uint64(snowflake.GenID()),
+			},
+			UserID:     app.UserID,
+			GameSkills: "[]",
+			Status:     model.CompanionStatusOffline,
+			Tier:       helper.LowestCompanionTier(l.svcCtx),
+		}
+		err = tx.Create(&profile).Error
+	}
+	if err != nil {
+		return err
+	}
+
+	// 技能截图已人工审核，申请的技能全部标记为认证
+	if _, err := helper.ReplaceCompanionSkills(l.ctx, tx, app.UserID, helper.DecodeApplicationSkills(app.Skills)); err != nil {
+		return err
+	}
+	if err := tx.Model(&model.CompanionSkill{}).
+		Where("companion_id = ?", app.UserID).
+		Update("is_verified", true).Error; err != nil {
+		return err
+	}
+	return tx.Model(&model.CompanionProfile{}).
+		Where("user_id = ?", app.UserID).
+		Update("is_verified", true).Error
+}
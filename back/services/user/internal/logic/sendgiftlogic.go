@@ -0,0 +1,175 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/metrics"
+	"SLGaming/back/services/user/internal/model"
+	userMQ "SLGaming/back/services/user/internal/mq"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type SendGiftLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSendGiftLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SendGiftLogic {
+	return &SendGiftLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SendGift 赠送礼物：按礼物单价 * 数量从赠送者转账给接收者
+func (l *SendGiftLogic) SendGift(in *user.SendGiftRequest) (*user.SendGiftResponse, error) {
+	senderID := in.GetSenderId()
+	receiverID := in.GetReceiverId()
+	quantity := in.GetQuantity()
+	if quantity == 0 {
+		quantity = 1
+	}
+
+	helper.LogRequest(l.Logger, helper.OpSendGift, map[string]interface{}{
+		"sender_id":    senderID,
+		"receiver_id":  receiverID,
+		"gift_id":      in.GetGiftId(),
+		"quantity":     quantity,
+		"biz_order_id": in.GetBizOrderId(),
+	})
+
+	if senderID == 0 || receiverID == 0 {
+		return nil, status.Error(codes.InvalidArgument, "sender_id and receiver_id are required")
+	}
+	if senderID == receiverID {
+		metrics.WalletTransferTotal.WithLabelValues("error", "gift").Inc()
+		return nil, status.Error(codes.InvalidArgument, "cannot send gift to yourself")
+	}
+	if in.GetGiftId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "gift_id is required")
+	}
+	maxQuantity := l.svcCtx.Config().Gift.MaxQuantity
+	if quantity < 0 || (maxQuantity > 0 && quantity > maxQuantity) {
+		return nil, status.Error(codes.InvalidArgument, "invalid quantity")
+	}
+
+	// 1. 查询礼物
+	var gift model.Gift
+	if err := l.svcCtx.DB().WithContext(l.ctx).Where("id = ?", in.GetGiftId()).First(&gift).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "gift not found")
+		}
+		helper.LogError(l.Logger, helper.OpSendGift, "query gift failed", err, map[string]interface{}{"gift_id": in.GetGiftId()})
+		return nil, status.Error(codes.Internal, "query gift failed")
+	}
+	if !gift.Enabled {
+		return nil, status.Error(codes.FailedPrecondition, "gift is not available")
+	}
+
+	amount := gift.Price * int64(quantity)
+
+	if err := ensureTransferTarget(l.ctx, l.svcCtx, receiverID); err != nil {
+		metrics.WalletTransferTotal.WithLabelValues("error", "gift").Inc()
+		return nil, err
+	}
+	// 存在拉黑关系时不能送礼（礼物附带附言）
+	if err := helper.CheckNotBlocked(l.ctx, l.svcCtx, senderID, receiverID, helper.BlockActionGift); err != nil {
+		metrics.WalletTransferTotal.WithLabelValues("error", "gift").Inc()
+		return nil, err
+	}
+
+	// 2. 风控：单笔/每日额度、每日次数
+	quota, err := helper.ReserveGiftQuota(l.ctx, l.svcCtx, l.Logger, senderID, amount)
+	if err != nil {
+		metrics.WalletTransferTotal.WithLabelValues("limited", "gift").Inc()
+		helper.LogWarning(l.Logger, helper.OpSendGift, "send gift limited", map[string]interface{}{
+			"sender_id": senderID,
+			"amount":    amount,
+			"error":     err.Error(),
+		})
+		return nil, err
+	}
+
+	bizOrderID := strings.TrimSpace(in.GetBizOrderId())
+	if bizOrderID == "" {
+		bizOrderID = helper.NewGiftBizOrderID("GF")
+	}
+	message := strings.TrimSpace(in.GetMessage())
+
+	// 3. 转账并写入送礼记录
+	walletService := helper.NewWalletServiceWithLock(l.svcCtx.DB(), l.svcCtx.DistributedLock)
+	result, err := walletService.Transfer(l.ctx, &helper.WalletTransferRequest{
+		FromUserID: senderID,
+		ToUserID:   receiverID,
+		Amount:     amount,
+		BizOrderID: bizOrderID,
+		Remark:     "gift:" + gift.Name,
+		Logger:     l.Logger,
+		AfterTransaction: func(tx *gorm.DB) error {
+			return tx.Create(&model.GiftRecord{
+				SenderID:   senderID,
+				ReceiverID: receiverID,
+				GiftID:     gift.ID,
+				GiftName:   gift.Name,
+				Quantity:   int(quantity),
+				Amount:     amount,
+				BizOrderID: bizOrderID,
+				Message:    message,
+			}).Error
+		},
+	})
+	if err != nil {
+		quota.Release(l.ctx)
+		metrics.WalletTransferTotal.WithLabelValues("error", "gift").Inc()
+		return nil, err
+	}
+
+	// 幂等重放不重复占用额度
+	if result.Duplicated {
+		quota.Release(l.ctx)
+	} else {
+		metrics.WalletTransferTotal.WithLabelValues("success", "gift").Inc()
+		metrics.WalletTransferAmount.WithLabelValues("gift").Observe(float64(amount))
+
+		clearWalletUserCache(l.svcCtx, l.Logger, senderID, receiverID)
+		helper.PublishGiftEvent(l.ctx, l.svcCtx, l.Logger, userMQ.EventTypeUserGift(), &userMQ.GiftSentPayload{
+			SenderID:   senderID,
+			ReceiverID: receiverID,
+			GiftID:     gift.ID,
+			GiftName:   gift.Name,
+			Quantity:   quantity,
+			Amount:     amount,
+			BizOrderID: bizOrderID,
+			Message:    message,
+			SentAt:     time.Now().Unix(),
+		})
+	}
+
+	helper.LogSuccess(l.Logger, helper.OpSendGift, map[string]interface{}{
+		"sender_id":    senderID,
+		"receiver_id":  receiverID,
+		"gift_id":      gift.ID,
+		"quantity":     quantity,
+		"amount":       amount,
+		"biz_order_id": bizOrderID,
+		"duplicated":   result.Duplicated,
+	})
+
+	return &user.SendGiftResponse{
+		Wallet:     result.ToWalletInfo(),
+		BizOrderId: bizOrderID,
+		Amount:     amount,
+	}, nil
+}
@@ -0,0 +1,137 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/metrics"
+	"SLGaming/back/services/user/internal/model"
+	userMQ "SLGaming/back/services/user/internal/mq"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SetCompanionTierOverrideLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetCompanionTierOverrideLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetCompanionTierOverrideLogic {
+	return &SetCompanionTierOverrideLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SetCompanionTierOverride 管理员指定陪玩等级（权限由网关 RBAC 控制）
+// tier>0 时立即生效并停止自动评估；tier=0 时取消指定，立即按当前窗口内的表现重新评估
+func (l *SetCompanionTierOverrideLogic) SetCompanionTierOverride(in *user.SetCompanionTierOverrideRequest) (*user.SetCompanionTierOverrideResponse, error) {
+	userID := in.GetUserId()
+	if userID == 0 || in.GetOperatorId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id and operator_id are required")
+	}
+	override := int(in.GetTier())
+	if override != 0 {
+		if _, ok := helper.CompanionTierLevel(l.svcCtx, override); !ok {
+			return nil, status.Error(codes.InvalidArgument, "invalid tier")
+		}
+	}
+	note := strings.TrimSpace(in.GetNote())
+	if len([]rune(note)) > 255 {
+		return nil, status.Error(codes.InvalidArgument, "note is too long")
+	}
+
+	// 记录指定时的表现，取消指定时据此重新评估
+	since := time.Now().Add(-l.svcCtx.Config().CompanionTier.Window)
+	all, err := helper.LoadCompanionTierStats(l.ctx, l.svcCtx.DB(), []uint64{userID}, since)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	stats := all[userID]
+	if stats == nil {
+		stats = &helper.CompanionTierStats{}
+	}
+	to, reason := override, model.TierReasonOverride
+	if override == 0 {
+		to, reason = helper.EvaluateCompanionTier(helper.CompanionTierLevels(l.svcCtx), stats), model.TierReasonOverrideCleared
+	}
+
+	var (
+		profile model.CompanionProfile
+		history *model.CompanionTierHistory
+		overCap []userMQ.TierOverCapPrice
+	)
+	err = l.svcCtx.DB().WithContext(l.ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+			Select("id, user_id, tier, tier_override").
+			Where("user_id = ?", userID).
+			First(&profile).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return status.Error(codes.NotFound, "companion profile not found")
+			}
+			return err
+		}
+		if profile.TierOverride == override && profile.Tier == to {
+			return nil
+		}
+
+		history = &model.CompanionTierHistory{
+			CompanionID:     userID,
+			FromTier:        profile.Tier,
+			ToTier:          to,
+			Reason:          reason,
+			CompletedOrders: stats.CompletedOrders,
+			Rating:          stats.Rating(),
+			CancelRate:      stats.CancelRate(),
+			DisputeRate:     stats.DisputeRate(),
+			OperatorID:      in.GetOperatorId(),
+			Note:            note,
+		}
+		var err error
+		if overCap, err = helper.ChangeCompanionTier(l.svcCtx, tx, history, &override); err != nil {
+			return err
+		}
+		profile.Tier, profile.TierOverride = to, override
+		return nil
+	})
+	if err != nil {
+		metrics.CompanionTierChangeTotal.WithLabelValues("override", "error").Inc()
+		if _, ok := status.FromError(err); ok {
+			return nil, err
+		}
+		helper.LogError(l.Logger, helper.OpCompanionTier, "set tier override failed", err, map[string]interface{}{
+			"user_id": userID,
+			"tier":    override,
+		})
+		return nil, status.Error(codes.Internal, "set tier override failed")
+	}
+
+	metrics.CompanionTierChangeTotal.WithLabelValues("override", "success").Inc()
+	if history != nil && history.FromTier != history.ToTier {
+		helper.PublishCompanionTierChanged(l.ctx, l.svcCtx, l.Logger, history, overCap)
+	}
+	helper.InvalidateCompanionList(l.svcCtx, l.Logger)
+	helper.LogInfo(l.Logger, helper.OpCompanionTier, "tier override updated", map[string]interface{}{
+		"user_id":     userID,
+		"operator_id": in.GetOperatorId(),
+		"tier":        profile.Tier,
+		"override":    override,
+	})
+
+	return &user.SetCompanionTierOverrideResponse{
+		Tier:       int32(profile.Tier),
+		TierName:   helper.CompanionTierName(l.svcCtx, profile.Tier),
+		Overridden: profile.TierOverride != 0,
+	}, nil
+}
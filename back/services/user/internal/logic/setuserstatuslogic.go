@@ -0,0 +1,142 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/metrics"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+// statusReasonMaxLen 禁言/封禁原因的最大长度（字符）
+const statusReasonMaxLen = 255
+
+type SetUserStatusLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetUserStatusLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetUserStatusLogic {
+	return &SetUserStatusLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SetUserStatus 管理员禁言、封禁或解除（权限由网关 RBAC 控制，撤销 Token 由网关完成）
+func (l *SetUserStatusLogic) SetUserStatus(in *user.SetUserStatusRequest) (*user.SetUserStatusResponse, error) {
+	userID := in.GetUserId()
+	if userID == 0 || in.GetOperatorId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id and operator_id are required")
+	}
+	if userID == in.GetOperatorId() {
+		return nil, status.Error(codes.InvalidArgument, "cannot change own status")
+	}
+
+	newStatus := int(in.GetStatus())
+	reason := strings.TrimSpace(in.GetReason())
+	if len([]rune(reason)) > statusReasonMaxLen {
+		return nil, status.Error(codes.InvalidArgument, "reason is too long")
+	}
+
+	now := time.Now()
+	var until *time.Time
+	switch newStatus {
+	case model.UserStatusActive:
+	case model.UserStatusMuted, model.UserStatusBanned:
+		if reason == "" {
+			return nil, status.Error(codes.InvalidArgument, "reason is required")
+		}
+		if in.GetUntil() > 0 {
+			t := time.Unix(in.GetUntil(), 0)
+			if !t.After(now) {
+				return nil, status.Error(codes.InvalidArgument, "until must be in the future")
+			}
+			until = &t
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "invalid status")
+	}
+
+	db := l.svcCtx.DB().WithContext(l.ctx)
+	var u model.User
+	if err := db.Where("id = ?", userID).First(&u).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "user not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if u.IsAdmin() {
+		return nil, status.Error(codes.PermissionDenied, "cannot change status of an admin")
+	}
+
+	previous := u.EffectiveStatus(now)
+	updates := map[string]interface{}{
+		"status":             newStatus,
+		"status_until":       until,
+		"status_reason":      reason,
+		"status_operator_id": in.GetOperatorId(),
+		"status_updated_at":  now,
+	}
+	if err := db.Model(&u).Updates(updates).Error; err != nil {
+		helper.LogError(l.Logger, helper.OpModeration, "update user status failed", err, map[string]interface{}{
+			"user_id": userID,
+		})
+		return nil, status.Error(codes.Internal, "update user status failed")
+	}
+	u.Status = newStatus
+	u.StatusUntil = until
+	u.StatusReason = reason
+	u.StatusOperatorID = in.GetOperatorId()
+	u.StatusUpdatedAt = &now
+
+	// 清除用户缓存，确保状态立即生效
+	if l.svcCtx.Redis != nil {
+		if _, err := l.svcCtx.Redis.DelCtx(l.ctx, GetUserCacheKey(int64(userID))); err != nil {
+			l.Logger.Errorf("delete user cache failed: %v", err)
+		}
+	}
+	// 封禁/解封会改变陪玩是否出现在列表中
+	if u.IsCompanion() {
+		helper.InvalidateCompanionList(l.svcCtx, l.Logger)
+	}
+
+	action := moderationAction(newStatus)
+	metrics.UserModerationTotal.WithLabelValues(action).Inc()
+	helper.LogInfo(l.Logger, helper.OpModeration, "user status changed", map[string]interface{}{
+		"user_id":         userID,
+		"operator_id":     in.GetOperatorId(),
+		"action":          action,
+		"previous_status": previous,
+		"until":           in.GetUntil(),
+		"reason":          reason,
+	})
+
+	return &user.SetUserStatusResponse{
+		User:           helper.ToUserInfo(&u),
+		PreviousStatus: int32(previous),
+	}, nil
+}
+
+func moderationAction(s int) string {
+	switch s {
+	case model.UserStatusMuted:
+		return "mute"
+	case model.UserStatusBanned:
+		return "ban"
+	default:
+		return "unban"
+	}
+}
@@ -0,0 +1,62 @@
+package logic
+
+import (
+	"context"
+	"errors"
+
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type SetVipAutoRenewLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSetVipAutoRenewLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SetVipAutoRenewLogic {
+	return &SetVipAutoRenewLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SetVipAutoRenew 开启/关闭会员自动续费
+func (l *SetVipAutoRenewLogic) SetVipAutoRenew(in *user.SetVipAutoRenewRequest) (*user.SetVipAutoRenewResponse, error) {
+	if in.GetUserId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	db := l.svcCtx.DB().WithContext(l.ctx)
+
+	var sub model.VipSubscription
+	if err := db.Where("user_id = ?", in.GetUserId()).First(&sub).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, status.Error(codes.NotFound, "vip subscription not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if !sub.IsActive() {
+		return nil, status.Error(codes.FailedPrecondition, "vip subscription is not active")
+	}
+
+	if err := db.Model(&sub).Update("auto_renew", in.GetAutoRenew()).Error; err != nil {
+		helper.LogError(l.Logger, helper.OpSubscribeVip, "update auto renew failed", err, map[string]interface{}{
+			"user_id": in.GetUserId(),
+		})
+		return nil, status.Error(codes.Internal, "update auto renew failed")
+	}
+	sub.AutoRenew = in.GetAutoRenew()
+
+	helper.InvalidateVipCache(l.svcCtx, l.Logger, in.GetUserId())
+
+	return &user.SetVipAutoRenewResponse{Subscription: helper.ToVipSubscriptionInfo(&sub)}, nil
+}
@@ -0,0 +1,172 @@
+package logic
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"strings"
+	"time"
+
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/metrics"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 身份证号：18位，最后一位可为 X
+var idCardNoPattern = regexp.MustCompile(`^\d{17}[\dXx]$`)
+
+// 技能证明截图最多张数
+const maxSkillProofs = 9
+
+type SubmitCompanionApplicationLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSubmitCompanionApplicationLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SubmitCompanionApplicationLogic {
+	return &SubmitCompanionApplicationLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SubmitCompanionApplication 提交陪玩入驻申请，进入待审核队列（审核通过前角色不变）
+// 老板和尚未认证的陪玩可以申请；同时只能有一条待审核申请，被驳回后需等待冷却期
+func (l *SubmitCompanionApplicationLogic) SubmitCompanionApplication(in *user.SubmitCompanionApplicationRequest) (*user.SubmitCompanionApplicationResponse, error) {
+	userID := in.GetUserId()
+	if userID == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	realName := strings.TrimSpace(in.GetRealName())
+	if n := len([]rune(realName)); n < 2 || n > 32 {
+		return nil, status.Error(codes.InvalidArgument, "invalid real_name")
+	}
+	idCardNo := strings.ToUpper(strings.TrimSpace(in.GetIdCardNo()))
+	if !idCardNoPattern.MatchString(idCardNo) {
+		return nil, status.Error(codes.InvalidArgument, "invalid id_card_no")
+	}
+	if !helper.IsValidMediaURL(in.GetIdCardFrontUrl()) || !helper.IsValidMediaURL(in.GetIdCardBackUrl()) {
+		return nil, status.Error(codes.InvalidArgument, "invalid id card photo url")
+	}
+	if len(in.GetSkillProofUrls()) == 0 || len(in.GetSkillProofUrls()) > maxSkillProofs {
+		return nil, status.Error(codes.InvalidArgument, "skill proof screenshots are required (at most 9)")
+	}
+	for _, u := range in.GetSkillProofUrls() {
+		if !helper.IsValidMediaURL(u) {
+			return nil, status.Error(codes.InvalidArgument, "invalid skill proof url")
+		}
+	}
+	if !helper.IsValidMediaURL(in.GetVoiceSampleUrl()) {
+		return nil, status.Error(codes.InvalidArgument, "invalid voice sample url")
+	}
+	bio := strings.TrimSpace(in.GetBio())
+	if len([]rune(bio)) > 255 {
+		return nil, status.Error(codes.InvalidArgument, "bio is too long")
+	}
+	// 新陪玩从最低等级开始，申请的技能价格不能超过该等级上限
+	prices := make([]int64, 0, len(in.GetSkills()))
+	for _, sk := range in.GetSkills() {
+		prices = append(prices, sk.GetPricePerHour())
+	}
+	if err := helper.CheckTierPriceCap(l.svcCtx, helper.LowestCompanionTier(l.svcCtx), prices...); err != nil {
+		return nil, err
+	}
+
+	cooldown := helper.ApplicationReapplyCooldown(l.svcCtx)
+	db := l.svcCtx.DB().WithContext(l.ctx)
+
+	var app *model.CompanionApplication
+	err := db.Transaction(func(tx *gorm.DB) error {
+		// 锁定用户行，串行化同一用户的并发提交
+		var u model.User
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("id = ?", userID).First(&u).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return status.Error(codes.NotFound, "user not found")
+			}
+			return err
+		}
+		if u.IsBanned() {
+			return status.Error(codes.PermissionDenied, "user is banned")
+		}
+		switch {
+		case u.IsAdmin():
+			return status.Error(codes.PermissionDenied, "admin cannot apply")
+		case u.IsCompanion():
+			var verified int64
+			if err := tx.Model(&model.CompanionProfile{}).
+				Where("user_id = ? AND is_verified = ?", userID, true).
+				Count(&verified).Error; err != nil {
+				return err
+			}
+			if verified > 0 {
+				return status.Error(codes.FailedPrecondition, "already a verified companion")
+			}
+		}
+
+		var last model.CompanionApplication
+		err := tx.Where("user_id = ?", userID).Order("created_at DESC").First(&last).Error
+		switch {
+		case err == nil:
+			if last.Status == model.CompanionApplicationPending {
+				return status.Error(codes.FailedPrecondition, "application already pending")
+			}
+			if reapplyAt := helper.ApplicationReapplyAt(&last, cooldown); time.Now().Before(reapplyAt) {
+				return status.Error(codes.ResourceExhausted, "reapply too soon")
+			}
+		case !errors.Is(err, gorm.ErrRecordNotFound):
+			return err
+		}
+
+		skills, err := helper.NormalizeCompanionSkills(tx, in.GetSkills())
+		if err != nil {
+			return err
+		}
+
+		app = &model.CompanionApplication{
+			UserID:         userID,
+			RealName:       realName,
+			IDCardNoMasked: helper.MaskIDCardNo(idCardNo),
+			IDCardFrontURL: in.GetIdCardFrontUrl(),
+			IDCardBackURL:  in.GetIdCardBackUrl(),
+			SkillProofURLs: helper.EncodeStringList(in.GetSkillProofUrls()),
+			VoiceSampleURL: in.GetVoiceSampleUrl(),
+			Skills:         helper.EncodeApplicationSkills(skills),
+			Bio:            bio,
+			Status:         model.CompanionApplicationPending,
+		}
+		return tx.Create(app).Error
+	})
+	if err != nil {
+		if _, ok := status.FromError(err); ok {
+			metrics.CompanionApplicationTotal.WithLabelValues("submit", "rejected").Inc()
+			return nil, err
+		}
+		helper.LogError(l.Logger, helper.OpCompanionApplication, "submit companion application failed", err, map[string]interface{}{
+			"user_id": userID,
+		})
+		metrics.CompanionApplicationTotal.WithLabelValues("submit", "error").Inc()
+		return nil, status.Error(codes.Internal, "submit companion application failed")
+	}
+
+	metrics.CompanionApplicationTotal.WithLabelValues("submit", "success").Inc()
+	helper.LogSuccess(l.Logger, helper.OpCompanionApplication, map[string]interface{}{
+		"action":         "submit",
+		"user_id":        userID,
+		"application_id": app.ID,
+	})
+
+	return &user.SubmitCompanionApplicationResponse{
+		Application: helper.ToCompanionApplicationInfo(app, false, cooldown),
+	}, nil
+}
@@ -0,0 +1,82 @@
+package logic
+
+import (
+	"context"
+	"strings"
+
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/metrics"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type SubscribeVipLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewSubscribeVipLogic(ctx context.Context, svcCtx *svc.ServiceContext) *SubscribeVipLogic {
+	return &SubscribeVipLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// SubscribeVip 使用帅币购买/续费会员
+func (l *SubscribeVipLogic) SubscribeVip(in *user.SubscribeVipRequest) (*user.SubscribeVipResponse, error) {
+	userID := in.GetUserId()
+	planCode := strings.TrimSpace(in.GetPlanCode())
+
+	helper.LogRequest(l.Logger, helper.OpSubscribeVip, map[string]interface{}{
+		"user_id":      userID,
+		"plan_code":    planCode,
+		"auto_renew":   in.GetAutoRenew(),
+		"biz_order_id": in.GetBizOrderId(),
+	})
+
+	if userID == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+	plan, ok := helper.FindVipPlan(l.svcCtx.Config().Vip, planCode)
+	if !ok {
+		metrics.VipSubscribeTotal.WithLabelValues("error", "purchase").Inc()
+		return nil, status.Error(codes.NotFound, "vip plan not found")
+	}
+
+	bizOrderID := strings.TrimSpace(in.GetBizOrderId())
+	if bizOrderID == "" {
+		bizOrderID = helper.NewGiftBizOrderID("VIP")
+	}
+
+	sub, result, err := helper.PurchaseVip(l.ctx, l.svcCtx, &helper.VipPurchaseRequest{
+		UserID:     userID,
+		Plan:       plan,
+		AutoRenew:  in.GetAutoRenew(),
+		BizOrderID: bizOrderID,
+		Logger:     l.Logger,
+	})
+	if err != nil {
+		metrics.VipSubscribeTotal.WithLabelValues("error", "purchase").Inc()
+		return nil, err
+	}
+
+	metrics.VipSubscribeTotal.WithLabelValues("success", "purchase").Inc()
+	helper.LogSuccess(l.Logger, helper.OpSubscribeVip, map[string]interface{}{
+		"user_id":      userID,
+		"plan_code":    plan.Code,
+		"level":        sub.Level,
+		"end_at":       sub.EndAt,
+		"biz_order_id": bizOrderID,
+	})
+
+	return &user.SubscribeVipResponse{
+		Subscription: helper.ToVipSubscriptionInfo(sub),
+		Wallet:       result.ToWalletInfo(),
+	}, nil
+}
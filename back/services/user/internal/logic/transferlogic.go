@@ -0,0 +1,164 @@
+package logic
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"SLGaming/back/services/user/internal/cache"
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/metrics"
+	"SLGaming/back/services/user/internal/model"
+	userMQ "SLGaming/back/services/user/internal/mq"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type TransferLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewTransferLogic(ctx context.Context, svcCtx *svc.ServiceContext) *TransferLogic {
+	return &TransferLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// Transfer 帅币打赏：转出方扣款、转入方加款在同一事务中完成
+func (l *TransferLogic) Transfer(in *user.TransferRequest) (*user.TransferResponse, error) {
+	fromID := in.GetFromUserId()
+	toID := in.GetToUserId()
+	amount := in.GetAmount()
+
+	helper.LogRequest(l.Logger, helper.OpTransfer, map[string]interface{}{
+		"from_user_id": fromID,
+		"to_user_id":   toID,
+		"amount":       amount,
+		"biz_order_id": in.GetBizOrderId(),
+	})
+
+	if fromID == 0 || toID == 0 {
+		return nil, status.Error(codes.InvalidArgument, "from_user_id and to_user_id are required")
+	}
+	if fromID == toID {
+		metrics.WalletTransferTotal.WithLabelValues("error", "tip").Inc()
+		return nil, status.Error(codes.InvalidArgument, "cannot transfer to yourself")
+	}
+	if amount <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "amount must be positive")
+	}
+
+	if err := ensureTransferTarget(l.ctx, l.svcCtx, toID); err != nil {
+		metrics.WalletTransferTotal.WithLabelValues("error", "tip").Inc()
+		return nil, err
+	}
+	// 存在拉黑关系时不能打赏（打赏附带留言）
+	if err := helper.CheckNotBlocked(l.ctx, l.svcCtx, fromID, toID, helper.BlockActionTransfer); err != nil {
+		metrics.WalletTransferTotal.WithLabelValues("error", "tip").Inc()
+		return nil, err
+	}
+
+	// 风控：单笔/每日额度、每日次数
+	quota, err := helper.ReserveGiftQuota(l.ctx, l.svcCtx, l.Logger, fromID, amount)
+	if err != nil {
+		metrics.WalletTransferTotal.WithLabelValues("limited", "tip").Inc()
+		helper.LogWarning(l.Logger, helper.OpTransfer, "transfer limited", map[string]interface{}{
+			"from_user_id": fromID,
+			"amount":       amount,
+			"error":        err.Error(),
+		})
+		return nil, err
+	}
+
+	bizOrderID := strings.TrimSpace(in.GetBizOrderId())
+	if bizOrderID == "" {
+		bizOrderID = helper.NewGiftBizOrderID("TP")
+	}
+	remark := strings.TrimSpace(in.GetRemark())
+
+	walletService := helper.NewWalletServiceWithLock(l.svcCtx.DB(), l.svcCtx.DistributedLock)
+	result, err := walletService.Transfer(l.ctx, &helper.WalletTransferRequest{
+		FromUserID: fromID,
+		ToUserID:   toID,
+		Amount:     amount,
+		BizOrderID: bizOrderID,
+		Remark:     remark,
+		Logger:     l.Logger,
+		AfterTransaction: func(tx *gorm.DB) error {
+			return tx.Create(&model.GiftRecord{
+				SenderID:   fromID,
+				ReceiverID: toID,
+				Quantity:   1,
+				Amount:     amount,
+				BizOrderID: bizOrderID,
+				Message:    remark,
+			}).Error
+		},
+	})
+	if err != nil {
+		quota.Release(l.ctx)
+		metrics.WalletTransferTotal.WithLabelValues("error", "tip").Inc()
+		return nil, err
+	}
+
+	// 幂等重放不重复占用额度
+	if result.Duplicated {
+		quota.Release(l.ctx)
+	} else {
+		metrics.WalletTransferTotal.WithLabelValues("success", "tip").Inc()
+		metrics.WalletTransferAmount.WithLabelValues("tip").Observe(float64(amount))
+
+		clearWalletUserCache(l.svcCtx, l.Logger, fromID, toID)
+		helper.PublishGiftEvent(l.ctx, l.svcCtx, l.Logger, userMQ.EventTypeUserTip(), &userMQ.GiftSentPayload{
+			SenderID:   fromID,
+			ReceiverID: toID,
+			Quantity:   1,
+			Amount:     amount,
+			BizOrderID: bizOrderID,
+			Message:    remark,
+			SentAt:     time.Now().Unix(),
+		})
+	}
+
+	return &user.TransferResponse{
+		Wallet:     result.ToWalletInfo(),
+		BizOrderId: bizOrderID,
+	}, nil
+}
+
+// ensureTransferTarget 校验转入方用户存在
+func ensureTransferTarget(ctx context.Context, svcCtx *svc.ServiceContext, userID uint64) error {
+	if svcCtx.BloomFilter != nil {
+		if exists, err := svcCtx.BloomFilter.UserID.MightContain(ctx, int64(userID)); err == nil && !exists {
+			return status.Error(codes.NotFound, "target user not found")
+		}
+	}
+
+	var count int64
+	if err := svcCtx.DB().WithContext(ctx).Model(&model.User{}).Where("id = ?", userID).Count(&count).Error; err != nil {
+		return status.Error(codes.Internal, "check target user failed")
+	}
+	if count == 0 {
+		return status.Error(codes.NotFound, "target user not found")
+	}
+	return nil
+}
+
+// clearWalletUserCache 清除双方用户缓存，确保余额立即更新
+func clearWalletUserCache(svcCtx *svc.ServiceContext, logger logx.Logger, userIDs ...uint64) {
+	for _, id := range userIDs {
+		if err := svcCtx.CacheManager.Delete(fmt.Sprintf(cache.UserInfoKey, id)); err != nil {
+			logger.Errorf("delete user cache failed: user_id=%d, err=%v", id, err)
+		}
+	}
+}
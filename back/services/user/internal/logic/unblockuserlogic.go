@@ -0,0 +1,60 @@
+package logic
+
+import (
+	"context"
+
+	"SLGaming/back/services/user/internal/metrics"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type UnblockUserLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUnblockUserLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnblockUserLogic {
+	return &UnblockUserLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UnblockUser 取消拉黑；已解除的关注关系不会恢复
+func (l *UnblockUserLogic) UnblockUser(in *user.UnblockUserRequest) (*user.UnblockUserResponse, error) {
+	if in.OperatorId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "operator_id is required")
+	}
+	if in.UserId == 0 {
+		return nil, status.Error(codes.InvalidArgument, "user_id is required")
+	}
+
+	// 物理删除，保证之后可以再次拉黑（唯一索引不区分软删除）
+	result := l.svcCtx.DB().WithContext(l.ctx).Unscoped().
+		Where("blocker_id = ? AND blocked_id = ?", in.OperatorId, in.UserId).
+		Delete(&model.BlockRelation{})
+	if result.Error != nil {
+		l.Errorf("delete block relation failed: %v", result.Error)
+		metrics.BlockTotal.WithLabelValues("error", "unblock").Inc()
+		return nil, status.Error(codes.Internal, "unblock failed")
+	}
+	if result.RowsAffected == 0 {
+		metrics.BlockTotal.WithLabelValues("not_found", "unblock").Inc()
+		return nil, status.Error(codes.NotFound, "you haven't blocked this user")
+	}
+
+	l.Infof("user %d unblocked user %d", in.OperatorId, in.UserId)
+	metrics.BlockTotal.WithLabelValues("success", "unblock").Inc()
+
+	return &user.UnblockUserResponse{
+		Success: true,
+		Message: "unblock user success",
+	}, nil
+}
@@ -0,0 +1,59 @@
+package logic
+
+import (
+	"context"
+	"strings"
+
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/metrics"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+type UnlockLoginLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUnlockLoginLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UnlockLoginLogic {
+	return &UnlockLoginLogic{
+		ctx:    ctx,
+		svcCtx: svcCtx,
+		Logger: logx.WithContext(ctx),
+	}
+}
+
+// UnlockLogin 管理员解除密码登录锁定（权限由网关 RBAC 控制）
+func (l *UnlockLoginLogic) UnlockLogin(in *user.UnlockLoginRequest) (*user.UnlockLoginResponse, error) {
+	phone := strings.TrimSpace(in.GetPhone())
+	ip := strings.TrimSpace(in.GetIp())
+	if phone == "" && ip == "" {
+		return nil, status.Error(codes.InvalidArgument, "phone or ip is required")
+	}
+
+	guard := helper.NewLoginGuard(l.svcCtx.Redis, l.svcCtx.Config().LoginProtection)
+	wasLocked, err := guard.Unlock(l.ctx, phone, ip)
+	if err != nil {
+		helper.LogError(l.Logger, helper.OpUnlockLogin, "unlock login failed", err, map[string]interface{}{
+			"phone": phone,
+			"ip":    ip,
+		})
+		return nil, status.Error(codes.Internal, "unlock login failed")
+	}
+
+	if wasLocked {
+		metrics.LoginProtectionTotal.WithLabelValues("unlocked_by_admin", "admin").Inc()
+	}
+	helper.LogSuccess(l.Logger, helper.OpUnlockLogin, map[string]interface{}{
+		"phone":      phone,
+		"ip":         ip,
+		"was_locked": wasLocked,
+	})
+
+	return &user.UnlockLoginResponse{WasLocked: wasLocked}, nil
+}
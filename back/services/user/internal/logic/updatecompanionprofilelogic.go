@@ -16,6 +16,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type UpdateCompanionProfileLogic struct {
@@ -70,6 +71,7 @@ func (l *UpdateCompanionProfileLogic) UpdateCompanionProfile(in *user.UpdateComp
 				GameSkills:   "[]",
 				PricePerHour: 0,
 				Status:       model.CompanionStatusOffline,
+				Tier:         helper.LowestCompanionTier(l.svcCtx),
 				Rating:       0,
 				TotalOrders:  0,
 				IsVerified:   false,
@@ -83,29 +85,87 @@ func (l *UpdateCompanionProfileLogic) UpdateCompanionProfile(in *user.UpdateComp
 	}
 
 	// 更新字段
+	oldStatus := profile.Status
+	oldPrice := profile.PricePerHour
 	updates := map[string]any{}
 
-	if gameSkill := strings.TrimSpace(in.GetGameSkill()); gameSkill != "" {
-		updates["game_skills"] = gameSkill
-	}
-
-	if in.GetPricePerHour() > 0 {
-		updates["price_per_hour"] = in.GetPricePerHour()
-	}
-
 	if in.GetStatus() >= 0 {
 		statusVal := int(in.GetStatus())
 		if statusVal != model.CompanionStatusOffline && statusVal != model.CompanionStatusOnline && statusVal != model.CompanionStatusBusy {
 			return nil, status.Error(codes.InvalidArgument, "invalid status")
 		}
-		updates["status"] = statusVal
+		// 忙碌状态由进行中的订单数决定（见 helper.TrackCompanionOrder），不能手动切换
+		if statusVal == model.CompanionStatusBusy && profile.Status != model.CompanionStatusBusy {
+			return nil, status.Error(codes.InvalidArgument, "busy status is managed by orders")
+		}
+		if statusVal != model.CompanionStatusBusy {
+			updates["status"] = statusVal
+		}
 	}
 
-	if len(updates) > 0 {
-		// 使用明确的 WHERE 条件更新，避免 GORM 报错
-		if err := db.Model(&model.CompanionProfile{}).Where("user_id = ?", userID).Updates(updates).Error; err != nil {
-			l.Errorf("update companion profile failed: user_id=%d, error=%v", userID, err)
+	// 技能与价格：skills 非空时整体替换；只传 game_skill 时替换为该单个游戏；只传价格时统一修改所有技能的价格
+	gameSkill := strings.TrimSpace(in.GetGameSkill())
+	skillsChanged := len(in.GetSkills()) > 0 || gameSkill != "" || in.GetPricePerHour() > 0
+
+	// 价格不能超过当前等级的上限
+	prices := []int64{in.GetPricePerHour()}
+	for _, sk := range in.GetSkills() {
+		prices = append(prices, sk.GetPricePerHour())
+	}
+	if err := helper.CheckTierPriceCap(l.svcCtx, profile.Tier, prices...); err != nil {
+		metrics.CompanionProfileUpdateTotal.WithLabelValues("error").Inc()
+		return nil, err
+	}
+
+	if skillsChanged || len(updates) > 0 {
+		err := db.Transaction(func(tx *gorm.DB) error {
+			switch {
+			case len(in.GetSkills()) > 0:
+				if _, err := helper.ReplaceCompanionSkills(l.ctx, tx, userID, in.GetSkills()); err != nil {
+					return err
+				}
+			case gameSkill != "":
+				price := in.GetPricePerHour()
+				if price <= 0 {
+					price = profile.PricePerHour
+				}
+				if _, err := helper.ReplaceCompanionSkills(l.ctx, tx, userID, []*user.CompanionSkill{
+					{GameName: gameSkill, PricePerHour: price},
+				}); err != nil {
+					return err
+				}
+			case in.GetPricePerHour() > 0:
+				if err := helper.SetCompanionSkillPrice(l.ctx, tx, userID, in.GetPricePerHour()); err != nil {
+					return err
+				}
+			}
+
+			if len(updates) > 0 {
+				// 忙碌期间切换的状态记为忙碌前状态，订单结束后生效；加锁读取避免与订单事件并发覆盖
+				var current model.CompanionProfile
+				if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).
+					Select("id, status").
+					Where("user_id = ?", userID).
+					First(&current).Error; err != nil {
+					return err
+				}
+				if statusVal, ok := updates["status"]; ok && current.Status == model.CompanionStatusBusy {
+					delete(updates, "status")
+					updates["status_before_busy"] = statusVal
+				}
+				// 使用明确的 WHERE 条件更新，避免 GORM 报错
+				if err := tx.Model(&model.CompanionProfile{}).Where("user_id = ?", userID).Updates(updates).Error; err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+		if err != nil {
 			metrics.CompanionProfileUpdateTotal.WithLabelValues("error").Inc()
+			if _, ok := status.FromError(err); ok {
+				return nil, err
+			}
+			l.Errorf("update companion profile failed: user_id=%d, error=%v", userID, err)
 			return nil, status.Error(codes.Internal, err.Error())
 		}
 		// 重新查询获取最新数据
@@ -114,11 +174,43 @@ func (l *UpdateCompanionProfileLogic) UpdateCompanionProfile(in *user.UpdateComp
 			metrics.CompanionProfileUpdateTotal.WithLabelValues("error").Inc()
 			return nil, status.Error(codes.Internal, err.Error())
 		}
+		// 状态、技能和价格都会影响列表筛选结果
+		helper.InvalidateCompanionList(l.svcCtx, l.Logger)
+		helper.PublishPriceActivity(l.ctx, l.svcCtx, l.Logger, userID, oldPrice, profile.PricePerHour)
+
+		if statusVal, ok := updates["status"].(int); ok {
+			l.syncPresence(userID, oldStatus, statusVal)
+		}
+	}
+
+	info := helper.ToCompanionInfo(&profile)
+	info.TierName = helper.CompanionTierName(l.svcCtx, profile.Tier)
+	if err := helper.AttachCompanionSkills(l.ctx, db, info); err != nil {
+		l.Errorf("load companion skills failed: user_id=%d, error=%v", userID, err)
+		metrics.CompanionProfileUpdateTotal.WithLabelValues("error").Inc()
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	metrics.CompanionProfileUpdateTotal.WithLabelValues("success").Inc()
 
 	return &user.UpdateCompanionProfileResponse{
-		Profile: helper.ToCompanionInfo(&profile),
+		Profile: info,
 	}, nil
 }
+
+// syncPresence 手动切换状态后同步心跳记录：上线/忙碌视为一次心跳，下线清除心跳；状态有变化时发布事件
+func (l *UpdateCompanionProfileLogic) syncPresence(userID uint64, oldStatus, newStatus int) {
+	var err error
+	if newStatus == model.CompanionStatusOffline {
+		err = helper.ClearPresence(l.ctx, l.svcCtx, userID)
+	} else {
+		err = helper.TouchPresence(l.ctx, l.svcCtx, userID, newStatus)
+	}
+	if err != nil {
+		helper.LogError(l.Logger, helper.OpCompanionPresence, "sync presence failed", err, map[string]interface{}{
+			"user_id": userID,
+			"status":  newStatus,
+		})
+	}
+	helper.PublishCompanionStatusChanged(l.ctx, l.svcCtx, l.Logger, userID, oldStatus, newStatus, helper.PresenceReasonManual)
+}
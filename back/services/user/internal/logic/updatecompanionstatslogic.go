@@ -63,6 +63,9 @@ func (l *UpdateCompanionStatsLogic) UpdateCompanionStats(in *user.UpdateCompanio
 	if p.TotalOrders > 0 {
 		p.Rating = (p.Rating*float64(oldOrders) + in.GetNewRating()*float64(in.GetDeltaOrders())) / float64(p.TotalOrders)
 	}
+	// 贝叶斯评分：单数少时向先验评分收缩，用于评分排名与列表排序
+	p.RatingScore = helper.RatingScore(l.svcCtx.Config().RatingScore, p.Rating, p.TotalOrders)
+	p.RankScore = helper.CompanionRankScore(l.svcCtx, p.RatingScore, p.Tier)
 
 	if err := db.Save(&p).Error; err != nil {
 		helper.LogError(l.Logger, helper.OpUpdateCompanionStats, "update companion stats failed", err, map[string]interface{}{
@@ -71,30 +74,57 @@ func (l *UpdateCompanionStatsLogic) UpdateCompanionStats(in *user.UpdateCompanio
 		return nil, status.Error(codes.Internal, "update companion stats failed")
 	}
 
+	// 评分和接单数参与列表筛选与排序
+	helper.InvalidateCompanionList(l.svcCtx, l.Logger)
+
 	// 更新 Redis 排名 ZSet（只维护前100名）
 	if l.svcCtx.Redis != nil {
-		l.updateRankingZSet(p.UserID, p.Rating, p.TotalOrders)
+		l.updateRankingZSet(p.UserID, p.RatingScore, p.TotalOrders)
+	}
+
+	// 记录评价事件，计入周期榜/游戏榜的评分排名（按订单幂等）
+	if in.GetOrderId() > 0 {
+		event := &model.CompanionRankingEvent{
+			OrderID:     in.GetOrderId(),
+			Kind:        model.RankingEventRated,
+			CompanionID: p.UserID,
+			GameName:    in.GetGameName(),
+			Rating:      in.GetNewRating(),
+		}
+		if err := helper.RecordRankingEvent(l.ctx, l.svcCtx, l.Logger, event); err != nil {
+			helper.LogError(l.Logger, helper.OpUpdateCompanionStats, "record rated ranking event failed", err, map[string]interface{}{
+				"user_id":  p.UserID,
+				"order_id": in.GetOrderId(),
+			})
+		}
 	}
 
 	// 记录成功日志
 	helper.LogSuccess(l.Logger, helper.OpUpdateCompanionStats, map[string]interface{}{
 		"user_id":      p.UserID,
 		"new_rating":   p.Rating,
+		"rating_score": p.RatingScore,
 		"total_orders": p.TotalOrders,
 	})
 
+	info := helper.ToCompanionInfo(&p)
+	info.TierName = helper.CompanionTierName(l.svcCtx, p.Tier)
+	if err := helper.AttachCompanionSkills(l.ctx, l.svcCtx.DB(), info); err != nil {
+		// 统计已更新成功，技能查询失败不影响返回
+		l.Errorf("load companion skills failed: user_id=%d, error=%v", p.UserID, err)
+	}
+
 	return &user.UpdateCompanionStatsResponse{
-		Profile: helper.ToCompanionInfo(&p),
+		Profile: info,
 	}, nil
 }
 
 // updateRankingZSet 更新排行榜ZSet（只维护前100名）
-func (l *UpdateCompanionStatsLogic) updateRankingZSet(userID uint64, rating float64, totalOrders int64) {
+func (l *UpdateCompanionStatsLogic) updateRankingZSet(userID uint64, ratingScore float64, totalOrders int64) {
 	userIDStr := strconv.FormatUint(userID, 10)
 
-	// 更新评分排名
-	ratingScore := int64(rating * 10000)
-	l.updateZSet("ranking:rating", ratingScore, userIDStr)
+	// 更新评分排名（按贝叶斯评分）
+	l.updateZSet("ranking:rating", int64(ratingScore*10000), userIDStr)
 
 	// 更新接单数排名
 	l.updateZSet("ranking:orders", totalOrders, userIDStr)
@@ -0,0 +1,80 @@
+package logic
+
+import (
+	"context"
+	"strings"
+
+	"SLGaming/back/services/user/internal/helper"
+	"SLGaming/back/services/user/internal/model"
+	"SLGaming/back/services/user/internal/svc"
+	"SLGaming/back/services/user/user"
+
+	"github.com/zeromicro/go-zero/core/logx"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"gorm.io/gorm"
+)
+
+type UpdateGiftLogic struct {
+	ctx    context.Context
+	svcCtx *svc.ServiceContext
+	logx.Logger
+}
+
+func NewUpdateGiftLogic(ctx context.Context, svcCtx *svc.ServiceContext) *UpdateGiftLogic {
+	return &UpdateGiftLogic{ctx: ctx, svcCtx: svcCtx, Logger: logx.WithContext(ctx)}
+}
+
+func (l *UpdateGiftLogic) UpdateGift(in *user.UpdateGiftRequest) (*user.UpdateGiftResponse, error) {
+	if in.GetId() == 0 {
+		return nil, status.Error(codes.InvalidArgument, "id is required")
+	}
+	if in.GetPrice() < 0 {
+		return nil, status.Error(codes.InvalidArgument, "price must be positive")
+	}
+
+	db := l.svcCtx.DB().WithContext(l.ctx)
+
+	var gift model.Gift
+	if err := db.First(&gift, "id = ?", in.GetId()).Error; err != nil {
+		if err == gorm.ErrRecordNotFound {
+			return nil, status.Error(codes.NotFound, "gift not found")
+		}
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	// 若改名，需检查唯一
+	if name := strings.TrimSpace(in.GetName()); name != "" && name != gift.Name {
+		var count int64
+		if err := db.Model(&model.Gift{}).Where("name = ?", name).Count(&count).Error; err != nil {
+			l.Errorf("check duplicate on update failed: %v", err)
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if count > 0 {
+			return nil, status.Error(codes.AlreadyExists, "gift already exists")
+		}
+		gift.Name = name
+	}
+	if in.GetPrice() > 0 {
+		gift.Price = in.GetPrice()
+	}
+	if iconURL := strings.TrimSpace(in.GetIconUrl()); iconURL != "" {
+		gift.IconURL = iconURL
+	}
+	gift.Sort = int(in.GetSort())
+	gift.Enabled = in.GetEnabled()
+
+	if err := db.Save(&gift).Error; err != nil {
+		l.Errorf("update gift failed: %v", err)
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	helper.LogSuccess(l.Logger, helper.OpManageGift, map[string]interface{}{
+		"action":  "update",
+		"gift_id": gift.ID,
+		"price":   gift.Price,
+		"enabled": gift.Enabled,
+	})
+
+	return &user.UpdateGiftResponse{Gift: helper.ToGiftInfo(&gift)}, nil
+}
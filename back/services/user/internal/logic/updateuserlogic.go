@@ -5,6 +5,7 @@ import (
 	"errors"
 	"strconv"
 	"strings"
+	"time"
 
 	"SLGaming/back/services/user/internal/helper"
 	"SLGaming/back/services/user/internal/model"
@@ -97,6 +98,24 @@ func (l *UpdateUserLogic) UpdateUser(in *user.UpdateUserRequest) (*user.UpdateUs
 		updates["bio"] = bio
 	}
 
+	// 更新性别
+	if in.GetGender() != 0 {
+		gender := int(in.GetGender())
+		if gender != model.GenderMale && gender != model.GenderFemale {
+			return nil, status.Error(codes.InvalidArgument, "invalid gender")
+		}
+		updates["gender"] = gender
+	}
+
+	// 更新生日
+	if raw := strings.TrimSpace(in.GetBirthday()); raw != "" {
+		birthday, ok := helper.ParseBirthday(raw, time.Now())
+		if !ok {
+			return nil, status.Error(codes.InvalidArgument, "invalid birthday")
+		}
+		updates["birthday"] = birthday
+	}
+
 	if len(updates) > 0 {
 		if err := db.Model(&u).Updates(updates).Error; err != nil {
 			return nil, status.Error(codes.Internal, err.Error())
@@ -114,6 +133,10 @@ func (l *UpdateUserLogic) UpdateUser(in *user.UpdateUserRequest) (*user.UpdateUs
 				l.Logger.Infof("user cache deleted successfully: %s", cacheKey)
 			}
 		}
+		// 陪玩的昵称、简介、性别等会展示在列表中并参与筛选
+		if u.IsCompanion() || updates["role"] != nil {
+			helper.InvalidateCompanionList(l.svcCtx, l.Logger)
+		}
 	}
 
 	return &user.UpdateUserResponse{
@@ -30,6 +30,42 @@ var (
 		[]string{"type"},
 	)
 
+	// LoginProtectionTotal 防暴力破解事件：failure / delayed / locked / blocked / unlocked_by_code / unlocked_by_admin
+	LoginProtectionTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "user_login_protection_total",
+			Help: "Total number of login brute-force protection events",
+		},
+		[]string{"event", "scope"},
+	)
+
+	// LoginNewDeviceTotal 来自新设备或新地区的登录（已发出安全提醒）
+	LoginNewDeviceTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "user_login_new_device_total",
+			Help: "Total number of logins from a new device or IP region",
+		},
+		[]string{"method"},
+	)
+
+	// UserModerationTotal 管理员设置账号状态：mute / ban / unban
+	UserModerationTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "user_moderation_total",
+			Help: "Total number of admin moderation actions",
+		},
+		[]string{"action"},
+	)
+
+	// VerifyTicketTotal 验证码票据校验结果：purpose × result（consumed / missing / invalid / expired / mismatch / used / error）
+	VerifyTicketTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "user_verify_ticket_total",
+			Help: "Total number of verify ticket checks by purpose and result",
+		},
+		[]string{"purpose", "result"},
+	)
+
 	WalletRechargeTotal = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "wallet_recharge_total",
@@ -62,6 +98,38 @@ var (
 		},
 	)
 
+	WalletTransferTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "wallet_transfer_total",
+			Help: "Total number of wallet transfer operations (tips and gifts)",
+		},
+		[]string{"status", "type"},
+	)
+
+	WalletTransferAmount = prometheus.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Name:    "wallet_transfer_amount",
+			Help:    "Amount of wallet transfer operations (tips and gifts)",
+			Buckets: []float64{10, 50, 100, 200, 500, 1000, 2000, 5000},
+		},
+		[]string{"type"},
+	)
+
+	VipSubscribeTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "vip_subscribe_total",
+			Help: "Total number of VIP purchases and renewals",
+		},
+		[]string{"status", "type"},
+	)
+
+	VipExpiredTotal = prometheus.NewCounter(
+		prometheus.CounterOpts{
+			Name: "vip_expired_total",
+			Help: "Total number of VIP subscriptions expired by the scheduled job",
+		},
+	)
+
 	FollowTotal = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "user_follow_total",
@@ -103,6 +171,95 @@ var (
 		[]string{"type"},
 	)
 
+	// CompanionApplicationTotal 陪玩入驻申请：submit / approve / reject 及结果
+	CompanionApplicationTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "companion_application_total",
+			Help: "Total number of companion application submissions and reviews",
+		},
+		[]string{"action", "status"},
+	)
+
+	// CompanionListCacheTotal 陪玩列表缓存：hit / miss / skip（不缓存的筛选组合）
+	CompanionListCacheTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "companion_list_cache_total",
+			Help: "Total number of companion list cache lookups by result",
+		},
+		[]string{"result"},
+	)
+
+	// CompanionPresenceTotal 陪玩在线心跳：heartbeat（success/offline/error）与超时自动离线（timeout）
+	CompanionPresenceTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "companion_presence_total",
+			Help: "Total number of companion heartbeats and presence timeouts",
+		},
+		[]string{"action", "status"},
+	)
+
+	// CompanionOrderStatusTotal 订单驱动的陪玩状态变更：busy / restored / error，对账修正为 reconciled
+	CompanionOrderStatusTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "companion_order_status_total",
+			Help: "Total number of companion status changes driven by order events",
+		},
+		[]string{"result"},
+	)
+
+	// CompanionTierChangeTotal 陪玩等级变更：source=evaluation/override，result=upgrade/downgrade/success/error
+	CompanionTierChangeTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "companion_tier_change_total",
+			Help: "Total number of companion tier changes",
+		},
+		[]string{"source", "result"},
+	)
+
+	// FeedEventTotal 陪玩动态：kind=online/review/price/post，delivery=push（写扩散）/pull（大 V 读时拉取）/duplicate（重复投递）
+	FeedEventTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "feed_event_total",
+			Help: "Total number of companion feed events",
+		},
+		[]string{"kind", "delivery"},
+	)
+
+	// FeedReadTotal 动态流读取：result=hit/rebuild/fallback（Redis 不可用时全部从 MySQL 拉取）/error
+	FeedReadTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "feed_read_total",
+			Help: "Total number of feed reads",
+		},
+		[]string{"result"},
+	)
+
+	// BlockTotal 拉黑操作：action=block/unblock，status=success/duplicate/not_found/error
+	BlockTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "user_block_total",
+			Help: "Total number of block operations",
+		},
+		[]string{"status", "action"},
+	)
+
+	// BlockRejectTotal 因拉黑关系被拒绝的操作：action=follow/transfer/gift
+	BlockRejectTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "user_block_reject_total",
+			Help: "Total number of operations rejected by block relations",
+		},
+		[]string{"action"},
+	)
+
+	RankingWindowRebuildTotal = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "ranking_window_rebuild_total",
+			Help: "Total number of period/game ranking window rebuilds from MySQL",
+		},
+		[]string{"type", "period", "status"},
+	)
+
 	RedisOperationTotal = prometheus.NewCounterVec(
 		prometheus.CounterOpts{
 			Name: "user_redis_operation_total",
@@ -133,15 +290,33 @@ func init() {
 	prometheus.MustRegister(UserRegisterTotal)
 	prometheus.MustRegister(UserLoginTotal)
 	prometheus.MustRegister(UserLoginDuration)
+	prometheus.MustRegister(LoginProtectionTotal)
+	prometheus.MustRegister(LoginNewDeviceTotal)
+	prometheus.MustRegister(UserModerationTotal)
+	prometheus.MustRegister(VerifyTicketTotal)
 	prometheus.MustRegister(WalletRechargeTotal)
 	prometheus.MustRegister(WalletRechargeAmount)
 	prometheus.MustRegister(WalletConsumeTotal)
 	prometheus.MustRegister(WalletConsumeAmount)
+	prometheus.MustRegister(WalletTransferTotal)
+	prometheus.MustRegister(WalletTransferAmount)
+	prometheus.MustRegister(VipSubscribeTotal)
+	prometheus.MustRegister(VipExpiredTotal)
 	prometheus.MustRegister(FollowTotal)
 	prometheus.MustRegister(CompanionApplyTotal)
 	prometheus.MustRegister(CompanionProfileUpdateTotal)
 	prometheus.MustRegister(RankingQueryTotal)
 	prometheus.MustRegister(RankingQueryDuration)
+	prometheus.MustRegister(RankingWindowRebuildTotal)
+	prometheus.MustRegister(CompanionApplicationTotal)
+	prometheus.MustRegister(CompanionListCacheTotal)
+	prometheus.MustRegister(CompanionPresenceTotal)
+	prometheus.MustRegister(CompanionOrderStatusTotal)
+	prometheus.MustRegister(CompanionTierChangeTotal)
+	prometheus.MustRegister(FeedEventTotal)
+	prometheus.MustRegister(FeedReadTotal)
+	prometheus.MustRegister(BlockTotal)
+	prometheus.MustRegister(BlockRejectTotal)
 	prometheus.MustRegister(RedisOperationTotal)
 	prometheus.MustRegister(DbQueryDuration)
 	prometheus.MustRegister(MqMessageTotal)
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"SLGaming/back/pkg/snowflake"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// BlockRelation 拉黑关系表
+// 拉黑对双方生效：存在任一方向的拉黑关系时，双方不能互相关注、下单、打赏或送礼
+type BlockRelation struct {
+	BaseModel
+
+	// 拉黑者ID
+	BlockerID uint64 `gorm:"not null;index:idx_blocker_blocked,unique;index:idx_blocker_blocked_at" json:"blocker_id"`
+
+	// 被拉黑者ID
+	BlockedID uint64 `gorm:"not null;index:idx_blocker_blocked,unique;index:idx_blocked_id" json:"blocked_id"`
+
+	// 拉黑时间
+	BlockedAt time.Time `gorm:"autoCreateTime;index:idx_blocker_blocked_at" json:"blocked_at"`
+}
+
+func (BlockRelation) TableName() string {
+	return "block_relations"
+}
+
+// BeforeCreate 创建前钩子
+func (br *BlockRelation) BeforeCreate(tx *gorm.DB) error {
+	// 生成雪花主键
+	if br.ID == 0 {
+		br.ID = uint64(snowflake.GenID())
+	}
+	return nil
+}
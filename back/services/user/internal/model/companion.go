@@ -12,19 +12,38 @@ type CompanionProfile struct {
 	GameSkills string `gorm:"type:text;comment:游戏技能列表(JSON)" json:"game_skills"`
 
 	// 每小时价格（单位：帅币）
-	PricePerHour int64 `gorm:"not null;default:0;comment:每小时价格(帅币)" json:"price_per_hour"`
+	PricePerHour int64 `gorm:"not null;default:0;index;comment:每小时价格(帅币)" json:"price_per_hour"`
 
 	// 陪玩状态：0=离线, 1=在线, 2=忙碌
-	Status int `gorm:"not null;default:0;index;comment:状态(0=离线,1=在线,2=忙碌)" json:"status"`
+	// 与 RatingScore / TotalOrders / RankScore 组成联合索引，覆盖列表"按状态筛选 + 排序"的查询
+	Status int `gorm:"not null;default:0;index;index:idx_companion_status_score,priority:1;index:idx_companion_status_orders,priority:1;index:idx_companion_status_rank,priority:1;comment:状态(0=离线,1=在线,2=忙碌)" json:"status"`
 
 	// 评分（0-5分，保留2位小数）
-	Rating float64 `gorm:"type:decimal(3,2);not null;default:0;comment:评分(0-5)" json:"rating"`
+	Rating float64 `gorm:"type:decimal(3,2);not null;default:0;index;comment:评分(0-5)" json:"rating"`
+
+	// 贝叶斯评分：按接单数向先验评分收缩后的得分，用于评分排名与列表排序（见 helper.RatingScore）
+	RatingScore float64 `gorm:"type:decimal(6,4);not null;default:0;index;index:idx_companion_status_score,priority:2;comment:贝叶斯评分" json:"rating_score"`
 
 	// 总接单数
-	TotalOrders int64 `gorm:"not null;default:0;comment:总接单数" json:"total_orders"`
+	TotalOrders int64 `gorm:"not null;default:0;index:idx_companion_status_orders,priority:2;index:idx_companion_status_rank,priority:3;comment:总接单数" json:"total_orders"`
 
 	// 是否认证（平台认证的陪玩）
 	IsVerified bool `gorm:"not null;default:false;index;comment:是否认证" json:"is_verified"`
+
+	// 进行中的订单数（已接单/服务中），由订单事件维护，见 companion_active_orders
+	ActiveOrders int `gorm:"not null;default:0;comment:进行中订单数" json:"active_orders"`
+
+	// 因订单进入忙碌前的状态，订单数回落后恢复为该状态
+	StatusBeforeBusy int `gorm:"not null;default:1;comment:忙碌前状态" json:"status_before_busy"`
+
+	// 陪玩等级（见 config.CompanionTierConf），由评估任务按近期表现计算，决定价格上限、抽成比例与排序加权
+	Tier int `gorm:"not null;default:1;index;comment:陪玩等级" json:"tier"`
+
+	// 管理员指定的等级，非 0 时评估任务不再调整该陪玩的等级
+	TierOverride int `gorm:"not null;default:0;comment:管理员指定等级(0=按评估)" json:"tier_override"`
+
+	// 列表默认排序得分：贝叶斯评分 + 等级加权（见 helper.CompanionRankScore），评分或等级变化时更新，等级评估任务每轮按配置校正
+	RankScore float64 `gorm:"type:decimal(6,4);not null;default:0;index:idx_companion_status_rank,priority:2;comment:排序得分(评分+等级加权)" json:"rank_score"`
 }
 
 func (c *CompanionProfile) TableName() string {
@@ -43,7 +62,7 @@ func (c *CompanionProfile) IsOnline() bool {
 	return c.Status == CompanionStatusOnline
 }
 
-// IsAvailable 判断是否可接单（在线且不忙碌）
-func (c *CompanionProfile) IsAvailable() bool {
-	return c.Status == CompanionStatusOnline
+// IsAvailable 判断是否可接单（在线且进行中的订单数未达到并发上限）
+func (c *CompanionProfile) IsAvailable(maxConcurrent int) bool {
+	return c.Status == CompanionStatusOnline && c.ActiveOrders < maxConcurrent
 }
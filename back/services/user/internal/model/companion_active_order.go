@@ -0,0 +1,20 @@
+package model
+
+import (
+	"time"
+)
+
+// CompanionActiveOrder 陪玩进行中的订单：接单事件写入，完成/取消事件标记释放（按订单幂等）
+// 释放的记录保留一段时间作为墓碑，避免乱序到达的接单事件或对账任务把已结束的订单重新计入
+type CompanionActiveOrder struct {
+	ID          uint64     `gorm:"primaryKey;autoIncrement"`
+	OrderID     uint64     `gorm:"not null;uniqueIndex;comment:订单ID"`
+	CompanionID uint64     `gorm:"not null;index:idx_active_order_companion,priority:1;comment:陪玩ID"`
+	ReleasedAt  *time.Time `gorm:"index:idx_active_order_companion,priority:2;index;comment:释放时间（完成/取消）"`
+	CreatedAt   time.Time  `gorm:"autoCreateTime;index"`
+}
+
+// TableName 返回表名
+func (CompanionActiveOrder) TableName() string {
+	return "companion_active_orders"
+}
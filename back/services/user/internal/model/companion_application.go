@@ -0,0 +1,78 @@
+package model
+
+import (
+	"time"
+
+	"SLGaming/back/pkg/snowflake"
+
+	"gorm.io/gorm"
+)
+
+// CompanionApplication 陪玩入驻申请
+//
+// 设计说明：
+// 1. 老板提交身份信息、技能截图和语音样本后进入待审核队列，审核通过前角色不变
+// 2. 审核通过：授予陪玩角色、写入申请的技能并标记认证；驳回：记录原因并通知申请人
+// 3. 同一用户同时只能有一条待审核申请，被驳回后需等待冷却期才能再次申请
+type CompanionApplication struct {
+	BaseModel
+
+	// 申请人ID
+	UserID uint64 `gorm:"not null;index;comment:申请人ID" json:"user_id,string"`
+
+	// 真实姓名
+	RealName string `gorm:"size:32;not null;comment:真实姓名" json:"real_name"`
+
+	// 身份证号：不保存明文，只保存脱敏副本（审核展示）
+	IDCardNoMasked string `gorm:"size:32;not null;default:'';comment:身份证号(脱敏)" json:"-"`
+
+	// 身份证正反面照片
+	IDCardFrontURL string `gorm:"size:255;not null;comment:身份证正面照片" json:"id_card_front_url"`
+	IDCardBackURL  string `gorm:"size:255;not null;comment:身份证反面照片" json:"id_card_back_url"`
+
+	// 技能证明截图（JSON 数组）
+	SkillProofURLs string `gorm:"type:text;comment:技能证明截图(JSON)" json:"skill_proof_urls"`
+
+	// 语音样本
+	VoiceSampleURL string `gorm:"size:255;not null;comment:语音样本" json:"voice_sample_url"`
+
+	// 申请的技能（JSON 数组，审核通过后写入 companion_skills）
+	Skills string `gorm:"type:text;comment:申请的技能(JSON)" json:"skills"`
+
+	// 个人简介
+	Bio string `gorm:"size:255;comment:个人简介" json:"bio"`
+
+	// 状态：1=待审核, 2=已通过, 3=已驳回
+	Status int `gorm:"not null;default:1;index;comment:状态(1=待审核,2=已通过,3=已驳回)" json:"status"`
+
+	// 驳回原因（展示给申请人）
+	RejectReason string `gorm:"size:255;comment:驳回原因" json:"reject_reason"`
+
+	// 审核备注（仅管理员可见）
+	ReviewNote string `gorm:"size:512;comment:审核备注" json:"review_note"`
+
+	// 审核人ID
+	ReviewerID uint64 `gorm:"not null;default:0;comment:审核人ID" json:"reviewer_id,string"`
+
+	// 审核时间
+	ReviewedAt *time.Time `gorm:"comment:审核时间" json:"reviewed_at"`
+}
+
+func (c *CompanionApplication) TableName() string {
+	return "companion_applications"
+}
+
+// BeforeCreate 创建前钩子：生成 ID
+func (c *CompanionApplication) BeforeCreate(tx *gorm.DB) error {
+	if c.ID == 0 {
+		c.ID = uint64(snowflake.GenID())
+	}
+	return nil
+}
+
+// 申请状态常量
+const (
+	CompanionApplicationPending  = 1 // 待审核
+	CompanionApplicationApproved = 2 // 已通过
+	CompanionApplicationRejected = 3 // 已驳回
+)
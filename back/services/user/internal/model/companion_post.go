@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+
+	"SLGaming/back/pkg/snowflake"
+
+	"gorm.io/gorm"
+)
+
+// CompanionPost 陪玩发布的帖子（文字 + 图片），发布后作为"新帖子"动态写入粉丝的动态流
+type CompanionPost struct {
+	ID        uint64    `gorm:"primaryKey;autoIncrement:false;index:idx_companion_post_user,priority:2"`
+	UserID    uint64    `gorm:"not null;index:idx_companion_post_user,priority:1;comment:陪玩ID"`
+	Content   string    `gorm:"size:500;not null;default:'';comment:帖子内容"`
+	ImageURLs string    `gorm:"type:text;comment:图片地址（JSON 数组）"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName 返回表名
+func (CompanionPost) TableName() string {
+	return "companion_posts"
+}
+
+// BeforeCreate 创建前钩子
+func (p *CompanionPost) BeforeCreate(tx *gorm.DB) error {
+	if p.ID == 0 {
+		p.ID = uint64(snowflake.GenID())
+	}
+	return nil
+}
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"time"
+)
+
+// CompanionSkill 陪玩技能表：一个陪玩可以提供多个游戏，每个游戏单独设置段位和价格
+// CompanionProfile.GameSkills / PricePerHour 保留为冗余字段（技能名称列表 JSON 与最低价格），由技能变更时同步
+type CompanionSkill struct {
+	ID uint64 `gorm:"primaryKey;autoIncrement" json:"id,string"`
+
+	// 陪玩用户ID（users.id）
+	CompanionID uint64 `gorm:"not null;uniqueIndex:uk_companion_skill,priority:1;comment:陪玩ID" json:"companion_id,string"`
+
+	// 游戏技能ID（game_skills.id）
+	GameSkillID uint64 `gorm:"not null;uniqueIndex:uk_companion_skill,priority:2;index;comment:游戏技能ID" json:"game_skill_id,string"`
+
+	// 段位/等级（如：星耀、钻石，自由填写）
+	Rank string `gorm:"size:32;not null;default:'';comment:段位" json:"rank"`
+
+	// 该游戏的每小时价格（帅币）
+	PricePerHour int64 `gorm:"not null;default:0;index;comment:每小时价格(帅币)" json:"price_per_hour"`
+
+	// 是否已认证该游戏的段位
+	IsVerified bool `gorm:"not null;default:false;comment:段位是否认证" json:"is_verified"`
+
+	CreatedAt time.Time `gorm:"autoCreateTime" json:"created_at"`
+	UpdatedAt time.Time `gorm:"autoUpdateTime" json:"updated_at"`
+}
+
+func (c *CompanionSkill) TableName() string {
+	return "companion_skills"
+}
+
+// 每个陪玩最多提供的游戏数
+const MaxCompanionSkills = 10
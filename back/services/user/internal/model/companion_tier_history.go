@@ -0,0 +1,33 @@
+package model
+
+import (
+	"time"
+)
+
+// 等级变更原因
+const (
+	TierReasonEvaluation      = "evaluation"       // 定期评估
+	TierReasonOverride        = "admin_override"   // 管理员指定
+	TierReasonOverrideCleared = "override_cleared" // 管理员取消指定，恢复按评估
+)
+
+// CompanionTierHistory 陪玩等级变更记录：保存变更时的评估指标，陪玩可据此了解升降级原因
+type CompanionTierHistory struct {
+	ID              uint64    `gorm:"primaryKey;autoIncrement"`
+	CompanionID     uint64    `gorm:"not null;index:idx_tier_history_companion,priority:1;comment:陪玩ID"`
+	FromTier        int       `gorm:"not null;comment:原等级"`
+	ToTier          int       `gorm:"not null;comment:新等级"`
+	Reason          string    `gorm:"size:32;not null;comment:变更原因"`
+	CompletedOrders int64     `gorm:"not null;default:0;comment:窗口内完成单数"`
+	Rating          float64   `gorm:"type:decimal(3,2);not null;default:0;comment:窗口内平均评分"`
+	CancelRate      float64   `gorm:"type:decimal(5,4);not null;default:0;comment:窗口内取消率"`
+	DisputeRate     float64   `gorm:"type:decimal(5,4);not null;default:0;comment:窗口内纠纷率"`
+	OperatorID      uint64    `gorm:"not null;default:0;comment:操作管理员ID（评估为0）"`
+	Note            string    `gorm:"size:255;not null;default:'';comment:备注"`
+	CreatedAt       time.Time `gorm:"autoCreateTime;index:idx_tier_history_companion,priority:2"`
+}
+
+// TableName 返回表名
+func (CompanionTierHistory) TableName() string {
+	return "companion_tier_histories"
+}
@@ -0,0 +1,48 @@
+package model
+
+import (
+	"time"
+
+	"SLGaming/back/pkg/snowflake"
+
+	"gorm.io/gorm"
+)
+
+// 关注动态类型
+const (
+	FeedEventOnline = "online" // 陪玩上线
+	FeedEventReview = "review" // 陪玩收到新评价
+	FeedEventPrice  = "price"  // 陪玩调整价格
+	FeedEventPost   = "post"   // 陪玩发布新帖子
+)
+
+// FeedEvent 陪玩动态：粉丝的动态流由这些事件组成
+// ID 为雪花 ID（按时间递增），动态流以 ID 作为游标分页，(actor_id, id) 索引用于按陪玩拉取；(actor_id, kind, dedup_key) 保证同一事件重复投递只记录一次
+type FeedEvent struct {
+	ID        uint64    `gorm:"primaryKey;autoIncrement:false;index:idx_feed_event_actor,priority:2"`
+	ActorID   uint64    `gorm:"not null;uniqueIndex:uk_feed_event_dedup,priority:1;index:idx_feed_event_actor,priority:1;comment:陪玩ID"`
+	Kind      string    `gorm:"size:16;not null;uniqueIndex:uk_feed_event_dedup,priority:2;comment:动态类型"`
+	DedupKey  string    `gorm:"size:64;not null;uniqueIndex:uk_feed_event_dedup,priority:3;comment:去重键"`
+	GameName  string    `gorm:"size:64;not null;default:'';comment:游戏名称（评价动态）"`
+	Rating    float64   `gorm:"not null;default:0;comment:评分（评价动态）"`
+	Comment   string    `gorm:"size:500;not null;default:'';comment:评价内容（评价动态）"`
+	OldPrice  int64     `gorm:"not null;default:0;comment:原价格（价格动态）"`
+	NewPrice  int64     `gorm:"not null;default:0;comment:新价格（价格动态）"`
+	PostID    uint64    `gorm:"not null;default:0;comment:帖子ID（帖子动态）"`
+	Content   string    `gorm:"size:500;not null;default:'';comment:帖子内容（帖子动态）"`
+	ImageURLs string    `gorm:"type:text;comment:帖子图片地址，JSON 数组（帖子动态）"`
+	CreatedAt time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName 返回表名
+func (FeedEvent) TableName() string {
+	return "feed_events"
+}
+
+// BeforeCreate 创建前钩子
+func (e *FeedEvent) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == 0 {
+		e.ID = uint64(snowflake.GenID())
+	}
+	return nil
+}
@@ -23,6 +23,18 @@ func main() {
 		&model.GameSkill{},
 		&model.FollowRelation{},
 		&model.ProcessedMessage{},
+		&model.Gift{},
+		&model.GiftRecord{},
+		&model.VipSubscription{},
+		&model.LoginEvent{},
+		&model.CompanionRankingEvent{},
+		&model.CompanionSkill{},
+		&model.CompanionApplication{},
+		&model.CompanionActiveOrder{},
+		&model.CompanionTierHistory{},
+		&model.FeedEvent{},
+		&model.CompanionPost{},
+		&model.BlockRelation{},
 	)
 	if err != nil {
 		log.Panicf("database migration failed: %v", err)
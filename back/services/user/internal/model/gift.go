@@ -0,0 +1,86 @@
+package model
+
+import (
+	"SLGaming/back/pkg/snowflake"
+
+	"gorm.io/gorm"
+)
+
+// 礼物与打赏记录模型
+//
+// 设计说明：
+// 1. Gift：礼物目录（名称、单价、图标），由管理员维护
+// 2. GiftRecord：每次打赏/送礼一条记录，BizOrderID 与双方钱包流水共用，便于对账
+
+// Gift 礼物目录
+type Gift struct {
+	BaseModel
+
+	// 礼物名称（唯一）
+	Name string `gorm:"size:64;uniqueIndex;not null;comment:礼物名称" json:"name"`
+
+	// 单价（帅币）
+	Price int64 `gorm:"not null;comment:单价(帅币)" json:"price"`
+
+	// 图标URL
+	IconURL string `gorm:"size:255;comment:图标URL" json:"icon_url"`
+
+	// 排序（越小越靠前）
+	Sort int `gorm:"not null;default:0;comment:排序" json:"sort"`
+
+	// 是否上架
+	Enabled bool `gorm:"not null;default:true;comment:是否上架" json:"enabled"`
+}
+
+func (g *Gift) TableName() string {
+	return "gifts"
+}
+
+// BeforeCreate 创建前钩子：生成 ID
+func (g *Gift) BeforeCreate(tx *gorm.DB) error {
+	if g.ID == 0 {
+		g.ID = uint64(snowflake.GenID())
+	}
+	return nil
+}
+
+// GiftRecord 打赏/送礼记录
+type GiftRecord struct {
+	BaseModel
+
+	// 赠送者ID
+	SenderID uint64 `gorm:"not null;index;comment:赠送者ID" json:"sender_id,string"`
+
+	// 接收者ID
+	ReceiverID uint64 `gorm:"not null;index;comment:接收者ID" json:"receiver_id,string"`
+
+	// 礼物ID（0 表示直接打赏帅币）
+	GiftID uint64 `gorm:"not null;default:0;comment:礼物ID(0=直接打赏)" json:"gift_id,string"`
+
+	// 礼物名称（冗余，防止礼物改名后历史记录变化）
+	GiftName string `gorm:"size:64;comment:礼物名称" json:"gift_name"`
+
+	// 数量
+	Quantity int `gorm:"not null;default:1;comment:数量" json:"quantity"`
+
+	// 总金额（帅币）
+	Amount int64 `gorm:"not null;comment:总金额(帅币)" json:"amount"`
+
+	// 业务单号（与钱包流水共用）
+	BizOrderID string `gorm:"size:64;uniqueIndex;not null;comment:业务单号" json:"biz_order_id"`
+
+	// 附言
+	Message string `gorm:"size:255;comment:附言" json:"message"`
+}
+
+func (r *GiftRecord) TableName() string {
+	return "gift_records"
+}
+
+// BeforeCreate 创建前钩子：生成 ID
+func (r *GiftRecord) BeforeCreate(tx *gorm.DB) error {
+	if r.ID == 0 {
+		r.ID = uint64(snowflake.GenID())
+	}
+	return nil
+}
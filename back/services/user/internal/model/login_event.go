@@ -0,0 +1,73 @@
+package model
+
+import (
+	"time"
+
+	"SLGaming/back/pkg/snowflake"
+
+	"gorm.io/gorm"
+)
+
+// 登录方式
+const (
+	LoginMethodPassword = "password"
+	LoginMethodCode     = "code"
+	LoginMethodRefresh  = "refresh"
+)
+
+// 登录结果
+const (
+	LoginResultSuccess = "success" // 登录成功
+	LoginResultFailed  = "failed"  // 密码错误
+	LoginResultDelayed = "delayed" // 失败次数过多，处于等待期内被拒绝
+	LoginResultLocked  = "locked"  // 账号或 IP 已被临时锁定
+	LoginResultBanned  = "banned"  // 账号已被封禁
+)
+
+// LoginEvent 登录记录（包括失败的尝试，供用户查看可疑登录）
+type LoginEvent struct {
+	BaseModel
+
+	// 用户ID（手机号不存在时为 0）
+	UserID uint64 `gorm:"not null;default:0;index:idx_login_event_user_time,priority:1;index:idx_login_event_user_device,priority:1;comment:用户ID" json:"user_id,string"`
+
+	// 登录手机号（仅用于排查，查询历史按用户ID）
+	Phone string `gorm:"size:20;index;comment:登录手机号" json:"phone"`
+
+	// 登录方式：password / code / refresh
+	Method string `gorm:"size:16;not null;comment:登录方式" json:"method"`
+
+	// 登录结果：success / failed / delayed / locked / banned
+	Result string `gorm:"size:16;not null;comment:登录结果" json:"result"`
+
+	// 失败原因或补充说明
+	Reason string `gorm:"size:128;comment:原因" json:"reason"`
+
+	IP        string `gorm:"size:64;comment:客户端IP" json:"ip"`
+	UserAgent string `gorm:"size:256;comment:User-Agent" json:"user_agent"`
+
+	// 设备指纹（User-Agent 归一化后的哈希）与 IP 所属网段，用于识别新设备/新地区登录
+	DeviceFingerprint string `gorm:"size:32;index:idx_login_event_user_device,priority:2;comment:设备指纹" json:"device_fingerprint"`
+	IPRegion          string `gorm:"size:64;comment:IP所属网段" json:"ip_region"`
+
+	// 是否为首次出现的设备或地区（已发出安全提醒）
+	NewDevice bool `gorm:"not null;default:false;comment:是否新设备或新地区" json:"new_device"`
+
+	// 发生时间
+	OccurredAt time.Time `gorm:"not null;index:idx_login_event_user_time,priority:2;comment:发生时间" json:"occurred_at"`
+}
+
+func (e *LoginEvent) TableName() string {
+	return "login_events"
+}
+
+// BeforeCreate 创建前钩子：生成 ID 与发生时间
+func (e *LoginEvent) BeforeCreate(tx *gorm.DB) error {
+	if e.ID == 0 {
+		e.ID = uint64(snowflake.GenID())
+	}
+	if e.OccurredAt.IsZero() {
+		e.OccurredAt = time.Now()
+	}
+	return nil
+}
@@ -0,0 +1,31 @@
+package model
+
+import (
+	"time"
+)
+
+// 排行榜事件类型
+const (
+	RankingEventCompleted = "completed" // 订单完成（计入接单榜）
+	RankingEventRated     = "rated"     // 订单评价（计入评分榜）
+	RankingEventCancelled = "cancelled" // 陪玩取消已接的订单（不计入排行榜，用于等级评估的取消率）
+	RankingEventDisputed  = "disputed"  // 老板对已完成的订单发起纠纷（不计入排行榜，用于等级评估的纠纷率）
+)
+
+// CompanionRankingEvent 陪玩排行榜事件：每个订单的完成、评价各记一条（按订单幂等）
+// 按周期/游戏划分的排行榜由这些事件增量累加，Redis 丢失后也从这里重建
+type CompanionRankingEvent struct {
+	ID          uint64    `gorm:"primaryKey;autoIncrement"`
+	OrderID     uint64    `gorm:"not null;uniqueIndex:uk_ranking_event_order_kind,priority:1;comment:订单ID"`
+	Kind        string    `gorm:"size:16;not null;uniqueIndex:uk_ranking_event_order_kind,priority:2;index:idx_ranking_event_kind_time,priority:1;comment:事件类型"`
+	CompanionID uint64    `gorm:"not null;index;comment:陪玩ID"`
+	GameName    string    `gorm:"size:64;not null;default:'';comment:游戏名称"`
+	Rating      float64   `gorm:"not null;default:0;comment:评分（仅评价事件）"`
+	OccurredAt  time.Time `gorm:"not null;index:idx_ranking_event_kind_time,priority:2;comment:发生时间"`
+	CreatedAt   time.Time `gorm:"autoCreateTime"`
+}
+
+// TableName 返回表名
+func (CompanionRankingEvent) TableName() string {
+	return "companion_ranking_events"
+}
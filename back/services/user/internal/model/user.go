@@ -16,6 +16,20 @@ const (
 	RoleAdmin     = 3 // 管理员
 )
 
+// 账号状态常量
+const (
+	UserStatusActive = 0 // 正常
+	UserStatusMuted  = 1 // 禁言：不能聊天和评价，订单流程不受影响
+	UserStatusBanned = 2 // 封禁：不能登录，陪玩不出现在列表和推荐中
+)
+
+// 性别常量
+const (
+	GenderUnknown = 0 // 未设置
+	GenderMale    = 1 // 男
+	GenderFemale  = 2 // 女
+)
+
 // BaseModel 基础模型
 type BaseModel struct {
 	// ID：系统内部唯一标识，雪花算法 (19位)，用于数据库关联
@@ -36,6 +50,9 @@ type User struct {
 	Password string `gorm:"size:128;not null;comment:加密密码" json:"password"`
 	Phone    string `gorm:"size:20;uniqueIndex;not null;comment:手机号" json:"phone"`
 
+	// 绑定的邮箱（小写），未绑定为 NULL，可用于邮箱验证码登录和找回密码
+	Email *string `gorm:"size:128;uniqueIndex;comment:绑定邮箱" json:"email"`
+
 	// 用户角色：1=老板, 2=陪玩, 3=管理员
 	Role int `gorm:"not null;default:1;index;comment:用户角色(1=老板,2=陪玩,3=管理员)" json:"role"`
 
@@ -45,9 +62,27 @@ type User struct {
 	// 个人简介（所有用户通用）
 	Bio string `gorm:"type:text;comment:个人简介" json:"bio"`
 
+	// 性别：0=未设置, 1=男, 2=女（用于陪玩筛选）
+	Gender int `gorm:"not null;default:0;index;comment:性别(0=未设置,1=男,2=女)" json:"gender"`
+
+	// 生日（只精确到日，用于计算年龄和陪玩年龄筛选）
+	Birthday *time.Time `gorm:"type:date;index;comment:生日" json:"birthday"`
+
 	// 冗余计数字段：粉丝数与关注数（用于快速展示，最终以 follows 表为准）
 	FollowerCount  int64 `gorm:"not null;default:0;comment:粉丝数" json:"follower_count"`
 	FollowingCount int64 `gorm:"not null;default:0;comment:关注数" json:"following_count"`
+
+	// 冗余会员字段：用于快速展示徽章（最终以 vip_subscriptions 表为准）
+	VipLevel    int        `gorm:"not null;default:0;comment:会员等级(0=非会员)" json:"vip_level"`
+	VipBadge    string     `gorm:"size:32;not null;default:'';comment:会员徽章" json:"vip_badge"`
+	VipExpireAt *time.Time `gorm:"comment:会员到期时间" json:"vip_expire_at"`
+
+	// 账号状态：0=正常, 1=禁言, 2=封禁；StatusUntil 为空表示永久，到期后自动视为正常
+	Status           int        `gorm:"not null;default:0;index;comment:账号状态(0=正常,1=禁言,2=封禁)" json:"status"`
+	StatusUntil      *time.Time `gorm:"comment:禁言/封禁到期时间(空=永久)" json:"status_until"`
+	StatusReason     string     `gorm:"size:255;not null;default:'';comment:禁言/封禁原因" json:"status_reason"`
+	StatusOperatorID uint64     `gorm:"not null;default:0;comment:设置状态的管理员ID" json:"status_operator_id,string"`
+	StatusUpdatedAt  *time.Time `gorm:"comment:状态变更时间" json:"status_updated_at"`
 }
 
 func (u *User) TableName() string {
@@ -64,6 +99,51 @@ func (u *User) IsCompanion() bool {
 	return u.Role == RoleCompanion
 }
 
+// Age 按生日计算周岁，未设置生日返回 0
+func (u *User) Age(now time.Time) int {
+	if u.Birthday == nil {
+		return 0
+	}
+	age := now.Year() - u.Birthday.Year()
+	if now.Month() < u.Birthday.Month() || (now.Month() == u.Birthday.Month() && now.Day() < u.Birthday.Day()) {
+		age--
+	}
+	if age < 0 {
+		return 0
+	}
+	return age
+}
+
+// IsVipActive 判断会员是否生效中
+func (u *User) IsVipActive() bool {
+	return u.VipLevel > 0 && u.VipExpireAt != nil && u.VipExpireAt.After(time.Now())
+}
+
+// EffectiveStatus 当前生效的账号状态（禁言/封禁到期后视为正常）
+func (u *User) EffectiveStatus(now time.Time) int {
+	if u.Status != UserStatusActive && u.StatusUntil != nil && !u.StatusUntil.After(now) {
+		return UserStatusActive
+	}
+	return u.Status
+}
+
+// IsBanned 判断是否处于封禁中
+func (u *User) IsBanned() bool {
+	return u.EffectiveStatus(time.Now()) == UserStatusBanned
+}
+
+// IsMuted 判断是否处于禁言中
+func (u *User) IsMuted() bool {
+	return u.EffectiveStatus(time.Now()) == UserStatusMuted
+}
+
+// NotBanned 查询条件：排除封禁中的用户（需关联 users 表）
+func NotBanned(now time.Time) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("(users.status <> ? OR (users.status_until IS NOT NULL AND users.status_until <= ?))", UserStatusBanned, now)
+	}
+}
+
 // IsAdmin 判断是否为管理员
 func (u *User) IsAdmin() bool {
 	return u.Role == RoleAdmin
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"time"
+
+	"SLGaming/back/pkg/snowflake"
+
+	"gorm.io/gorm"
+)
+
+// 会员订阅状态
+const (
+	VipStatusActive  = 1 // 生效中
+	VipStatusExpired = 2 // 已过期
+)
+
+// VipSubscription 会员订阅（每个用户一条记录，续费/升级时延长到期时间）
+// 套餐权益（折扣、徽章、推荐优先级、限流倍数）按 PlanCode 从配置读取
+type VipSubscription struct {
+	BaseModel
+
+	// 用户ID（唯一）
+	UserID uint64 `gorm:"not null;uniqueIndex;comment:用户ID" json:"user_id,string"`
+
+	// 当前套餐编码
+	PlanCode string `gorm:"size:32;not null;comment:套餐编码" json:"plan_code"`
+
+	// 会员等级（冗余，便于查询）
+	Level int `gorm:"not null;default:0;comment:会员等级" json:"level"`
+
+	// 本轮订阅开始时间与到期时间
+	StartAt time.Time `gorm:"not null;comment:开始时间" json:"start_at"`
+	EndAt   time.Time `gorm:"not null;index:idx_vip_status_end;comment:到期时间" json:"end_at"`
+
+	// 是否自动续费（到期前从钱包扣款）
+	AutoRenew bool `gorm:"not null;default:false;comment:是否自动续费" json:"auto_renew"`
+
+	// 状态：1=生效中, 2=已过期
+	Status int `gorm:"not null;default:1;index:idx_vip_status_end,priority:1;comment:状态(1=生效中,2=已过期)" json:"status"`
+
+	// 最近一次扣款的业务单号
+	LastBizOrderID string `gorm:"size:64;comment:最近一次扣款业务单号" json:"last_biz_order_id"`
+}
+
+func (v *VipSubscription) TableName() string {
+	return "vip_subscriptions"
+}
+
+// BeforeCreate 创建前钩子：生成 ID
+func (v *VipSubscription) BeforeCreate(tx *gorm.DB) error {
+	if v.ID == 0 {
+		v.ID = uint64(snowflake.GenID())
+	}
+	return nil
+}
+
+// IsActive 订阅是否生效中
+func (v *VipSubscription) IsActive() bool {
+	return v.Status == VipStatusActive && v.EndAt.After(time.Now())
+}
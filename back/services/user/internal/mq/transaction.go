@@ -18,8 +18,27 @@ const (
 	eventTypeRefundSucceeded = "ORDER_REFUND_SUCCEEDED" // 退款成功事件
 	eventTypeFollowUser      = "USER_FOLLOW"            // 关注用户事件
 	eventTypeUnfollowUser    = "USER_UNFOLLOW"          // 取消关注用户事件
+	giftEventTopic           = "gift_events"            // 打赏/礼物事件独立 topic
+	eventTypeUserTip         = "USER_TIP"               // 帅币打赏事件
+	eventTypeUserGift        = "USER_GIFT"              // 赠送礼物事件
+	securityEventTopic       = "security_events"        // 账号安全事件独立 topic，由通知系统消费
+	eventTypeNewDeviceLogin  = "USER_NEW_DEVICE_LOGIN"  // 新设备/新地区登录事件
+	companionEventTopic      = "companion_events"       // 陪玩入驻等事件独立 topic，由通知系统消费
+	eventTypeAppReviewed     = "APPLICATION_REVIEWED"   // 入驻申请审核完成事件
 )
 
+// 陪玩在线状态变更事件（companion_events topic），供推荐、派单等下游感知陪玩上下线
+const eventTypeStatusChanged = "COMPANION_STATUS_CHANGED"
+
+// 陪玩动态事件（follow_events topic），由关注事件消费者写入粉丝的动态流
+const eventTypeCompanionActivity = "COMPANION_ACTIVITY"
+
+// 拉黑用户事件（follow_events topic），由关注事件消费者解除双方的关注关系
+const eventTypeBlockUser = "USER_BLOCK"
+
+// 陪玩等级变更事件（companion_events topic），由通知系统告知陪玩新等级及超过新等级上限的价格
+const eventTypeTierChanged = "COMPANION_TIER_CHANGED"
+
 // UserEventTopic 返回用户领域事件使用的 RocketMQ Topic
 func UserEventTopic() string {
 	return userEventTopic
@@ -45,6 +64,61 @@ func EventTypeUnfollowUser() string {
 	return eventTypeUnfollowUser
 }
 
+// GiftEventTopic 返回打赏/礼物事件使用的 RocketMQ Topic
+func GiftEventTopic() string {
+	return giftEventTopic
+}
+
+// EventTypeUserTip 返回帅币打赏事件类型
+func EventTypeUserTip() string {
+	return eventTypeUserTip
+}
+
+// EventTypeUserGift 返回赠送礼物事件类型
+func EventTypeUserGift() string {
+	return eventTypeUserGift
+}
+
+// SecurityEventTopic 返回账号安全事件使用的 RocketMQ Topic
+func SecurityEventTopic() string {
+	return securityEventTopic
+}
+
+// EventTypeNewDeviceLogin 返回新设备/新地区登录事件类型
+func EventTypeNewDeviceLogin() string {
+	return eventTypeNewDeviceLogin
+}
+
+// CompanionEventTopic 返回陪玩事件使用的 RocketMQ Topic
+func CompanionEventTopic() string {
+	return companionEventTopic
+}
+
+// EventTypeApplicationReviewed 返回入驻申请审核完成事件类型
+func EventTypeApplicationReviewed() string {
+	return eventTypeAppReviewed
+}
+
+// EventTypeCompanionStatusChanged 返回陪玩在线状态变更事件类型
+func EventTypeCompanionStatusChanged() string {
+	return eventTypeStatusChanged
+}
+
+// EventTypeCompanionActivity 返回陪玩动态事件类型
+func EventTypeCompanionActivity() string {
+	return eventTypeCompanionActivity
+}
+
+// EventTypeBlockUser 返回拉黑用户事件类型
+func EventTypeBlockUser() string {
+	return eventTypeBlockUser
+}
+
+// EventTypeCompanionTierChanged 返回陪玩等级变更事件类型
+func EventTypeCompanionTierChanged() string {
+	return eventTypeTierChanged
+}
+
 // RefundSucceededPayload 用户退款成功事件负载
 // 由用户服务产生，订单服务消费，用于将订单状态 CANCEL_REFUNDING -> CANCELLED。
 type RefundSucceededPayload struct {
@@ -67,6 +141,93 @@ type UnfollowUserPayload struct {
 	FollowingID uint64 `json:"following_id"` // 被关注者ID
 }
 
+// BlockUserPayload 拉黑用户事件负载
+type BlockUserPayload struct {
+	BlockerID uint64 `json:"blocker_id"` // 拉黑者ID
+	BlockedID uint64 `json:"blocked_id"` // 被拉黑者ID
+}
+
+// GiftSentPayload 打赏/送礼事件负载
+// 由用户服务在转账成功后发出，供关注动态、陪玩统计等下游消费。
+type GiftSentPayload struct {
+	SenderID   uint64 `json:"sender_id"`   // 赠送者ID
+	ReceiverID uint64 `json:"receiver_id"` // 接收者ID
+	GiftID     uint64 `json:"gift_id"`     // 礼物ID（0=直接打赏）
+	GiftName   string `json:"gift_name"`   // 礼物名称
+	Quantity   int32  `json:"quantity"`    // 数量
+	Amount     int64  `json:"amount"`      // 总金额（帅币）
+	BizOrderID string `json:"biz_order_id"`
+	Message    string `json:"message"`
+	SentAt     int64  `json:"sent_at"` // 发送时间（Unix 秒）
+}
+
+// NewDeviceLoginPayload 新设备/新地区登录事件负载
+// 由用户服务在登录成功后发出，供通知系统向用户发送安全提醒。
+type NewDeviceLoginPayload struct {
+	UserID            uint64 `json:"user_id"`
+	EventID           uint64 `json:"event_id"`           // 登录记录ID
+	Method            string `json:"method"`             // 登录方式
+	IP                string `json:"ip"`                 // 客户端 IP
+	IPRegion          string `json:"ip_region"`          // IP 所属网段
+	UserAgent         string `json:"user_agent"`         // 客户端 User-Agent
+	DeviceFingerprint string `json:"device_fingerprint"` // 设备指纹
+	NewDevice         bool   `json:"new_device"`         // 设备首次出现
+	NewRegion         bool   `json:"new_region"`         // 地区首次出现
+	LoginAt           int64  `json:"login_at"`           // 登录时间（Unix 秒）
+}
+
+// ApplicationReviewedPayload 入驻申请审核完成事件负载
+// 由用户服务在审核后发出，供通知系统告知申请人结果（驳回时附带原因）。
+type ApplicationReviewedPayload struct {
+	ApplicationID uint64 `json:"application_id"`
+	UserID        uint64 `json:"user_id"`       // 申请人ID
+	Approved      bool   `json:"approved"`      // 是否通过
+	RejectReason  string `json:"reject_reason"` // 驳回原因
+	ReapplyAt     int64  `json:"reapply_at"`    // 可再次申请的时间（Unix 秒，通过时为0）
+	ReviewedAt    int64  `json:"reviewed_at"`   // 审核时间（Unix 秒）
+}
+
+// CompanionStatusChangedPayload 陪玩在线状态变更事件载荷
+type CompanionStatusChangedPayload struct {
+	UserID    uint64 `json:"user_id"`
+	OldStatus int    `json:"old_status"` // 0=离线, 1=在线, 2=忙碌
+	Status    int    `json:"status"`
+	Reason    string `json:"reason"` // manual=陪玩手动切换, presence_timeout=心跳超时
+	ChangedAt int64  `json:"changed_at"`
+}
+
+// CompanionActivityPayload 陪玩动态事件负载（上线、调价、新帖子）；评价动态来自订单服务的 ORDER_RATED 事件
+type CompanionActivityPayload struct {
+	CompanionID uint64   `json:"companion_id"`
+	Kind        string   `json:"kind"`      // online=上线, price=调价, post=新帖子
+	DedupKey    string   `json:"dedup_key"` // 同一陪玩同类动态的去重键
+	OldPrice    int64    `json:"old_price"`
+	NewPrice    int64    `json:"new_price"`
+	PostID      uint64   `json:"post_id,omitempty"`
+	Content     string   `json:"content,omitempty"`
+	ImageURLs   []string `json:"image_urls,omitempty"`
+	OccurredAt  int64    `json:"occurred_at"` // 发生时间（Unix 秒）
+}
+
+// CompanionTierChangedPayload 陪玩等级变更事件负载
+// 降级后价格超过新等级上限时不改动陪玩的定价（下单时按上限拒绝），OverCapPrices 列出超限的价格供通知陪玩重新定价
+type CompanionTierChangedPayload struct {
+	CompanionID     uint64             `json:"companion_id"`
+	FromTier        int                `json:"from_tier"`
+	ToTier          int                `json:"to_tier"`
+	ToTierName      string             `json:"to_tier_name"`
+	Reason          string             `json:"reason"` // evaluation / override / override_cleared
+	MaxPricePerHour int64              `json:"max_price_per_hour"`
+	OverCapPrices   []TierOverCapPrice `json:"over_cap_prices,omitempty"`
+	ChangedAt       int64              `json:"changed_at"` // 变更时间（Unix 秒）
+}
+
+// TierOverCapPrice 超过等级价格上限的价格
+type TierOverCapPrice struct {
+	GameSkillID  uint64 `json:"game_skill_id"` // 0 表示资料上的统一价格
+	PricePerHour int64  `json:"price_per_hour"`
+}
+
 // ExecuteUserEventTx 用户领域事件本地事务执行器
 // 处理 ORDER_REFUND_SUCCEEDED：在一个本地事务中完成钱包退款和流水记录
 func ExecuteUserEventTx(ctx context.Context, db *gorm.DB, msg *primitive.Message) primitive.LocalTransactionState {
@@ -48,6 +48,31 @@ func (s *UserServer) LoginByCode(ctx context.Context, in *user.LoginByCodeReques
 	return l.LoginByCode(in)
 }
 
+func (s *UserServer) UnlockLogin(ctx context.Context, in *user.UnlockLoginRequest) (*user.UnlockLoginResponse, error) {
+	l := logic.NewUnlockLoginLogic(ctx, s.svcCtx)
+	return l.UnlockLogin(in)
+}
+
+func (s *UserServer) RecordLoginEvent(ctx context.Context, in *user.RecordLoginEventRequest) (*user.RecordLoginEventResponse, error) {
+	l := logic.NewRecordLoginEventLogic(ctx, s.svcCtx)
+	return l.RecordLoginEvent(in)
+}
+
+func (s *UserServer) ListLoginEvents(ctx context.Context, in *user.ListLoginEventsRequest) (*user.ListLoginEventsResponse, error) {
+	l := logic.NewListLoginEventsLogic(ctx, s.svcCtx)
+	return l.ListLoginEvents(in)
+}
+
+func (s *UserServer) SetUserStatus(ctx context.Context, in *user.SetUserStatusRequest) (*user.SetUserStatusResponse, error) {
+	l := logic.NewSetUserStatusLogic(ctx, s.svcCtx)
+	return l.SetUserStatus(in)
+}
+
+func (s *UserServer) FilterBannedUsers(ctx context.Context, in *user.FilterBannedUsersRequest) (*user.FilterBannedUsersResponse, error) {
+	l := logic.NewFilterBannedUsersLogic(ctx, s.svcCtx)
+	return l.FilterBannedUsers(in)
+}
+
 func (s *UserServer) ForgetPassword(ctx context.Context, in *user.ForgetPasswordRequest) (*user.ForgetPasswordResponse, error) {
 	l := logic.NewForgetPasswordLogic(ctx, s.svcCtx)
 	return l.ForgetPassword(in)
@@ -63,6 +88,11 @@ func (s *UserServer) ChangePassword(ctx context.Context, in *user.ChangePassword
 	return l.ChangePassword(in)
 }
 
+func (s *UserServer) BindEmail(ctx context.Context, in *user.BindEmailRequest) (*user.BindEmailResponse, error) {
+	l := logic.NewBindEmailLogic(ctx, s.svcCtx)
+	return l.BindEmail(in)
+}
+
 // 帅币钱包相关接口
 func (s *UserServer) GetWallet(ctx context.Context, in *user.GetWalletRequest) (*user.GetWalletResponse, error) {
 	l := logic.NewGetWalletLogic(ctx, s.svcCtx)
@@ -94,6 +124,53 @@ func (s *UserServer) RechargeList(ctx context.Context, in *user.RechargeListRequ
 	return l.RechargeList(in)
 }
 
+// 打赏/礼物相关接口
+func (s *UserServer) Transfer(ctx context.Context, in *user.TransferRequest) (*user.TransferResponse, error) {
+	l := logic.NewTransferLogic(ctx, s.svcCtx)
+	return l.Transfer(in)
+}
+
+func (s *UserServer) SendGift(ctx context.Context, in *user.SendGiftRequest) (*user.SendGiftResponse, error) {
+	l := logic.NewSendGiftLogic(ctx, s.svcCtx)
+	return l.SendGift(in)
+}
+
+func (s *UserServer) ListGifts(ctx context.Context, in *user.ListGiftsRequest) (*user.ListGiftsResponse, error) {
+	l := logic.NewListGiftsLogic(ctx, s.svcCtx)
+	return l.ListGifts(in)
+}
+
+func (s *UserServer) CreateGift(ctx context.Context, in *user.CreateGiftRequest) (*user.CreateGiftResponse, error) {
+	l := logic.NewCreateGiftLogic(ctx, s.svcCtx)
+	return l.CreateGift(in)
+}
+
+func (s *UserServer) UpdateGift(ctx context.Context, in *user.UpdateGiftRequest) (*user.UpdateGiftResponse, error) {
+	l := logic.NewUpdateGiftLogic(ctx, s.svcCtx)
+	return l.UpdateGift(in)
+}
+
+// 会员（VIP）相关接口
+func (s *UserServer) ListVipPlans(ctx context.Context, in *user.ListVipPlansRequest) (*user.ListVipPlansResponse, error) {
+	l := logic.NewListVipPlansLogic(ctx, s.svcCtx)
+	return l.ListVipPlans(in)
+}
+
+func (s *UserServer) SubscribeVip(ctx context.Context, in *user.SubscribeVipRequest) (*user.SubscribeVipResponse, error) {
+	l := logic.NewSubscribeVipLogic(ctx, s.svcCtx)
+	return l.SubscribeVip(in)
+}
+
+func (s *UserServer) SetVipAutoRenew(ctx context.Context, in *user.SetVipAutoRenewRequest) (*user.SetVipAutoRenewResponse, error) {
+	l := logic.NewSetVipAutoRenewLogic(ctx, s.svcCtx)
+	return l.SetVipAutoRenew(in)
+}
+
+func (s *UserServer) GetVipEntitlements(ctx context.Context, in *user.GetVipEntitlementsRequest) (*user.GetVipEntitlementsResponse, error) {
+	l := logic.NewGetVipEntitlementsLogic(ctx, s.svcCtx)
+	return l.GetVipEntitlements(in)
+}
+
 // 陪玩信息相关接口
 func (s *UserServer) GetCompanionProfile(ctx context.Context, in *user.GetCompanionProfileRequest) (*user.GetCompanionProfileResponse, error) {
 	l := logic.NewGetCompanionProfileLogic(ctx, s.svcCtx)
@@ -115,6 +192,48 @@ func (s *UserServer) GetCompanionList(ctx context.Context, in *user.GetCompanion
 	return l.GetCompanionList(in)
 }
 
+func (s *UserServer) CompanionHeartbeat(ctx context.Context, in *user.CompanionHeartbeatRequest) (*user.CompanionHeartbeatResponse, error) {
+	l := logic.NewCompanionHeartbeatLogic(ctx, s.svcCtx)
+	return l.CompanionHeartbeat(in)
+}
+
+func (s *UserServer) GetCompanionPresence(ctx context.Context, in *user.GetCompanionPresenceRequest) (*user.GetCompanionPresenceResponse, error) {
+	l := logic.NewGetCompanionPresenceLogic(ctx, s.svcCtx)
+	return l.GetCompanionPresence(in)
+}
+
+// 陪玩入驻申请与审核
+func (s *UserServer) SubmitCompanionApplication(ctx context.Context, in *user.SubmitCompanionApplicationRequest) (*user.SubmitCompanionApplicationResponse, error) {
+	l := logic.NewSubmitCompanionApplicationLogic(ctx, s.svcCtx)
+	return l.SubmitCompanionApplication(in)
+}
+
+func (s *UserServer) GetMyCompanionApplication(ctx context.Context, in *user.GetMyCompanionApplicationRequest) (*user.GetMyCompanionApplicationResponse, error) {
+	l := logic.NewGetMyCompanionApplicationLogic(ctx, s.svcCtx)
+	return l.GetMyCompanionApplication(in)
+}
+
+func (s *UserServer) ListCompanionApplications(ctx context.Context, in *user.ListCompanionApplicationsRequest) (*user.ListCompanionApplicationsResponse, error) {
+	l := logic.NewListCompanionApplicationsLogic(ctx, s.svcCtx)
+	return l.ListCompanionApplications(in)
+}
+
+func (s *UserServer) ReviewCompanionApplication(ctx context.Context, in *user.ReviewCompanionApplicationRequest) (*user.ReviewCompanionApplicationResponse, error) {
+	l := logic.NewReviewCompanionApplicationLogic(ctx, s.svcCtx)
+	return l.ReviewCompanionApplication(in)
+}
+
+// 陪玩等级相关接口
+func (s *UserServer) GetCompanionTierHistory(ctx context.Context, in *user.GetCompanionTierHistoryRequest) (*user.GetCompanionTierHistoryResponse, error) {
+	l := logic.NewGetCompanionTierHistoryLogic(ctx, s.svcCtx)
+	return l.GetCompanionTierHistory(in)
+}
+
+func (s *UserServer) SetCompanionTierOverride(ctx context.Context, in *user.SetCompanionTierOverrideRequest) (*user.SetCompanionTierOverrideResponse, error) {
+	l := logic.NewSetCompanionTierOverrideLogic(ctx, s.svcCtx)
+	return l.SetCompanionTierOverride(in)
+}
+
 // 陪玩排名相关接口
 func (s *UserServer) GetCompanionRatingRanking(ctx context.Context, in *user.GetCompanionRatingRankingRequest) (*user.GetCompanionRatingRankingResponse, error) {
 	l := logic.NewGetCompanionRatingRankingLogic(ctx, s.svcCtx)
@@ -177,3 +296,34 @@ func (s *UserServer) CheckFollowStatus(ctx context.Context, in *user.CheckFollow
 	l := logic.NewCheckFollowStatusLogic(ctx, s.svcCtx)
 	return l.CheckFollowStatus(in)
 }
+
+func (s *UserServer) GetFeed(ctx context.Context, in *user.GetFeedRequest) (*user.GetFeedResponse, error) {
+	l := logic.NewGetFeedLogic(ctx, s.svcCtx)
+	return l.GetFeed(in)
+}
+
+func (s *UserServer) PublishCompanionPost(ctx context.Context, in *user.PublishCompanionPostRequest) (*user.PublishCompanionPostResponse, error) {
+	l := logic.NewPublishCompanionPostLogic(ctx, s.svcCtx)
+	return l.PublishCompanionPost(in)
+}
+
+// 拉黑相关接口
+func (s *UserServer) BlockUser(ctx context.Context, in *user.BlockUserRequest) (*user.BlockUserResponse, error) {
+	l := logic.NewBlockUserLogic(ctx, s.svcCtx)
+	return l.BlockUser(in)
+}
+
+func (s *UserServer) UnblockUser(ctx context.Context, in *user.UnblockUserRequest) (*user.UnblockUserResponse, error) {
+	l := logic.NewUnblockUserLogic(ctx, s.svcCtx)
+	return l.UnblockUser(in)
+}
+
+func (s *UserServer) ListBlocked(ctx context.Context, in *user.ListBlockedRequest) (*user.ListBlockedResponse, error) {
+	l := logic.NewListBlockedLogic(ctx, s.svcCtx)
+	return l.ListBlocked(in)
+}
+
+func (s *UserServer) FilterBlockedUsers(ctx context.Context, in *user.FilterBlockedUsersRequest) (*user.FilterBlockedUsersResponse, error) {
+	l := logic.NewFilterBlockedUsersLogic(ctx, s.svcCtx)
+	return l.FilterBlockedUsers(in)
+}
@@ -8,6 +8,7 @@ import (
 	"SLGaming/back/pkg/lock"
 	"SLGaming/back/pkg/rpc"
 	"SLGaming/back/services/agent/agentclient"
+	"SLGaming/back/services/order/orderclient"
 	"SLGaming/back/services/user/internal/bloom"
 	"SLGaming/back/services/user/internal/cache"
 	"SLGaming/back/services/user/internal/config"
@@ -35,6 +36,9 @@ type ServiceContext struct {
 	// 用户缓存服务
 	UserCache *cache.UserCache
 
+	// 陪玩缓存服务（陪玩列表）
+	CompanionCache *cache.CompanionCache
+
 	// 布隆过滤器
 	BloomFilter *bloom.UserBloomFilters
 
@@ -49,6 +53,9 @@ type ServiceContext struct {
 
 	// Agent RPC 客户端（用于头像审核等异步任务）
 	AgentRPC agentclient.Agent
+
+	// Order RPC 客户端（用于陪玩进行中订单的对账）
+	OrderRPC orderclient.Order
 }
 
 // NewServiceContext 根据配置初始化所有依赖。
@@ -86,6 +93,7 @@ func NewServiceContext(c config.Config) *ServiceContext {
 	// 初始化缓存管理器和用户缓存服务
 	ctx.CacheManager = cache.NewManager(redisClient)
 	ctx.UserCache = cache.NewUserCache(ctx.CacheManager)
+	ctx.CompanionCache = cache.NewCompanionCache(ctx.CacheManager)
 	logx.Infof("缓存服务已初始化")
 
 	// 初始化布隆过滤器（如果为空会自动从数据库导入）
@@ -126,18 +134,17 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		}
 	}
 
+	retryOpts := c.Upstream.Retry
+	if retryOpts.MaxRetries == 0 {
+		retryOpts = rpc.DefaultRetryOptions()
+	}
+	consulAdapter := &pkgIoc.ConsulConfigAdapter{
+		Address: c.Consul.Address,
+		Token:   c.Consul.Token,
+	}
+
 	// 初始化 Agent RPC 客户端
 	if c.Upstream.AgentService != "" {
-		retryOpts := c.Upstream.Retry
-		if retryOpts.MaxRetries == 0 {
-			retryOpts = rpc.DefaultRetryOptions()
-		}
-
-		consulAdapter := &pkgIoc.ConsulConfigAdapter{
-			Address: c.Consul.Address,
-			Token:   c.Consul.Token,
-		}
-
 		cli, err := rpc.NewDynamicRPCClientOrFallback(consulAdapter, rpc.DynamicClientOptions{
 			ServiceName: c.Upstream.AgentService,
 			Timeout:     c.Upstream.RPCTimeout,
@@ -151,6 +158,21 @@ func NewServiceContext(c config.Config) *ServiceContext {
 		}
 	}
 
+	// 初始化 Order RPC 客户端
+	if c.Upstream.OrderService != "" {
+		cli, err := rpc.NewDynamicRPCClientOrFallback(consulAdapter, rpc.DynamicClientOptions{
+			ServiceName: c.Upstream.OrderService,
+			Timeout:     c.Upstream.RPCTimeout,
+			Retry:       retryOpts,
+		})
+		if err != nil {
+			logx.Errorf("init order rpc client failed: service=%s, err=%v", c.Upstream.OrderService, err)
+		} else if cli != nil {
+			ctx.OrderRPC = orderclient.NewOrder(cli)
+			logx.Infof("init order rpc client success: service=%s (动态客户端+自动重试)", c.Upstream.OrderService)
+		}
+	}
+
 	return ctx
 }
 
@@ -12,6 +12,7 @@ import (
 	"sync"
 	"syscall"
 
+	"SLGaming/back/pkg/verifyticket"
 	"SLGaming/back/services/user/internal/config"
 	"SLGaming/back/services/user/internal/helper"
 	"SLGaming/back/services/user/internal/ioc"
@@ -32,7 +33,11 @@ import (
 	"gopkg.in/yaml.v3"
 )
 
-var configFile = flag.String("f", "etc/user.yaml", "the config file")
+var (
+	configFile          = flag.String("f", "etc/user.yaml", "the config file")
+	backfillRatingScore = flag.Bool("backfill-rating-score", false, "recompute companion rating scores and rebuild the rating ranking, then exit")
+	migrateSkills       = flag.Bool("migrate-companion-skills", false, "migrate companion game_skills JSON into the companion_skills table, then exit")
+)
 
 func getAvailablePort() (int, error) {
 	listener, err := net.Listen("tcp", ":0")
@@ -106,8 +111,49 @@ func main() {
 		}
 	}
 
+	secret, err := verifyticket.ResolveSecret(cfg.VerifyTicket.Secret, cfg.Mode)
+	if err != nil {
+		helper.LogError(logger, helper.OpServer, "invalid verify ticket secret", err, map[string]interface{}{
+			"mode": cfg.Mode,
+		})
+		os.Exit(1)
+	}
+	cfg.VerifyTicket.Secret = secret
+
 	ctx := svc.NewServiceContext(cfg)
 
+	// 回填命令：按当前配置重算所有陪玩的贝叶斯评分后退出，不启动服务
+	if *backfillRatingScore {
+		updated, err := helper.BackfillRatingScores(context.Background(), ctx, logger)
+		if err != nil {
+			helper.LogError(logger, helper.OpServer, "backfill rating scores failed", err, map[string]interface{}{
+				"updated": updated,
+			})
+			os.Exit(1)
+		}
+		helper.LogSuccess(logger, helper.OpServer, map[string]interface{}{
+			"action":  "backfill_rating_score",
+			"updated": updated,
+		})
+		return
+	}
+
+	// 迁移命令：把陪玩资料中的 game_skills JSON 拆分到 companion_skills 表后退出，不启动服务
+	if *migrateSkills {
+		migrated, err := helper.MigrateCompanionSkills(context.Background(), ctx, logger)
+		if err != nil {
+			helper.LogError(logger, helper.OpServer, "migrate companion skills failed", err, map[string]interface{}{
+				"migrated": migrated,
+			})
+			os.Exit(1)
+		}
+		helper.LogSuccess(logger, helper.OpServer, map[string]interface{}{
+			"action":   "migrate_companion_skills",
+			"migrated": migrated,
+		})
+		return
+	}
+
 	metricsPort, err := startMetricsServer(cfg.MetricsPort)
 	if err != nil {
 		helper.LogError(logger, helper.OpServer, "start metrics server failed", err, nil)
@@ -131,6 +177,12 @@ func main() {
 				helper.LogError(logger, helper.OpServer, "unmarshal nacos config on update failed", err, nil)
 				return
 			}
+			secret, err := verifyticket.ResolveSecret(newCfg.VerifyTicket.Secret, newCfg.Mode)
+			if err != nil {
+				helper.LogError(logger, helper.OpServer, "nacos update rejected: invalid verify ticket secret", err, nil)
+				return
+			}
+			newCfg.VerifyTicket.Secret = secret
 			if err := ctx.UpdateConfig(newCfg); err != nil {
 				helper.LogError(logger, helper.OpServer, "update service context config failed", err, nil)
 				return
@@ -147,6 +199,11 @@ func main() {
 	job.StartRechargeEventConsumer(rootCtx, ctx)
 	job.StartAvatarModerationConsumer(rootCtx, ctx)
 	job.StartFollowEventConsumer(rootCtx, ctx)
+	job.StartVipExpiryJob(rootCtx, ctx)
+	job.StartPresenceSweepJob(rootCtx, ctx)
+	job.StartCompanionOrderConsumer(rootCtx, ctx)
+	job.StartCompanionOrderReconcileJob(rootCtx, ctx)
+	job.StartCompanionTierJob(rootCtx, ctx)
 
 	helper.WarmupRankingFromMySQLAsync(ctx, logx.WithContext(rootCtx))
 
@@ -26,7 +26,8 @@ type RegisterRequest struct {
 	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
 	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
 	Nickname      string                 `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Role          int32                  `protobuf:"varint,4,opt,name=role,proto3" json:"role,omitempty"` // 用户角色：1=老板, 2=陪玩, 3=管理员（默认1）
+	Role          int32                  `protobuf:"varint,4,opt,name=role,proto3" json:"role,omitempty"`                                    // 用户角色：1=老板, 2=陪玩, 3=管理员（默认1）
+	VerifyTicket  string                 `protobuf:"bytes,5,opt,name=verify_ticket,json=verifyTicket,proto3" json:"verify_ticket,omitempty"` // 验证码票据（purpose=register）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -89,6 +90,13 @@ func (x *RegisterRequest) GetRole() int32 {
 	return 0
 }
 
+func (x *RegisterRequest) GetVerifyTicket() string {
+	if x != nil {
+		return x.VerifyTicket
+	}
+	return ""
+}
+
 type RegisterResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -145,6 +153,8 @@ type LoginRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
 	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	ClientIp      string                 `protobuf:"bytes,3,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`    // 客户端 IP（防暴力破解与登录记录）
+	UserAgent     string                 `protobuf:"bytes,4,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"` // 客户端 User-Agent
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -193,6 +203,20 @@ func (x *LoginRequest) GetPassword() string {
 	return ""
 }
 
+func (x *LoginRequest) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *LoginRequest) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
 type LoginResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -318,6 +342,15 @@ type UserInfo struct {
 	FrozenBalance  int64                  `protobuf:"varint,9,opt,name=frozen_balance,json=frozenBalance,proto3" json:"frozen_balance,omitempty"`     // 冻结帅币余额（预留）
 	FollowerCount  int64                  `protobuf:"varint,10,opt,name=follower_count,json=followerCount,proto3" json:"follower_count,omitempty"`    // 粉丝数（冗余展示，最终以 follows 表为准）
 	FollowingCount int64                  `protobuf:"varint,11,opt,name=following_count,json=followingCount,proto3" json:"following_count,omitempty"` // 关注数（冗余展示）
+	VipLevel       int32                  `protobuf:"varint,12,opt,name=vip_level,json=vipLevel,proto3" json:"vip_level,omitempty"`                   // 会员等级（0=非会员）
+	VipBadge       string                 `protobuf:"bytes,13,opt,name=vip_badge,json=vipBadge,proto3" json:"vip_badge,omitempty"`                    // 会员徽章
+	VipExpireAt    int64                  `protobuf:"varint,14,opt,name=vip_expire_at,json=vipExpireAt,proto3" json:"vip_expire_at,omitempty"`        // 会员到期时间（Unix 秒，非会员为0）
+	Status         int32                  `protobuf:"varint,15,opt,name=status,proto3" json:"status,omitempty"`                                       // 账号状态：0=正常, 1=禁言, 2=封禁（已到期的禁言/封禁返回0）
+	StatusUntil    int64                  `protobuf:"varint,16,opt,name=status_until,json=statusUntil,proto3" json:"status_until,omitempty"`          // 禁言/封禁到期时间（Unix 秒，0=永久）
+	StatusReason   string                 `protobuf:"bytes,17,opt,name=status_reason,json=statusReason,proto3" json:"status_reason,omitempty"`        // 禁言/封禁原因
+	Email          string                 `protobuf:"bytes,18,opt,name=email,proto3" json:"email,omitempty"`                                          // 绑定的邮箱（未绑定为空）
+	Gender         int32                  `protobuf:"varint,19,opt,name=gender,proto3" json:"gender,omitempty"`                                       // 性别：0=未设置, 1=男, 2=女
+	Birthday       string                 `protobuf:"bytes,20,opt,name=birthday,proto3" json:"birthday,omitempty"`                                    // 生日（YYYY-MM-DD，未设置为空）
 	unknownFields  protoimpl.UnknownFields
 	sizeCache      protoimpl.SizeCache
 }
@@ -429,6 +462,69 @@ func (x *UserInfo) GetFollowingCount() int64 {
 	return 0
 }
 
+func (x *UserInfo) GetVipLevel() int32 {
+	if x != nil {
+		return x.VipLevel
+	}
+	return 0
+}
+
+func (x *UserInfo) GetVipBadge() string {
+	if x != nil {
+		return x.VipBadge
+	}
+	return ""
+}
+
+func (x *UserInfo) GetVipExpireAt() int64 {
+	if x != nil {
+		return x.VipExpireAt
+	}
+	return 0
+}
+
+func (x *UserInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *UserInfo) GetStatusUntil() int64 {
+	if x != nil {
+		return x.StatusUntil
+	}
+	return 0
+}
+
+func (x *UserInfo) GetStatusReason() string {
+	if x != nil {
+		return x.StatusReason
+	}
+	return ""
+}
+
+func (x *UserInfo) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UserInfo) GetGender() int32 {
+	if x != nil {
+		return x.Gender
+	}
+	return 0
+}
+
+func (x *UserInfo) GetBirthday() string {
+	if x != nil {
+		return x.Birthday
+	}
+	return ""
+}
+
 type GetUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *UserInfo              `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -482,6 +578,8 @@ type UpdateUserRequest struct {
 	Role          int32                  `protobuf:"varint,5,opt,name=role,proto3" json:"role,omitempty"`                           // 可选，用户角色
 	AvatarUrl     string                 `protobuf:"bytes,6,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"` // 可选，头像URL
 	Bio           string                 `protobuf:"bytes,7,opt,name=bio,proto3" json:"bio,omitempty"`                              // 可选，个人简介
+	Gender        int32                  `protobuf:"varint,8,opt,name=gender,proto3" json:"gender,omitempty"`                       // 可选，性别：1=男, 2=女（0=不修改）
+	Birthday      string                 `protobuf:"bytes,9,opt,name=birthday,proto3" json:"birthday,omitempty"`                    // 可选，生日（YYYY-MM-DD）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -565,6 +663,20 @@ func (x *UpdateUserRequest) GetBio() string {
 	return ""
 }
 
+func (x *UpdateUserRequest) GetGender() int32 {
+	if x != nil {
+		return x.Gender
+	}
+	return 0
+}
+
+func (x *UpdateUserRequest) GetBirthday() string {
+	if x != nil {
+		return x.Birthday
+	}
+	return ""
+}
+
 type UpdateUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	User          *UserInfo              `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
@@ -611,7 +723,11 @@ func (x *UpdateUserResponse) GetUser() *UserInfo {
 
 type LoginByCodeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
+	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`                                   // 手机号与邮箱二选一
+	ClientIp      string                 `protobuf:"bytes,2,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`             // 客户端 IP
+	UserAgent     string                 `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`          // 客户端 User-Agent
+	VerifyTicket  string                 `protobuf:"bytes,4,opt,name=verify_ticket,json=verifyTicket,proto3" json:"verify_ticket,omitempty"` // 验证码票据（purpose=login，签发给 phone 或 email）
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`                                   // 邮箱验证码登录时使用
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -653,6 +769,34 @@ func (x *LoginByCodeRequest) GetPhone() string {
 	return ""
 }
 
+func (x *LoginByCodeRequest) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *LoginByCodeRequest) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *LoginByCodeRequest) GetVerifyTicket() string {
+	if x != nil {
+		return x.VerifyTicket
+	}
+	return ""
+}
+
+func (x *LoginByCodeRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
 type LoginByCodeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -705,28 +849,29 @@ func (x *LoginByCodeResponse) GetUid() uint64 {
 	return 0
 }
 
-type ForgetPasswordRequest struct {
+// 管理员解除登录锁定（手机号与 IP 至少填一个）
+type UnlockLoginRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"`
-	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Ip            string                 `protobuf:"bytes,2,opt,name=ip,proto3" json:"ip,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ForgetPasswordRequest) Reset() {
-	*x = ForgetPasswordRequest{}
+func (x *UnlockLoginRequest) Reset() {
+	*x = UnlockLoginRequest{}
 	mi := &file_user_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ForgetPasswordRequest) String() string {
+func (x *UnlockLoginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForgetPasswordRequest) ProtoMessage() {}
+func (*UnlockLoginRequest) ProtoMessage() {}
 
-func (x *ForgetPasswordRequest) ProtoReflect() protoreflect.Message {
+func (x *UnlockLoginRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -738,47 +883,46 @@ func (x *ForgetPasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForgetPasswordRequest.ProtoReflect.Descriptor instead.
-func (*ForgetPasswordRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnlockLoginRequest.ProtoReflect.Descriptor instead.
+func (*UnlockLoginRequest) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *ForgetPasswordRequest) GetPhone() string {
+func (x *UnlockLoginRequest) GetPhone() string {
 	if x != nil {
 		return x.Phone
 	}
 	return ""
 }
 
-func (x *ForgetPasswordRequest) GetPassword() string {
+func (x *UnlockLoginRequest) GetIp() string {
 	if x != nil {
-		return x.Password
+		return x.Ip
 	}
 	return ""
 }
 
-type ForgetPasswordResponse struct {
+type UnlockLoginResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Uid           uint64                 `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
+	WasLocked     bool                   `protobuf:"varint,1,opt,name=was_locked,json=wasLocked,proto3" json:"was_locked,omitempty"` // 解除前是否处于锁定状态
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ForgetPasswordResponse) Reset() {
-	*x = ForgetPasswordResponse{}
+func (x *UnlockLoginResponse) Reset() {
+	*x = UnlockLoginResponse{}
 	mi := &file_user_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ForgetPasswordResponse) String() string {
+func (x *UnlockLoginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ForgetPasswordResponse) ProtoMessage() {}
+func (*UnlockLoginResponse) ProtoMessage() {}
 
-func (x *ForgetPasswordResponse) ProtoReflect() protoreflect.Message {
+func (x *UnlockLoginResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -790,49 +934,45 @@ func (x *ForgetPasswordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ForgetPasswordResponse.ProtoReflect.Descriptor instead.
-func (*ForgetPasswordResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UnlockLoginResponse.ProtoReflect.Descriptor instead.
+func (*UnlockLoginResponse) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *ForgetPasswordResponse) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *ForgetPasswordResponse) GetUid() uint64 {
+func (x *UnlockLoginResponse) GetWasLocked() bool {
 	if x != nil {
-		return x.Uid
+		return x.WasLocked
 	}
-	return 0
+	return false
 }
 
-// 修改手机号（需验证原手机号）
-type ChangePhoneRequest struct {
+// 记录一次登录（网关刷新 Token 时调用；密码/验证码登录由用户服务自行记录）
+type RecordLoginEventRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	OldPhone      string                 `protobuf:"bytes,2,opt,name=old_phone,json=oldPhone,proto3" json:"old_phone,omitempty"`
-	NewPhone      string                 `protobuf:"bytes,3,opt,name=new_phone,json=newPhone,proto3" json:"new_phone,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`                        // 登录方式：refresh
+	Result        string                 `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`                        // 登录结果：success / failed
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                        // 失败原因
+	ClientIp      string                 `protobuf:"bytes,5,opt,name=client_ip,json=clientIp,proto3" json:"client_ip,omitempty"`    // 客户端 IP
+	UserAgent     string                 `protobuf:"bytes,6,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"` // 客户端 User-Agent
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangePhoneRequest) Reset() {
-	*x = ChangePhoneRequest{}
+func (x *RecordLoginEventRequest) Reset() {
+	*x = RecordLoginEventRequest{}
 	mi := &file_user_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangePhoneRequest) String() string {
+func (x *RecordLoginEventRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePhoneRequest) ProtoMessage() {}
+func (*RecordLoginEventRequest) ProtoMessage() {}
 
-func (x *ChangePhoneRequest) ProtoReflect() protoreflect.Message {
+func (x *RecordLoginEventRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -844,53 +984,73 @@ func (x *ChangePhoneRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePhoneRequest.ProtoReflect.Descriptor instead.
-func (*ChangePhoneRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecordLoginEventRequest.ProtoReflect.Descriptor instead.
+func (*RecordLoginEventRequest) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{13}
 }
 
-func (x *ChangePhoneRequest) GetUserId() uint64 {
+func (x *RecordLoginEventRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *ChangePhoneRequest) GetOldPhone() string {
+func (x *RecordLoginEventRequest) GetMethod() string {
 	if x != nil {
-		return x.OldPhone
+		return x.Method
 	}
 	return ""
 }
 
-func (x *ChangePhoneRequest) GetNewPhone() string {
+func (x *RecordLoginEventRequest) GetResult() string {
 	if x != nil {
-		return x.NewPhone
+		return x.Result
 	}
 	return ""
 }
 
-type ChangePhoneResponse struct {
+func (x *RecordLoginEventRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *RecordLoginEventRequest) GetClientIp() string {
+	if x != nil {
+		return x.ClientIp
+	}
+	return ""
+}
+
+func (x *RecordLoginEventRequest) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+type RecordLoginEventResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangePhoneResponse) Reset() {
-	*x = ChangePhoneResponse{}
+func (x *RecordLoginEventResponse) Reset() {
+	*x = RecordLoginEventResponse{}
 	mi := &file_user_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangePhoneResponse) String() string {
+func (x *RecordLoginEventResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePhoneResponse) ProtoMessage() {}
+func (*RecordLoginEventResponse) ProtoMessage() {}
 
-func (x *ChangePhoneResponse) ProtoReflect() protoreflect.Message {
+func (x *RecordLoginEventResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -902,42 +1062,40 @@ func (x *ChangePhoneResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePhoneResponse.ProtoReflect.Descriptor instead.
-func (*ChangePhoneResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecordLoginEventResponse.ProtoReflect.Descriptor instead.
+func (*RecordLoginEventResponse) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ChangePhoneResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-// 修改密码（需验证原手机号）
-type ChangePasswordRequest struct {
+type LoginEventInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	OldPhone      string                 `protobuf:"bytes,2,opt,name=old_phone,json=oldPhone,proto3" json:"old_phone,omitempty"`
-	NewPassword   string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Method        string                 `protobuf:"bytes,2,opt,name=method,proto3" json:"method,omitempty"`                            // 登录方式：password / code / refresh
+	Result        string                 `protobuf:"bytes,3,opt,name=result,proto3" json:"result,omitempty"`                            // 登录结果：success / failed / delayed / locked
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                            // 失败原因
+	Ip            string                 `protobuf:"bytes,5,opt,name=ip,proto3" json:"ip,omitempty"`                                    // 客户端 IP
+	IpRegion      string                 `protobuf:"bytes,6,opt,name=ip_region,json=ipRegion,proto3" json:"ip_region,omitempty"`        // IP 所属网段
+	UserAgent     string                 `protobuf:"bytes,7,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`     // 客户端 User-Agent
+	NewDevice     bool                   `protobuf:"varint,8,opt,name=new_device,json=newDevice,proto3" json:"new_device,omitempty"`    // 是否为首次出现的设备或地区
+	OccurredAt    int64                  `protobuf:"varint,9,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"` // 发生时间（Unix 秒）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangePasswordRequest) Reset() {
-	*x = ChangePasswordRequest{}
+func (x *LoginEventInfo) Reset() {
+	*x = LoginEventInfo{}
 	mi := &file_user_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangePasswordRequest) String() string {
+func (x *LoginEventInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangePasswordRequest) ProtoMessage() {}
+func (*LoginEventInfo) ProtoMessage() {}
 
-func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+func (x *LoginEventInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -949,101 +1107,99 @@ func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
-func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use LoginEventInfo.ProtoReflect.Descriptor instead.
+func (*LoginEventInfo) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *ChangePasswordRequest) GetUserId() uint64 {
+func (x *LoginEventInfo) GetId() uint64 {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *ChangePasswordRequest) GetOldPhone() string {
+func (x *LoginEventInfo) GetMethod() string {
 	if x != nil {
-		return x.OldPhone
+		return x.Method
 	}
 	return ""
 }
 
-func (x *ChangePasswordRequest) GetNewPassword() string {
+func (x *LoginEventInfo) GetResult() string {
 	if x != nil {
-		return x.NewPassword
+		return x.Result
 	}
 	return ""
 }
 
-type ChangePasswordResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *LoginEventInfo) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
 }
 
-func (x *ChangePasswordResponse) Reset() {
-	*x = ChangePasswordResponse{}
-	mi := &file_user_proto_msgTypes[16]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *LoginEventInfo) GetIp() string {
+	if x != nil {
+		return x.Ip
+	}
+	return ""
 }
 
-func (x *ChangePasswordResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *LoginEventInfo) GetIpRegion() string {
+	if x != nil {
+		return x.IpRegion
+	}
+	return ""
 }
 
-func (*ChangePasswordResponse) ProtoMessage() {}
-
-func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[16]
+func (x *LoginEventInfo) GetUserAgent() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.UserAgent
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
-func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{16}
+func (x *LoginEventInfo) GetNewDevice() bool {
+	if x != nil {
+		return x.NewDevice
+	}
+	return false
 }
 
-func (x *ChangePasswordResponse) GetSuccess() bool {
+func (x *LoginEventInfo) GetOccurredAt() int64 {
 	if x != nil {
-		return x.Success
+		return x.OccurredAt
 	}
-	return false
+	return 0
 }
 
-// 钱包信息
-type WalletInfo struct {
+// 分页查询登录记录（按时间倒序）
+type ListLoginEventsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                      // 用户ID
-	Balance       int64                  `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`                                  // 帅币可用余额
-	FrozenBalance int64                  `protobuf:"varint,3,opt,name=frozen_balance,json=frozenBalance,proto3" json:"frozen_balance,omitempty"` // 冻结帅币余额（预留）
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                         // 页码（从1开始）
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *WalletInfo) Reset() {
-	*x = WalletInfo{}
-	mi := &file_user_proto_msgTypes[17]
+func (x *ListLoginEventsRequest) Reset() {
+	*x = ListLoginEventsRequest{}
+	mi := &file_user_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *WalletInfo) String() string {
+func (x *ListLoginEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WalletInfo) ProtoMessage() {}
+func (*ListLoginEventsRequest) ProtoMessage() {}
 
-func (x *WalletInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[17]
+func (x *ListLoginEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1054,55 +1210,57 @@ func (x *WalletInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WalletInfo.ProtoReflect.Descriptor instead.
-func (*WalletInfo) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ListLoginEventsRequest.ProtoReflect.Descriptor instead.
+func (*ListLoginEventsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *WalletInfo) GetUserId() uint64 {
+func (x *ListLoginEventsRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *WalletInfo) GetBalance() int64 {
+func (x *ListLoginEventsRequest) GetPage() int32 {
 	if x != nil {
-		return x.Balance
+		return x.Page
 	}
 	return 0
 }
 
-func (x *WalletInfo) GetFrozenBalance() int64 {
+func (x *ListLoginEventsRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.FrozenBalance
+		return x.PageSize
 	}
 	return 0
 }
 
-// 查询钱包
-type GetWalletRequest struct {
+type ListLoginEventsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	Events        []*LoginEventInfo      `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetWalletRequest) Reset() {
-	*x = GetWalletRequest{}
-	mi := &file_user_proto_msgTypes[18]
+func (x *ListLoginEventsResponse) Reset() {
+	*x = ListLoginEventsResponse{}
+	mi := &file_user_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetWalletRequest) String() string {
+func (x *ListLoginEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetWalletRequest) ProtoMessage() {}
+func (*ListLoginEventsResponse) ProtoMessage() {}
 
-func (x *GetWalletRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[18]
+func (x *ListLoginEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1113,88 +1271,66 @@ func (x *GetWalletRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetWalletRequest.ProtoReflect.Descriptor instead.
-func (*GetWalletRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ListLoginEventsResponse.ProtoReflect.Descriptor instead.
+func (*ListLoginEventsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetWalletRequest) GetUserId() uint64 {
+func (x *ListLoginEventsResponse) GetEvents() []*LoginEventInfo {
 	if x != nil {
-		return x.UserId
+		return x.Events
 	}
-	return 0
-}
-
-type GetWalletResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Wallet        *WalletInfo            `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetWalletResponse) Reset() {
-	*x = GetWalletResponse{}
-	mi := &file_user_proto_msgTypes[19]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetWalletResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return nil
 }
 
-func (*GetWalletResponse) ProtoMessage() {}
-
-func (x *GetWalletResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[19]
+func (x *ListLoginEventsResponse) GetTotal() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Total
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetWalletResponse.ProtoReflect.Descriptor instead.
-func (*GetWalletResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{19}
+func (x *ListLoginEventsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
 }
 
-func (x *GetWalletResponse) GetWallet() *WalletInfo {
+func (x *ListLoginEventsResponse) GetPageSize() int32 {
 	if x != nil {
-		return x.Wallet
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-// 充值帅币（现金 -> 帅币），这里只处理“加币”，实际支付回调可以在上游确认后调用此接口
-type RechargeRequest struct {
+// 管理员设置账号状态（禁言/封禁/解除）
+type SetUserStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`              // 用户ID
-	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`                            // 充值帅币数量（正数）
-	BizOrderId    string                 `protobuf:"bytes,3,opt,name=biz_order_id,json=bizOrderId,proto3" json:"biz_order_id,omitempty"` // 关联的支付单号 / 业务订单号
-	Remark        string                 `protobuf:"bytes,4,opt,name=remark,proto3" json:"remark,omitempty"`                             // 备注
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`                           // 0=正常（解除禁言/封禁）, 1=禁言, 2=封禁
+	Until         int64                  `protobuf:"varint,3,opt,name=until,proto3" json:"until,omitempty"`                             // 到期时间（Unix 秒，0=永久；解除时忽略）
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`                            // 原因
+	OperatorId    uint64                 `protobuf:"varint,5,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作的管理员ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RechargeRequest) Reset() {
-	*x = RechargeRequest{}
-	mi := &file_user_proto_msgTypes[20]
+func (x *SetUserStatusRequest) Reset() {
+	*x = SetUserStatusRequest{}
+	mi := &file_user_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RechargeRequest) String() string {
+func (x *SetUserStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RechargeRequest) ProtoMessage() {}
+func (*SetUserStatusRequest) ProtoMessage() {}
 
-func (x *RechargeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[20]
+func (x *SetUserStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1205,61 +1341,69 @@ func (x *RechargeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RechargeRequest.ProtoReflect.Descriptor instead.
-func (*RechargeRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use SetUserStatusRequest.ProtoReflect.Descriptor instead.
+func (*SetUserStatusRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *RechargeRequest) GetUserId() uint64 {
+func (x *SetUserStatusRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *RechargeRequest) GetAmount() int64 {
+func (x *SetUserStatusRequest) GetStatus() int32 {
 	if x != nil {
-		return x.Amount
+		return x.Status
 	}
 	return 0
 }
 
-func (x *RechargeRequest) GetBizOrderId() string {
+func (x *SetUserStatusRequest) GetUntil() int64 {
 	if x != nil {
-		return x.BizOrderId
+		return x.Until
 	}
-	return ""
+	return 0
 }
 
-func (x *RechargeRequest) GetRemark() string {
+func (x *SetUserStatusRequest) GetReason() string {
 	if x != nil {
-		return x.Remark
+		return x.Reason
 	}
 	return ""
 }
 
-type RechargeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Wallet        *WalletInfo            `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"` // 充值后的最新钱包信息
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SetUserStatusRequest) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
 }
 
-func (x *RechargeResponse) Reset() {
-	*x = RechargeResponse{}
-	mi := &file_user_proto_msgTypes[21]
+type SetUserStatusResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	User           *UserInfo              `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	PreviousStatus int32                  `protobuf:"varint,2,opt,name=previous_status,json=previousStatus,proto3" json:"previous_status,omitempty"` // 变更前生效的状态
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SetUserStatusResponse) Reset() {
+	*x = SetUserStatusResponse{}
+	mi := &file_user_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RechargeResponse) String() string {
+func (x *SetUserStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RechargeResponse) ProtoMessage() {}
+func (*SetUserStatusResponse) ProtoMessage() {}
 
-func (x *RechargeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[21]
+func (x *SetUserStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1270,46 +1414,48 @@ func (x *RechargeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RechargeResponse.ProtoReflect.Descriptor instead.
-func (*RechargeResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use SetUserStatusResponse.ProtoReflect.Descriptor instead.
+func (*SetUserStatusResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *RechargeResponse) GetWallet() *WalletInfo {
+func (x *SetUserStatusResponse) GetUser() *UserInfo {
 	if x != nil {
-		return x.Wallet
+		return x.User
 	}
 	return nil
 }
 
-// 充值订单落库（pending）
-type CreateRechargeOrderRequest struct {
+func (x *SetUserStatusResponse) GetPreviousStatus() int32 {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return 0
+}
+
+// 从给定用户中筛出封禁中的用户（用于过滤推荐结果）
+type FilterBannedUsersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`   // 用户ID
-	OrderNo       string                 `protobuf:"bytes,2,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"` // 充值单号
-	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`                 // 充值金额（帅币）
-	PayType       string                 `protobuf:"bytes,4,opt,name=pay_type,json=payType,proto3" json:"pay_type,omitempty"` // 支付方式（alipay 等）
-	Remark        string                 `protobuf:"bytes,5,opt,name=remark,proto3" json:"remark,omitempty"`                  // 备注
-	Status        int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`                 // 订单状态：0=待支付,1=成功,2=失败,3=关闭
+	UserIds       []uint64               `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRechargeOrderRequest) Reset() {
-	*x = CreateRechargeOrderRequest{}
-	mi := &file_user_proto_msgTypes[22]
+func (x *FilterBannedUsersRequest) Reset() {
+	*x = FilterBannedUsersRequest{}
+	mi := &file_user_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRechargeOrderRequest) String() string {
+func (x *FilterBannedUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRechargeOrderRequest) ProtoMessage() {}
+func (*FilterBannedUsersRequest) ProtoMessage() {}
 
-func (x *CreateRechargeOrderRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[22]
+func (x *FilterBannedUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1320,75 +1466,40 @@ func (x *CreateRechargeOrderRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRechargeOrderRequest.ProtoReflect.Descriptor instead.
-func (*CreateRechargeOrderRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{22}
-}
-
-func (x *CreateRechargeOrderRequest) GetUserId() uint64 {
-	if x != nil {
-		return x.UserId
-	}
-	return 0
-}
-
-func (x *CreateRechargeOrderRequest) GetOrderNo() string {
-	if x != nil {
-		return x.OrderNo
-	}
-	return ""
-}
-
-func (x *CreateRechargeOrderRequest) GetAmount() int64 {
-	if x != nil {
-		return x.Amount
-	}
-	return 0
-}
-
-func (x *CreateRechargeOrderRequest) GetPayType() string {
-	if x != nil {
-		return x.PayType
-	}
-	return ""
-}
-
-func (x *CreateRechargeOrderRequest) GetRemark() string {
-	if x != nil {
-		return x.Remark
-	}
-	return ""
+// Deprecated: Use FilterBannedUsersRequest.ProtoReflect.Descriptor instead.
+func (*FilterBannedUsersRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *CreateRechargeOrderRequest) GetStatus() int32 {
+func (x *FilterBannedUsersRequest) GetUserIds() []uint64 {
 	if x != nil {
-		return x.Status
+		return x.UserIds
 	}
-	return 0
+	return nil
 }
 
-type CreateRechargeOrderResponse struct {
+type FilterBannedUsersResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	BannedUserIds []uint64               `protobuf:"varint,1,rep,packed,name=banned_user_ids,json=bannedUserIds,proto3" json:"banned_user_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRechargeOrderResponse) Reset() {
-	*x = CreateRechargeOrderResponse{}
-	mi := &file_user_proto_msgTypes[23]
+func (x *FilterBannedUsersResponse) Reset() {
+	*x = FilterBannedUsersResponse{}
+	mi := &file_user_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRechargeOrderResponse) String() string {
+func (x *FilterBannedUsersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRechargeOrderResponse) ProtoMessage() {}
+func (*FilterBannedUsersResponse) ProtoMessage() {}
 
-func (x *CreateRechargeOrderResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[23]
+func (x *FilterBannedUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1399,45 +1510,43 @@ func (x *CreateRechargeOrderResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRechargeOrderResponse.ProtoReflect.Descriptor instead.
-func (*CreateRechargeOrderResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use FilterBannedUsersResponse.ProtoReflect.Descriptor instead.
+func (*FilterBannedUsersResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *CreateRechargeOrderResponse) GetSuccess() bool {
+func (x *FilterBannedUsersResponse) GetBannedUserIds() []uint64 {
 	if x != nil {
-		return x.Success
+		return x.BannedUserIds
 	}
-	return false
+	return nil
 }
 
-// 更新充值订单状态
-type UpdateRechargeOrderStatusRequest struct {
+type ForgetPasswordRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderNo       string                 `protobuf:"bytes,1,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"` // 充值单号
-	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`                 // 订单状态：0=待支付,1=成功,2=失败,3=关闭
-	TradeNo       string                 `protobuf:"bytes,3,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no,omitempty"` // 支付平台交易号（可选）
-	Remark        string                 `protobuf:"bytes,4,opt,name=remark,proto3" json:"remark,omitempty"`                  // 备注（可选）
-	PaidAt        int64                  `protobuf:"varint,5,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`   // 支付完成时间（Unix 秒，可选）
+	Phone         string                 `protobuf:"bytes,1,opt,name=phone,proto3" json:"phone,omitempty"` // 手机号与邮箱二选一
+	Password      string                 `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	VerifyTicket  string                 `protobuf:"bytes,4,opt,name=verify_ticket,json=verifyTicket,proto3" json:"verify_ticket,omitempty"` // 验证码票据（purpose=forget_password，签发给 phone 或 email）
+	Email         string                 `protobuf:"bytes,5,opt,name=email,proto3" json:"email,omitempty"`                                   // 通过邮箱验证码找回密码时使用
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateRechargeOrderStatusRequest) Reset() {
-	*x = UpdateRechargeOrderStatusRequest{}
-	mi := &file_user_proto_msgTypes[24]
+func (x *ForgetPasswordRequest) Reset() {
+	*x = ForgetPasswordRequest{}
+	mi := &file_user_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateRechargeOrderStatusRequest) String() string {
+func (x *ForgetPasswordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRechargeOrderStatusRequest) ProtoMessage() {}
+func (*ForgetPasswordRequest) ProtoMessage() {}
 
-func (x *UpdateRechargeOrderStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[24]
+func (x *ForgetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1448,68 +1557,62 @@ func (x *UpdateRechargeOrderStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRechargeOrderStatusRequest.ProtoReflect.Descriptor instead.
-func (*UpdateRechargeOrderStatusRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ForgetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ForgetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *UpdateRechargeOrderStatusRequest) GetOrderNo() string {
+func (x *ForgetPasswordRequest) GetPhone() string {
 	if x != nil {
-		return x.OrderNo
+		return x.Phone
 	}
 	return ""
 }
 
-func (x *UpdateRechargeOrderStatusRequest) GetStatus() int32 {
+func (x *ForgetPasswordRequest) GetPassword() string {
 	if x != nil {
-		return x.Status
+		return x.Password
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateRechargeOrderStatusRequest) GetTradeNo() string {
+func (x *ForgetPasswordRequest) GetVerifyTicket() string {
 	if x != nil {
-		return x.TradeNo
+		return x.VerifyTicket
 	}
 	return ""
 }
 
-func (x *UpdateRechargeOrderStatusRequest) GetRemark() string {
+func (x *ForgetPasswordRequest) GetEmail() string {
 	if x != nil {
-		return x.Remark
+		return x.Email
 	}
 	return ""
 }
 
-func (x *UpdateRechargeOrderStatusRequest) GetPaidAt() int64 {
-	if x != nil {
-		return x.PaidAt
-	}
-	return 0
-}
-
-type UpdateRechargeOrderStatusResponse struct {
+type ForgetPasswordResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Uid           uint64                 `protobuf:"varint,2,opt,name=uid,proto3" json:"uid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateRechargeOrderStatusResponse) Reset() {
-	*x = UpdateRechargeOrderStatusResponse{}
-	mi := &file_user_proto_msgTypes[25]
+func (x *ForgetPasswordResponse) Reset() {
+	*x = ForgetPasswordResponse{}
+	mi := &file_user_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateRechargeOrderStatusResponse) String() string {
+func (x *ForgetPasswordResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateRechargeOrderStatusResponse) ProtoMessage() {}
+func (*ForgetPasswordResponse) ProtoMessage() {}
 
-func (x *UpdateRechargeOrderStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[25]
+func (x *ForgetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1520,47 +1623,52 @@ func (x *UpdateRechargeOrderStatusResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateRechargeOrderStatusResponse.ProtoReflect.Descriptor instead.
-func (*UpdateRechargeOrderStatusResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use ForgetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ForgetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *UpdateRechargeOrderStatusResponse) GetSuccess() bool {
+func (x *ForgetPasswordResponse) GetId() uint64 {
 	if x != nil {
-		return x.Success
+		return x.Id
 	}
-	return false
+	return 0
 }
 
-// 充值记录列表
-type RechargeOrderInfo struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderNo       string                 `protobuf:"bytes,1,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
-	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`                        // 订单状态：0=待支付,1=成功,2=失败,3=关闭
-	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`                        // 充值金额
-	PayType       string                 `protobuf:"bytes,4,opt,name=pay_type,json=payType,proto3" json:"pay_type,omitempty"`        // 支付方式
-	TradeNo       string                 `protobuf:"bytes,5,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no,omitempty"`        // 支付平台交易号（可选）
-	PaidAt        int64                  `protobuf:"varint,6,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`          // 支付完成时间（Unix 秒，可选）
-	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 创建时间（Unix 秒）
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *ForgetPasswordResponse) GetUid() uint64 {
+	if x != nil {
+		return x.Uid
+	}
+	return 0
 }
 
-func (x *RechargeOrderInfo) Reset() {
-	*x = RechargeOrderInfo{}
-	mi := &file_user_proto_msgTypes[26]
+// 修改手机号（需验证原手机号）
+type ChangePhoneRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OldPhone       string                 `protobuf:"bytes,2,opt,name=old_phone,json=oldPhone,proto3" json:"old_phone,omitempty"`
+	NewPhone       string                 `protobuf:"bytes,3,opt,name=new_phone,json=newPhone,proto3" json:"new_phone,omitempty"`
+	OldPhoneTicket string                 `protobuf:"bytes,4,opt,name=old_phone_ticket,json=oldPhoneTicket,proto3" json:"old_phone_ticket,omitempty"` // 原手机号验证码票据（purpose=change_phone）
+	NewPhoneTicket string                 `protobuf:"bytes,5,opt,name=new_phone_ticket,json=newPhoneTicket,proto3" json:"new_phone_ticket,omitempty"` // 新手机号验证码票据（purpose=change_phone_new）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *ChangePhoneRequest) Reset() {
+	*x = ChangePhoneRequest{}
+	mi := &file_user_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RechargeOrderInfo) String() string {
+func (x *ChangePhoneRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RechargeOrderInfo) ProtoMessage() {}
+func (*ChangePhoneRequest) ProtoMessage() {}
 
-func (x *RechargeOrderInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[26]
+func (x *ChangePhoneRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1571,85 +1679,115 @@ func (x *RechargeOrderInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RechargeOrderInfo.ProtoReflect.Descriptor instead.
-func (*RechargeOrderInfo) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use ChangePhoneRequest.ProtoReflect.Descriptor instead.
+func (*ChangePhoneRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *RechargeOrderInfo) GetOrderNo() string {
+func (x *ChangePhoneRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.OrderNo
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *RechargeOrderInfo) GetStatus() int32 {
+func (x *ChangePhoneRequest) GetOldPhone() string {
 	if x != nil {
-		return x.Status
+		return x.OldPhone
 	}
-	return 0
+	return ""
 }
 
-func (x *RechargeOrderInfo) GetAmount() int64 {
+func (x *ChangePhoneRequest) GetNewPhone() string {
 	if x != nil {
-		return x.Amount
+		return x.NewPhone
 	}
-	return 0
+	return ""
 }
 
-func (x *RechargeOrderInfo) GetPayType() string {
+func (x *ChangePhoneRequest) GetOldPhoneTicket() string {
 	if x != nil {
-		return x.PayType
+		return x.OldPhoneTicket
 	}
 	return ""
 }
 
-func (x *RechargeOrderInfo) GetTradeNo() string {
+func (x *ChangePhoneRequest) GetNewPhoneTicket() string {
 	if x != nil {
-		return x.TradeNo
+		return x.NewPhoneTicket
 	}
 	return ""
 }
 
-func (x *RechargeOrderInfo) GetPaidAt() int64 {
+type ChangePhoneResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePhoneResponse) Reset() {
+	*x = ChangePhoneResponse{}
+	mi := &file_user_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePhoneResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePhoneResponse) ProtoMessage() {}
+
+func (x *ChangePhoneResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[25]
 	if x != nil {
-		return x.PaidAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *RechargeOrderInfo) GetCreatedAt() int64 {
+// Deprecated: Use ChangePhoneResponse.ProtoReflect.Descriptor instead.
+func (*ChangePhoneResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *ChangePhoneResponse) GetSuccess() bool {
 	if x != nil {
-		return x.CreatedAt
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-type RechargeListRequest struct {
+// 绑定（或更换）邮箱，需要新邮箱的验证码票据
+type BindEmailRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // 用户ID
-	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`                     // 订单状态筛选（-1=全部）
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                         // 页码（从1开始）
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Email         string                 `protobuf:"bytes,2,opt,name=email,proto3" json:"email,omitempty"`
+	VerifyTicket  string                 `protobuf:"bytes,3,opt,name=verify_ticket,json=verifyTicket,proto3" json:"verify_ticket,omitempty"` // 验证码票据（purpose=bind_email）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RechargeListRequest) Reset() {
-	*x = RechargeListRequest{}
-	mi := &file_user_proto_msgTypes[27]
+func (x *BindEmailRequest) Reset() {
+	*x = BindEmailRequest{}
+	mi := &file_user_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RechargeListRequest) String() string {
+func (x *BindEmailRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RechargeListRequest) ProtoMessage() {}
+func (*BindEmailRequest) ProtoMessage() {}
 
-func (x *RechargeListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[27]
+func (x *BindEmailRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1660,64 +1798,54 @@ func (x *RechargeListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RechargeListRequest.ProtoReflect.Descriptor instead.
-func (*RechargeListRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use BindEmailRequest.ProtoReflect.Descriptor instead.
+func (*BindEmailRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *RechargeListRequest) GetUserId() uint64 {
+func (x *BindEmailRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *RechargeListRequest) GetStatus() int32 {
-	if x != nil {
-		return x.Status
-	}
-	return 0
-}
-
-func (x *RechargeListRequest) GetPage() int32 {
+func (x *BindEmailRequest) GetEmail() string {
 	if x != nil {
-		return x.Page
+		return x.Email
 	}
-	return 0
+	return ""
 }
 
-func (x *RechargeListRequest) GetPageSize() int32 {
+func (x *BindEmailRequest) GetVerifyTicket() string {
 	if x != nil {
-		return x.PageSize
+		return x.VerifyTicket
 	}
-	return 0
+	return ""
 }
 
-type RechargeListResponse struct {
+type BindEmailResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*RechargeOrderInfo   `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	User          *UserInfo              `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RechargeListResponse) Reset() {
-	*x = RechargeListResponse{}
-	mi := &file_user_proto_msgTypes[28]
+func (x *BindEmailResponse) Reset() {
+	*x = BindEmailResponse{}
+	mi := &file_user_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RechargeListResponse) String() string {
+func (x *BindEmailResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RechargeListResponse) ProtoMessage() {}
+func (*BindEmailResponse) ProtoMessage() {}
 
-func (x *RechargeListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[28]
+func (x *BindEmailResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1728,65 +1856,44 @@ func (x *RechargeListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RechargeListResponse.ProtoReflect.Descriptor instead.
-func (*RechargeListResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use BindEmailResponse.ProtoReflect.Descriptor instead.
+func (*BindEmailResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *RechargeListResponse) GetOrders() []*RechargeOrderInfo {
+func (x *BindEmailResponse) GetUser() *UserInfo {
 	if x != nil {
-		return x.Orders
+		return x.User
 	}
 	return nil
 }
 
-func (x *RechargeListResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *RechargeListResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *RechargeListResponse) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-// 消费帅币（支付订单时扣减帅币）
-type ConsumeRequest struct {
+// 修改密码（需验证原手机号）
+type ChangePasswordRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`              // 用户ID
-	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`                            // 消费帅币数量（正数，服务内部会按负数写流水）
-	BizOrderId    string                 `protobuf:"bytes,3,opt,name=biz_order_id,json=bizOrderId,proto3" json:"biz_order_id,omitempty"` // 关联的业务订单号
-	Remark        string                 `protobuf:"bytes,4,opt,name=remark,proto3" json:"remark,omitempty"`                             // 备注
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OldPhone      string                 `protobuf:"bytes,2,opt,name=old_phone,json=oldPhone,proto3" json:"old_phone,omitempty"`
+	NewPassword   string                 `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	VerifyTicket  string                 `protobuf:"bytes,4,opt,name=verify_ticket,json=verifyTicket,proto3" json:"verify_ticket,omitempty"` // 验证码票据（purpose=change_password）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConsumeRequest) Reset() {
-	*x = ConsumeRequest{}
-	mi := &file_user_proto_msgTypes[29]
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_user_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConsumeRequest) String() string {
+func (x *ChangePasswordRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConsumeRequest) ProtoMessage() {}
+func (*ChangePasswordRequest) ProtoMessage() {}
 
-func (x *ConsumeRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[29]
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1797,61 +1904,61 @@ func (x *ConsumeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConsumeRequest.ProtoReflect.Descriptor instead.
-func (*ConsumeRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *ConsumeRequest) GetUserId() uint64 {
+func (x *ChangePasswordRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *ConsumeRequest) GetAmount() int64 {
+func (x *ChangePasswordRequest) GetOldPhone() string {
 	if x != nil {
-		return x.Amount
+		return x.OldPhone
 	}
-	return 0
+	return ""
 }
 
-func (x *ConsumeRequest) GetBizOrderId() string {
+func (x *ChangePasswordRequest) GetNewPassword() string {
 	if x != nil {
-		return x.BizOrderId
+		return x.NewPassword
 	}
 	return ""
 }
 
-func (x *ConsumeRequest) GetRemark() string {
+func (x *ChangePasswordRequest) GetVerifyTicket() string {
 	if x != nil {
-		return x.Remark
+		return x.VerifyTicket
 	}
 	return ""
 }
 
-type ConsumeResponse struct {
+type ChangePasswordResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Wallet        *WalletInfo            `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"` // 扣减后的最新钱包信息
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConsumeResponse) Reset() {
-	*x = ConsumeResponse{}
-	mi := &file_user_proto_msgTypes[30]
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_user_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConsumeResponse) String() string {
+func (x *ChangePasswordResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConsumeResponse) ProtoMessage() {}
+func (*ChangePasswordResponse) ProtoMessage() {}
 
-func (x *ConsumeResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[30]
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1862,50 +1969,43 @@ func (x *ConsumeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConsumeResponse.ProtoReflect.Descriptor instead.
-func (*ConsumeResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *ConsumeResponse) GetWallet() *WalletInfo {
+func (x *ChangePasswordResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Wallet
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-// 陪玩信息
-type CompanionInfo struct {
+// 钱包信息
+type WalletInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                     // 用户ID
-	GameSkill     string                 `protobuf:"bytes,2,opt,name=game_skill,json=gameSkill,proto3" json:"game_skill,omitempty"`             // 游戏技能（单个游戏名称）
-	PricePerHour  int64                  `protobuf:"varint,3,opt,name=price_per_hour,json=pricePerHour,proto3" json:"price_per_hour,omitempty"` // 每小时价格（帅币）
-	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`                                   // 状态：0=离线, 1=在线, 2=忙碌
-	Rating        float64                `protobuf:"fixed64,5,opt,name=rating,proto3" json:"rating,omitempty"`                                  // 评分（0-5分）
-	TotalOrders   int64                  `protobuf:"varint,6,opt,name=total_orders,json=totalOrders,proto3" json:"total_orders,omitempty"`      // 总接单数
-	IsVerified    bool                   `protobuf:"varint,7,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`         // 是否认证
-	AvatarUrl     string                 `protobuf:"bytes,8,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`             // 头像URL
-	Bio           string                 `protobuf:"bytes,9,opt,name=bio,proto3" json:"bio,omitempty"`                                          // 个人简介
-	Nickname      string                 `protobuf:"bytes,10,opt,name=nickname,proto3" json:"nickname,omitempty"`                               // 昵称
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                      // 用户ID
+	Balance       int64                  `protobuf:"varint,2,opt,name=balance,proto3" json:"balance,omitempty"`                                  // 帅币可用余额
+	FrozenBalance int64                  `protobuf:"varint,3,opt,name=frozen_balance,json=frozenBalance,proto3" json:"frozen_balance,omitempty"` // 冻结帅币余额（预留）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompanionInfo) Reset() {
-	*x = CompanionInfo{}
-	mi := &file_user_proto_msgTypes[31]
+func (x *WalletInfo) Reset() {
+	*x = WalletInfo{}
+	mi := &file_user_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompanionInfo) String() string {
+func (x *WalletInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompanionInfo) ProtoMessage() {}
+func (*WalletInfo) ProtoMessage() {}
 
-func (x *CompanionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[31]
+func (x *WalletInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1916,105 +2016,98 @@ func (x *CompanionInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompanionInfo.ProtoReflect.Descriptor instead.
-func (*CompanionInfo) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use WalletInfo.ProtoReflect.Descriptor instead.
+func (*WalletInfo) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *CompanionInfo) GetUserId() uint64 {
+func (x *WalletInfo) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *CompanionInfo) GetGameSkill() string {
-	if x != nil {
-		return x.GameSkill
-	}
-	return ""
-}
-
-func (x *CompanionInfo) GetPricePerHour() int64 {
+func (x *WalletInfo) GetBalance() int64 {
 	if x != nil {
-		return x.PricePerHour
+		return x.Balance
 	}
 	return 0
 }
 
-func (x *CompanionInfo) GetStatus() int32 {
+func (x *WalletInfo) GetFrozenBalance() int64 {
 	if x != nil {
-		return x.Status
+		return x.FrozenBalance
 	}
 	return 0
 }
 
-func (x *CompanionInfo) GetRating() float64 {
-	if x != nil {
-		return x.Rating
-	}
-	return 0
+// 查询钱包
+type GetWalletRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompanionInfo) GetTotalOrders() int64 {
-	if x != nil {
-		return x.TotalOrders
-	}
-	return 0
+func (x *GetWalletRequest) Reset() {
+	*x = GetWalletRequest{}
+	mi := &file_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompanionInfo) GetIsVerified() bool {
-	if x != nil {
-		return x.IsVerified
-	}
-	return false
+func (x *GetWalletRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *CompanionInfo) GetAvatarUrl() string {
+func (*GetWalletRequest) ProtoMessage() {}
+
+func (x *GetWalletRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[31]
 	if x != nil {
-		return x.AvatarUrl
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CompanionInfo) GetBio() string {
-	if x != nil {
-		return x.Bio
-	}
-	return ""
+// Deprecated: Use GetWalletRequest.ProtoReflect.Descriptor instead.
+func (*GetWalletRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *CompanionInfo) GetNickname() string {
+func (x *GetWalletRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Nickname
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-// ------------- 游戏技能（词典）相关 -------------
-type GameSkill struct {
+type GetWalletResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                  // 技能ID
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`               // 技能名称（唯一）
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // 描述（可选）
+	Wallet        *WalletInfo            `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GameSkill) Reset() {
-	*x = GameSkill{}
+func (x *GetWalletResponse) Reset() {
+	*x = GetWalletResponse{}
 	mi := &file_user_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GameSkill) String() string {
+func (x *GetWalletResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GameSkill) ProtoMessage() {}
+func (*GetWalletResponse) ProtoMessage() {}
 
-func (x *GameSkill) ProtoReflect() protoreflect.Message {
+func (x *GetWalletResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2026,52 +2119,43 @@ func (x *GameSkill) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GameSkill.ProtoReflect.Descriptor instead.
-func (*GameSkill) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetWalletResponse.ProtoReflect.Descriptor instead.
+func (*GetWalletResponse) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *GameSkill) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *GameSkill) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *GameSkill) GetDescription() string {
+func (x *GetWalletResponse) GetWallet() *WalletInfo {
 	if x != nil {
-		return x.Description
+		return x.Wallet
 	}
-	return ""
+	return nil
 }
 
-type ListGameSkillsRequest struct {
+// 充值帅币（现金 -> 帅币），这里只处理“加币”，实际支付回调可以在上游确认后调用此接口
+type RechargeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`              // 用户ID
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`                            // 充值帅币数量（正数）
+	BizOrderId    string                 `protobuf:"bytes,3,opt,name=biz_order_id,json=bizOrderId,proto3" json:"biz_order_id,omitempty"` // 关联的支付单号 / 业务订单号
+	Remark        string                 `protobuf:"bytes,4,opt,name=remark,proto3" json:"remark,omitempty"`                             // 备注
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListGameSkillsRequest) Reset() {
-	*x = ListGameSkillsRequest{}
+func (x *RechargeRequest) Reset() {
+	*x = RechargeRequest{}
 	mi := &file_user_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGameSkillsRequest) String() string {
+func (x *RechargeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGameSkillsRequest) ProtoMessage() {}
+func (*RechargeRequest) ProtoMessage() {}
 
-func (x *ListGameSkillsRequest) ProtoReflect() protoreflect.Message {
+func (x *RechargeRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2083,32 +2167,60 @@ func (x *ListGameSkillsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGameSkillsRequest.ProtoReflect.Descriptor instead.
-func (*ListGameSkillsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RechargeRequest.ProtoReflect.Descriptor instead.
+func (*RechargeRequest) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{33}
 }
 
-type ListGameSkillsResponse struct {
+func (x *RechargeRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RechargeRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RechargeRequest) GetBizOrderId() string {
+	if x != nil {
+		return x.BizOrderId
+	}
+	return ""
+}
+
+func (x *RechargeRequest) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+type RechargeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Skills        []*GameSkill           `protobuf:"bytes,1,rep,name=skills,proto3" json:"skills,omitempty"`
+	Wallet        *WalletInfo            `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"` // 充值后的最新钱包信息
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ListGameSkillsResponse) Reset() {
-	*x = ListGameSkillsResponse{}
+func (x *RechargeResponse) Reset() {
+	*x = RechargeResponse{}
 	mi := &file_user_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ListGameSkillsResponse) String() string {
+func (x *RechargeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListGameSkillsResponse) ProtoMessage() {}
+func (*RechargeResponse) ProtoMessage() {}
 
-func (x *ListGameSkillsResponse) ProtoReflect() protoreflect.Message {
+func (x *RechargeResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2120,40 +2232,45 @@ func (x *ListGameSkillsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListGameSkillsResponse.ProtoReflect.Descriptor instead.
-func (*ListGameSkillsResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use RechargeResponse.ProtoReflect.Descriptor instead.
+func (*RechargeResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *ListGameSkillsResponse) GetSkills() []*GameSkill {
+func (x *RechargeResponse) GetWallet() *WalletInfo {
 	if x != nil {
-		return x.Skills
+		return x.Wallet
 	}
 	return nil
 }
 
-type CreateGameSkillRequest struct {
+// 充值订单落库（pending）
+type CreateRechargeOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`               // 技能名称（必填，唯一）
-	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"` // 描述（可选）
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`   // 用户ID
+	OrderNo       string                 `protobuf:"bytes,2,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"` // 充值单号
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`                 // 充值金额（帅币）
+	PayType       string                 `protobuf:"bytes,4,opt,name=pay_type,json=payType,proto3" json:"pay_type,omitempty"` // 支付方式（alipay 等）
+	Remark        string                 `protobuf:"bytes,5,opt,name=remark,proto3" json:"remark,omitempty"`                  // 备注
+	Status        int32                  `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`                 // 订单状态：0=待支付,1=成功,2=失败,3=关闭
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGameSkillRequest) Reset() {
-	*x = CreateGameSkillRequest{}
+func (x *CreateRechargeOrderRequest) Reset() {
+	*x = CreateRechargeOrderRequest{}
 	mi := &file_user_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGameSkillRequest) String() string {
+func (x *CreateRechargeOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGameSkillRequest) ProtoMessage() {}
+func (*CreateRechargeOrderRequest) ProtoMessage() {}
 
-func (x *CreateGameSkillRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateRechargeOrderRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2165,46 +2282,74 @@ func (x *CreateGameSkillRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGameSkillRequest.ProtoReflect.Descriptor instead.
-func (*CreateGameSkillRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateRechargeOrderRequest.ProtoReflect.Descriptor instead.
+func (*CreateRechargeOrderRequest) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *CreateGameSkillRequest) GetName() string {
+func (x *CreateRechargeOrderRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Name
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateRechargeOrderRequest) GetOrderNo() string {
+	if x != nil {
+		return x.OrderNo
 	}
 	return ""
 }
 
-func (x *CreateGameSkillRequest) GetDescription() string {
+func (x *CreateRechargeOrderRequest) GetAmount() int64 {
 	if x != nil {
-		return x.Description
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *CreateRechargeOrderRequest) GetPayType() string {
+	if x != nil {
+		return x.PayType
 	}
 	return ""
 }
 
-type CreateGameSkillResponse struct {
+func (x *CreateRechargeOrderRequest) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+func (x *CreateRechargeOrderRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type CreateRechargeOrderResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Skill         *GameSkill             `protobuf:"bytes,1,opt,name=skill,proto3" json:"skill,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateGameSkillResponse) Reset() {
-	*x = CreateGameSkillResponse{}
+func (x *CreateRechargeOrderResponse) Reset() {
+	*x = CreateRechargeOrderResponse{}
 	mi := &file_user_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateGameSkillResponse) String() string {
+func (x *CreateRechargeOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateGameSkillResponse) ProtoMessage() {}
+func (*CreateRechargeOrderResponse) ProtoMessage() {}
 
-func (x *CreateGameSkillResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateRechargeOrderResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2216,41 +2361,44 @@ func (x *CreateGameSkillResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateGameSkillResponse.ProtoReflect.Descriptor instead.
-func (*CreateGameSkillResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateRechargeOrderResponse.ProtoReflect.Descriptor instead.
+func (*CreateRechargeOrderResponse) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *CreateGameSkillResponse) GetSkill() *GameSkill {
+func (x *CreateRechargeOrderResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Skill
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type UpdateGameSkillRequest struct {
+// 更新充值订单状态
+type UpdateRechargeOrderStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                  // 技能ID（必填）
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`               // 技能名称（必填，唯一）
-	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // 描述（可选）
+	OrderNo       string                 `protobuf:"bytes,1,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"` // 充值单号
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`                 // 订单状态：0=待支付,1=成功,2=失败,3=关闭
+	TradeNo       string                 `protobuf:"bytes,3,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no,omitempty"` // 支付平台交易号（可选）
+	Remark        string                 `protobuf:"bytes,4,opt,name=remark,proto3" json:"remark,omitempty"`                  // 备注（可选）
+	PaidAt        int64                  `protobuf:"varint,5,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`   // 支付完成时间（Unix 秒，可选）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateGameSkillRequest) Reset() {
-	*x = UpdateGameSkillRequest{}
+func (x *UpdateRechargeOrderStatusRequest) Reset() {
+	*x = UpdateRechargeOrderStatusRequest{}
 	mi := &file_user_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGameSkillRequest) String() string {
+func (x *UpdateRechargeOrderStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGameSkillRequest) ProtoMessage() {}
+func (*UpdateRechargeOrderStatusRequest) ProtoMessage() {}
 
-func (x *UpdateGameSkillRequest) ProtoReflect() protoreflect.Message {
+func (x *UpdateRechargeOrderStatusRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2262,53 +2410,67 @@ func (x *UpdateGameSkillRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGameSkillRequest.ProtoReflect.Descriptor instead.
-func (*UpdateGameSkillRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateRechargeOrderStatusRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRechargeOrderStatusRequest) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *UpdateGameSkillRequest) GetId() uint64 {
+func (x *UpdateRechargeOrderStatusRequest) GetOrderNo() string {
 	if x != nil {
-		return x.Id
+		return x.OrderNo
+	}
+	return ""
+}
+
+func (x *UpdateRechargeOrderStatusRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
 	}
 	return 0
 }
 
-func (x *UpdateGameSkillRequest) GetName() string {
+func (x *UpdateRechargeOrderStatusRequest) GetTradeNo() string {
 	if x != nil {
-		return x.Name
+		return x.TradeNo
 	}
 	return ""
 }
 
-func (x *UpdateGameSkillRequest) GetDescription() string {
+func (x *UpdateRechargeOrderStatusRequest) GetRemark() string {
 	if x != nil {
-		return x.Description
+		return x.Remark
 	}
 	return ""
 }
 
-type UpdateGameSkillResponse struct {
+func (x *UpdateRechargeOrderStatusRequest) GetPaidAt() int64 {
+	if x != nil {
+		return x.PaidAt
+	}
+	return 0
+}
+
+type UpdateRechargeOrderStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Skill         *GameSkill             `protobuf:"bytes,1,opt,name=skill,proto3" json:"skill,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateGameSkillResponse) Reset() {
-	*x = UpdateGameSkillResponse{}
+func (x *UpdateRechargeOrderStatusResponse) Reset() {
+	*x = UpdateRechargeOrderStatusResponse{}
 	mi := &file_user_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateGameSkillResponse) String() string {
+func (x *UpdateRechargeOrderStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateGameSkillResponse) ProtoMessage() {}
+func (*UpdateRechargeOrderStatusResponse) ProtoMessage() {}
 
-func (x *UpdateGameSkillResponse) ProtoReflect() protoreflect.Message {
+func (x *UpdateRechargeOrderStatusResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2320,39 +2482,46 @@ func (x *UpdateGameSkillResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateGameSkillResponse.ProtoReflect.Descriptor instead.
-func (*UpdateGameSkillResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use UpdateRechargeOrderStatusResponse.ProtoReflect.Descriptor instead.
+func (*UpdateRechargeOrderStatusResponse) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *UpdateGameSkillResponse) GetSkill() *GameSkill {
+func (x *UpdateRechargeOrderStatusResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Skill
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-type DeleteGameSkillRequest struct {
+// 充值记录列表
+type RechargeOrderInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 技能ID（必填）
+	OrderNo       string                 `protobuf:"bytes,1,opt,name=order_no,json=orderNo,proto3" json:"order_no,omitempty"`
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`                        // 订单状态：0=待支付,1=成功,2=失败,3=关闭
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`                        // 充值金额
+	PayType       string                 `protobuf:"bytes,4,opt,name=pay_type,json=payType,proto3" json:"pay_type,omitempty"`        // 支付方式
+	TradeNo       string                 `protobuf:"bytes,5,opt,name=trade_no,json=tradeNo,proto3" json:"trade_no,omitempty"`        // 支付平台交易号（可选）
+	PaidAt        int64                  `protobuf:"varint,6,opt,name=paid_at,json=paidAt,proto3" json:"paid_at,omitempty"`          // 支付完成时间（Unix 秒，可选）
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"` // 创建时间（Unix 秒）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteGameSkillRequest) Reset() {
-	*x = DeleteGameSkillRequest{}
+func (x *RechargeOrderInfo) Reset() {
+	*x = RechargeOrderInfo{}
 	mi := &file_user_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGameSkillRequest) String() string {
+func (x *RechargeOrderInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGameSkillRequest) ProtoMessage() {}
+func (*RechargeOrderInfo) ProtoMessage() {}
 
-func (x *DeleteGameSkillRequest) ProtoReflect() protoreflect.Message {
+func (x *RechargeOrderInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2364,39 +2533,84 @@ func (x *DeleteGameSkillRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGameSkillRequest.ProtoReflect.Descriptor instead.
-func (*DeleteGameSkillRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RechargeOrderInfo.ProtoReflect.Descriptor instead.
+func (*RechargeOrderInfo) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *DeleteGameSkillRequest) GetId() uint64 {
+func (x *RechargeOrderInfo) GetOrderNo() string {
 	if x != nil {
-		return x.Id
+		return x.OrderNo
+	}
+	return ""
+}
+
+func (x *RechargeOrderInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
 	}
 	return 0
 }
 
-type DeleteGameSkillResponse struct {
+func (x *RechargeOrderInfo) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *RechargeOrderInfo) GetPayType() string {
+	if x != nil {
+		return x.PayType
+	}
+	return ""
+}
+
+func (x *RechargeOrderInfo) GetTradeNo() string {
+	if x != nil {
+		return x.TradeNo
+	}
+	return ""
+}
+
+func (x *RechargeOrderInfo) GetPaidAt() int64 {
+	if x != nil {
+		return x.PaidAt
+	}
+	return 0
+}
+
+func (x *RechargeOrderInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+type RechargeListRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`       // 用户ID
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`                     // 订单状态筛选（-1=全部）
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                         // 页码（从1开始）
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteGameSkillResponse) Reset() {
-	*x = DeleteGameSkillResponse{}
+func (x *RechargeListRequest) Reset() {
+	*x = RechargeListRequest{}
 	mi := &file_user_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteGameSkillResponse) String() string {
+func (x *RechargeListRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteGameSkillResponse) ProtoMessage() {}
+func (*RechargeListRequest) ProtoMessage() {}
 
-func (x *DeleteGameSkillResponse) ProtoReflect() protoreflect.Message {
+func (x *RechargeListRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2408,40 +2622,63 @@ func (x *DeleteGameSkillResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteGameSkillResponse.ProtoReflect.Descriptor instead.
-func (*DeleteGameSkillResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RechargeListRequest.ProtoReflect.Descriptor instead.
+func (*RechargeListRequest) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *DeleteGameSkillResponse) GetSuccess() bool {
+func (x *RechargeListRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Success
+		return x.UserId
 	}
-	return false
+	return 0
 }
 
-// 获取陪玩信息
-type GetCompanionProfileRequest struct {
+func (x *RechargeListRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *RechargeListRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *RechargeListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type RechargeListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	Orders        []*RechargeOrderInfo   `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCompanionProfileRequest) Reset() {
-	*x = GetCompanionProfileRequest{}
+func (x *RechargeListResponse) Reset() {
+	*x = RechargeListResponse{}
 	mi := &file_user_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanionProfileRequest) String() string {
+func (x *RechargeListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanionProfileRequest) ProtoMessage() {}
+func (*RechargeListResponse) ProtoMessage() {}
 
-func (x *GetCompanionProfileRequest) ProtoReflect() protoreflect.Message {
+func (x *RechargeListResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2453,88 +2690,65 @@ func (x *GetCompanionProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanionProfileRequest.ProtoReflect.Descriptor instead.
-func (*GetCompanionProfileRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RechargeListResponse.ProtoReflect.Descriptor instead.
+func (*RechargeListResponse) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *GetCompanionProfileRequest) GetUserId() uint64 {
+func (x *RechargeListResponse) GetOrders() []*RechargeOrderInfo {
 	if x != nil {
-		return x.UserId
+		return x.Orders
 	}
-	return 0
-}
-
-type GetCompanionProfileResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Profile       *CompanionInfo         `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetCompanionProfileResponse) Reset() {
-	*x = GetCompanionProfileResponse{}
-	mi := &file_user_proto_msgTypes[42]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *GetCompanionProfileResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return nil
 }
 
-func (*GetCompanionProfileResponse) ProtoMessage() {}
-
-func (x *GetCompanionProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[42]
+func (x *RechargeListResponse) GetTotal() int32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Total
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetCompanionProfileResponse.ProtoReflect.Descriptor instead.
-func (*GetCompanionProfileResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{42}
+func (x *RechargeListResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
 }
 
-func (x *GetCompanionProfileResponse) GetProfile() *CompanionInfo {
+func (x *RechargeListResponse) GetPageSize() int32 {
 	if x != nil {
-		return x.Profile
+		return x.PageSize
 	}
-	return nil
+	return 0
 }
 
-// 更新陪玩信息
-type UpdateCompanionProfileRequest struct {
+// 消费帅币（支付订单时扣减帅币）
+type ConsumeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                     // 用户ID（必填）
-	GameSkill     string                 `protobuf:"bytes,2,opt,name=game_skill,json=gameSkill,proto3" json:"game_skill,omitempty"`             // 可选，游戏技能（单个游戏名称）
-	PricePerHour  int64                  `protobuf:"varint,3,opt,name=price_per_hour,json=pricePerHour,proto3" json:"price_per_hour,omitempty"` // 可选，每小时价格（帅币）
-	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`                                   // 可选，状态：0=离线, 1=在线, 2=忙碌
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`              // 用户ID
+	Amount        int64                  `protobuf:"varint,2,opt,name=amount,proto3" json:"amount,omitempty"`                            // 消费帅币数量（正数，服务内部会按负数写流水）
+	BizOrderId    string                 `protobuf:"bytes,3,opt,name=biz_order_id,json=bizOrderId,proto3" json:"biz_order_id,omitempty"` // 关联的业务订单号
+	Remark        string                 `protobuf:"bytes,4,opt,name=remark,proto3" json:"remark,omitempty"`                             // 备注
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCompanionProfileRequest) Reset() {
-	*x = UpdateCompanionProfileRequest{}
-	mi := &file_user_proto_msgTypes[43]
+func (x *ConsumeRequest) Reset() {
+	*x = ConsumeRequest{}
+	mi := &file_user_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCompanionProfileRequest) String() string {
+func (x *ConsumeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCompanionProfileRequest) ProtoMessage() {}
+func (*ConsumeRequest) ProtoMessage() {}
 
-func (x *UpdateCompanionProfileRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[43]
+func (x *ConsumeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2545,61 +2759,61 @@ func (x *UpdateCompanionProfileRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCompanionProfileRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCompanionProfileRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use ConsumeRequest.ProtoReflect.Descriptor instead.
+func (*ConsumeRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *UpdateCompanionProfileRequest) GetUserId() uint64 {
+func (x *ConsumeRequest) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *UpdateCompanionProfileRequest) GetGameSkill() string {
+func (x *ConsumeRequest) GetAmount() int64 {
 	if x != nil {
-		return x.GameSkill
+		return x.Amount
 	}
-	return ""
+	return 0
 }
 
-func (x *UpdateCompanionProfileRequest) GetPricePerHour() int64 {
+func (x *ConsumeRequest) GetBizOrderId() string {
 	if x != nil {
-		return x.PricePerHour
+		return x.BizOrderId
 	}
-	return 0
+	return ""
 }
 
-func (x *UpdateCompanionProfileRequest) GetStatus() int32 {
+func (x *ConsumeRequest) GetRemark() string {
 	if x != nil {
-		return x.Status
+		return x.Remark
 	}
-	return 0
+	return ""
 }
 
-type UpdateCompanionProfileResponse struct {
+type ConsumeResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Profile       *CompanionInfo         `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	Wallet        *WalletInfo            `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"` // 扣减后的最新钱包信息
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCompanionProfileResponse) Reset() {
-	*x = UpdateCompanionProfileResponse{}
-	mi := &file_user_proto_msgTypes[44]
+func (x *ConsumeResponse) Reset() {
+	*x = ConsumeResponse{}
+	mi := &file_user_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCompanionProfileResponse) String() string {
+func (x *ConsumeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCompanionProfileResponse) ProtoMessage() {}
+func (*ConsumeResponse) ProtoMessage() {}
 
-func (x *UpdateCompanionProfileResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[44]
+func (x *ConsumeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2610,43 +2824,46 @@ func (x *UpdateCompanionProfileResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCompanionProfileResponse.ProtoReflect.Descriptor instead.
-func (*UpdateCompanionProfileResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use ConsumeResponse.ProtoReflect.Descriptor instead.
+func (*ConsumeResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *UpdateCompanionProfileResponse) GetProfile() *CompanionInfo {
+func (x *ConsumeResponse) GetWallet() *WalletInfo {
 	if x != nil {
-		return x.Profile
+		return x.Wallet
 	}
 	return nil
 }
 
-// 更新陪玩统计信息（评分 & 接单数）
-type UpdateCompanionStatsRequest struct {
+// 礼物信息
+type GiftInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // 用户ID（陪玩）
-	DeltaOrders   int64                  `protobuf:"varint,2,opt,name=delta_orders,json=deltaOrders,proto3" json:"delta_orders,omitempty"` // 接单数增量（一般为1）
-	NewRating     float64                `protobuf:"fixed64,3,opt,name=new_rating,json=newRating,proto3" json:"new_rating,omitempty"`      // 本次订单评分（0-5）
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                         // 礼物ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                      // 礼物名称
+	Price         int64                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`                   // 单价（帅币）
+	IconUrl       string                 `protobuf:"bytes,4,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"` // 图标URL
+	Sort          int32                  `protobuf:"varint,5,opt,name=sort,proto3" json:"sort,omitempty"`                     // 排序（越小越靠前）
+	Enabled       bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`               // 是否上架
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateCompanionStatsRequest) Reset() {
-	*x = UpdateCompanionStatsRequest{}
-	mi := &file_user_proto_msgTypes[45]
+func (x *GiftInfo) Reset() {
+	*x = GiftInfo{}
+	mi := &file_user_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCompanionStatsRequest) String() string {
+func (x *GiftInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCompanionStatsRequest) ProtoMessage() {}
+func (*GiftInfo) ProtoMessage() {}
 
-func (x *UpdateCompanionStatsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[45]
+func (x *GiftInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2657,54 +2874,76 @@ func (x *UpdateCompanionStatsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCompanionStatsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateCompanionStatsRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{45}
+// Deprecated: Use GiftInfo.ProtoReflect.Descriptor instead.
+func (*GiftInfo) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *UpdateCompanionStatsRequest) GetUserId() uint64 {
+func (x *GiftInfo) GetId() uint64 {
 	if x != nil {
-		return x.UserId
+		return x.Id
 	}
 	return 0
 }
 
-func (x *UpdateCompanionStatsRequest) GetDeltaOrders() int64 {
+func (x *GiftInfo) GetName() string {
 	if x != nil {
-		return x.DeltaOrders
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GiftInfo) GetPrice() int64 {
+	if x != nil {
+		return x.Price
 	}
 	return 0
 }
 
-func (x *UpdateCompanionStatsRequest) GetNewRating() float64 {
+func (x *GiftInfo) GetIconUrl() string {
 	if x != nil {
-		return x.NewRating
+		return x.IconUrl
+	}
+	return ""
+}
+
+func (x *GiftInfo) GetSort() int32 {
+	if x != nil {
+		return x.Sort
 	}
 	return 0
 }
 
-type UpdateCompanionStatsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Profile       *CompanionInfo         `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *GiftInfo) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
 }
 
-func (x *UpdateCompanionStatsResponse) Reset() {
-	*x = UpdateCompanionStatsResponse{}
-	mi := &file_user_proto_msgTypes[46]
+// 礼物列表
+type ListGiftsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeDisabled bool                   `protobuf:"varint,1,opt,name=include_disabled,json=includeDisabled,proto3" json:"include_disabled,omitempty"` // 是否包含已下架礼物（管理端使用）
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListGiftsRequest) Reset() {
+	*x = ListGiftsRequest{}
+	mi := &file_user_proto_msgTypes[45]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateCompanionStatsResponse) String() string {
+func (x *ListGiftsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateCompanionStatsResponse) ProtoMessage() {}
+func (*ListGiftsRequest) ProtoMessage() {}
 
-func (x *UpdateCompanionStatsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[46]
+func (x *ListGiftsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[45]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2715,46 +2954,87 @@ func (x *UpdateCompanionStatsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateCompanionStatsResponse.ProtoReflect.Descriptor instead.
-func (*UpdateCompanionStatsResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use ListGiftsRequest.ProtoReflect.Descriptor instead.
+func (*ListGiftsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *UpdateCompanionStatsResponse) GetProfile() *CompanionInfo {
+func (x *ListGiftsRequest) GetIncludeDisabled() bool {
 	if x != nil {
-		return x.Profile
+		return x.IncludeDisabled
 	}
-	return nil
+	return false
 }
 
-// 获取陪玩列表（用于订单匹配）
-type GetCompanionListRequest struct {
+type ListGiftsResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	GameSkill     string                 `protobuf:"bytes,1,opt,name=game_skill,json=gameSkill,proto3" json:"game_skill,omitempty"`     // 可选，游戏技能筛选（单个游戏名称）
-	MinPrice      int32                  `protobuf:"varint,2,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`       // 可选，最低价格
-	MaxPrice      int32                  `protobuf:"varint,3,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`       // 可选，最高价格
-	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`                           // 可选，状态筛选：0=离线, 1=在线, 2=忙碌（默认只返回在线）
-	IsVerified    bool                   `protobuf:"varint,5,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"` // 可选，是否只返回认证陪玩
-	Page          int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`                               // 页码（从1开始）
-	PageSize      int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`       // 每页数量
+	Gifts         []*GiftInfo            `protobuf:"bytes,1,rep,name=gifts,proto3" json:"gifts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCompanionListRequest) Reset() {
-	*x = GetCompanionListRequest{}
-	mi := &file_user_proto_msgTypes[47]
+func (x *ListGiftsResponse) Reset() {
+	*x = ListGiftsResponse{}
+	mi := &file_user_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanionListRequest) String() string {
+func (x *ListGiftsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanionListRequest) ProtoMessage() {}
+func (*ListGiftsResponse) ProtoMessage() {}
 
-func (x *GetCompanionListRequest) ProtoReflect() protoreflect.Message {
+func (x *ListGiftsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGiftsResponse.ProtoReflect.Descriptor instead.
+func (*ListGiftsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *ListGiftsResponse) GetGifts() []*GiftInfo {
+	if x != nil {
+		return x.Gifts
+	}
+	return nil
+}
+
+// 创建礼物（管理员）
+type CreateGiftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Price         int64                  `protobuf:"varint,2,opt,name=price,proto3" json:"price,omitempty"`
+	IconUrl       string                 `protobuf:"bytes,3,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"`
+	Sort          int32                  `protobuf:"varint,4,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGiftRequest) Reset() {
+	*x = CreateGiftRequest{}
+	mi := &file_user_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGiftRequest) ProtoMessage() {}
+
+func (x *CreateGiftRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_user_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2766,85 +3046,190 @@ func (x *GetCompanionListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanionListRequest.ProtoReflect.Descriptor instead.
-func (*GetCompanionListRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateGiftRequest.ProtoReflect.Descriptor instead.
+func (*CreateGiftRequest) Descriptor() ([]byte, []int) {
 	return file_user_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *GetCompanionListRequest) GetGameSkill() string {
+func (x *CreateGiftRequest) GetName() string {
 	if x != nil {
-		return x.GameSkill
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetCompanionListRequest) GetMinPrice() int32 {
+func (x *CreateGiftRequest) GetPrice() int64 {
 	if x != nil {
-		return x.MinPrice
+		return x.Price
 	}
 	return 0
 }
 
-func (x *GetCompanionListRequest) GetMaxPrice() int32 {
+func (x *CreateGiftRequest) GetIconUrl() string {
 	if x != nil {
-		return x.MaxPrice
+		return x.IconUrl
+	}
+	return ""
+}
+
+func (x *CreateGiftRequest) GetSort() int32 {
+	if x != nil {
+		return x.Sort
 	}
 	return 0
 }
 
-func (x *GetCompanionListRequest) GetStatus() int32 {
+type CreateGiftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Gift          *GiftInfo              `protobuf:"bytes,1,opt,name=gift,proto3" json:"gift,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGiftResponse) Reset() {
+	*x = CreateGiftResponse{}
+	mi := &file_user_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGiftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGiftResponse) ProtoMessage() {}
+
+func (x *CreateGiftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[48]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGiftResponse.ProtoReflect.Descriptor instead.
+func (*CreateGiftResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *CreateGiftResponse) GetGift() *GiftInfo {
+	if x != nil {
+		return x.Gift
+	}
+	return nil
+}
+
+// 更新礼物（管理员）
+type UpdateGiftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                      // 为空表示不修改
+	Price         int64                  `protobuf:"varint,3,opt,name=price,proto3" json:"price,omitempty"`                   // 0 表示不修改
+	IconUrl       string                 `protobuf:"bytes,4,opt,name=icon_url,json=iconUrl,proto3" json:"icon_url,omitempty"` // 为空表示不修改
+	Sort          int32                  `protobuf:"varint,5,opt,name=sort,proto3" json:"sort,omitempty"`
+	Enabled       bool                   `protobuf:"varint,6,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGiftRequest) Reset() {
+	*x = UpdateGiftRequest{}
+	mi := &file_user_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGiftRequest) ProtoMessage() {}
+
+func (x *UpdateGiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGiftRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGiftRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *UpdateGiftRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
 	}
 	return 0
 }
 
-func (x *GetCompanionListRequest) GetIsVerified() bool {
+func (x *UpdateGiftRequest) GetName() string {
 	if x != nil {
-		return x.IsVerified
+		return x.Name
 	}
-	return false
+	return ""
 }
 
-func (x *GetCompanionListRequest) GetPage() int32 {
+func (x *UpdateGiftRequest) GetPrice() int64 {
 	if x != nil {
-		return x.Page
+		return x.Price
 	}
 	return 0
 }
 
-func (x *GetCompanionListRequest) GetPageSize() int32 {
+func (x *UpdateGiftRequest) GetIconUrl() string {
 	if x != nil {
-		return x.PageSize
+		return x.IconUrl
+	}
+	return ""
+}
+
+func (x *UpdateGiftRequest) GetSort() int32 {
+	if x != nil {
+		return x.Sort
 	}
 	return 0
 }
 
-type GetCompanionListResponse struct {
+func (x *UpdateGiftRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type UpdateGiftResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Companions    []*CompanionInfo       `protobuf:"bytes,1,rep,name=companions,proto3" json:"companions,omitempty"`              // 陪玩列表
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                       // 总数
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                         // 当前页码
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	Gift          *GiftInfo              `protobuf:"bytes,1,opt,name=gift,proto3" json:"gift,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCompanionListResponse) Reset() {
-	*x = GetCompanionListResponse{}
-	mi := &file_user_proto_msgTypes[48]
+func (x *UpdateGiftResponse) Reset() {
+	*x = UpdateGiftResponse{}
+	mi := &file_user_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanionListResponse) String() string {
+func (x *UpdateGiftResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanionListResponse) ProtoMessage() {}
+func (*UpdateGiftResponse) ProtoMessage() {}
 
-func (x *GetCompanionListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[48]
+func (x *UpdateGiftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2855,68 +3240,4450 @@ func (x *GetCompanionListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanionListResponse.ProtoReflect.Descriptor instead.
-func (*GetCompanionListResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use UpdateGiftResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGiftResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *GetCompanionListResponse) GetCompanions() []*CompanionInfo {
+func (x *UpdateGiftResponse) GetGift() *GiftInfo {
 	if x != nil {
-		return x.Companions
+		return x.Gift
 	}
 	return nil
 }
 
-func (x *GetCompanionListResponse) GetTotal() int32 {
+// 帅币转账/打赏（用户 -> 用户）
+type TransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	FromUserId    uint64                 `protobuf:"varint,1,opt,name=from_user_id,json=fromUserId,proto3" json:"from_user_id,omitempty"` // 转出用户ID
+	ToUserId      uint64                 `protobuf:"varint,2,opt,name=to_user_id,json=toUserId,proto3" json:"to_user_id,omitempty"`       // 转入用户ID
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`                             // 转账帅币数量（正数）
+	BizOrderId    string                 `protobuf:"bytes,4,opt,name=biz_order_id,json=bizOrderId,proto3" json:"biz_order_id,omitempty"`  // 业务单号（幂等，为空时服务端生成）
+	Remark        string                 `protobuf:"bytes,5,opt,name=remark,proto3" json:"remark,omitempty"`                              // 备注/留言
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferRequest) Reset() {
+	*x = TransferRequest{}
+	mi := &file_user_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferRequest) ProtoMessage() {}
+
+func (x *TransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[51]
 	if x != nil {
-		return x.Total
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferRequest.ProtoReflect.Descriptor instead.
+func (*TransferRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *TransferRequest) GetFromUserId() uint64 {
+	if x != nil {
+		return x.FromUserId
 	}
 	return 0
 }
 
-func (x *GetCompanionListResponse) GetPage() int32 {
+func (x *TransferRequest) GetToUserId() uint64 {
 	if x != nil {
-		return x.Page
+		return x.ToUserId
+	}
+	return 0
+}
+
+func (x *TransferRequest) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *TransferRequest) GetBizOrderId() string {
+	if x != nil {
+		return x.BizOrderId
+	}
+	return ""
+}
+
+func (x *TransferRequest) GetRemark() string {
+	if x != nil {
+		return x.Remark
+	}
+	return ""
+}
+
+type TransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Wallet        *WalletInfo            `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`                             // 转出方最新钱包信息
+	BizOrderId    string                 `protobuf:"bytes,2,opt,name=biz_order_id,json=bizOrderId,proto3" json:"biz_order_id,omitempty"` // 本次转账业务单号（双方流水共用）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferResponse) Reset() {
+	*x = TransferResponse{}
+	mi := &file_user_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferResponse) ProtoMessage() {}
+
+func (x *TransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferResponse.ProtoReflect.Descriptor instead.
+func (*TransferResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *TransferResponse) GetWallet() *WalletInfo {
+	if x != nil {
+		return x.Wallet
+	}
+	return nil
+}
+
+func (x *TransferResponse) GetBizOrderId() string {
+	if x != nil {
+		return x.BizOrderId
+	}
+	return ""
+}
+
+// 赠送礼物
+type SendGiftRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	SenderId      uint64                 `protobuf:"varint,1,opt,name=sender_id,json=senderId,proto3" json:"sender_id,omitempty"`        // 赠送者ID
+	ReceiverId    uint64                 `protobuf:"varint,2,opt,name=receiver_id,json=receiverId,proto3" json:"receiver_id,omitempty"`  // 接收者ID
+	GiftId        uint64                 `protobuf:"varint,3,opt,name=gift_id,json=giftId,proto3" json:"gift_id,omitempty"`              // 礼物ID
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`                        // 数量（默认1）
+	BizOrderId    string                 `protobuf:"bytes,5,opt,name=biz_order_id,json=bizOrderId,proto3" json:"biz_order_id,omitempty"` // 业务单号（幂等，为空时服务端生成）
+	Message       string                 `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`                           // 附言
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendGiftRequest) Reset() {
+	*x = SendGiftRequest{}
+	mi := &file_user_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendGiftRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendGiftRequest) ProtoMessage() {}
+
+func (x *SendGiftRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendGiftRequest.ProtoReflect.Descriptor instead.
+func (*SendGiftRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *SendGiftRequest) GetSenderId() uint64 {
+	if x != nil {
+		return x.SenderId
+	}
+	return 0
+}
+
+func (x *SendGiftRequest) GetReceiverId() uint64 {
+	if x != nil {
+		return x.ReceiverId
+	}
+	return 0
+}
+
+func (x *SendGiftRequest) GetGiftId() uint64 {
+	if x != nil {
+		return x.GiftId
+	}
+	return 0
+}
+
+func (x *SendGiftRequest) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *SendGiftRequest) GetBizOrderId() string {
+	if x != nil {
+		return x.BizOrderId
+	}
+	return ""
+}
+
+func (x *SendGiftRequest) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type SendGiftResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Wallet        *WalletInfo            `protobuf:"bytes,1,opt,name=wallet,proto3" json:"wallet,omitempty"`                             // 赠送者最新钱包信息
+	BizOrderId    string                 `protobuf:"bytes,2,opt,name=biz_order_id,json=bizOrderId,proto3" json:"biz_order_id,omitempty"` // 本次赠送业务单号
+	Amount        int64                  `protobuf:"varint,3,opt,name=amount,proto3" json:"amount,omitempty"`                            // 实际扣减帅币
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SendGiftResponse) Reset() {
+	*x = SendGiftResponse{}
+	mi := &file_user_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SendGiftResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SendGiftResponse) ProtoMessage() {}
+
+func (x *SendGiftResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SendGiftResponse.ProtoReflect.Descriptor instead.
+func (*SendGiftResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *SendGiftResponse) GetWallet() *WalletInfo {
+	if x != nil {
+		return x.Wallet
+	}
+	return nil
+}
+
+func (x *SendGiftResponse) GetBizOrderId() string {
+	if x != nil {
+		return x.BizOrderId
+	}
+	return ""
+}
+
+func (x *SendGiftResponse) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+// 会员套餐
+type VipPlanInfo struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	Code                string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`                                                              // 套餐编码
+	Name                string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`                                                              // 套餐名称
+	Level               int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`                                                           // 会员等级（越大权益越高）
+	DurationDays        int32                  `protobuf:"varint,4,opt,name=duration_days,json=durationDays,proto3" json:"duration_days,omitempty"`                         // 时长（天）
+	Price               int64                  `protobuf:"varint,5,opt,name=price,proto3" json:"price,omitempty"`                                                           // 价格（帅币）
+	DiscountPercent     int32                  `protobuf:"varint,6,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent,omitempty"`                // 下单折扣百分比（如 5 表示减免 5%）
+	Badge               string                 `protobuf:"bytes,7,opt,name=badge,proto3" json:"badge,omitempty"`                                                            // 徽章
+	RecommendPriority   int32                  `protobuf:"varint,8,opt,name=recommend_priority,json=recommendPriority,proto3" json:"recommend_priority,omitempty"`          // 推荐优先级
+	RateLimitMultiplier float64                `protobuf:"fixed64,9,opt,name=rate_limit_multiplier,json=rateLimitMultiplier,proto3" json:"rate_limit_multiplier,omitempty"` // 接口限流倍数
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *VipPlanInfo) Reset() {
+	*x = VipPlanInfo{}
+	mi := &file_user_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VipPlanInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VipPlanInfo) ProtoMessage() {}
+
+func (x *VipPlanInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VipPlanInfo.ProtoReflect.Descriptor instead.
+func (*VipPlanInfo) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *VipPlanInfo) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *VipPlanInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *VipPlanInfo) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *VipPlanInfo) GetDurationDays() int32 {
+	if x != nil {
+		return x.DurationDays
+	}
+	return 0
+}
+
+func (x *VipPlanInfo) GetPrice() int64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *VipPlanInfo) GetDiscountPercent() int32 {
+	if x != nil {
+		return x.DiscountPercent
+	}
+	return 0
+}
+
+func (x *VipPlanInfo) GetBadge() string {
+	if x != nil {
+		return x.Badge
+	}
+	return ""
+}
+
+func (x *VipPlanInfo) GetRecommendPriority() int32 {
+	if x != nil {
+		return x.RecommendPriority
+	}
+	return 0
+}
+
+func (x *VipPlanInfo) GetRateLimitMultiplier() float64 {
+	if x != nil {
+		return x.RateLimitMultiplier
+	}
+	return 0
+}
+
+type ListVipPlansRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVipPlansRequest) Reset() {
+	*x = ListVipPlansRequest{}
+	mi := &file_user_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVipPlansRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVipPlansRequest) ProtoMessage() {}
+
+func (x *ListVipPlansRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVipPlansRequest.ProtoReflect.Descriptor instead.
+func (*ListVipPlansRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{56}
+}
+
+type ListVipPlansResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Plans         []*VipPlanInfo         `protobuf:"bytes,1,rep,name=plans,proto3" json:"plans,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVipPlansResponse) Reset() {
+	*x = ListVipPlansResponse{}
+	mi := &file_user_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVipPlansResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVipPlansResponse) ProtoMessage() {}
+
+func (x *ListVipPlansResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVipPlansResponse.ProtoReflect.Descriptor instead.
+func (*ListVipPlansResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListVipPlansResponse) GetPlans() []*VipPlanInfo {
+	if x != nil {
+		return x.Plans
+	}
+	return nil
+}
+
+// 会员订阅
+type VipSubscriptionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PlanCode      string                 `protobuf:"bytes,2,opt,name=plan_code,json=planCode,proto3" json:"plan_code,omitempty"`     // 当前套餐编码
+	Level         int32                  `protobuf:"varint,3,opt,name=level,proto3" json:"level,omitempty"`                          // 会员等级
+	StartAt       int64                  `protobuf:"varint,4,opt,name=start_at,json=startAt,proto3" json:"start_at,omitempty"`       // 开始时间（Unix 秒）
+	EndAt         int64                  `protobuf:"varint,5,opt,name=end_at,json=endAt,proto3" json:"end_at,omitempty"`             // 到期时间（Unix 秒）
+	AutoRenew     bool                   `protobuf:"varint,6,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"` // 是否自动续费
+	Status        int32                  `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`                        // 状态：1=生效中, 2=已过期
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VipSubscriptionInfo) Reset() {
+	*x = VipSubscriptionInfo{}
+	mi := &file_user_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VipSubscriptionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VipSubscriptionInfo) ProtoMessage() {}
+
+func (x *VipSubscriptionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VipSubscriptionInfo.ProtoReflect.Descriptor instead.
+func (*VipSubscriptionInfo) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *VipSubscriptionInfo) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *VipSubscriptionInfo) GetPlanCode() string {
+	if x != nil {
+		return x.PlanCode
+	}
+	return ""
+}
+
+func (x *VipSubscriptionInfo) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *VipSubscriptionInfo) GetStartAt() int64 {
+	if x != nil {
+		return x.StartAt
+	}
+	return 0
+}
+
+func (x *VipSubscriptionInfo) GetEndAt() int64 {
+	if x != nil {
+		return x.EndAt
+	}
+	return 0
+}
+
+func (x *VipSubscriptionInfo) GetAutoRenew() bool {
+	if x != nil {
+		return x.AutoRenew
+	}
+	return false
+}
+
+func (x *VipSubscriptionInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+// 购买/续费会员（使用帅币）
+type SubscribeVipRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	PlanCode      string                 `protobuf:"bytes,2,opt,name=plan_code,json=planCode,proto3" json:"plan_code,omitempty"`         // 套餐编码
+	AutoRenew     bool                   `protobuf:"varint,3,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`     // 是否开启自动续费
+	BizOrderId    string                 `protobuf:"bytes,4,opt,name=biz_order_id,json=bizOrderId,proto3" json:"biz_order_id,omitempty"` // 业务单号（幂等，为空时服务端生成）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeVipRequest) Reset() {
+	*x = SubscribeVipRequest{}
+	mi := &file_user_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeVipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeVipRequest) ProtoMessage() {}
+
+func (x *SubscribeVipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeVipRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeVipRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *SubscribeVipRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SubscribeVipRequest) GetPlanCode() string {
+	if x != nil {
+		return x.PlanCode
+	}
+	return ""
+}
+
+func (x *SubscribeVipRequest) GetAutoRenew() bool {
+	if x != nil {
+		return x.AutoRenew
+	}
+	return false
+}
+
+func (x *SubscribeVipRequest) GetBizOrderId() string {
+	if x != nil {
+		return x.BizOrderId
+	}
+	return ""
+}
+
+type SubscribeVipResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *VipSubscriptionInfo   `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	Wallet        *WalletInfo            `protobuf:"bytes,2,opt,name=wallet,proto3" json:"wallet,omitempty"` // 扣款后的钱包信息
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubscribeVipResponse) Reset() {
+	*x = SubscribeVipResponse{}
+	mi := &file_user_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubscribeVipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribeVipResponse) ProtoMessage() {}
+
+func (x *SubscribeVipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribeVipResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeVipResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *SubscribeVipResponse) GetSubscription() *VipSubscriptionInfo {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+func (x *SubscribeVipResponse) GetWallet() *WalletInfo {
+	if x != nil {
+		return x.Wallet
+	}
+	return nil
+}
+
+// 开启/关闭自动续费
+type SetVipAutoRenewRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AutoRenew     bool                   `protobuf:"varint,2,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetVipAutoRenewRequest) Reset() {
+	*x = SetVipAutoRenewRequest{}
+	mi := &file_user_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVipAutoRenewRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVipAutoRenewRequest) ProtoMessage() {}
+
+func (x *SetVipAutoRenewRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVipAutoRenewRequest.ProtoReflect.Descriptor instead.
+func (*SetVipAutoRenewRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *SetVipAutoRenewRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetVipAutoRenewRequest) GetAutoRenew() bool {
+	if x != nil {
+		return x.AutoRenew
+	}
+	return false
+}
+
+type SetVipAutoRenewResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Subscription  *VipSubscriptionInfo   `protobuf:"bytes,1,opt,name=subscription,proto3" json:"subscription,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetVipAutoRenewResponse) Reset() {
+	*x = SetVipAutoRenewResponse{}
+	mi := &file_user_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetVipAutoRenewResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetVipAutoRenewResponse) ProtoMessage() {}
+
+func (x *SetVipAutoRenewResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetVipAutoRenewResponse.ProtoReflect.Descriptor instead.
+func (*SetVipAutoRenewResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *SetVipAutoRenewResponse) GetSubscription() *VipSubscriptionInfo {
+	if x != nil {
+		return x.Subscription
+	}
+	return nil
+}
+
+// 查询会员权益（供其他服务调用）
+type GetVipEntitlementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVipEntitlementsRequest) Reset() {
+	*x = GetVipEntitlementsRequest{}
+	mi := &file_user_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVipEntitlementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVipEntitlementsRequest) ProtoMessage() {}
+
+func (x *GetVipEntitlementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVipEntitlementsRequest.ProtoReflect.Descriptor instead.
+func (*GetVipEntitlementsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *GetVipEntitlementsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetVipEntitlementsResponse struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	IsVip               bool                   `protobuf:"varint,1,opt,name=is_vip,json=isVip,proto3" json:"is_vip,omitempty"`                                              // 是否为生效中的会员
+	Level               int32                  `protobuf:"varint,2,opt,name=level,proto3" json:"level,omitempty"`                                                           // 会员等级（0=非会员）
+	PlanCode            string                 `protobuf:"bytes,3,opt,name=plan_code,json=planCode,proto3" json:"plan_code,omitempty"`                                      // 套餐编码
+	Badge               string                 `protobuf:"bytes,4,opt,name=badge,proto3" json:"badge,omitempty"`                                                            // 徽章
+	DiscountPercent     int32                  `protobuf:"varint,5,opt,name=discount_percent,json=discountPercent,proto3" json:"discount_percent,omitempty"`                // 下单折扣百分比
+	RecommendPriority   int32                  `protobuf:"varint,6,opt,name=recommend_priority,json=recommendPriority,proto3" json:"recommend_priority,omitempty"`          // 推荐优先级
+	RateLimitMultiplier float64                `protobuf:"fixed64,7,opt,name=rate_limit_multiplier,json=rateLimitMultiplier,proto3" json:"rate_limit_multiplier,omitempty"` // 接口限流倍数（非会员为1）
+	ExpireAt            int64                  `protobuf:"varint,8,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`                                     // 到期时间（Unix 秒）
+	AutoRenew           bool                   `protobuf:"varint,9,opt,name=auto_renew,json=autoRenew,proto3" json:"auto_renew,omitempty"`                                  // 是否自动续费
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *GetVipEntitlementsResponse) Reset() {
+	*x = GetVipEntitlementsResponse{}
+	mi := &file_user_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVipEntitlementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVipEntitlementsResponse) ProtoMessage() {}
+
+func (x *GetVipEntitlementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVipEntitlementsResponse.ProtoReflect.Descriptor instead.
+func (*GetVipEntitlementsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *GetVipEntitlementsResponse) GetIsVip() bool {
+	if x != nil {
+		return x.IsVip
+	}
+	return false
+}
+
+func (x *GetVipEntitlementsResponse) GetLevel() int32 {
+	if x != nil {
+		return x.Level
+	}
+	return 0
+}
+
+func (x *GetVipEntitlementsResponse) GetPlanCode() string {
+	if x != nil {
+		return x.PlanCode
+	}
+	return ""
+}
+
+func (x *GetVipEntitlementsResponse) GetBadge() string {
+	if x != nil {
+		return x.Badge
+	}
+	return ""
+}
+
+func (x *GetVipEntitlementsResponse) GetDiscountPercent() int32 {
+	if x != nil {
+		return x.DiscountPercent
+	}
+	return 0
+}
+
+func (x *GetVipEntitlementsResponse) GetRecommendPriority() int32 {
+	if x != nil {
+		return x.RecommendPriority
+	}
+	return 0
+}
+
+func (x *GetVipEntitlementsResponse) GetRateLimitMultiplier() float64 {
+	if x != nil {
+		return x.RateLimitMultiplier
+	}
+	return 0
+}
+
+func (x *GetVipEntitlementsResponse) GetExpireAt() int64 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
+func (x *GetVipEntitlementsResponse) GetAutoRenew() bool {
+	if x != nil {
+		return x.AutoRenew
+	}
+	return false
+}
+
+// 陪玩提供的单个游戏技能
+type CompanionSkill struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameSkillId   uint64                 `protobuf:"varint,1,opt,name=game_skill_id,json=gameSkillId,proto3" json:"game_skill_id,omitempty"`    // 游戏技能ID（与 game_name 二选一，优先使用ID）
+	GameName      string                 `protobuf:"bytes,2,opt,name=game_name,json=gameName,proto3" json:"game_name,omitempty"`                // 游戏名称
+	Rank          string                 `protobuf:"bytes,3,opt,name=rank,proto3" json:"rank,omitempty"`                                        // 段位/等级
+	PricePerHour  int64                  `protobuf:"varint,4,opt,name=price_per_hour,json=pricePerHour,proto3" json:"price_per_hour,omitempty"` // 该游戏的每小时价格（帅币）
+	IsVerified    bool                   `protobuf:"varint,5,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`         // 段位是否认证（只读，更新时忽略）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompanionSkill) Reset() {
+	*x = CompanionSkill{}
+	mi := &file_user_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanionSkill) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanionSkill) ProtoMessage() {}
+
+func (x *CompanionSkill) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanionSkill.ProtoReflect.Descriptor instead.
+func (*CompanionSkill) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *CompanionSkill) GetGameSkillId() uint64 {
+	if x != nil {
+		return x.GameSkillId
+	}
+	return 0
+}
+
+func (x *CompanionSkill) GetGameName() string {
+	if x != nil {
+		return x.GameName
+	}
+	return ""
+}
+
+func (x *CompanionSkill) GetRank() string {
+	if x != nil {
+		return x.Rank
+	}
+	return ""
+}
+
+func (x *CompanionSkill) GetPricePerHour() int64 {
+	if x != nil {
+		return x.PricePerHour
+	}
+	return 0
+}
+
+func (x *CompanionSkill) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+// 陪玩信息
+type CompanionInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                     // 用户ID
+	GameSkill     string                 `protobuf:"bytes,2,opt,name=game_skill,json=gameSkill,proto3" json:"game_skill,omitempty"`             // 主游戏技能（第一个技能的名称，兼容旧客户端）
+	PricePerHour  int64                  `protobuf:"varint,3,opt,name=price_per_hour,json=pricePerHour,proto3" json:"price_per_hour,omitempty"` // 起步价：所有技能中的最低每小时价格（帅币）
+	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`                                   // 状态：0=离线, 1=在线, 2=忙碌
+	Rating        float64                `protobuf:"fixed64,5,opt,name=rating,proto3" json:"rating,omitempty"`                                  // 评分（0-5分）
+	TotalOrders   int64                  `protobuf:"varint,6,opt,name=total_orders,json=totalOrders,proto3" json:"total_orders,omitempty"`      // 总接单数
+	IsVerified    bool                   `protobuf:"varint,7,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`         // 是否认证
+	AvatarUrl     string                 `protobuf:"bytes,8,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`             // 头像URL
+	Bio           string                 `protobuf:"bytes,9,opt,name=bio,proto3" json:"bio,omitempty"`                                          // 个人简介
+	Nickname      string                 `protobuf:"bytes,10,opt,name=nickname,proto3" json:"nickname,omitempty"`                               // 昵称
+	RatingScore   float64                `protobuf:"fixed64,11,opt,name=rating_score,json=ratingScore,proto3" json:"rating_score,omitempty"`    // 贝叶斯评分（按接单数修正后的评分，用于排名与排序）
+	Skills        []*CompanionSkill      `protobuf:"bytes,12,rep,name=skills,proto3" json:"skills,omitempty"`                                   // 提供的游戏技能（含各自段位与价格）
+	Gender        int32                  `protobuf:"varint,13,opt,name=gender,proto3" json:"gender,omitempty"`                                  // 性别：0=未设置, 1=男, 2=女
+	Age           int32                  `protobuf:"varint,14,opt,name=age,proto3" json:"age,omitempty"`                                        // 年龄（未设置生日为0）
+	Tier          int32                  `protobuf:"varint,15,opt,name=tier,proto3" json:"tier,omitempty"`                                      // 陪玩等级（越大越高）
+	TierName      string                 `protobuf:"bytes,16,opt,name=tier_name,json=tierName,proto3" json:"tier_name,omitempty"`               // 等级名称（徽章展示）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompanionInfo) Reset() {
+	*x = CompanionInfo{}
+	mi := &file_user_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanionInfo) ProtoMessage() {}
+
+func (x *CompanionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanionInfo.ProtoReflect.Descriptor instead.
+func (*CompanionInfo) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *CompanionInfo) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CompanionInfo) GetGameSkill() string {
+	if x != nil {
+		return x.GameSkill
+	}
+	return ""
+}
+
+func (x *CompanionInfo) GetPricePerHour() int64 {
+	if x != nil {
+		return x.PricePerHour
+	}
+	return 0
+}
+
+func (x *CompanionInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *CompanionInfo) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CompanionInfo) GetTotalOrders() int64 {
+	if x != nil {
+		return x.TotalOrders
+	}
+	return 0
+}
+
+func (x *CompanionInfo) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *CompanionInfo) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *CompanionInfo) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *CompanionInfo) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *CompanionInfo) GetRatingScore() float64 {
+	if x != nil {
+		return x.RatingScore
+	}
+	return 0
+}
+
+func (x *CompanionInfo) GetSkills() []*CompanionSkill {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *CompanionInfo) GetGender() int32 {
+	if x != nil {
+		return x.Gender
+	}
+	return 0
+}
+
+func (x *CompanionInfo) GetAge() int32 {
+	if x != nil {
+		return x.Age
+	}
+	return 0
+}
+
+func (x *CompanionInfo) GetTier() int32 {
+	if x != nil {
+		return x.Tier
+	}
+	return 0
+}
+
+func (x *CompanionInfo) GetTierName() string {
+	if x != nil {
+		return x.TierName
+	}
+	return ""
+}
+
+// ------------- 游戏技能（词典）相关 -------------
+type GameSkill struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                  // 技能ID
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`               // 技能名称（唯一）
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // 描述（可选）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GameSkill) Reset() {
+	*x = GameSkill{}
+	mi := &file_user_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GameSkill) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GameSkill) ProtoMessage() {}
+
+func (x *GameSkill) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GameSkill.ProtoReflect.Descriptor instead.
+func (*GameSkill) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *GameSkill) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GameSkill) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *GameSkill) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type ListGameSkillsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGameSkillsRequest) Reset() {
+	*x = ListGameSkillsRequest{}
+	mi := &file_user_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGameSkillsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGameSkillsRequest) ProtoMessage() {}
+
+func (x *ListGameSkillsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGameSkillsRequest.ProtoReflect.Descriptor instead.
+func (*ListGameSkillsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{68}
+}
+
+type ListGameSkillsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skills        []*GameSkill           `protobuf:"bytes,1,rep,name=skills,proto3" json:"skills,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListGameSkillsResponse) Reset() {
+	*x = ListGameSkillsResponse{}
+	mi := &file_user_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListGameSkillsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListGameSkillsResponse) ProtoMessage() {}
+
+func (x *ListGameSkillsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListGameSkillsResponse.ProtoReflect.Descriptor instead.
+func (*ListGameSkillsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *ListGameSkillsResponse) GetSkills() []*GameSkill {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+type CreateGameSkillRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`               // 技能名称（必填，唯一）
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"` // 描述（可选）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGameSkillRequest) Reset() {
+	*x = CreateGameSkillRequest{}
+	mi := &file_user_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGameSkillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGameSkillRequest) ProtoMessage() {}
+
+func (x *CreateGameSkillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGameSkillRequest.ProtoReflect.Descriptor instead.
+func (*CreateGameSkillRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreateGameSkillRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateGameSkillRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type CreateGameSkillResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skill         *GameSkill             `protobuf:"bytes,1,opt,name=skill,proto3" json:"skill,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateGameSkillResponse) Reset() {
+	*x = CreateGameSkillResponse{}
+	mi := &file_user_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateGameSkillResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGameSkillResponse) ProtoMessage() {}
+
+func (x *CreateGameSkillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGameSkillResponse.ProtoReflect.Descriptor instead.
+func (*CreateGameSkillResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateGameSkillResponse) GetSkill() *GameSkill {
+	if x != nil {
+		return x.Skill
+	}
+	return nil
+}
+
+type UpdateGameSkillRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                  // 技能ID（必填）
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`               // 技能名称（必填，唯一）
+	Description   string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"` // 描述（可选）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGameSkillRequest) Reset() {
+	*x = UpdateGameSkillRequest{}
+	mi := &file_user_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGameSkillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGameSkillRequest) ProtoMessage() {}
+
+func (x *UpdateGameSkillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGameSkillRequest.ProtoReflect.Descriptor instead.
+func (*UpdateGameSkillRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *UpdateGameSkillRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *UpdateGameSkillRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateGameSkillRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+type UpdateGameSkillResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Skill         *GameSkill             `protobuf:"bytes,1,opt,name=skill,proto3" json:"skill,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateGameSkillResponse) Reset() {
+	*x = UpdateGameSkillResponse{}
+	mi := &file_user_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateGameSkillResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateGameSkillResponse) ProtoMessage() {}
+
+func (x *UpdateGameSkillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateGameSkillResponse.ProtoReflect.Descriptor instead.
+func (*UpdateGameSkillResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *UpdateGameSkillResponse) GetSkill() *GameSkill {
+	if x != nil {
+		return x.Skill
+	}
+	return nil
+}
+
+type DeleteGameSkillRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"` // 技能ID（必填）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGameSkillRequest) Reset() {
+	*x = DeleteGameSkillRequest{}
+	mi := &file_user_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGameSkillRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGameSkillRequest) ProtoMessage() {}
+
+func (x *DeleteGameSkillRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGameSkillRequest.ProtoReflect.Descriptor instead.
+func (*DeleteGameSkillRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *DeleteGameSkillRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type DeleteGameSkillResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteGameSkillResponse) Reset() {
+	*x = DeleteGameSkillResponse{}
+	mi := &file_user_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteGameSkillResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteGameSkillResponse) ProtoMessage() {}
+
+func (x *DeleteGameSkillResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteGameSkillResponse.ProtoReflect.Descriptor instead.
+func (*DeleteGameSkillResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *DeleteGameSkillResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+// 获取陪玩信息
+type GetCompanionProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 用户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanionProfileRequest) Reset() {
+	*x = GetCompanionProfileRequest{}
+	mi := &file_user_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanionProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanionProfileRequest) ProtoMessage() {}
+
+func (x *GetCompanionProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanionProfileRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanionProfileRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *GetCompanionProfileRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetCompanionProfileResponse struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	Profile           *CompanionInfo         `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	CommissionPercent int32                  `protobuf:"varint,2,opt,name=commission_percent,json=commissionPercent,proto3" json:"commission_percent,omitempty"` // 陪玩当前等级的平台抽成百分比（订单服务下单时快照到订单）
+	MaxPricePerHour   int64                  `protobuf:"varint,3,opt,name=max_price_per_hour,json=maxPricePerHour,proto3" json:"max_price_per_hour,omitempty"`   // 陪玩当前等级的每小时价格上限（0 表示不限，订单服务下单时校验）
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *GetCompanionProfileResponse) Reset() {
+	*x = GetCompanionProfileResponse{}
+	mi := &file_user_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanionProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanionProfileResponse) ProtoMessage() {}
+
+func (x *GetCompanionProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanionProfileResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanionProfileResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *GetCompanionProfileResponse) GetProfile() *CompanionInfo {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+func (x *GetCompanionProfileResponse) GetCommissionPercent() int32 {
+	if x != nil {
+		return x.CommissionPercent
+	}
+	return 0
+}
+
+func (x *GetCompanionProfileResponse) GetMaxPricePerHour() int64 {
+	if x != nil {
+		return x.MaxPricePerHour
+	}
+	return 0
+}
+
+// 更新陪玩信息
+type UpdateCompanionProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                     // 用户ID（必填）
+	GameSkill     string                 `protobuf:"bytes,2,opt,name=game_skill,json=gameSkill,proto3" json:"game_skill,omitempty"`             // 可选，游戏技能（单个游戏名称，设置后技能列表替换为该游戏；已废弃，请使用 skills）
+	PricePerHour  int64                  `protobuf:"varint,3,opt,name=price_per_hour,json=pricePerHour,proto3" json:"price_per_hour,omitempty"` // 可选，每小时价格（帅币）；未传 skills 时作用于所有技能
+	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`                                   // 可选，状态：0=离线, 1=在线, 2=忙碌
+	Skills        []*CompanionSkill      `protobuf:"bytes,5,rep,name=skills,proto3" json:"skills,omitempty"`                                    // 可选，非空时整体替换技能列表
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCompanionProfileRequest) Reset() {
+	*x = UpdateCompanionProfileRequest{}
+	mi := &file_user_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCompanionProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompanionProfileRequest) ProtoMessage() {}
+
+func (x *UpdateCompanionProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompanionProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCompanionProfileRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *UpdateCompanionProfileRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateCompanionProfileRequest) GetGameSkill() string {
+	if x != nil {
+		return x.GameSkill
+	}
+	return ""
+}
+
+func (x *UpdateCompanionProfileRequest) GetPricePerHour() int64 {
+	if x != nil {
+		return x.PricePerHour
+	}
+	return 0
+}
+
+func (x *UpdateCompanionProfileRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *UpdateCompanionProfileRequest) GetSkills() []*CompanionSkill {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+type UpdateCompanionProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *CompanionInfo         `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCompanionProfileResponse) Reset() {
+	*x = UpdateCompanionProfileResponse{}
+	mi := &file_user_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCompanionProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompanionProfileResponse) ProtoMessage() {}
+
+func (x *UpdateCompanionProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompanionProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCompanionProfileResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *UpdateCompanionProfileResponse) GetProfile() *CompanionInfo {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// 更新陪玩统计信息（评分 & 接单数）
+type UpdateCompanionStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // 用户ID（陪玩）
+	DeltaOrders   int64                  `protobuf:"varint,2,opt,name=delta_orders,json=deltaOrders,proto3" json:"delta_orders,omitempty"` // 接单数增量（一般为1）
+	NewRating     float64                `protobuf:"fixed64,3,opt,name=new_rating,json=newRating,proto3" json:"new_rating,omitempty"`      // 本次订单评分（0-5）
+	OrderId       uint64                 `protobuf:"varint,4,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`             // 订单ID（用于周期榜/游戏榜，按订单幂等；为0时不计入）
+	GameName      string                 `protobuf:"bytes,5,opt,name=game_name,json=gameName,proto3" json:"game_name,omitempty"`           // 订单游戏名称
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCompanionStatsRequest) Reset() {
+	*x = UpdateCompanionStatsRequest{}
+	mi := &file_user_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCompanionStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompanionStatsRequest) ProtoMessage() {}
+
+func (x *UpdateCompanionStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompanionStatsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateCompanionStatsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *UpdateCompanionStatsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *UpdateCompanionStatsRequest) GetDeltaOrders() int64 {
+	if x != nil {
+		return x.DeltaOrders
+	}
+	return 0
+}
+
+func (x *UpdateCompanionStatsRequest) GetNewRating() float64 {
+	if x != nil {
+		return x.NewRating
+	}
+	return 0
+}
+
+func (x *UpdateCompanionStatsRequest) GetOrderId() uint64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *UpdateCompanionStatsRequest) GetGameName() string {
+	if x != nil {
+		return x.GameName
+	}
+	return ""
+}
+
+type UpdateCompanionStatsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Profile       *CompanionInfo         `protobuf:"bytes,1,opt,name=profile,proto3" json:"profile,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateCompanionStatsResponse) Reset() {
+	*x = UpdateCompanionStatsResponse{}
+	mi := &file_user_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateCompanionStatsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateCompanionStatsResponse) ProtoMessage() {}
+
+func (x *UpdateCompanionStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateCompanionStatsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateCompanionStatsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *UpdateCompanionStatsResponse) GetProfile() *CompanionInfo {
+	if x != nil {
+		return x.Profile
+	}
+	return nil
+}
+
+// 获取陪玩列表（用于订单匹配）
+type GetCompanionListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	GameSkill     string                 `protobuf:"bytes,1,opt,name=game_skill,json=gameSkill,proto3" json:"game_skill,omitempty"`     // 可选，游戏技能筛选（单个游戏名称，匹配陪玩的任一技能）
+	MinPrice      int32                  `protobuf:"varint,2,opt,name=min_price,json=minPrice,proto3" json:"min_price,omitempty"`       // 可选，最低价格（指定游戏时按该游戏的价格，否则按起步价）
+	MaxPrice      int32                  `protobuf:"varint,3,opt,name=max_price,json=maxPrice,proto3" json:"max_price,omitempty"`       // 可选，最高价格
+	Status        int32                  `protobuf:"varint,4,opt,name=status,proto3" json:"status,omitempty"`                           // 可选，状态筛选：1=在线, 2=忙碌（0=不限，此时不返回离线陪玩）
+	IsVerified    bool                   `protobuf:"varint,5,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"` // 可选，是否只返回认证陪玩
+	Page          int32                  `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`                               // 页码（从1开始）
+	PageSize      int32                  `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`       // 每页数量
+	OnlineOnly    bool                   `protobuf:"varint,8,opt,name=online_only,json=onlineOnly,proto3" json:"online_only,omitempty"` // 可选，只返回在线（可接单）陪玩，优先于 status
+	Gender        int32                  `protobuf:"varint,9,opt,name=gender,proto3" json:"gender,omitempty"`                           // 可选，性别：1=男, 2=女（0=不限）
+	MinAge        int32                  `protobuf:"varint,10,opt,name=min_age,json=minAge,proto3" json:"min_age,omitempty"`            // 可选，最小年龄（指定年龄筛选时不返回未设置生日的陪玩）
+	MaxAge        int32                  `protobuf:"varint,11,opt,name=max_age,json=maxAge,proto3" json:"max_age,omitempty"`            // 可选，最大年龄
+	MinRating     float64                `protobuf:"fixed64,12,opt,name=min_rating,json=minRating,proto3" json:"min_rating,omitempty"`  // 可选，最低评分（0-5）
+	Keyword       string                 `protobuf:"bytes,13,opt,name=keyword,proto3" json:"keyword,omitempty"`                         // 可选，关键词（匹配昵称或个人简介）
+	SortBy        string                 `protobuf:"bytes,14,opt,name=sort_by,json=sortBy,proto3" json:"sort_by,omitempty"`             // 可选，排序：rating（默认，贝叶斯评分）、price_asc、price_desc、popularity（接单数）、newest（入驻时间）
+	ViewerId      uint64                 `protobuf:"varint,15,opt,name=viewer_id,json=viewerId,proto3" json:"viewer_id,omitempty"`      // 可选，当前登录用户ID（不返回与其存在拉黑关系的陪玩）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanionListRequest) Reset() {
+	*x = GetCompanionListRequest{}
+	mi := &file_user_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanionListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanionListRequest) ProtoMessage() {}
+
+func (x *GetCompanionListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanionListRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanionListRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *GetCompanionListRequest) GetGameSkill() string {
+	if x != nil {
+		return x.GameSkill
+	}
+	return ""
+}
+
+func (x *GetCompanionListRequest) GetMinPrice() int32 {
+	if x != nil {
+		return x.MinPrice
+	}
+	return 0
+}
+
+func (x *GetCompanionListRequest) GetMaxPrice() int32 {
+	if x != nil {
+		return x.MaxPrice
+	}
+	return 0
+}
+
+func (x *GetCompanionListRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *GetCompanionListRequest) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *GetCompanionListRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCompanionListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetCompanionListRequest) GetOnlineOnly() bool {
+	if x != nil {
+		return x.OnlineOnly
+	}
+	return false
+}
+
+func (x *GetCompanionListRequest) GetGender() int32 {
+	if x != nil {
+		return x.Gender
+	}
+	return 0
+}
+
+func (x *GetCompanionListRequest) GetMinAge() int32 {
+	if x != nil {
+		return x.MinAge
+	}
+	return 0
+}
+
+func (x *GetCompanionListRequest) GetMaxAge() int32 {
+	if x != nil {
+		return x.MaxAge
+	}
+	return 0
+}
+
+func (x *GetCompanionListRequest) GetMinRating() float64 {
+	if x != nil {
+		return x.MinRating
+	}
+	return 0
+}
+
+func (x *GetCompanionListRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+func (x *GetCompanionListRequest) GetSortBy() string {
+	if x != nil {
+		return x.SortBy
+	}
+	return ""
+}
+
+func (x *GetCompanionListRequest) GetViewerId() uint64 {
+	if x != nil {
+		return x.ViewerId
+	}
+	return 0
+}
+
+type GetCompanionListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Companions    []*CompanionInfo       `protobuf:"bytes,1,rep,name=companions,proto3" json:"companions,omitempty"`              // 陪玩列表
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                       // 总数
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                         // 当前页码
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanionListResponse) Reset() {
+	*x = GetCompanionListResponse{}
+	mi := &file_user_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanionListResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanionListResponse) ProtoMessage() {}
+
+func (x *GetCompanionListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanionListResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanionListResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *GetCompanionListResponse) GetCompanions() []*CompanionInfo {
+	if x != nil {
+		return x.Companions
+	}
+	return nil
+}
+
+func (x *GetCompanionListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetCompanionListResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCompanionListResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 陪玩心跳：刷新在线有效期（离线状态下的心跳不会自动上线）
+type CompanionHeartbeatRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompanionHeartbeatRequest) Reset() {
+	*x = CompanionHeartbeatRequest{}
+	mi := &file_user_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanionHeartbeatRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanionHeartbeatRequest) ProtoMessage() {}
+
+func (x *CompanionHeartbeatRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanionHeartbeatRequest.ProtoReflect.Descriptor instead.
+func (*CompanionHeartbeatRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *CompanionHeartbeatRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type CompanionHeartbeatResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Status          int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`                                          // 当前状态：0=离线, 1=在线, 2=忙碌
+	ExpireAt        int64                  `protobuf:"varint,2,opt,name=expire_at,json=expireAt,proto3" json:"expire_at,omitempty"`                      // 本次心跳的有效期截止时间（Unix 秒），离线时为0
+	IntervalSeconds int32                  `protobuf:"varint,3,opt,name=interval_seconds,json=intervalSeconds,proto3" json:"interval_seconds,omitempty"` // 建议的心跳间隔（秒）
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CompanionHeartbeatResponse) Reset() {
+	*x = CompanionHeartbeatResponse{}
+	mi := &file_user_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanionHeartbeatResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanionHeartbeatResponse) ProtoMessage() {}
+
+func (x *CompanionHeartbeatResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanionHeartbeatResponse.ProtoReflect.Descriptor instead.
+func (*CompanionHeartbeatResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CompanionHeartbeatResponse) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *CompanionHeartbeatResponse) GetExpireAt() int64 {
+	if x != nil {
+		return x.ExpireAt
+	}
+	return 0
+}
+
+func (x *CompanionHeartbeatResponse) GetIntervalSeconds() int32 {
+	if x != nil {
+		return x.IntervalSeconds
+	}
+	return 0
+}
+
+// 批量查询陪玩的实时状态（心跳超时的在线陪玩按离线返回）
+type GetCompanionPresenceRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserIds       []uint64               `protobuf:"varint,1,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanionPresenceRequest) Reset() {
+	*x = GetCompanionPresenceRequest{}
+	mi := &file_user_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanionPresenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanionPresenceRequest) ProtoMessage() {}
+
+func (x *GetCompanionPresenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanionPresenceRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanionPresenceRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *GetCompanionPresenceRequest) GetUserIds() []uint64 {
+	if x != nil {
+		return x.UserIds
+	}
+	return nil
+}
+
+type CompanionPresence struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Status        int32                  `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"` // 实时状态：0=离线, 1=在线, 2=忙碌
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompanionPresence) Reset() {
+	*x = CompanionPresence{}
+	mi := &file_user_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanionPresence) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanionPresence) ProtoMessage() {}
+
+func (x *CompanionPresence) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanionPresence.ProtoReflect.Descriptor instead.
+func (*CompanionPresence) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *CompanionPresence) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CompanionPresence) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+type GetCompanionPresenceResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Presences     []*CompanionPresence   `protobuf:"bytes,1,rep,name=presences,proto3" json:"presences,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanionPresenceResponse) Reset() {
+	*x = GetCompanionPresenceResponse{}
+	mi := &file_user_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanionPresenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanionPresenceResponse) ProtoMessage() {}
+
+func (x *GetCompanionPresenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanionPresenceResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanionPresenceResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetCompanionPresenceResponse) GetPresences() []*CompanionPresence {
+	if x != nil {
+		return x.Presences
+	}
+	return nil
+}
+
+// 陪玩入驻申请
+type CompanionApplicationInfo struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Id             uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId         uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                            // 申请人ID
+	RealName       string                 `protobuf:"bytes,3,opt,name=real_name,json=realName,proto3" json:"real_name,omitempty"`                       // 真实姓名
+	IdCardNo       string                 `protobuf:"bytes,4,opt,name=id_card_no,json=idCardNo,proto3" json:"id_card_no,omitempty"`                     // 身份证号（脱敏，明文不落库）
+	IdCardFrontUrl string                 `protobuf:"bytes,5,opt,name=id_card_front_url,json=idCardFrontUrl,proto3" json:"id_card_front_url,omitempty"` // 身份证正面照片
+	IdCardBackUrl  string                 `protobuf:"bytes,6,opt,name=id_card_back_url,json=idCardBackUrl,proto3" json:"id_card_back_url,omitempty"`    // 身份证反面照片
+	SkillProofUrls []string               `protobuf:"bytes,7,rep,name=skill_proof_urls,json=skillProofUrls,proto3" json:"skill_proof_urls,omitempty"`   // 技能证明截图
+	VoiceSampleUrl string                 `protobuf:"bytes,8,opt,name=voice_sample_url,json=voiceSampleUrl,proto3" json:"voice_sample_url,omitempty"`   // 语音样本
+	Skills         []*CompanionSkill      `protobuf:"bytes,9,rep,name=skills,proto3" json:"skills,omitempty"`                                           // 申请的技能
+	Bio            string                 `protobuf:"bytes,10,opt,name=bio,proto3" json:"bio,omitempty"`                                                // 个人简介
+	Status         int32                  `protobuf:"varint,11,opt,name=status,proto3" json:"status,omitempty"`                                         // 1=待审核, 2=已通过, 3=已驳回
+	RejectReason   string                 `protobuf:"bytes,12,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"`          // 驳回原因
+	ReviewNote     string                 `protobuf:"bytes,13,opt,name=review_note,json=reviewNote,proto3" json:"review_note,omitempty"`                // 审核备注（仅管理员查询时返回）
+	ReviewerId     uint64                 `protobuf:"varint,14,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`               // 审核人ID
+	ReviewedAt     int64                  `protobuf:"varint,15,opt,name=reviewed_at,json=reviewedAt,proto3" json:"reviewed_at,omitempty"`               // 审核时间（Unix 秒，未审核为0）
+	CreatedAt      int64                  `protobuf:"varint,16,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`                  // 提交时间（Unix 秒）
+	ReapplyAt      int64                  `protobuf:"varint,17,opt,name=reapply_at,json=reapplyAt,proto3" json:"reapply_at,omitempty"`                  // 被驳回后可再次申请的时间（Unix 秒，申请人查询时返回）
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CompanionApplicationInfo) Reset() {
+	*x = CompanionApplicationInfo{}
+	mi := &file_user_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanionApplicationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanionApplicationInfo) ProtoMessage() {}
+
+func (x *CompanionApplicationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanionApplicationInfo.ProtoReflect.Descriptor instead.
+func (*CompanionApplicationInfo) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *CompanionApplicationInfo) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CompanionApplicationInfo) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CompanionApplicationInfo) GetRealName() string {
+	if x != nil {
+		return x.RealName
+	}
+	return ""
+}
+
+func (x *CompanionApplicationInfo) GetIdCardNo() string {
+	if x != nil {
+		return x.IdCardNo
+	}
+	return ""
+}
+
+func (x *CompanionApplicationInfo) GetIdCardFrontUrl() string {
+	if x != nil {
+		return x.IdCardFrontUrl
+	}
+	return ""
+}
+
+func (x *CompanionApplicationInfo) GetIdCardBackUrl() string {
+	if x != nil {
+		return x.IdCardBackUrl
+	}
+	return ""
+}
+
+func (x *CompanionApplicationInfo) GetSkillProofUrls() []string {
+	if x != nil {
+		return x.SkillProofUrls
+	}
+	return nil
+}
+
+func (x *CompanionApplicationInfo) GetVoiceSampleUrl() string {
+	if x != nil {
+		return x.VoiceSampleUrl
+	}
+	return ""
+}
+
+func (x *CompanionApplicationInfo) GetSkills() []*CompanionSkill {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *CompanionApplicationInfo) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+func (x *CompanionApplicationInfo) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *CompanionApplicationInfo) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *CompanionApplicationInfo) GetReviewNote() string {
+	if x != nil {
+		return x.ReviewNote
+	}
+	return ""
+}
+
+func (x *CompanionApplicationInfo) GetReviewerId() uint64 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *CompanionApplicationInfo) GetReviewedAt() int64 {
+	if x != nil {
+		return x.ReviewedAt
+	}
+	return 0
+}
+
+func (x *CompanionApplicationInfo) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *CompanionApplicationInfo) GetReapplyAt() int64 {
+	if x != nil {
+		return x.ReapplyAt
+	}
+	return 0
+}
+
+// 提交入驻申请（仅老板可申请，审核通过前角色不变）
+type SubmitCompanionApplicationRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	UserId         uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RealName       string                 `protobuf:"bytes,2,opt,name=real_name,json=realName,proto3" json:"real_name,omitempty"`
+	IdCardNo       string                 `protobuf:"bytes,3,opt,name=id_card_no,json=idCardNo,proto3" json:"id_card_no,omitempty"`
+	IdCardFrontUrl string                 `protobuf:"bytes,4,opt,name=id_card_front_url,json=idCardFrontUrl,proto3" json:"id_card_front_url,omitempty"`
+	IdCardBackUrl  string                 `protobuf:"bytes,5,opt,name=id_card_back_url,json=idCardBackUrl,proto3" json:"id_card_back_url,omitempty"`
+	SkillProofUrls []string               `protobuf:"bytes,6,rep,name=skill_proof_urls,json=skillProofUrls,proto3" json:"skill_proof_urls,omitempty"` // 至少1张
+	VoiceSampleUrl string                 `protobuf:"bytes,7,opt,name=voice_sample_url,json=voiceSampleUrl,proto3" json:"voice_sample_url,omitempty"`
+	Skills         []*CompanionSkill      `protobuf:"bytes,8,rep,name=skills,proto3" json:"skills,omitempty"` // 至少1个游戏
+	Bio            string                 `protobuf:"bytes,9,opt,name=bio,proto3" json:"bio,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SubmitCompanionApplicationRequest) Reset() {
+	*x = SubmitCompanionApplicationRequest{}
+	mi := &file_user_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitCompanionApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitCompanionApplicationRequest) ProtoMessage() {}
+
+func (x *SubmitCompanionApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitCompanionApplicationRequest.ProtoReflect.Descriptor instead.
+func (*SubmitCompanionApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *SubmitCompanionApplicationRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SubmitCompanionApplicationRequest) GetRealName() string {
+	if x != nil {
+		return x.RealName
+	}
+	return ""
+}
+
+func (x *SubmitCompanionApplicationRequest) GetIdCardNo() string {
+	if x != nil {
+		return x.IdCardNo
+	}
+	return ""
+}
+
+func (x *SubmitCompanionApplicationRequest) GetIdCardFrontUrl() string {
+	if x != nil {
+		return x.IdCardFrontUrl
+	}
+	return ""
+}
+
+func (x *SubmitCompanionApplicationRequest) GetIdCardBackUrl() string {
+	if x != nil {
+		return x.IdCardBackUrl
+	}
+	return ""
+}
+
+func (x *SubmitCompanionApplicationRequest) GetSkillProofUrls() []string {
+	if x != nil {
+		return x.SkillProofUrls
+	}
+	return nil
+}
+
+func (x *SubmitCompanionApplicationRequest) GetVoiceSampleUrl() string {
+	if x != nil {
+		return x.VoiceSampleUrl
+	}
+	return ""
+}
+
+func (x *SubmitCompanionApplicationRequest) GetSkills() []*CompanionSkill {
+	if x != nil {
+		return x.Skills
+	}
+	return nil
+}
+
+func (x *SubmitCompanionApplicationRequest) GetBio() string {
+	if x != nil {
+		return x.Bio
+	}
+	return ""
+}
+
+type SubmitCompanionApplicationResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Application   *CompanionApplicationInfo `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SubmitCompanionApplicationResponse) Reset() {
+	*x = SubmitCompanionApplicationResponse{}
+	mi := &file_user_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SubmitCompanionApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubmitCompanionApplicationResponse) ProtoMessage() {}
+
+func (x *SubmitCompanionApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubmitCompanionApplicationResponse.ProtoReflect.Descriptor instead.
+func (*SubmitCompanionApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *SubmitCompanionApplicationResponse) GetApplication() *CompanionApplicationInfo {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+// 查询自己最近一次的入驻申请
+type GetMyCompanionApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyCompanionApplicationRequest) Reset() {
+	*x = GetMyCompanionApplicationRequest{}
+	mi := &file_user_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyCompanionApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyCompanionApplicationRequest) ProtoMessage() {}
+
+func (x *GetMyCompanionApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyCompanionApplicationRequest.ProtoReflect.Descriptor instead.
+func (*GetMyCompanionApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetMyCompanionApplicationRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetMyCompanionApplicationResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Application   *CompanionApplicationInfo `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"` // 从未申请时为空
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyCompanionApplicationResponse) Reset() {
+	*x = GetMyCompanionApplicationResponse{}
+	mi := &file_user_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyCompanionApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyCompanionApplicationResponse) ProtoMessage() {}
+
+func (x *GetMyCompanionApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyCompanionApplicationResponse.ProtoReflect.Descriptor instead.
+func (*GetMyCompanionApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *GetMyCompanionApplicationResponse) GetApplication() *CompanionApplicationInfo {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+// 管理员查询审核队列（待审核按提交时间正序，其余按审核时间倒序）
+type ListCompanionApplicationsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Status        int32                  `protobuf:"varint,1,opt,name=status,proto3" json:"status,omitempty"`               // 0=全部, 1=待审核, 2=已通过, 3=已驳回
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"` // 按申请人筛选（可选）
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompanionApplicationsRequest) Reset() {
+	*x = ListCompanionApplicationsRequest{}
+	mi := &file_user_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompanionApplicationsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompanionApplicationsRequest) ProtoMessage() {}
+
+func (x *ListCompanionApplicationsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompanionApplicationsRequest.ProtoReflect.Descriptor instead.
+func (*ListCompanionApplicationsRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *ListCompanionApplicationsRequest) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *ListCompanionApplicationsRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListCompanionApplicationsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCompanionApplicationsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListCompanionApplicationsResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Applications  []*CompanionApplicationInfo `protobuf:"bytes,1,rep,name=applications,proto3" json:"applications,omitempty"`
+	Total         int32                       `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                       `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                       `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListCompanionApplicationsResponse) Reset() {
+	*x = ListCompanionApplicationsResponse{}
+	mi := &file_user_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListCompanionApplicationsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCompanionApplicationsResponse) ProtoMessage() {}
+
+func (x *ListCompanionApplicationsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCompanionApplicationsResponse.ProtoReflect.Descriptor instead.
+func (*ListCompanionApplicationsResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *ListCompanionApplicationsResponse) GetApplications() []*CompanionApplicationInfo {
+	if x != nil {
+		return x.Applications
+	}
+	return nil
+}
+
+func (x *ListCompanionApplicationsResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListCompanionApplicationsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListCompanionApplicationsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 管理员审核申请：通过时授予陪玩角色并认证，驳回时必须填写原因
+type ReviewCompanionApplicationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ApplicationId uint64                 `protobuf:"varint,1,opt,name=application_id,json=applicationId,proto3" json:"application_id,omitempty"`
+	ReviewerId    uint64                 `protobuf:"varint,2,opt,name=reviewer_id,json=reviewerId,proto3" json:"reviewer_id,omitempty"`
+	Approve       bool                   `protobuf:"varint,3,opt,name=approve,proto3" json:"approve,omitempty"`
+	RejectReason  string                 `protobuf:"bytes,4,opt,name=reject_reason,json=rejectReason,proto3" json:"reject_reason,omitempty"` // 驳回原因（展示给申请人）
+	Note          string                 `protobuf:"bytes,5,opt,name=note,proto3" json:"note,omitempty"`                                     // 审核备注（仅管理员可见）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewCompanionApplicationRequest) Reset() {
+	*x = ReviewCompanionApplicationRequest{}
+	mi := &file_user_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewCompanionApplicationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewCompanionApplicationRequest) ProtoMessage() {}
+
+func (x *ReviewCompanionApplicationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewCompanionApplicationRequest.ProtoReflect.Descriptor instead.
+func (*ReviewCompanionApplicationRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *ReviewCompanionApplicationRequest) GetApplicationId() uint64 {
+	if x != nil {
+		return x.ApplicationId
+	}
+	return 0
+}
+
+func (x *ReviewCompanionApplicationRequest) GetReviewerId() uint64 {
+	if x != nil {
+		return x.ReviewerId
+	}
+	return 0
+}
+
+func (x *ReviewCompanionApplicationRequest) GetApprove() bool {
+	if x != nil {
+		return x.Approve
+	}
+	return false
+}
+
+func (x *ReviewCompanionApplicationRequest) GetRejectReason() string {
+	if x != nil {
+		return x.RejectReason
+	}
+	return ""
+}
+
+func (x *ReviewCompanionApplicationRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type ReviewCompanionApplicationResponse struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Application   *CompanionApplicationInfo `protobuf:"bytes,1,opt,name=application,proto3" json:"application,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReviewCompanionApplicationResponse) Reset() {
+	*x = ReviewCompanionApplicationResponse{}
+	mi := &file_user_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReviewCompanionApplicationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReviewCompanionApplicationResponse) ProtoMessage() {}
+
+func (x *ReviewCompanionApplicationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReviewCompanionApplicationResponse.ProtoReflect.Descriptor instead.
+func (*ReviewCompanionApplicationResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *ReviewCompanionApplicationResponse) GetApplication() *CompanionApplicationInfo {
+	if x != nil {
+		return x.Application
+	}
+	return nil
+}
+
+// 等级变更记录（附带变更时的评估指标）
+type CompanionTierHistoryItem struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	FromTier        int32                  `protobuf:"varint,1,opt,name=from_tier,json=fromTier,proto3" json:"from_tier,omitempty"`
+	FromTierName    string                 `protobuf:"bytes,2,opt,name=from_tier_name,json=fromTierName,proto3" json:"from_tier_name,omitempty"`
+	ToTier          int32                  `protobuf:"varint,3,opt,name=to_tier,json=toTier,proto3" json:"to_tier,omitempty"`
+	ToTierName      string                 `protobuf:"bytes,4,opt,name=to_tier_name,json=toTierName,proto3" json:"to_tier_name,omitempty"`
+	Reason          string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`                                           // evaluation=定期评估, admin_override=管理员指定, override_cleared=取消指定
+	CompletedOrders int64                  `protobuf:"varint,6,opt,name=completed_orders,json=completedOrders,proto3" json:"completed_orders,omitempty"` // 评估窗口内完成单数
+	Rating          float64                `protobuf:"fixed64,7,opt,name=rating,proto3" json:"rating,omitempty"`                                         // 评估窗口内平均评分
+	CancelRate      float64                `protobuf:"fixed64,8,opt,name=cancel_rate,json=cancelRate,proto3" json:"cancel_rate,omitempty"`               // 评估窗口内取消率（0-1）
+	Note            string                 `protobuf:"bytes,9,opt,name=note,proto3" json:"note,omitempty"`                                               // 管理员备注
+	CreatedAt       int64                  `protobuf:"varint,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	DisputeRate     float64                `protobuf:"fixed64,11,opt,name=dispute_rate,json=disputeRate,proto3" json:"dispute_rate,omitempty"` // 评估窗口内纠纷率（0-1）
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CompanionTierHistoryItem) Reset() {
+	*x = CompanionTierHistoryItem{}
+	mi := &file_user_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanionTierHistoryItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanionTierHistoryItem) ProtoMessage() {}
+
+func (x *CompanionTierHistoryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanionTierHistoryItem.ProtoReflect.Descriptor instead.
+func (*CompanionTierHistoryItem) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *CompanionTierHistoryItem) GetFromTier() int32 {
+	if x != nil {
+		return x.FromTier
+	}
+	return 0
+}
+
+func (x *CompanionTierHistoryItem) GetFromTierName() string {
+	if x != nil {
+		return x.FromTierName
+	}
+	return ""
+}
+
+func (x *CompanionTierHistoryItem) GetToTier() int32 {
+	if x != nil {
+		return x.ToTier
+	}
+	return 0
+}
+
+func (x *CompanionTierHistoryItem) GetToTierName() string {
+	if x != nil {
+		return x.ToTierName
+	}
+	return ""
+}
+
+func (x *CompanionTierHistoryItem) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *CompanionTierHistoryItem) GetCompletedOrders() int64 {
+	if x != nil {
+		return x.CompletedOrders
+	}
+	return 0
+}
+
+func (x *CompanionTierHistoryItem) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CompanionTierHistoryItem) GetCancelRate() float64 {
+	if x != nil {
+		return x.CancelRate
+	}
+	return 0
+}
+
+func (x *CompanionTierHistoryItem) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CompanionTierHistoryItem) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *CompanionTierHistoryItem) GetDisputeRate() float64 {
+	if x != nil {
+		return x.DisputeRate
+	}
+	return 0
+}
+
+// 查询陪玩等级及变更记录（按时间倒序）
+type GetCompanionTierHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanionTierHistoryRequest) Reset() {
+	*x = GetCompanionTierHistoryRequest{}
+	mi := &file_user_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanionTierHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanionTierHistoryRequest) ProtoMessage() {}
+
+func (x *GetCompanionTierHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanionTierHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanionTierHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *GetCompanionTierHistoryRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetCompanionTierHistoryRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCompanionTierHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetCompanionTierHistoryResponse struct {
+	state         protoimpl.MessageState      `protogen:"open.v1"`
+	Tier          int32                       `protobuf:"varint,1,opt,name=tier,proto3" json:"tier,omitempty"` // 当前等级
+	TierName      string                      `protobuf:"bytes,2,opt,name=tier_name,json=tierName,proto3" json:"tier_name,omitempty"`
+	Overridden    bool                        `protobuf:"varint,3,opt,name=overridden,proto3" json:"overridden,omitempty"` // 是否为管理员指定
+	Items         []*CompanionTierHistoryItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	Total         int32                       `protobuf:"varint,5,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                       `protobuf:"varint,6,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                       `protobuf:"varint,7,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanionTierHistoryResponse) Reset() {
+	*x = GetCompanionTierHistoryResponse{}
+	mi := &file_user_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanionTierHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanionTierHistoryResponse) ProtoMessage() {}
+
+func (x *GetCompanionTierHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanionTierHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanionTierHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *GetCompanionTierHistoryResponse) GetTier() int32 {
+	if x != nil {
+		return x.Tier
+	}
+	return 0
+}
+
+func (x *GetCompanionTierHistoryResponse) GetTierName() string {
+	if x != nil {
+		return x.TierName
+	}
+	return ""
+}
+
+func (x *GetCompanionTierHistoryResponse) GetOverridden() bool {
+	if x != nil {
+		return x.Overridden
+	}
+	return false
+}
+
+func (x *GetCompanionTierHistoryResponse) GetItems() []*CompanionTierHistoryItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *GetCompanionTierHistoryResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetCompanionTierHistoryResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCompanionTierHistoryResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 管理员指定陪玩等级：tier 为 0 时取消指定，下次评估按表现重新计算
+type SetCompanionTierOverrideRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OperatorId    uint64                 `protobuf:"varint,2,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`
+	Tier          int32                  `protobuf:"varint,3,opt,name=tier,proto3" json:"tier,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCompanionTierOverrideRequest) Reset() {
+	*x = SetCompanionTierOverrideRequest{}
+	mi := &file_user_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCompanionTierOverrideRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCompanionTierOverrideRequest) ProtoMessage() {}
+
+func (x *SetCompanionTierOverrideRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCompanionTierOverrideRequest.ProtoReflect.Descriptor instead.
+func (*SetCompanionTierOverrideRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *SetCompanionTierOverrideRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *SetCompanionTierOverrideRequest) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *SetCompanionTierOverrideRequest) GetTier() int32 {
+	if x != nil {
+		return x.Tier
+	}
+	return 0
+}
+
+func (x *SetCompanionTierOverrideRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+type SetCompanionTierOverrideResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Tier          int32                  `protobuf:"varint,1,opt,name=tier,proto3" json:"tier,omitempty"`
+	TierName      string                 `protobuf:"bytes,2,opt,name=tier_name,json=tierName,proto3" json:"tier_name,omitempty"`
+	Overridden    bool                   `protobuf:"varint,3,opt,name=overridden,proto3" json:"overridden,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetCompanionTierOverrideResponse) Reset() {
+	*x = SetCompanionTierOverrideResponse{}
+	mi := &file_user_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetCompanionTierOverrideResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetCompanionTierOverrideResponse) ProtoMessage() {}
+
+func (x *SetCompanionTierOverrideResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetCompanionTierOverrideResponse.ProtoReflect.Descriptor instead.
+func (*SetCompanionTierOverrideResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *SetCompanionTierOverrideResponse) GetTier() int32 {
+	if x != nil {
+		return x.Tier
+	}
+	return 0
+}
+
+func (x *SetCompanionTierOverrideResponse) GetTierName() string {
+	if x != nil {
+		return x.TierName
+	}
+	return ""
+}
+
+func (x *SetCompanionTierOverrideResponse) GetOverridden() bool {
+	if x != nil {
+		return x.Overridden
+	}
+	return false
+}
+
+// 陪玩排名项
+type CompanionRankingItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                 // 用户ID
+	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`                            // 昵称
+	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`         // 头像URL
+	Rating        float64                `protobuf:"fixed64,4,opt,name=rating,proto3" json:"rating,omitempty"`                              // 评分（用于评分排名）
+	TotalOrders   int64                  `protobuf:"varint,5,opt,name=total_orders,json=totalOrders,proto3" json:"total_orders,omitempty"`  // 总接单数（用于接单数排名）
+	Rank          int32                  `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`                                   // 排名（从1开始）
+	IsVerified    bool                   `protobuf:"varint,7,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`     // 是否认证
+	RatingScore   float64                `protobuf:"fixed64,8,opt,name=rating_score,json=ratingScore,proto3" json:"rating_score,omitempty"` // 贝叶斯评分（评分榜的排序依据）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CompanionRankingItem) Reset() {
+	*x = CompanionRankingItem{}
+	mi := &file_user_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompanionRankingItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompanionRankingItem) ProtoMessage() {}
+
+func (x *CompanionRankingItem) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompanionRankingItem.ProtoReflect.Descriptor instead.
+func (*CompanionRankingItem) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *CompanionRankingItem) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CompanionRankingItem) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *CompanionRankingItem) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *CompanionRankingItem) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *CompanionRankingItem) GetTotalOrders() int64 {
+	if x != nil {
+		return x.TotalOrders
+	}
+	return 0
+}
+
+func (x *CompanionRankingItem) GetRank() int32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *CompanionRankingItem) GetIsVerified() bool {
+	if x != nil {
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *CompanionRankingItem) GetRatingScore() float64 {
+	if x != nil {
+		return x.RatingScore
+	}
+	return 0
+}
+
+// 查询陪玩评分排名
+type GetCompanionRatingRankingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                         // 页码（从1开始）
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量（默认10，最大100）
+	Period        string                 `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`                      // 周期：day/week/month/all（默认all）
+	Game          string                 `protobuf:"bytes,4,opt,name=game,proto3" json:"game,omitempty"`                          // 游戏名称（为空时不限游戏）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanionRatingRankingRequest) Reset() {
+	*x = GetCompanionRatingRankingRequest{}
+	mi := &file_user_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanionRatingRankingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanionRatingRankingRequest) ProtoMessage() {}
+
+func (x *GetCompanionRatingRankingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanionRatingRankingRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanionRatingRankingRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetCompanionRatingRankingRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCompanionRatingRankingRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetCompanionRatingRankingRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *GetCompanionRatingRankingRequest) GetGame() string {
+	if x != nil {
+		return x.Game
+	}
+	return ""
+}
+
+type GetCompanionRatingRankingResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Rankings      []*CompanionRankingItem `protobuf:"bytes,1,rep,name=rankings,proto3" json:"rankings,omitempty"`                  // 排名列表
+	Total         int32                   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                       // 总数
+	Page          int32                   `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                         // 当前页码
+	PageSize      int32                   `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanionRatingRankingResponse) Reset() {
+	*x = GetCompanionRatingRankingResponse{}
+	mi := &file_user_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanionRatingRankingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanionRatingRankingResponse) ProtoMessage() {}
+
+func (x *GetCompanionRatingRankingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanionRatingRankingResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanionRatingRankingResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetCompanionRatingRankingResponse) GetRankings() []*CompanionRankingItem {
+	if x != nil {
+		return x.Rankings
+	}
+	return nil
+}
+
+func (x *GetCompanionRatingRankingResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetCompanionRatingRankingResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCompanionRatingRankingResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 查询陪玩总接单数排名
+type GetCompanionOrdersRankingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                         // 页码（从1开始）
+	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量（默认10，最大100）
+	Period        string                 `protobuf:"bytes,3,opt,name=period,proto3" json:"period,omitempty"`                      // 周期：day/week/month/all（默认all）
+	Game          string                 `protobuf:"bytes,4,opt,name=game,proto3" json:"game,omitempty"`                          // 游戏名称（为空时不限游戏）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanionOrdersRankingRequest) Reset() {
+	*x = GetCompanionOrdersRankingRequest{}
+	mi := &file_user_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanionOrdersRankingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanionOrdersRankingRequest) ProtoMessage() {}
+
+func (x *GetCompanionOrdersRankingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanionOrdersRankingRequest.ProtoReflect.Descriptor instead.
+func (*GetCompanionOrdersRankingRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *GetCompanionOrdersRankingRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCompanionOrdersRankingRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetCompanionOrdersRankingRequest) GetPeriod() string {
+	if x != nil {
+		return x.Period
+	}
+	return ""
+}
+
+func (x *GetCompanionOrdersRankingRequest) GetGame() string {
+	if x != nil {
+		return x.Game
+	}
+	return ""
+}
+
+type GetCompanionOrdersRankingResponse struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Rankings      []*CompanionRankingItem `protobuf:"bytes,1,rep,name=rankings,proto3" json:"rankings,omitempty"`                  // 排名列表
+	Total         int32                   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                       // 总数
+	Page          int32                   `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                         // 当前页码
+	PageSize      int32                   `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetCompanionOrdersRankingResponse) Reset() {
+	*x = GetCompanionOrdersRankingResponse{}
+	mi := &file_user_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetCompanionOrdersRankingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetCompanionOrdersRankingResponse) ProtoMessage() {}
+
+func (x *GetCompanionOrdersRankingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetCompanionOrdersRankingResponse.ProtoReflect.Descriptor instead.
+func (*GetCompanionOrdersRankingResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{107}
+}
+
+func (x *GetCompanionOrdersRankingResponse) GetRankings() []*CompanionRankingItem {
+	if x != nil {
+		return x.Rankings
+	}
+	return nil
+}
+
+func (x *GetCompanionOrdersRankingResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetCompanionOrdersRankingResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetCompanionOrdersRankingResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 关注用户
+type FollowUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId    uint64                 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人ID（关注者）
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 被关注的用户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowUserRequest) Reset() {
+	*x = FollowUserRequest{}
+	mi := &file_user_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowUserRequest) ProtoMessage() {}
+
+func (x *FollowUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowUserRequest.ProtoReflect.Descriptor instead.
+func (*FollowUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *FollowUserRequest) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *FollowUserRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type FollowUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FollowUserResponse) Reset() {
+	*x = FollowUserResponse{}
+	mi := &file_user_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FollowUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FollowUserResponse) ProtoMessage() {}
+
+func (x *FollowUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FollowUserResponse.ProtoReflect.Descriptor instead.
+func (*FollowUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{109}
+}
+
+func (x *FollowUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *FollowUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 取消关注用户
+type UnfollowUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId    uint64                 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人ID（关注者）
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 要取消关注的用户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowUserRequest) Reset() {
+	*x = UnfollowUserRequest{}
+	mi := &file_user_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowUserRequest) ProtoMessage() {}
+
+func (x *UnfollowUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowUserRequest.ProtoReflect.Descriptor instead.
+func (*UnfollowUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *UnfollowUserRequest) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *UnfollowUserRequest) GetUserId() uint64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type UnfollowUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UnfollowUserResponse) Reset() {
+	*x = UnfollowUserResponse{}
+	mi := &file_user_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnfollowUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnfollowUserResponse) ProtoMessage() {}
+
+func (x *UnfollowUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnfollowUserResponse.ProtoReflect.Descriptor instead.
+func (*UnfollowUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{111}
+}
+
+func (x *UnfollowUserResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *UnfollowUserResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// 获取我的关注列表
+type GetMyFollowingListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId    uint64                 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人ID
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                               // 页码（从1开始）
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`       // 每页数量
+	UserRole      int32                  `protobuf:"varint,4,opt,name=user_role,json=userRole,proto3" json:"user_role,omitempty"`       // 可选，过滤用户角色：1=老板, 2=陪玩
+	Keyword       string                 `protobuf:"bytes,5,opt,name=keyword,proto3" json:"keyword,omitempty"`                          // 可选，搜索关键词（昵称模糊匹配）
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyFollowingListRequest) Reset() {
+	*x = GetMyFollowingListRequest{}
+	mi := &file_user_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyFollowingListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyFollowingListRequest) ProtoMessage() {}
+
+func (x *GetMyFollowingListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyFollowingListRequest.ProtoReflect.Descriptor instead.
+func (*GetMyFollowingListRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{112}
+}
+
+func (x *GetMyFollowingListRequest) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *GetMyFollowingListRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetMyFollowingListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetMyFollowingListRequest) GetUserRole() int32 {
+	if x != nil {
+		return x.UserRole
+	}
+	return 0
+}
+
+func (x *GetMyFollowingListRequest) GetKeyword() string {
+	if x != nil {
+		return x.Keyword
+	}
+	return ""
+}
+
+// 获取我的粉丝列表
+type GetMyFollowersListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId    uint64                 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人ID
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                               // 页码（从1开始）
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`       // 每页数量
+	UserRole      int32                  `protobuf:"varint,4,opt,name=user_role,json=userRole,proto3" json:"user_role,omitempty"`       // 可选，过滤用户角色：1=老板, 2=陪玩
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMyFollowersListRequest) Reset() {
+	*x = GetMyFollowersListRequest{}
+	mi := &file_user_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMyFollowersListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMyFollowersListRequest) ProtoMessage() {}
+
+func (x *GetMyFollowersListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMyFollowersListRequest.ProtoReflect.Descriptor instead.
+func (*GetMyFollowersListRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetMyFollowersListRequest) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *GetMyFollowersListRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetMyFollowersListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *GetMyFollowersListRequest) GetUserRole() int32 {
+	if x != nil {
+		return x.UserRole
+	}
+	return 0
+}
+
+// 获取互相关注列表
+type GetMutualFollowListRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId    uint64                 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人ID
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                               // 页码（从1开始）
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`       // 每页数量
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMutualFollowListRequest) Reset() {
+	*x = GetMutualFollowListRequest{}
+	mi := &file_user_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMutualFollowListRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMutualFollowListRequest) ProtoMessage() {}
+
+func (x *GetMutualFollowListRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMutualFollowListRequest.ProtoReflect.Descriptor instead.
+func (*GetMutualFollowListRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *GetMutualFollowListRequest) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *GetMutualFollowListRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *GetMutualFollowListRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// 检查关注状态
+type CheckFollowStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OperatorId    uint64                 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`         // 操作人ID
+	TargetUserId  uint64                 `protobuf:"varint,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"` // 目标用户ID
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckFollowStatusRequest) Reset() {
+	*x = CheckFollowStatusRequest{}
+	mi := &file_user_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckFollowStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckFollowStatusRequest) ProtoMessage() {}
+
+func (x *CheckFollowStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckFollowStatusRequest.ProtoReflect.Descriptor instead.
+func (*CheckFollowStatusRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{115}
+}
+
+func (x *CheckFollowStatusRequest) GetOperatorId() uint64 {
+	if x != nil {
+		return x.OperatorId
+	}
+	return 0
+}
+
+func (x *CheckFollowStatusRequest) GetTargetUserId() uint64 {
+	if x != nil {
+		return x.TargetUserId
+	}
+	return 0
+}
+
+type CheckFollowStatusResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	IsFollowing   bool                   `protobuf:"varint,1,opt,name=is_following,json=isFollowing,proto3" json:"is_following,omitempty"` // 当前用户是否关注了目标用户
+	IsFollowed    bool                   `protobuf:"varint,2,opt,name=is_followed,json=isFollowed,proto3" json:"is_followed,omitempty"`    // 目标用户是否关注了当前用户
+	IsMutual      bool                   `protobuf:"varint,3,opt,name=is_mutual,json=isMutual,proto3" json:"is_mutual,omitempty"`          // 是否互相关注
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckFollowStatusResponse) Reset() {
+	*x = CheckFollowStatusResponse{}
+	mi := &file_user_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckFollowStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckFollowStatusResponse) ProtoMessage() {}
+
+func (x *CheckFollowStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckFollowStatusResponse.ProtoReflect.Descriptor instead.
+func (*CheckFollowStatusResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{116}
+}
+
+func (x *CheckFollowStatusResponse) GetIsFollowing() bool {
+	if x != nil {
+		return x.IsFollowing
+	}
+	return false
+}
+
+func (x *CheckFollowStatusResponse) GetIsFollowed() bool {
+	if x != nil {
+		return x.IsFollowed
 	}
-	return 0
+	return false
 }
 
-func (x *GetCompanionListResponse) GetPageSize() int32 {
+func (x *CheckFollowStatusResponse) GetIsMutual() bool {
 	if x != nil {
-		return x.PageSize
+		return x.IsMutual
 	}
-	return 0
+	return false
 }
 
-// 陪玩排名项
-type CompanionRankingItem struct {
+// 用户关注信息
+type UserFollowInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`                // 用户ID
-	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`                           // 昵称
-	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`        // 头像URL
-	Rating        float64                `protobuf:"fixed64,4,opt,name=rating,proto3" json:"rating,omitempty"`                             // 评分（用于评分排名）
-	TotalOrders   int64                  `protobuf:"varint,5,opt,name=total_orders,json=totalOrders,proto3" json:"total_orders,omitempty"` // 总接单数（用于接单数排名）
-	Rank          int32                  `protobuf:"varint,6,opt,name=rank,proto3" json:"rank,omitempty"`                                  // 排名（从1开始）
-	IsVerified    bool                   `protobuf:"varint,7,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`    // 是否认证
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	Role          int32                  `protobuf:"varint,4,opt,name=role,proto3" json:"role,omitempty"`                                  // 用户角色：1=老板, 2=陪玩
+	IsVerified    bool                   `protobuf:"varint,5,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`    // 是否验证（仅陪玩）
+	Rating        float64                `protobuf:"fixed64,6,opt,name=rating,proto3" json:"rating,omitempty"`                             // 评分（仅陪玩）
+	TotalOrders   int64                  `protobuf:"varint,7,opt,name=total_orders,json=totalOrders,proto3" json:"total_orders,omitempty"` // 总订单数（仅陪玩）
+	IsMutual      bool                   `protobuf:"varint,8,opt,name=is_mutual,json=isMutual,proto3" json:"is_mutual,omitempty"`          // 是否互相关注
+	FollowedAt    int64                  `protobuf:"varint,9,opt,name=followed_at,json=followedAt,proto3" json:"followed_at,omitempty"`    // 关注时间戳
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CompanionRankingItem) Reset() {
-	*x = CompanionRankingItem{}
-	mi := &file_user_proto_msgTypes[49]
+func (x *UserFollowInfo) Reset() {
+	*x = UserFollowInfo{}
+	mi := &file_user_proto_msgTypes[117]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CompanionRankingItem) String() string {
+func (x *UserFollowInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CompanionRankingItem) ProtoMessage() {}
+func (*UserFollowInfo) ProtoMessage() {}
 
-func (x *CompanionRankingItem) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[49]
+func (x *UserFollowInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[117]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2927,84 +7694,99 @@ func (x *CompanionRankingItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CompanionRankingItem.ProtoReflect.Descriptor instead.
-func (*CompanionRankingItem) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use UserFollowInfo.ProtoReflect.Descriptor instead.
+func (*UserFollowInfo) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{117}
 }
 
-func (x *CompanionRankingItem) GetUserId() uint64 {
+func (x *UserFollowInfo) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *CompanionRankingItem) GetNickname() string {
+func (x *UserFollowInfo) GetNickname() string {
 	if x != nil {
 		return x.Nickname
 	}
 	return ""
 }
 
-func (x *CompanionRankingItem) GetAvatarUrl() string {
+func (x *UserFollowInfo) GetAvatarUrl() string {
 	if x != nil {
 		return x.AvatarUrl
 	}
 	return ""
 }
 
-func (x *CompanionRankingItem) GetRating() float64 {
+func (x *UserFollowInfo) GetRole() int32 {
 	if x != nil {
-		return x.Rating
+		return x.Role
 	}
 	return 0
 }
 
-func (x *CompanionRankingItem) GetTotalOrders() int64 {
+func (x *UserFollowInfo) GetIsVerified() bool {
 	if x != nil {
-		return x.TotalOrders
+		return x.IsVerified
+	}
+	return false
+}
+
+func (x *UserFollowInfo) GetRating() float64 {
+	if x != nil {
+		return x.Rating
 	}
 	return 0
 }
 
-func (x *CompanionRankingItem) GetRank() int32 {
+func (x *UserFollowInfo) GetTotalOrders() int64 {
 	if x != nil {
-		return x.Rank
+		return x.TotalOrders
 	}
 	return 0
 }
 
-func (x *CompanionRankingItem) GetIsVerified() bool {
+func (x *UserFollowInfo) GetIsMutual() bool {
 	if x != nil {
-		return x.IsVerified
+		return x.IsMutual
 	}
 	return false
 }
 
-// 查询陪玩评分排名
-type GetCompanionRatingRankingRequest struct {
+func (x *UserFollowInfo) GetFollowedAt() int64 {
+	if x != nil {
+		return x.FollowedAt
+	}
+	return 0
+}
+
+type GetMyFollowingListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                         // 页码（从1开始）
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量（默认10，最大100）
+	Users         []*UserFollowInfo      `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCompanionRatingRankingRequest) Reset() {
-	*x = GetCompanionRatingRankingRequest{}
-	mi := &file_user_proto_msgTypes[50]
+func (x *GetMyFollowingListResponse) Reset() {
+	*x = GetMyFollowingListResponse{}
+	mi := &file_user_proto_msgTypes[118]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanionRatingRankingRequest) String() string {
+func (x *GetMyFollowingListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanionRatingRankingRequest) ProtoMessage() {}
+func (*GetMyFollowingListResponse) ProtoMessage() {}
 
-func (x *GetCompanionRatingRankingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[50]
+func (x *GetMyFollowingListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[118]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3015,50 +7797,64 @@ func (x *GetCompanionRatingRankingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanionRatingRankingRequest.ProtoReflect.Descriptor instead.
-func (*GetCompanionRatingRankingRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use GetMyFollowingListResponse.ProtoReflect.Descriptor instead.
+func (*GetMyFollowingListResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{118}
 }
 
-func (x *GetCompanionRatingRankingRequest) GetPage() int32 {
+func (x *GetMyFollowingListResponse) GetUsers() []*UserFollowInfo {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *GetMyFollowingListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetMyFollowingListResponse) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *GetCompanionRatingRankingRequest) GetPageSize() int32 {
+func (x *GetMyFollowingListResponse) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-type GetCompanionRatingRankingResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Rankings      []*CompanionRankingItem `protobuf:"bytes,1,rep,name=rankings,proto3" json:"rankings,omitempty"`                  // 排名列表
-	Total         int32                   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                       // 总数
-	Page          int32                   `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                         // 当前页码
-	PageSize      int32                   `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+type GetMyFollowersListResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*UserFollowInfo      `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCompanionRatingRankingResponse) Reset() {
-	*x = GetCompanionRatingRankingResponse{}
-	mi := &file_user_proto_msgTypes[51]
+func (x *GetMyFollowersListResponse) Reset() {
+	*x = GetMyFollowersListResponse{}
+	mi := &file_user_proto_msgTypes[119]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanionRatingRankingResponse) String() string {
+func (x *GetMyFollowersListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanionRatingRankingResponse) ProtoMessage() {}
+func (*GetMyFollowersListResponse) ProtoMessage() {}
 
-func (x *GetCompanionRatingRankingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[51]
+func (x *GetMyFollowersListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[119]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3069,63 +7865,64 @@ func (x *GetCompanionRatingRankingResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanionRatingRankingResponse.ProtoReflect.Descriptor instead.
-func (*GetCompanionRatingRankingResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use GetMyFollowersListResponse.ProtoReflect.Descriptor instead.
+func (*GetMyFollowersListResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{119}
 }
 
-func (x *GetCompanionRatingRankingResponse) GetRankings() []*CompanionRankingItem {
+func (x *GetMyFollowersListResponse) GetUsers() []*UserFollowInfo {
 	if x != nil {
-		return x.Rankings
+		return x.Users
 	}
 	return nil
 }
 
-func (x *GetCompanionRatingRankingResponse) GetTotal() int32 {
+func (x *GetMyFollowersListResponse) GetTotal() int32 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-func (x *GetCompanionRatingRankingResponse) GetPage() int32 {
+func (x *GetMyFollowersListResponse) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *GetCompanionRatingRankingResponse) GetPageSize() int32 {
+func (x *GetMyFollowersListResponse) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-// 查询陪玩总接单数排名
-type GetCompanionOrdersRankingRequest struct {
+type GetMutualFollowListResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Page          int32                  `protobuf:"varint,1,opt,name=page,proto3" json:"page,omitempty"`                         // 页码（从1开始）
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量（默认10，最大100）
+	Users         []*UserFollowInfo      `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCompanionOrdersRankingRequest) Reset() {
-	*x = GetCompanionOrdersRankingRequest{}
-	mi := &file_user_proto_msgTypes[52]
+func (x *GetMutualFollowListResponse) Reset() {
+	*x = GetMutualFollowListResponse{}
+	mi := &file_user_proto_msgTypes[120]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanionOrdersRankingRequest) String() string {
+func (x *GetMutualFollowListResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanionOrdersRankingRequest) ProtoMessage() {}
+func (*GetMutualFollowListResponse) ProtoMessage() {}
 
-func (x *GetCompanionOrdersRankingRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[52]
+func (x *GetMutualFollowListResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[120]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3136,50 +7933,75 @@ func (x *GetCompanionOrdersRankingRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanionOrdersRankingRequest.ProtoReflect.Descriptor instead.
-func (*GetCompanionOrdersRankingRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use GetMutualFollowListResponse.ProtoReflect.Descriptor instead.
+func (*GetMutualFollowListResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{120}
 }
 
-func (x *GetCompanionOrdersRankingRequest) GetPage() int32 {
+func (x *GetMutualFollowListResponse) GetUsers() []*UserFollowInfo {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+func (x *GetMutualFollowListResponse) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *GetMutualFollowListResponse) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *GetCompanionOrdersRankingRequest) GetPageSize() int32 {
+func (x *GetMutualFollowListResponse) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-type GetCompanionOrdersRankingResponse struct {
-	state         protoimpl.MessageState  `protogen:"open.v1"`
-	Rankings      []*CompanionRankingItem `protobuf:"bytes,1,rep,name=rankings,proto3" json:"rankings,omitempty"`                  // 排名列表
-	Total         int32                   `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`                       // 总数
-	Page          int32                   `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`                         // 当前页码
-	PageSize      int32                   `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"` // 每页数量
+// 动态项：关注的陪玩上线、收到新评价、调整价格、发布新帖子
+type FeedItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            uint64                 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`                                      // 动态ID（同时作为分页游标）
+	CompanionId   uint64                 `protobuf:"varint,2,opt,name=companion_id,json=companionId,proto3" json:"companion_id,omitempty"` // 陪玩ID
+	Nickname      string                 `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	AvatarUrl     string                 `protobuf:"bytes,4,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
+	Kind          string                 `protobuf:"bytes,5,opt,name=kind,proto3" json:"kind,omitempty"`                           // online=上线, review=新评价, price=调价, post=新帖子
+	GameName      string                 `protobuf:"bytes,6,opt,name=game_name,json=gameName,proto3" json:"game_name,omitempty"`   // 评价的游戏（review）
+	Rating        float64                `protobuf:"fixed64,7,opt,name=rating,proto3" json:"rating,omitempty"`                     // 评分（review）
+	Comment       string                 `protobuf:"bytes,8,opt,name=comment,proto3" json:"comment,omitempty"`                     // 评价内容（review）
+	OldPrice      int64                  `protobuf:"varint,9,opt,name=old_price,json=oldPrice,proto3" json:"old_price,omitempty"`  // 原价格（price）
+	NewPrice      int64                  `protobuf:"varint,10,opt,name=new_price,json=newPrice,proto3" json:"new_price,omitempty"` // 新价格（price）
+	CreatedAt     int64                  `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	PostId        uint64                 `protobuf:"varint,12,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`         // 帖子ID（post）
+	Content       string                 `protobuf:"bytes,13,opt,name=content,proto3" json:"content,omitempty"`                      // 帖子内容（post）
+	ImageUrls     []string               `protobuf:"bytes,14,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"` // 帖子图片（post）
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetCompanionOrdersRankingResponse) Reset() {
-	*x = GetCompanionOrdersRankingResponse{}
-	mi := &file_user_proto_msgTypes[53]
+func (x *FeedItem) Reset() {
+	*x = FeedItem{}
+	mi := &file_user_proto_msgTypes[121]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetCompanionOrdersRankingResponse) String() string {
+func (x *FeedItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetCompanionOrdersRankingResponse) ProtoMessage() {}
+func (*FeedItem) ProtoMessage() {}
 
-func (x *GetCompanionOrdersRankingResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[53]
+func (x *FeedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[121]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3190,63 +8012,134 @@ func (x *GetCompanionOrdersRankingResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetCompanionOrdersRankingResponse.ProtoReflect.Descriptor instead.
-func (*GetCompanionOrdersRankingResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use FeedItem.ProtoReflect.Descriptor instead.
+func (*FeedItem) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *FeedItem) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *FeedItem) GetCompanionId() uint64 {
+	if x != nil {
+		return x.CompanionId
+	}
+	return 0
+}
+
+func (x *FeedItem) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *FeedItem) GetAvatarUrl() string {
+	if x != nil {
+		return x.AvatarUrl
+	}
+	return ""
+}
+
+func (x *FeedItem) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *FeedItem) GetGameName() string {
+	if x != nil {
+		return x.GameName
+	}
+	return ""
+}
+
+func (x *FeedItem) GetRating() float64 {
+	if x != nil {
+		return x.Rating
+	}
+	return 0
+}
+
+func (x *FeedItem) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
 }
 
-func (x *GetCompanionOrdersRankingResponse) GetRankings() []*CompanionRankingItem {
+func (x *FeedItem) GetOldPrice() int64 {
 	if x != nil {
-		return x.Rankings
+		return x.OldPrice
 	}
-	return nil
+	return 0
 }
 
-func (x *GetCompanionOrdersRankingResponse) GetTotal() int32 {
+func (x *FeedItem) GetNewPrice() int64 {
 	if x != nil {
-		return x.Total
+		return x.NewPrice
 	}
 	return 0
 }
 
-func (x *GetCompanionOrdersRankingResponse) GetPage() int32 {
+func (x *FeedItem) GetCreatedAt() int64 {
 	if x != nil {
-		return x.Page
+		return x.CreatedAt
 	}
 	return 0
 }
 
-func (x *GetCompanionOrdersRankingResponse) GetPageSize() int32 {
+func (x *FeedItem) GetPostId() uint64 {
 	if x != nil {
-		return x.PageSize
+		return x.PostId
 	}
 	return 0
 }
 
-// 关注用户
-type FollowUserRequest struct {
+func (x *FeedItem) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *FeedItem) GetImageUrls() []string {
+	if x != nil {
+		return x.ImageUrls
+	}
+	return nil
+}
+
+// 陪玩发布帖子：内容与图片至少有一项，发布后写入粉丝的动态流
+type PublishCompanionPostRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OperatorId    uint64                 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人ID（关注者）
-	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 被关注的用户ID
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`                      // 最多 500 字
+	ImageUrls     []string               `protobuf:"bytes,3,rep,name=image_urls,json=imageUrls,proto3" json:"image_urls,omitempty"` // 最多 9 张
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FollowUserRequest) Reset() {
-	*x = FollowUserRequest{}
-	mi := &file_user_proto_msgTypes[54]
+func (x *PublishCompanionPostRequest) Reset() {
+	*x = PublishCompanionPostRequest{}
+	mi := &file_user_proto_msgTypes[122]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FollowUserRequest) String() string {
+func (x *PublishCompanionPostRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FollowUserRequest) ProtoMessage() {}
+func (*PublishCompanionPostRequest) ProtoMessage() {}
 
-func (x *FollowUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[54]
+func (x *PublishCompanionPostRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[122]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3257,48 +8150,55 @@ func (x *FollowUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FollowUserRequest.ProtoReflect.Descriptor instead.
-func (*FollowUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use PublishCompanionPostRequest.ProtoReflect.Descriptor instead.
+func (*PublishCompanionPostRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{122}
 }
 
-func (x *FollowUserRequest) GetOperatorId() uint64 {
+func (x *PublishCompanionPostRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.OperatorId
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *FollowUserRequest) GetUserId() uint64 {
+func (x *PublishCompanionPostRequest) GetContent() string {
 	if x != nil {
-		return x.UserId
+		return x.Content
 	}
-	return 0
+	return ""
 }
 
-type FollowUserResponse struct {
+func (x *PublishCompanionPostRequest) GetImageUrls() []string {
+	if x != nil {
+		return x.ImageUrls
+	}
+	return nil
+}
+
+type PublishCompanionPostResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	PostId        uint64                 `protobuf:"varint,1,opt,name=post_id,json=postId,proto3" json:"post_id,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FollowUserResponse) Reset() {
-	*x = FollowUserResponse{}
-	mi := &file_user_proto_msgTypes[55]
+func (x *PublishCompanionPostResponse) Reset() {
+	*x = PublishCompanionPostResponse{}
+	mi := &file_user_proto_msgTypes[123]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FollowUserResponse) String() string {
+func (x *PublishCompanionPostResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FollowUserResponse) ProtoMessage() {}
+func (*PublishCompanionPostResponse) ProtoMessage() {}
 
-func (x *FollowUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[55]
+func (x *PublishCompanionPostResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[123]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3309,49 +8209,50 @@ func (x *FollowUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FollowUserResponse.ProtoReflect.Descriptor instead.
-func (*FollowUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use PublishCompanionPostResponse.ProtoReflect.Descriptor instead.
+func (*PublishCompanionPostResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{123}
 }
 
-func (x *FollowUserResponse) GetSuccess() bool {
+func (x *PublishCompanionPostResponse) GetPostId() uint64 {
 	if x != nil {
-		return x.Success
+		return x.PostId
 	}
-	return false
+	return 0
 }
 
-func (x *FollowUserResponse) GetMessage() string {
+func (x *PublishCompanionPostResponse) GetCreatedAt() int64 {
 	if x != nil {
-		return x.Message
+		return x.CreatedAt
 	}
-	return ""
+	return 0
 }
 
-// 取消关注用户
-type UnfollowUserRequest struct {
+// 查询关注动态（按时间倒序，游标分页）
+type GetFeedRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OperatorId    uint64                 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人ID（关注者）
-	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 要取消关注的用户ID
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Cursor        uint64                 `protobuf:"varint,2,opt,name=cursor,proto3" json:"cursor,omitempty"` // 上一页返回的 next_cursor，首页传 0
+	Limit         int32                  `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnfollowUserRequest) Reset() {
-	*x = UnfollowUserRequest{}
-	mi := &file_user_proto_msgTypes[56]
+func (x *GetFeedRequest) Reset() {
+	*x = GetFeedRequest{}
+	mi := &file_user_proto_msgTypes[124]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnfollowUserRequest) String() string {
+func (x *GetFeedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnfollowUserRequest) ProtoMessage() {}
+func (*GetFeedRequest) ProtoMessage() {}
 
-func (x *UnfollowUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[56]
+func (x *GetFeedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[124]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3362,48 +8263,56 @@ func (x *UnfollowUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnfollowUserRequest.ProtoReflect.Descriptor instead.
-func (*UnfollowUserRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use GetFeedRequest.ProtoReflect.Descriptor instead.
+func (*GetFeedRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{124}
 }
 
-func (x *UnfollowUserRequest) GetOperatorId() uint64 {
+func (x *GetFeedRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.OperatorId
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *UnfollowUserRequest) GetUserId() uint64 {
+func (x *GetFeedRequest) GetCursor() uint64 {
 	if x != nil {
-		return x.UserId
+		return x.Cursor
 	}
 	return 0
 }
 
-type UnfollowUserResponse struct {
+func (x *GetFeedRequest) GetLimit() int32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+type GetFeedResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	Items         []*FeedItem            `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	NextCursor    uint64                 `protobuf:"varint,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	HasMore       bool                   `protobuf:"varint,3,opt,name=has_more,json=hasMore,proto3" json:"has_more,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UnfollowUserResponse) Reset() {
-	*x = UnfollowUserResponse{}
-	mi := &file_user_proto_msgTypes[57]
+func (x *GetFeedResponse) Reset() {
+	*x = GetFeedResponse{}
+	mi := &file_user_proto_msgTypes[125]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UnfollowUserResponse) String() string {
+func (x *GetFeedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UnfollowUserResponse) ProtoMessage() {}
+func (*GetFeedResponse) ProtoMessage() {}
 
-func (x *UnfollowUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[57]
+func (x *GetFeedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[125]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3414,52 +8323,56 @@ func (x *UnfollowUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UnfollowUserResponse.ProtoReflect.Descriptor instead.
-func (*UnfollowUserResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use GetFeedResponse.ProtoReflect.Descriptor instead.
+func (*GetFeedResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{125}
 }
 
-func (x *UnfollowUserResponse) GetSuccess() bool {
+func (x *GetFeedResponse) GetItems() []*FeedItem {
 	if x != nil {
-		return x.Success
+		return x.Items
 	}
-	return false
+	return nil
 }
 
-func (x *UnfollowUserResponse) GetMessage() string {
+func (x *GetFeedResponse) GetNextCursor() uint64 {
 	if x != nil {
-		return x.Message
+		return x.NextCursor
 	}
-	return ""
+	return 0
 }
 
-// 获取我的关注列表
-type GetMyFollowingListRequest struct {
+func (x *GetFeedResponse) GetHasMore() bool {
+	if x != nil {
+		return x.HasMore
+	}
+	return false
+}
+
+// 拉黑用户（拉黑后双方自动互相取消关注，且不能互相关注、下单、打赏或送礼）
+type BlockUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OperatorId    uint64                 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人ID
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                               // 页码（从1开始）
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`       // 每页数量
-	UserRole      int32                  `protobuf:"varint,4,opt,name=user_role,json=userRole,proto3" json:"user_role,omitempty"`       // 可选，过滤用户角色：1=老板, 2=陪玩
-	Keyword       string                 `protobuf:"bytes,5,opt,name=keyword,proto3" json:"keyword,omitempty"`                          // 可选，搜索关键词（昵称模糊匹配）
+	OperatorId    uint64                 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人ID（拉黑者）
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 被拉黑的用户ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMyFollowingListRequest) Reset() {
-	*x = GetMyFollowingListRequest{}
-	mi := &file_user_proto_msgTypes[58]
+func (x *BlockUserRequest) Reset() {
+	*x = BlockUserRequest{}
+	mi := &file_user_proto_msgTypes[126]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMyFollowingListRequest) String() string {
+func (x *BlockUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMyFollowingListRequest) ProtoMessage() {}
+func (*BlockUserRequest) ProtoMessage() {}
 
-func (x *GetMyFollowingListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[58]
+func (x *BlockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[126]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3470,72 +8383,48 @@ func (x *GetMyFollowingListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMyFollowingListRequest.ProtoReflect.Descriptor instead.
-func (*GetMyFollowingListRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{58}
+// Deprecated: Use BlockUserRequest.ProtoReflect.Descriptor instead.
+func (*BlockUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{126}
 }
 
-func (x *GetMyFollowingListRequest) GetOperatorId() uint64 {
+func (x *BlockUserRequest) GetOperatorId() uint64 {
 	if x != nil {
 		return x.OperatorId
 	}
 	return 0
 }
 
-func (x *GetMyFollowingListRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetMyFollowingListRequest) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
-func (x *GetMyFollowingListRequest) GetUserRole() int32 {
+func (x *BlockUserRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.UserRole
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetMyFollowingListRequest) GetKeyword() string {
-	if x != nil {
-		return x.Keyword
-	}
-	return ""
-}
-
-// 获取我的粉丝列表
-type GetMyFollowersListRequest struct {
+type BlockUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OperatorId    uint64                 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人ID
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                               // 页码（从1开始）
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`       // 每页数量
-	UserRole      int32                  `protobuf:"varint,4,opt,name=user_role,json=userRole,proto3" json:"user_role,omitempty"`       // 可选，过滤用户角色：1=老板, 2=陪玩
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMyFollowersListRequest) Reset() {
-	*x = GetMyFollowersListRequest{}
-	mi := &file_user_proto_msgTypes[59]
+func (x *BlockUserResponse) Reset() {
+	*x = BlockUserResponse{}
+	mi := &file_user_proto_msgTypes[127]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMyFollowersListRequest) String() string {
+func (x *BlockUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMyFollowersListRequest) ProtoMessage() {}
+func (*BlockUserResponse) ProtoMessage() {}
 
-func (x *GetMyFollowersListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[59]
+func (x *BlockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[127]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3546,64 +8435,49 @@ func (x *GetMyFollowersListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMyFollowersListRequest.ProtoReflect.Descriptor instead.
-func (*GetMyFollowersListRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{59}
-}
-
-func (x *GetMyFollowersListRequest) GetOperatorId() uint64 {
-	if x != nil {
-		return x.OperatorId
-	}
-	return 0
-}
-
-func (x *GetMyFollowersListRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
+// Deprecated: Use BlockUserResponse.ProtoReflect.Descriptor instead.
+func (*BlockUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{127}
 }
 
-func (x *GetMyFollowersListRequest) GetPageSize() int32 {
+func (x *BlockUserResponse) GetSuccess() bool {
 	if x != nil {
-		return x.PageSize
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *GetMyFollowersListRequest) GetUserRole() int32 {
+func (x *BlockUserResponse) GetMessage() string {
 	if x != nil {
-		return x.UserRole
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-// 获取互相关注列表
-type GetMutualFollowListRequest struct {
+// 取消拉黑
+type UnblockUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OperatorId    uint64                 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人ID
-	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                               // 页码（从1开始）
-	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`       // 每页数量
+	OperatorId    uint64                 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人ID（拉黑者）
+	UserId        uint64                 `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`             // 要取消拉黑的用户ID
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMutualFollowListRequest) Reset() {
-	*x = GetMutualFollowListRequest{}
-	mi := &file_user_proto_msgTypes[60]
+func (x *UnblockUserRequest) Reset() {
+	*x = UnblockUserRequest{}
+	mi := &file_user_proto_msgTypes[128]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMutualFollowListRequest) String() string {
+func (x *UnblockUserRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMutualFollowListRequest) ProtoMessage() {}
+func (*UnblockUserRequest) ProtoMessage() {}
 
-func (x *GetMutualFollowListRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[60]
+func (x *UnblockUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[128]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3614,56 +8488,48 @@ func (x *GetMutualFollowListRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMutualFollowListRequest.ProtoReflect.Descriptor instead.
-func (*GetMutualFollowListRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use UnblockUserRequest.ProtoReflect.Descriptor instead.
+func (*UnblockUserRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{128}
 }
 
-func (x *GetMutualFollowListRequest) GetOperatorId() uint64 {
+func (x *UnblockUserRequest) GetOperatorId() uint64 {
 	if x != nil {
 		return x.OperatorId
 	}
 	return 0
 }
 
-func (x *GetMutualFollowListRequest) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetMutualFollowListRequest) GetPageSize() int32 {
+func (x *UnblockUserRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.PageSize
+		return x.UserId
 	}
 	return 0
 }
 
-// 检查关注状态
-type CheckFollowStatusRequest struct {
+type UnblockUserResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	OperatorId    uint64                 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"`         // 操作人ID
-	TargetUserId  uint64                 `protobuf:"varint,2,opt,name=target_user_id,json=targetUserId,proto3" json:"target_user_id,omitempty"` // 目标用户ID
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckFollowStatusRequest) Reset() {
-	*x = CheckFollowStatusRequest{}
-	mi := &file_user_proto_msgTypes[61]
+func (x *UnblockUserResponse) Reset() {
+	*x = UnblockUserResponse{}
+	mi := &file_user_proto_msgTypes[129]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckFollowStatusRequest) String() string {
+func (x *UnblockUserResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckFollowStatusRequest) ProtoMessage() {}
+func (*UnblockUserResponse) ProtoMessage() {}
 
-func (x *CheckFollowStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[61]
+func (x *UnblockUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[129]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3674,49 +8540,50 @@ func (x *CheckFollowStatusRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckFollowStatusRequest.ProtoReflect.Descriptor instead.
-func (*CheckFollowStatusRequest) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{61}
+// Deprecated: Use UnblockUserResponse.ProtoReflect.Descriptor instead.
+func (*UnblockUserResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{129}
 }
 
-func (x *CheckFollowStatusRequest) GetOperatorId() uint64 {
+func (x *UnblockUserResponse) GetSuccess() bool {
 	if x != nil {
-		return x.OperatorId
+		return x.Success
 	}
-	return 0
+	return false
 }
 
-func (x *CheckFollowStatusRequest) GetTargetUserId() uint64 {
+func (x *UnblockUserResponse) GetMessage() string {
 	if x != nil {
-		return x.TargetUserId
+		return x.Message
 	}
-	return 0
+	return ""
 }
 
-type CheckFollowStatusResponse struct {
+// 获取我的黑名单
+type ListBlockedRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	IsFollowing   bool                   `protobuf:"varint,1,opt,name=is_following,json=isFollowing,proto3" json:"is_following,omitempty"` // 当前用户是否关注了目标用户
-	IsFollowed    bool                   `protobuf:"varint,2,opt,name=is_followed,json=isFollowed,proto3" json:"is_followed,omitempty"`    // 目标用户是否关注了当前用户
-	IsMutual      bool                   `protobuf:"varint,3,opt,name=is_mutual,json=isMutual,proto3" json:"is_mutual,omitempty"`          // 是否互相关注
+	OperatorId    uint64                 `protobuf:"varint,1,opt,name=operator_id,json=operatorId,proto3" json:"operator_id,omitempty"` // 操作人ID
+	Page          int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`                               // 页码（从1开始）
+	PageSize      int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`       // 每页数量
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckFollowStatusResponse) Reset() {
-	*x = CheckFollowStatusResponse{}
-	mi := &file_user_proto_msgTypes[62]
+func (x *ListBlockedRequest) Reset() {
+	*x = ListBlockedRequest{}
+	mi := &file_user_proto_msgTypes[130]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckFollowStatusResponse) String() string {
+func (x *ListBlockedRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckFollowStatusResponse) ProtoMessage() {}
+func (*ListBlockedRequest) ProtoMessage() {}
 
-func (x *CheckFollowStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[62]
+func (x *ListBlockedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[130]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3727,63 +8594,58 @@ func (x *CheckFollowStatusResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckFollowStatusResponse.ProtoReflect.Descriptor instead.
-func (*CheckFollowStatusResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{62}
+// Deprecated: Use ListBlockedRequest.ProtoReflect.Descriptor instead.
+func (*ListBlockedRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{130}
 }
 
-func (x *CheckFollowStatusResponse) GetIsFollowing() bool {
+func (x *ListBlockedRequest) GetOperatorId() uint64 {
 	if x != nil {
-		return x.IsFollowing
+		return x.OperatorId
 	}
-	return false
+	return 0
 }
 
-func (x *CheckFollowStatusResponse) GetIsFollowed() bool {
+func (x *ListBlockedRequest) GetPage() int32 {
 	if x != nil {
-		return x.IsFollowed
+		return x.Page
 	}
-	return false
+	return 0
 }
 
-func (x *CheckFollowStatusResponse) GetIsMutual() bool {
+func (x *ListBlockedRequest) GetPageSize() int32 {
 	if x != nil {
-		return x.IsMutual
+		return x.PageSize
 	}
-	return false
+	return 0
 }
 
-// 用户关注信息
-type UserFollowInfo struct {
+type BlockedUserInfo struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	Nickname      string                 `protobuf:"bytes,2,opt,name=nickname,proto3" json:"nickname,omitempty"`
 	AvatarUrl     string                 `protobuf:"bytes,3,opt,name=avatar_url,json=avatarUrl,proto3" json:"avatar_url,omitempty"`
-	Role          int32                  `protobuf:"varint,4,opt,name=role,proto3" json:"role,omitempty"`                                  // 用户角色：1=老板, 2=陪玩
-	IsVerified    bool                   `protobuf:"varint,5,opt,name=is_verified,json=isVerified,proto3" json:"is_verified,omitempty"`    // 是否验证（仅陪玩）
-	Rating        float64                `protobuf:"fixed64,6,opt,name=rating,proto3" json:"rating,omitempty"`                             // 评分（仅陪玩）
-	TotalOrders   int64                  `protobuf:"varint,7,opt,name=total_orders,json=totalOrders,proto3" json:"total_orders,omitempty"` // 总订单数（仅陪玩）
-	IsMutual      bool                   `protobuf:"varint,8,opt,name=is_mutual,json=isMutual,proto3" json:"is_mutual,omitempty"`          // 是否互相关注
-	FollowedAt    int64                  `protobuf:"varint,9,opt,name=followed_at,json=followedAt,proto3" json:"followed_at,omitempty"`    // 关注时间戳
+	Role          int32                  `protobuf:"varint,4,opt,name=role,proto3" json:"role,omitempty"`                            // 用户角色：1=老板, 2=陪玩
+	BlockedAt     int64                  `protobuf:"varint,5,opt,name=blocked_at,json=blockedAt,proto3" json:"blocked_at,omitempty"` // 拉黑时间戳
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UserFollowInfo) Reset() {
-	*x = UserFollowInfo{}
-	mi := &file_user_proto_msgTypes[63]
+func (x *BlockedUserInfo) Reset() {
+	*x = BlockedUserInfo{}
+	mi := &file_user_proto_msgTypes[131]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UserFollowInfo) String() string {
+func (x *BlockedUserInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UserFollowInfo) ProtoMessage() {}
+func (*BlockedUserInfo) ProtoMessage() {}
 
-func (x *UserFollowInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[63]
+func (x *BlockedUserInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[131]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3794,77 +8656,49 @@ func (x *UserFollowInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UserFollowInfo.ProtoReflect.Descriptor instead.
-func (*UserFollowInfo) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use BlockedUserInfo.ProtoReflect.Descriptor instead.
+func (*BlockedUserInfo) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{131}
 }
 
-func (x *UserFollowInfo) GetUserId() uint64 {
+func (x *BlockedUserInfo) GetUserId() uint64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-func (x *UserFollowInfo) GetNickname() string {
+func (x *BlockedUserInfo) GetNickname() string {
 	if x != nil {
 		return x.Nickname
 	}
 	return ""
 }
 
-func (x *UserFollowInfo) GetAvatarUrl() string {
+func (x *BlockedUserInfo) GetAvatarUrl() string {
 	if x != nil {
 		return x.AvatarUrl
 	}
 	return ""
 }
 
-func (x *UserFollowInfo) GetRole() int32 {
+func (x *BlockedUserInfo) GetRole() int32 {
 	if x != nil {
 		return x.Role
 	}
 	return 0
 }
 
-func (x *UserFollowInfo) GetIsVerified() bool {
-	if x != nil {
-		return x.IsVerified
-	}
-	return false
-}
-
-func (x *UserFollowInfo) GetRating() float64 {
-	if x != nil {
-		return x.Rating
-	}
-	return 0
-}
-
-func (x *UserFollowInfo) GetTotalOrders() int64 {
-	if x != nil {
-		return x.TotalOrders
-	}
-	return 0
-}
-
-func (x *UserFollowInfo) GetIsMutual() bool {
-	if x != nil {
-		return x.IsMutual
-	}
-	return false
-}
-
-func (x *UserFollowInfo) GetFollowedAt() int64 {
+func (x *BlockedUserInfo) GetBlockedAt() int64 {
 	if x != nil {
-		return x.FollowedAt
+		return x.BlockedAt
 	}
 	return 0
 }
 
-type GetMyFollowingListResponse struct {
+type ListBlockedResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*UserFollowInfo      `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	Users         []*BlockedUserInfo     `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
 	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
 	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
 	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
@@ -3872,21 +8706,21 @@ type GetMyFollowingListResponse struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMyFollowingListResponse) Reset() {
-	*x = GetMyFollowingListResponse{}
-	mi := &file_user_proto_msgTypes[64]
+func (x *ListBlockedResponse) Reset() {
+	*x = ListBlockedResponse{}
+	mi := &file_user_proto_msgTypes[132]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMyFollowingListResponse) String() string {
+func (x *ListBlockedResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMyFollowingListResponse) ProtoMessage() {}
+func (*ListBlockedResponse) ProtoMessage() {}
 
-func (x *GetMyFollowingListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[64]
+func (x *ListBlockedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[132]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3897,64 +8731,63 @@ func (x *GetMyFollowingListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMyFollowingListResponse.ProtoReflect.Descriptor instead.
-func (*GetMyFollowingListResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use ListBlockedResponse.ProtoReflect.Descriptor instead.
+func (*ListBlockedResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{132}
 }
 
-func (x *GetMyFollowingListResponse) GetUsers() []*UserFollowInfo {
+func (x *ListBlockedResponse) GetUsers() []*BlockedUserInfo {
 	if x != nil {
 		return x.Users
 	}
 	return nil
 }
 
-func (x *GetMyFollowingListResponse) GetTotal() int32 {
+func (x *ListBlockedResponse) GetTotal() int32 {
 	if x != nil {
 		return x.Total
 	}
 	return 0
 }
 
-func (x *GetMyFollowingListResponse) GetPage() int32 {
+func (x *ListBlockedResponse) GetPage() int32 {
 	if x != nil {
 		return x.Page
 	}
 	return 0
 }
 
-func (x *GetMyFollowingListResponse) GetPageSize() int32 {
+func (x *ListBlockedResponse) GetPageSize() int32 {
 	if x != nil {
 		return x.PageSize
 	}
 	return 0
 }
 
-type GetMyFollowersListResponse struct {
+// 过滤与指定用户存在拉黑关系（任一方向）的用户，供下单、推荐等跨服务场景使用
+type FilterBlockedUsersRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*UserFollowInfo      `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	UserId        uint64                 `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	UserIds       []uint64               `protobuf:"varint,2,rep,packed,name=user_ids,json=userIds,proto3" json:"user_ids,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetMyFollowersListResponse) Reset() {
-	*x = GetMyFollowersListResponse{}
-	mi := &file_user_proto_msgTypes[65]
+func (x *FilterBlockedUsersRequest) Reset() {
+	*x = FilterBlockedUsersRequest{}
+	mi := &file_user_proto_msgTypes[133]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMyFollowersListResponse) String() string {
+func (x *FilterBlockedUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMyFollowersListResponse) ProtoMessage() {}
+func (*FilterBlockedUsersRequest) ProtoMessage() {}
 
-func (x *GetMyFollowersListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[65]
+func (x *FilterBlockedUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[133]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3965,64 +8798,47 @@ func (x *GetMyFollowersListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMyFollowersListResponse.ProtoReflect.Descriptor instead.
-func (*GetMyFollowersListResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{65}
-}
-
-func (x *GetMyFollowersListResponse) GetUsers() []*UserFollowInfo {
-	if x != nil {
-		return x.Users
-	}
-	return nil
-}
-
-func (x *GetMyFollowersListResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
+// Deprecated: Use FilterBlockedUsersRequest.ProtoReflect.Descriptor instead.
+func (*FilterBlockedUsersRequest) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{133}
 }
 
-func (x *GetMyFollowersListResponse) GetPage() int32 {
+func (x *FilterBlockedUsersRequest) GetUserId() uint64 {
 	if x != nil {
-		return x.Page
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *GetMyFollowersListResponse) GetPageSize() int32 {
+func (x *FilterBlockedUsersRequest) GetUserIds() []uint64 {
 	if x != nil {
-		return x.PageSize
+		return x.UserIds
 	}
-	return 0
+	return nil
 }
 
-type GetMutualFollowListResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*UserFollowInfo      `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
-	Total         int32                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
-	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
-	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+type FilterBlockedUsersResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	BlockedUserIds []uint64               `protobuf:"varint,1,rep,packed,name=blocked_user_ids,json=blockedUserIds,proto3" json:"blocked_user_ids,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *GetMutualFollowListResponse) Reset() {
-	*x = GetMutualFollowListResponse{}
-	mi := &file_user_proto_msgTypes[66]
+func (x *FilterBlockedUsersResponse) Reset() {
+	*x = FilterBlockedUsersResponse{}
+	mi := &file_user_proto_msgTypes[134]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetMutualFollowListResponse) String() string {
+func (x *FilterBlockedUsersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetMutualFollowListResponse) ProtoMessage() {}
+func (*FilterBlockedUsersResponse) ProtoMessage() {}
 
-func (x *GetMutualFollowListResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_user_proto_msgTypes[66]
+func (x *FilterBlockedUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_user_proto_msgTypes[134]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4033,63 +8849,46 @@ func (x *GetMutualFollowListResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetMutualFollowListResponse.ProtoReflect.Descriptor instead.
-func (*GetMutualFollowListResponse) Descriptor() ([]byte, []int) {
-	return file_user_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use FilterBlockedUsersResponse.ProtoReflect.Descriptor instead.
+func (*FilterBlockedUsersResponse) Descriptor() ([]byte, []int) {
+	return file_user_proto_rawDescGZIP(), []int{134}
 }
 
-func (x *GetMutualFollowListResponse) GetUsers() []*UserFollowInfo {
+func (x *FilterBlockedUsersResponse) GetBlockedUserIds() []uint64 {
 	if x != nil {
-		return x.Users
+		return x.BlockedUserIds
 	}
 	return nil
 }
 
-func (x *GetMutualFollowListResponse) GetTotal() int32 {
-	if x != nil {
-		return x.Total
-	}
-	return 0
-}
-
-func (x *GetMutualFollowListResponse) GetPage() int32 {
-	if x != nil {
-		return x.Page
-	}
-	return 0
-}
-
-func (x *GetMutualFollowListResponse) GetPageSize() int32 {
-	if x != nil {
-		return x.PageSize
-	}
-	return 0
-}
-
 var File_user_proto protoreflect.FileDescriptor
 
 const file_user_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"user.proto\x12\x04user\"s\n" +
+	"user.proto\x12\x04user\"\x98\x01\n" +
 	"\x0fRegisterRequest\x12\x14\n" +
 	"\x05phone\x18\x01 \x01(\tR\x05phone\x12\x1a\n" +
 	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1a\n" +
 	"\bnickname\x18\x03 \x01(\tR\bnickname\x12\x12\n" +
-	"\x04role\x18\x04 \x01(\x05R\x04role\"4\n" +
+	"\x04role\x18\x04 \x01(\x05R\x04role\x12#\n" +
+	"\rverify_ticket\x18\x05 \x01(\tR\fverifyTicket\"4\n" +
 	"\x10RegisterResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x10\n" +
-	"\x03uid\x18\x02 \x01(\x04R\x03uid\"@\n" +
+	"\x03uid\x18\x02 \x01(\x04R\x03uid\"|\n" +
 	"\fLoginRequest\x12\x14\n" +
 	"\x05phone\x18\x01 \x01(\tR\x05phone\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"1\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x1b\n" +
+	"\tclient_ip\x18\x03 \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x04 \x01(\tR\tuserAgent\"1\n" +
 	"\rLoginResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x10\n" +
 	"\x03uid\x18\x02 \x01(\x04R\x03uid\"H\n" +
 	"\x0eGetUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x10\n" +
 	"\x03uid\x18\x02 \x01(\x04R\x03uid\x12\x14\n" +
-	"\x05phone\x18\x03 \x01(\tR\x05phone\"\xb4\x02\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\"\xbc\x04\n" +
 	"\bUserInfo\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x10\n" +
 	"\x03uid\x18\x02 \x01(\x04R\x03uid\x12\x1a\n" +
@@ -4103,9 +8902,18 @@ const file_user_proto_rawDesc = "" +
 	"\x0efrozen_balance\x18\t \x01(\x03R\rfrozenBalance\x12%\n" +
 	"\x0efollower_count\x18\n" +
 	" \x01(\x03R\rfollowerCount\x12'\n" +
-	"\x0ffollowing_count\x18\v \x01(\x03R\x0efollowingCount\"5\n" +
+	"\x0ffollowing_count\x18\v \x01(\x03R\x0efollowingCount\x12\x1b\n" +
+	"\tvip_level\x18\f \x01(\x05R\bvipLevel\x12\x1b\n" +
+	"\tvip_badge\x18\r \x01(\tR\bvipBadge\x12\"\n" +
+	"\rvip_expire_at\x18\x0e \x01(\x03R\vvipExpireAt\x12\x16\n" +
+	"\x06status\x18\x0f \x01(\x05R\x06status\x12!\n" +
+	"\fstatus_until\x18\x10 \x01(\x03R\vstatusUntil\x12#\n" +
+	"\rstatus_reason\x18\x11 \x01(\tR\fstatusReason\x12\x14\n" +
+	"\x05email\x18\x12 \x01(\tR\x05email\x12\x16\n" +
+	"\x06gender\x18\x13 \x01(\x05R\x06gender\x12\x1a\n" +
+	"\bbirthday\x18\x14 \x01(\tR\bbirthday\"5\n" +
 	"\x0fGetUserResponse\x12\"\n" +
-	"\x04user\x18\x01 \x01(\v2\x0e.user.UserInfoR\x04user\"\xb6\x01\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.user.UserInfoR\x04user\"\xea\x01\n" +
 	"\x11UpdateUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x1a\n" +
 	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x1a\n" +
@@ -4114,30 +8922,99 @@ const file_user_proto_rawDesc = "" +
 	"\x04role\x18\x05 \x01(\x05R\x04role\x12\x1d\n" +
 	"\n" +
 	"avatar_url\x18\x06 \x01(\tR\tavatarUrl\x12\x10\n" +
-	"\x03bio\x18\a \x01(\tR\x03bio\"8\n" +
+	"\x03bio\x18\a \x01(\tR\x03bio\x12\x16\n" +
+	"\x06gender\x18\b \x01(\x05R\x06gender\x12\x1a\n" +
+	"\bbirthday\x18\t \x01(\tR\bbirthday\"8\n" +
 	"\x12UpdateUserResponse\x12\"\n" +
-	"\x04user\x18\x01 \x01(\v2\x0e.user.UserInfoR\x04user\"*\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.user.UserInfoR\x04user\"\xa1\x01\n" +
 	"\x12LoginByCodeRequest\x12\x14\n" +
-	"\x05phone\x18\x01 \x01(\tR\x05phone\"7\n" +
+	"\x05phone\x18\x01 \x01(\tR\x05phone\x12\x1b\n" +
+	"\tclient_ip\x18\x02 \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x03 \x01(\tR\tuserAgent\x12#\n" +
+	"\rverify_ticket\x18\x04 \x01(\tR\fverifyTicket\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\"7\n" +
 	"\x13LoginByCodeResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x10\n" +
-	"\x03uid\x18\x02 \x01(\x04R\x03uid\"I\n" +
+	"\x03uid\x18\x02 \x01(\x04R\x03uid\":\n" +
+	"\x12UnlockLoginRequest\x12\x14\n" +
+	"\x05phone\x18\x01 \x01(\tR\x05phone\x12\x0e\n" +
+	"\x02ip\x18\x02 \x01(\tR\x02ip\"4\n" +
+	"\x13UnlockLoginResponse\x12\x1d\n" +
+	"\n" +
+	"was_locked\x18\x01 \x01(\bR\twasLocked\"\xb6\x01\n" +
+	"\x17RecordLoginEventRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x16\n" +
+	"\x06result\x18\x03 \x01(\tR\x06result\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1b\n" +
+	"\tclient_ip\x18\x05 \x01(\tR\bclientIp\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x06 \x01(\tR\tuserAgent\"\x1a\n" +
+	"\x18RecordLoginEventResponse\"\xf4\x01\n" +
+	"\x0eLoginEventInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x16\n" +
+	"\x06method\x18\x02 \x01(\tR\x06method\x12\x16\n" +
+	"\x06result\x18\x03 \x01(\tR\x06result\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x0e\n" +
+	"\x02ip\x18\x05 \x01(\tR\x02ip\x12\x1b\n" +
+	"\tip_region\x18\x06 \x01(\tR\bipRegion\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\a \x01(\tR\tuserAgent\x12\x1d\n" +
+	"\n" +
+	"new_device\x18\b \x01(\bR\tnewDevice\x12\x1f\n" +
+	"\voccurred_at\x18\t \x01(\x03R\n" +
+	"occurredAt\"b\n" +
+	"\x16ListLoginEventsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x8e\x01\n" +
+	"\x17ListLoginEventsResponse\x12,\n" +
+	"\x06events\x18\x01 \x03(\v2\x14.user.LoginEventInfoR\x06events\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x96\x01\n" +
+	"\x14SetUserStatusRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\x12\x14\n" +
+	"\x05until\x18\x03 \x01(\x03R\x05until\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12\x1f\n" +
+	"\voperator_id\x18\x05 \x01(\x04R\n" +
+	"operatorId\"d\n" +
+	"\x15SetUserStatusResponse\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.user.UserInfoR\x04user\x12'\n" +
+	"\x0fprevious_status\x18\x02 \x01(\x05R\x0epreviousStatus\"5\n" +
+	"\x18FilterBannedUsersRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x04R\auserIds\"C\n" +
+	"\x19FilterBannedUsersResponse\x12&\n" +
+	"\x0fbanned_user_ids\x18\x01 \x03(\x04R\rbannedUserIds\"\x84\x01\n" +
 	"\x15ForgetPasswordRequest\x12\x14\n" +
 	"\x05phone\x18\x01 \x01(\tR\x05phone\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword\":\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12#\n" +
+	"\rverify_ticket\x18\x04 \x01(\tR\fverifyTicket\x12\x14\n" +
+	"\x05email\x18\x05 \x01(\tR\x05email\":\n" +
 	"\x16ForgetPasswordResponse\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x10\n" +
-	"\x03uid\x18\x02 \x01(\x04R\x03uid\"g\n" +
+	"\x03uid\x18\x02 \x01(\x04R\x03uid\"\xbb\x01\n" +
 	"\x12ChangePhoneRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
 	"\told_phone\x18\x02 \x01(\tR\boldPhone\x12\x1b\n" +
-	"\tnew_phone\x18\x03 \x01(\tR\bnewPhone\"/\n" +
+	"\tnew_phone\x18\x03 \x01(\tR\bnewPhone\x12(\n" +
+	"\x10old_phone_ticket\x18\x04 \x01(\tR\x0eoldPhoneTicket\x12(\n" +
+	"\x10new_phone_ticket\x18\x05 \x01(\tR\x0enewPhoneTicket\"/\n" +
 	"\x13ChangePhoneResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"p\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"f\n" +
+	"\x10BindEmailRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x14\n" +
+	"\x05email\x18\x02 \x01(\tR\x05email\x12#\n" +
+	"\rverify_ticket\x18\x03 \x01(\tR\fverifyTicket\"7\n" +
+	"\x11BindEmailResponse\x12\"\n" +
+	"\x04user\x18\x01 \x01(\v2\x0e.user.UserInfoR\x04user\"\x95\x01\n" +
 	"\x15ChangePasswordRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
 	"\told_phone\x18\x02 \x01(\tR\boldPhone\x12!\n" +
-	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\"2\n" +
+	"\fnew_password\x18\x03 \x01(\tR\vnewPassword\x12#\n" +
+	"\rverify_ticket\x18\x04 \x01(\tR\fverifyTicket\"2\n" +
 	"\x16ChangePasswordResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"f\n" +
 	"\n" +
@@ -4200,7 +9077,119 @@ const file_user_proto_rawDesc = "" +
 	"bizOrderId\x12\x16\n" +
 	"\x06remark\x18\x04 \x01(\tR\x06remark\";\n" +
 	"\x0fConsumeResponse\x12(\n" +
-	"\x06wallet\x18\x01 \x01(\v2\x10.user.WalletInfoR\x06wallet\"\xae\x02\n" +
+	"\x06wallet\x18\x01 \x01(\v2\x10.user.WalletInfoR\x06wallet\"\x8d\x01\n" +
+	"\bGiftInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x03R\x05price\x12\x19\n" +
+	"\bicon_url\x18\x04 \x01(\tR\aiconUrl\x12\x12\n" +
+	"\x04sort\x18\x05 \x01(\x05R\x04sort\x12\x18\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\"=\n" +
+	"\x10ListGiftsRequest\x12)\n" +
+	"\x10include_disabled\x18\x01 \x01(\bR\x0fincludeDisabled\"9\n" +
+	"\x11ListGiftsResponse\x12$\n" +
+	"\x05gifts\x18\x01 \x03(\v2\x0e.user.GiftInfoR\x05gifts\"l\n" +
+	"\x11CreateGiftRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x02 \x01(\x03R\x05price\x12\x19\n" +
+	"\bicon_url\x18\x03 \x01(\tR\aiconUrl\x12\x12\n" +
+	"\x04sort\x18\x04 \x01(\x05R\x04sort\"8\n" +
+	"\x12CreateGiftResponse\x12\"\n" +
+	"\x04gift\x18\x01 \x01(\v2\x0e.user.GiftInfoR\x04gift\"\x96\x01\n" +
+	"\x11UpdateGiftRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05price\x18\x03 \x01(\x03R\x05price\x12\x19\n" +
+	"\bicon_url\x18\x04 \x01(\tR\aiconUrl\x12\x12\n" +
+	"\x04sort\x18\x05 \x01(\x05R\x04sort\x12\x18\n" +
+	"\aenabled\x18\x06 \x01(\bR\aenabled\"8\n" +
+	"\x12UpdateGiftResponse\x12\"\n" +
+	"\x04gift\x18\x01 \x01(\v2\x0e.user.GiftInfoR\x04gift\"\xa3\x01\n" +
+	"\x0fTransferRequest\x12 \n" +
+	"\ffrom_user_id\x18\x01 \x01(\x04R\n" +
+	"fromUserId\x12\x1c\n" +
+	"\n" +
+	"to_user_id\x18\x02 \x01(\x04R\btoUserId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\x12 \n" +
+	"\fbiz_order_id\x18\x04 \x01(\tR\n" +
+	"bizOrderId\x12\x16\n" +
+	"\x06remark\x18\x05 \x01(\tR\x06remark\"^\n" +
+	"\x10TransferResponse\x12(\n" +
+	"\x06wallet\x18\x01 \x01(\v2\x10.user.WalletInfoR\x06wallet\x12 \n" +
+	"\fbiz_order_id\x18\x02 \x01(\tR\n" +
+	"bizOrderId\"\xc0\x01\n" +
+	"\x0fSendGiftRequest\x12\x1b\n" +
+	"\tsender_id\x18\x01 \x01(\x04R\bsenderId\x12\x1f\n" +
+	"\vreceiver_id\x18\x02 \x01(\x04R\n" +
+	"receiverId\x12\x17\n" +
+	"\agift_id\x18\x03 \x01(\x04R\x06giftId\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12 \n" +
+	"\fbiz_order_id\x18\x05 \x01(\tR\n" +
+	"bizOrderId\x12\x18\n" +
+	"\amessage\x18\x06 \x01(\tR\amessage\"v\n" +
+	"\x10SendGiftResponse\x12(\n" +
+	"\x06wallet\x18\x01 \x01(\v2\x10.user.WalletInfoR\x06wallet\x12 \n" +
+	"\fbiz_order_id\x18\x02 \x01(\tR\n" +
+	"bizOrderId\x12\x16\n" +
+	"\x06amount\x18\x03 \x01(\x03R\x06amount\"\xaa\x02\n" +
+	"\vVipPlanInfo\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\x05R\x05level\x12#\n" +
+	"\rduration_days\x18\x04 \x01(\x05R\fdurationDays\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\x03R\x05price\x12)\n" +
+	"\x10discount_percent\x18\x06 \x01(\x05R\x0fdiscountPercent\x12\x14\n" +
+	"\x05badge\x18\a \x01(\tR\x05badge\x12-\n" +
+	"\x12recommend_priority\x18\b \x01(\x05R\x11recommendPriority\x122\n" +
+	"\x15rate_limit_multiplier\x18\t \x01(\x01R\x13rateLimitMultiplier\"\x15\n" +
+	"\x13ListVipPlansRequest\"?\n" +
+	"\x14ListVipPlansResponse\x12'\n" +
+	"\x05plans\x18\x01 \x03(\v2\x11.user.VipPlanInfoR\x05plans\"\xca\x01\n" +
+	"\x13VipSubscriptionInfo\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
+	"\tplan_code\x18\x02 \x01(\tR\bplanCode\x12\x14\n" +
+	"\x05level\x18\x03 \x01(\x05R\x05level\x12\x19\n" +
+	"\bstart_at\x18\x04 \x01(\x03R\astartAt\x12\x15\n" +
+	"\x06end_at\x18\x05 \x01(\x03R\x05endAt\x12\x1d\n" +
+	"\n" +
+	"auto_renew\x18\x06 \x01(\bR\tautoRenew\x12\x16\n" +
+	"\x06status\x18\a \x01(\x05R\x06status\"\x8c\x01\n" +
+	"\x13SubscribeVipRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
+	"\tplan_code\x18\x02 \x01(\tR\bplanCode\x12\x1d\n" +
+	"\n" +
+	"auto_renew\x18\x03 \x01(\bR\tautoRenew\x12 \n" +
+	"\fbiz_order_id\x18\x04 \x01(\tR\n" +
+	"bizOrderId\"\x7f\n" +
+	"\x14SubscribeVipResponse\x12=\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x19.user.VipSubscriptionInfoR\fsubscription\x12(\n" +
+	"\x06wallet\x18\x02 \x01(\v2\x10.user.WalletInfoR\x06wallet\"P\n" +
+	"\x16SetVipAutoRenewRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1d\n" +
+	"\n" +
+	"auto_renew\x18\x02 \x01(\bR\tautoRenew\"X\n" +
+	"\x17SetVipAutoRenewResponse\x12=\n" +
+	"\fsubscription\x18\x01 \x01(\v2\x19.user.VipSubscriptionInfoR\fsubscription\"4\n" +
+	"\x19GetVipEntitlementsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"\xc6\x02\n" +
+	"\x1aGetVipEntitlementsResponse\x12\x15\n" +
+	"\x06is_vip\x18\x01 \x01(\bR\x05isVip\x12\x14\n" +
+	"\x05level\x18\x02 \x01(\x05R\x05level\x12\x1b\n" +
+	"\tplan_code\x18\x03 \x01(\tR\bplanCode\x12\x14\n" +
+	"\x05badge\x18\x04 \x01(\tR\x05badge\x12)\n" +
+	"\x10discount_percent\x18\x05 \x01(\x05R\x0fdiscountPercent\x12-\n" +
+	"\x12recommend_priority\x18\x06 \x01(\x05R\x11recommendPriority\x122\n" +
+	"\x15rate_limit_multiplier\x18\a \x01(\x01R\x13rateLimitMultiplier\x12\x1b\n" +
+	"\texpire_at\x18\b \x01(\x03R\bexpireAt\x12\x1d\n" +
+	"\n" +
+	"auto_renew\x18\t \x01(\bR\tautoRenew\"\xac\x01\n" +
+	"\x0eCompanionSkill\x12\"\n" +
+	"\rgame_skill_id\x18\x01 \x01(\x04R\vgameSkillId\x12\x1b\n" +
+	"\tgame_name\x18\x02 \x01(\tR\bgameName\x12\x12\n" +
+	"\x04rank\x18\x03 \x01(\tR\x04rank\x12$\n" +
+	"\x0eprice_per_hour\x18\x04 \x01(\x03R\fpricePerHour\x12\x1f\n" +
+	"\vis_verified\x18\x05 \x01(\bR\n" +
+	"isVerified\"\xda\x03\n" +
 	"\rCompanionInfo\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1d\n" +
 	"\n" +
@@ -4215,7 +9204,13 @@ const file_user_proto_rawDesc = "" +
 	"avatar_url\x18\b \x01(\tR\tavatarUrl\x12\x10\n" +
 	"\x03bio\x18\t \x01(\tR\x03bio\x12\x1a\n" +
 	"\bnickname\x18\n" +
-	" \x01(\tR\bnickname\"Q\n" +
+	" \x01(\tR\bnickname\x12!\n" +
+	"\frating_score\x18\v \x01(\x01R\vratingScore\x12,\n" +
+	"\x06skills\x18\f \x03(\v2\x14.user.CompanionSkillR\x06skills\x12\x16\n" +
+	"\x06gender\x18\r \x01(\x05R\x06gender\x12\x10\n" +
+	"\x03age\x18\x0e \x01(\x05R\x03age\x12\x12\n" +
+	"\x04tier\x18\x0f \x01(\x05R\x04tier\x12\x1b\n" +
+	"\ttier_name\x18\x10 \x01(\tR\btierName\"Q\n" +
 	"\tGameSkill\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
@@ -4239,24 +9234,29 @@ const file_user_proto_rawDesc = "" +
 	"\x17DeleteGameSkillResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\"5\n" +
 	"\x1aGetCompanionProfileRequest\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x04R\x06userId\"L\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"\xa8\x01\n" +
 	"\x1bGetCompanionProfileResponse\x12-\n" +
-	"\aprofile\x18\x01 \x01(\v2\x13.user.CompanionInfoR\aprofile\"\x95\x01\n" +
+	"\aprofile\x18\x01 \x01(\v2\x13.user.CompanionInfoR\aprofile\x12-\n" +
+	"\x12commission_percent\x18\x02 \x01(\x05R\x11commissionPercent\x12+\n" +
+	"\x12max_price_per_hour\x18\x03 \x01(\x03R\x0fmaxPricePerHour\"\xc3\x01\n" +
 	"\x1dUpdateCompanionProfileRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1d\n" +
 	"\n" +
 	"game_skill\x18\x02 \x01(\tR\tgameSkill\x12$\n" +
 	"\x0eprice_per_hour\x18\x03 \x01(\x03R\fpricePerHour\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\x05R\x06status\"O\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\x12,\n" +
+	"\x06skills\x18\x05 \x03(\v2\x14.user.CompanionSkillR\x06skills\"O\n" +
 	"\x1eUpdateCompanionProfileResponse\x12-\n" +
-	"\aprofile\x18\x01 \x01(\v2\x13.user.CompanionInfoR\aprofile\"x\n" +
+	"\aprofile\x18\x01 \x01(\v2\x13.user.CompanionInfoR\aprofile\"\xb0\x01\n" +
 	"\x1bUpdateCompanionStatsRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12!\n" +
 	"\fdelta_orders\x18\x02 \x01(\x03R\vdeltaOrders\x12\x1d\n" +
 	"\n" +
-	"new_rating\x18\x03 \x01(\x01R\tnewRating\"M\n" +
+	"new_rating\x18\x03 \x01(\x01R\tnewRating\x12\x19\n" +
+	"\border_id\x18\x04 \x01(\x04R\aorderId\x12\x1b\n" +
+	"\tgame_name\x18\x05 \x01(\tR\bgameName\"M\n" +
 	"\x1cUpdateCompanionStatsResponse\x12-\n" +
-	"\aprofile\x18\x01 \x01(\v2\x13.user.CompanionInfoR\aprofile\"\xdc\x01\n" +
+	"\aprofile\x18\x01 \x01(\v2\x13.user.CompanionInfoR\aprofile\"\xb6\x03\n" +
 	"\x17GetCompanionListRequest\x12\x1d\n" +
 	"\n" +
 	"game_skill\x18\x01 \x01(\tR\tgameSkill\x12\x1b\n" +
@@ -4266,14 +9266,141 @@ const file_user_proto_rawDesc = "" +
 	"\vis_verified\x18\x05 \x01(\bR\n" +
 	"isVerified\x12\x12\n" +
 	"\x04page\x18\x06 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\a \x01(\x05R\bpageSize\"\x96\x01\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\x12\x1f\n" +
+	"\vonline_only\x18\b \x01(\bR\n" +
+	"onlineOnly\x12\x16\n" +
+	"\x06gender\x18\t \x01(\x05R\x06gender\x12\x17\n" +
+	"\amin_age\x18\n" +
+	" \x01(\x05R\x06minAge\x12\x17\n" +
+	"\amax_age\x18\v \x01(\x05R\x06maxAge\x12\x1d\n" +
+	"\n" +
+	"min_rating\x18\f \x01(\x01R\tminRating\x12\x18\n" +
+	"\akeyword\x18\r \x01(\tR\akeyword\x12\x17\n" +
+	"\asort_by\x18\x0e \x01(\tR\x06sortBy\x12\x1b\n" +
+	"\tviewer_id\x18\x0f \x01(\x04R\bviewerId\"\x96\x01\n" +
 	"\x18GetCompanionListResponse\x123\n" +
 	"\n" +
 	"companions\x18\x01 \x03(\v2\x13.user.CompanionInfoR\n" +
 	"companions\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xda\x01\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"4\n" +
+	"\x19CompanionHeartbeatRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"|\n" +
+	"\x1aCompanionHeartbeatResponse\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x1b\n" +
+	"\texpire_at\x18\x02 \x01(\x03R\bexpireAt\x12)\n" +
+	"\x10interval_seconds\x18\x03 \x01(\x05R\x0fintervalSeconds\"8\n" +
+	"\x1bGetCompanionPresenceRequest\x12\x19\n" +
+	"\buser_ids\x18\x01 \x03(\x04R\auserIds\"D\n" +
+	"\x11CompanionPresence\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\"U\n" +
+	"\x1cGetCompanionPresenceResponse\x125\n" +
+	"\tpresences\x18\x01 \x03(\v2\x17.user.CompanionPresenceR\tpresences\"\xc4\x04\n" +
+	"\x18CompanionApplicationInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x1b\n" +
+	"\treal_name\x18\x03 \x01(\tR\brealName\x12\x1c\n" +
+	"\n" +
+	"id_card_no\x18\x04 \x01(\tR\bidCardNo\x12)\n" +
+	"\x11id_card_front_url\x18\x05 \x01(\tR\x0eidCardFrontUrl\x12'\n" +
+	"\x10id_card_back_url\x18\x06 \x01(\tR\ridCardBackUrl\x12(\n" +
+	"\x10skill_proof_urls\x18\a \x03(\tR\x0eskillProofUrls\x12(\n" +
+	"\x10voice_sample_url\x18\b \x01(\tR\x0evoiceSampleUrl\x12,\n" +
+	"\x06skills\x18\t \x03(\v2\x14.user.CompanionSkillR\x06skills\x12\x10\n" +
+	"\x03bio\x18\n" +
+	" \x01(\tR\x03bio\x12\x16\n" +
+	"\x06status\x18\v \x01(\x05R\x06status\x12#\n" +
+	"\rreject_reason\x18\f \x01(\tR\frejectReason\x12\x1f\n" +
+	"\vreview_note\x18\r \x01(\tR\n" +
+	"reviewNote\x12\x1f\n" +
+	"\vreviewer_id\x18\x0e \x01(\x04R\n" +
+	"reviewerId\x12\x1f\n" +
+	"\vreviewed_at\x18\x0f \x01(\x03R\n" +
+	"reviewedAt\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x10 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"reapply_at\x18\x11 \x01(\x03R\treapplyAt\"\xdf\x02\n" +
+	"!SubmitCompanionApplicationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1b\n" +
+	"\treal_name\x18\x02 \x01(\tR\brealName\x12\x1c\n" +
+	"\n" +
+	"id_card_no\x18\x03 \x01(\tR\bidCardNo\x12)\n" +
+	"\x11id_card_front_url\x18\x04 \x01(\tR\x0eidCardFrontUrl\x12'\n" +
+	"\x10id_card_back_url\x18\x05 \x01(\tR\ridCardBackUrl\x12(\n" +
+	"\x10skill_proof_urls\x18\x06 \x03(\tR\x0eskillProofUrls\x12(\n" +
+	"\x10voice_sample_url\x18\a \x01(\tR\x0evoiceSampleUrl\x12,\n" +
+	"\x06skills\x18\b \x03(\v2\x14.user.CompanionSkillR\x06skills\x12\x10\n" +
+	"\x03bio\x18\t \x01(\tR\x03bio\"f\n" +
+	"\"SubmitCompanionApplicationResponse\x12@\n" +
+	"\vapplication\x18\x01 \x01(\v2\x1e.user.CompanionApplicationInfoR\vapplication\";\n" +
+	" GetMyCompanionApplicationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\"e\n" +
+	"!GetMyCompanionApplicationResponse\x12@\n" +
+	"\vapplication\x18\x01 \x01(\v2\x1e.user.CompanionApplicationInfoR\vapplication\"\x84\x01\n" +
+	" ListCompanionApplicationsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\x05R\x06status\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xae\x01\n" +
+	"!ListCompanionApplicationsResponse\x12B\n" +
+	"\fapplications\x18\x01 \x03(\v2\x1e.user.CompanionApplicationInfoR\fapplications\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xbe\x01\n" +
+	"!ReviewCompanionApplicationRequest\x12%\n" +
+	"\x0eapplication_id\x18\x01 \x01(\x04R\rapplicationId\x12\x1f\n" +
+	"\vreviewer_id\x18\x02 \x01(\x04R\n" +
+	"reviewerId\x12\x18\n" +
+	"\aapprove\x18\x03 \x01(\bR\aapprove\x12#\n" +
+	"\rreject_reason\x18\x04 \x01(\tR\frejectReason\x12\x12\n" +
+	"\x04note\x18\x05 \x01(\tR\x04note\"f\n" +
+	"\"ReviewCompanionApplicationResponse\x12@\n" +
+	"\vapplication\x18\x01 \x01(\v2\x1e.user.CompanionApplicationInfoR\vapplication\"\xea\x02\n" +
+	"\x18CompanionTierHistoryItem\x12\x1b\n" +
+	"\tfrom_tier\x18\x01 \x01(\x05R\bfromTier\x12$\n" +
+	"\x0efrom_tier_name\x18\x02 \x01(\tR\ffromTierName\x12\x17\n" +
+	"\ato_tier\x18\x03 \x01(\x05R\x06toTier\x12 \n" +
+	"\fto_tier_name\x18\x04 \x01(\tR\n" +
+	"toTierName\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12)\n" +
+	"\x10completed_orders\x18\x06 \x01(\x03R\x0fcompletedOrders\x12\x16\n" +
+	"\x06rating\x18\a \x01(\x01R\x06rating\x12\x1f\n" +
+	"\vcancel_rate\x18\b \x01(\x01R\n" +
+	"cancelRate\x12\x12\n" +
+	"\x04note\x18\t \x01(\tR\x04note\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\x03R\tcreatedAt\x12!\n" +
+	"\fdispute_rate\x18\v \x01(\x01R\vdisputeRate\"j\n" +
+	"\x1eGetCompanionTierHistoryRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\xef\x01\n" +
+	"\x1fGetCompanionTierHistoryResponse\x12\x12\n" +
+	"\x04tier\x18\x01 \x01(\x05R\x04tier\x12\x1b\n" +
+	"\ttier_name\x18\x02 \x01(\tR\btierName\x12\x1e\n" +
+	"\n" +
+	"overridden\x18\x03 \x01(\bR\n" +
+	"overridden\x124\n" +
+	"\x05items\x18\x04 \x03(\v2\x1e.user.CompanionTierHistoryItemR\x05items\x12\x14\n" +
+	"\x05total\x18\x05 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x06 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\a \x01(\x05R\bpageSize\"\x83\x01\n" +
+	"\x1fSetCompanionTierOverrideRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1f\n" +
+	"\voperator_id\x18\x02 \x01(\x04R\n" +
+	"operatorId\x12\x12\n" +
+	"\x04tier\x18\x03 \x01(\x05R\x04tier\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\"s\n" +
+	" SetCompanionTierOverrideResponse\x12\x12\n" +
+	"\x04tier\x18\x01 \x01(\x05R\x04tier\x12\x1b\n" +
+	"\ttier_name\x18\x02 \x01(\tR\btierName\x12\x1e\n" +
+	"\n" +
+	"overridden\x18\x03 \x01(\bR\n" +
+	"overridden\"\xfd\x01\n" +
 	"\x14CompanionRankingItem\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1a\n" +
 	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x1d\n" +
@@ -4283,18 +9410,23 @@ const file_user_proto_rawDesc = "" +
 	"\ftotal_orders\x18\x05 \x01(\x03R\vtotalOrders\x12\x12\n" +
 	"\x04rank\x18\x06 \x01(\x05R\x04rank\x12\x1f\n" +
 	"\vis_verified\x18\a \x01(\bR\n" +
-	"isVerified\"S\n" +
+	"isVerified\x12!\n" +
+	"\frating_score\x18\b \x01(\x01R\vratingScore\"\x7f\n" +
 	" GetCompanionRatingRankingRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"\xa2\x01\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06period\x18\x03 \x01(\tR\x06period\x12\x12\n" +
+	"\x04game\x18\x04 \x01(\tR\x04game\"\xa2\x01\n" +
 	"!GetCompanionRatingRankingResponse\x126\n" +
 	"\brankings\x18\x01 \x03(\v2\x1a.user.CompanionRankingItemR\brankings\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"S\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x7f\n" +
 	" GetCompanionOrdersRankingRequest\x12\x12\n" +
 	"\x04page\x18\x01 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\"\xa2\x01\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06period\x18\x03 \x01(\tR\x06period\x12\x12\n" +
+	"\x04game\x18\x04 \x01(\tR\x04game\"\xa2\x01\n" +
 	"!GetCompanionOrdersRankingResponse\x126\n" +
 	"\brankings\x18\x01 \x03(\v2\x1a.user.CompanionRankingItemR\brankings\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
@@ -4368,27 +9500,126 @@ const file_user_proto_rawDesc = "" +
 	"\x05users\x18\x01 \x03(\v2\x14.user.UserFollowInfoR\x05users\x12\x14\n" +
 	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
 	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
-	"\tpage_size\x18\x04 \x01(\x05R\bpageSize2\xc8\x12\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x86\x03\n" +
+	"\bFeedItem\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x04R\x02id\x12!\n" +
+	"\fcompanion_id\x18\x02 \x01(\x04R\vcompanionId\x12\x1a\n" +
+	"\bnickname\x18\x03 \x01(\tR\bnickname\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x04 \x01(\tR\tavatarUrl\x12\x12\n" +
+	"\x04kind\x18\x05 \x01(\tR\x04kind\x12\x1b\n" +
+	"\tgame_name\x18\x06 \x01(\tR\bgameName\x12\x16\n" +
+	"\x06rating\x18\a \x01(\x01R\x06rating\x12\x18\n" +
+	"\acomment\x18\b \x01(\tR\acomment\x12\x1b\n" +
+	"\told_price\x18\t \x01(\x03R\boldPrice\x12\x1b\n" +
+	"\tnew_price\x18\n" +
+	" \x01(\x03R\bnewPrice\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\x03R\tcreatedAt\x12\x17\n" +
+	"\apost_id\x18\f \x01(\x04R\x06postId\x12\x18\n" +
+	"\acontent\x18\r \x01(\tR\acontent\x12\x1d\n" +
+	"\n" +
+	"image_urls\x18\x0e \x03(\tR\timageUrls\"o\n" +
+	"\x1bPublishCompanionPostRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\x12\x1d\n" +
+	"\n" +
+	"image_urls\x18\x03 \x03(\tR\timageUrls\"V\n" +
+	"\x1cPublishCompanionPostResponse\x12\x17\n" +
+	"\apost_id\x18\x01 \x01(\x04R\x06postId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\x03R\tcreatedAt\"W\n" +
+	"\x0eGetFeedRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\x04R\x06cursor\x12\x14\n" +
+	"\x05limit\x18\x03 \x01(\x05R\x05limit\"s\n" +
+	"\x0fGetFeedResponse\x12$\n" +
+	"\x05items\x18\x01 \x03(\v2\x0e.user.FeedItemR\x05items\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\x04R\n" +
+	"nextCursor\x12\x19\n" +
+	"\bhas_more\x18\x03 \x01(\bR\ahasMore\"L\n" +
+	"\x10BlockUserRequest\x12\x1f\n" +
+	"\voperator_id\x18\x01 \x01(\x04R\n" +
+	"operatorId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\"G\n" +
+	"\x11BlockUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"N\n" +
+	"\x12UnblockUserRequest\x12\x1f\n" +
+	"\voperator_id\x18\x01 \x01(\x04R\n" +
+	"operatorId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x04R\x06userId\"I\n" +
+	"\x13UnblockUserResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"f\n" +
+	"\x12ListBlockedRequest\x12\x1f\n" +
+	"\voperator_id\x18\x01 \x01(\x04R\n" +
+	"operatorId\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x98\x01\n" +
+	"\x0fBlockedUserInfo\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x1a\n" +
+	"\bnickname\x18\x02 \x01(\tR\bnickname\x12\x1d\n" +
+	"\n" +
+	"avatar_url\x18\x03 \x01(\tR\tavatarUrl\x12\x12\n" +
+	"\x04role\x18\x04 \x01(\x05R\x04role\x12\x1d\n" +
+	"\n" +
+	"blocked_at\x18\x05 \x01(\x03R\tblockedAt\"\x89\x01\n" +
+	"\x13ListBlockedResponse\x12+\n" +
+	"\x05users\x18\x01 \x03(\v2\x15.user.BlockedUserInfoR\x05users\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x05R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"O\n" +
+	"\x19FilterBlockedUsersRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x04R\x06userId\x12\x19\n" +
+	"\buser_ids\x18\x02 \x03(\x04R\auserIds\"F\n" +
+	"\x1aFilterBlockedUsersResponse\x12(\n" +
+	"\x10blocked_user_ids\x18\x01 \x03(\x04R\x0eblockedUserIds2\xf9$\n" +
 	"\x04User\x129\n" +
 	"\bRegister\x12\x15.user.RegisterRequest\x1a\x16.user.RegisterResponse\x120\n" +
 	"\x05Login\x12\x12.user.LoginRequest\x1a\x13.user.LoginResponse\x126\n" +
 	"\aGetUser\x12\x14.user.GetUserRequest\x1a\x15.user.GetUserResponse\x12?\n" +
 	"\n" +
 	"UpdateUser\x12\x17.user.UpdateUserRequest\x1a\x18.user.UpdateUserResponse\x12B\n" +
-	"\vLoginByCode\x12\x18.user.LoginByCodeRequest\x1a\x19.user.LoginByCodeResponse\x12K\n" +
+	"\vLoginByCode\x12\x18.user.LoginByCodeRequest\x1a\x19.user.LoginByCodeResponse\x12B\n" +
+	"\vUnlockLogin\x12\x18.user.UnlockLoginRequest\x1a\x19.user.UnlockLoginResponse\x12Q\n" +
+	"\x10RecordLoginEvent\x12\x1d.user.RecordLoginEventRequest\x1a\x1e.user.RecordLoginEventResponse\x12N\n" +
+	"\x0fListLoginEvents\x12\x1c.user.ListLoginEventsRequest\x1a\x1d.user.ListLoginEventsResponse\x12H\n" +
+	"\rSetUserStatus\x12\x1a.user.SetUserStatusRequest\x1a\x1b.user.SetUserStatusResponse\x12T\n" +
+	"\x11FilterBannedUsers\x12\x1e.user.FilterBannedUsersRequest\x1a\x1f.user.FilterBannedUsersResponse\x12K\n" +
 	"\x0eForgetPassword\x12\x1b.user.ForgetPasswordRequest\x1a\x1c.user.ForgetPasswordResponse\x12B\n" +
 	"\vChangePhone\x12\x18.user.ChangePhoneRequest\x1a\x19.user.ChangePhoneResponse\x12K\n" +
 	"\x0eChangePassword\x12\x1b.user.ChangePasswordRequest\x1a\x1c.user.ChangePasswordResponse\x12<\n" +
+	"\tBindEmail\x12\x16.user.BindEmailRequest\x1a\x17.user.BindEmailResponse\x12<\n" +
 	"\tGetWallet\x12\x16.user.GetWalletRequest\x1a\x17.user.GetWalletResponse\x129\n" +
 	"\bRecharge\x12\x15.user.RechargeRequest\x1a\x16.user.RechargeResponse\x126\n" +
 	"\aConsume\x12\x14.user.ConsumeRequest\x1a\x15.user.ConsumeResponse\x12Z\n" +
 	"\x13CreateRechargeOrder\x12 .user.CreateRechargeOrderRequest\x1a!.user.CreateRechargeOrderResponse\x12l\n" +
 	"\x19UpdateRechargeOrderStatus\x12&.user.UpdateRechargeOrderStatusRequest\x1a'.user.UpdateRechargeOrderStatusResponse\x12E\n" +
-	"\fRechargeList\x12\x19.user.RechargeListRequest\x1a\x1a.user.RechargeListResponse\x12Z\n" +
+	"\fRechargeList\x12\x19.user.RechargeListRequest\x1a\x1a.user.RechargeListResponse\x129\n" +
+	"\bTransfer\x12\x15.user.TransferRequest\x1a\x16.user.TransferResponse\x129\n" +
+	"\bSendGift\x12\x15.user.SendGiftRequest\x1a\x16.user.SendGiftResponse\x12<\n" +
+	"\tListGifts\x12\x16.user.ListGiftsRequest\x1a\x17.user.ListGiftsResponse\x12?\n" +
+	"\n" +
+	"CreateGift\x12\x17.user.CreateGiftRequest\x1a\x18.user.CreateGiftResponse\x12?\n" +
+	"\n" +
+	"UpdateGift\x12\x17.user.UpdateGiftRequest\x1a\x18.user.UpdateGiftResponse\x12E\n" +
+	"\fListVipPlans\x12\x19.user.ListVipPlansRequest\x1a\x1a.user.ListVipPlansResponse\x12E\n" +
+	"\fSubscribeVip\x12\x19.user.SubscribeVipRequest\x1a\x1a.user.SubscribeVipResponse\x12N\n" +
+	"\x0fSetVipAutoRenew\x12\x1c.user.SetVipAutoRenewRequest\x1a\x1d.user.SetVipAutoRenewResponse\x12W\n" +
+	"\x12GetVipEntitlements\x12\x1f.user.GetVipEntitlementsRequest\x1a .user.GetVipEntitlementsResponse\x12Z\n" +
 	"\x13GetCompanionProfile\x12 .user.GetCompanionProfileRequest\x1a!.user.GetCompanionProfileResponse\x12c\n" +
 	"\x16UpdateCompanionProfile\x12#.user.UpdateCompanionProfileRequest\x1a$.user.UpdateCompanionProfileResponse\x12]\n" +
 	"\x14UpdateCompanionStats\x12!.user.UpdateCompanionStatsRequest\x1a\".user.UpdateCompanionStatsResponse\x12Q\n" +
-	"\x10GetCompanionList\x12\x1d.user.GetCompanionListRequest\x1a\x1e.user.GetCompanionListResponse\x12l\n" +
+	"\x10GetCompanionList\x12\x1d.user.GetCompanionListRequest\x1a\x1e.user.GetCompanionListResponse\x12W\n" +
+	"\x12CompanionHeartbeat\x12\x1f.user.CompanionHeartbeatRequest\x1a .user.CompanionHeartbeatResponse\x12]\n" +
+	"\x14GetCompanionPresence\x12!.user.GetCompanionPresenceRequest\x1a\".user.GetCompanionPresenceResponse\x12o\n" +
+	"\x1aSubmitCompanionApplication\x12'.user.SubmitCompanionApplicationRequest\x1a(.user.SubmitCompanionApplicationResponse\x12l\n" +
+	"\x19GetMyCompanionApplication\x12&.user.GetMyCompanionApplicationRequest\x1a'.user.GetMyCompanionApplicationResponse\x12l\n" +
+	"\x19ListCompanionApplications\x12&.user.ListCompanionApplicationsRequest\x1a'.user.ListCompanionApplicationsResponse\x12o\n" +
+	"\x1aReviewCompanionApplication\x12'.user.ReviewCompanionApplicationRequest\x1a(.user.ReviewCompanionApplicationResponse\x12f\n" +
+	"\x17GetCompanionTierHistory\x12$.user.GetCompanionTierHistoryRequest\x1a%.user.GetCompanionTierHistoryResponse\x12i\n" +
+	"\x18SetCompanionTierOverride\x12%.user.SetCompanionTierOverrideRequest\x1a&.user.SetCompanionTierOverrideResponse\x12l\n" +
 	"\x19GetCompanionRatingRanking\x12&.user.GetCompanionRatingRankingRequest\x1a'.user.GetCompanionRatingRankingResponse\x12l\n" +
 	"\x19GetCompanionOrdersRanking\x12&.user.GetCompanionOrdersRankingRequest\x1a'.user.GetCompanionOrdersRankingResponse\x12K\n" +
 	"\x0eListGameSkills\x12\x1b.user.ListGameSkillsRequest\x1a\x1c.user.ListGameSkillsResponse\x12N\n" +
@@ -4401,7 +9632,13 @@ const file_user_proto_rawDesc = "" +
 	"\x12GetMyFollowingList\x12\x1f.user.GetMyFollowingListRequest\x1a .user.GetMyFollowingListResponse\x12W\n" +
 	"\x12GetMyFollowersList\x12\x1f.user.GetMyFollowersListRequest\x1a .user.GetMyFollowersListResponse\x12Z\n" +
 	"\x13GetMutualFollowList\x12 .user.GetMutualFollowListRequest\x1a!.user.GetMutualFollowListResponse\x12T\n" +
-	"\x11CheckFollowStatus\x12\x1e.user.CheckFollowStatusRequest\x1a\x1f.user.CheckFollowStatusResponseB\bZ\x06./userb\x06proto3"
+	"\x11CheckFollowStatus\x12\x1e.user.CheckFollowStatusRequest\x1a\x1f.user.CheckFollowStatusResponse\x126\n" +
+	"\aGetFeed\x12\x14.user.GetFeedRequest\x1a\x15.user.GetFeedResponse\x12]\n" +
+	"\x14PublishCompanionPost\x12!.user.PublishCompanionPostRequest\x1a\".user.PublishCompanionPostResponse\x12<\n" +
+	"\tBlockUser\x12\x16.user.BlockUserRequest\x1a\x17.user.BlockUserResponse\x12B\n" +
+	"\vUnblockUser\x12\x18.user.UnblockUserRequest\x1a\x19.user.UnblockUserResponse\x12B\n" +
+	"\vListBlocked\x12\x18.user.ListBlockedRequest\x1a\x19.user.ListBlockedResponse\x12W\n" +
+	"\x12FilterBlockedUsers\x12\x1f.user.FilterBlockedUsersRequest\x1a .user.FilterBlockedUsersResponseB\bZ\x06./userb\x06proto3"
 
 var (
 	file_user_proto_rawDescOnce sync.Once
@@ -4415,160 +9652,310 @@ func file_user_proto_rawDescGZIP() []byte {
 	return file_user_proto_rawDescData
 }
 
-var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 67)
+var file_user_proto_msgTypes = make([]protoimpl.MessageInfo, 135)
 var file_user_proto_goTypes = []any{
-	(*RegisterRequest)(nil),                   // 0: user.RegisterRequest
-	(*RegisterResponse)(nil),                  // 1: user.RegisterResponse
-	(*LoginRequest)(nil),                      // 2: user.LoginRequest
-	(*LoginResponse)(nil),                     // 3: user.LoginResponse
-	(*GetUserRequest)(nil),                    // 4: user.GetUserRequest
-	(*UserInfo)(nil),                          // 5: user.UserInfo
-	(*GetUserResponse)(nil),                   // 6: user.GetUserResponse
-	(*UpdateUserRequest)(nil),                 // 7: user.UpdateUserRequest
-	(*UpdateUserResponse)(nil),                // 8: user.UpdateUserResponse
-	(*LoginByCodeRequest)(nil),                // 9: user.LoginByCodeRequest
-	(*LoginByCodeResponse)(nil),               // 10: user.LoginByCodeResponse
-	(*ForgetPasswordRequest)(nil),             // 11: user.ForgetPasswordRequest
-	(*ForgetPasswordResponse)(nil),            // 12: user.ForgetPasswordResponse
-	(*ChangePhoneRequest)(nil),                // 13: user.ChangePhoneRequest
-	(*ChangePhoneResponse)(nil),               // 14: user.ChangePhoneResponse
-	(*ChangePasswordRequest)(nil),             // 15: user.ChangePasswordRequest
-	(*ChangePasswordResponse)(nil),            // 16: user.ChangePasswordResponse
-	(*WalletInfo)(nil),                        // 17: user.WalletInfo
-	(*GetWalletRequest)(nil),                  // 18: user.GetWalletRequest
-	(*GetWalletResponse)(nil),                 // 19: user.GetWalletResponse
-	(*RechargeRequest)(nil),                   // 20: user.RechargeRequest
-	(*RechargeResponse)(nil),                  // 21: user.RechargeResponse
-	(*CreateRechargeOrderRequest)(nil),        // 22: user.CreateRechargeOrderRequest
-	(*CreateRechargeOrderResponse)(nil),       // 23: user.CreateRechargeOrderResponse
-	(*UpdateRechargeOrderStatusRequest)(nil),  // 24: user.UpdateRechargeOrderStatusRequest
-	(*UpdateRechargeOrderStatusResponse)(nil), // 25: user.UpdateRechargeOrderStatusResponse
-	(*RechargeOrderInfo)(nil),                 // 26: user.RechargeOrderInfo
-	(*RechargeListRequest)(nil),               // 27: user.RechargeListRequest
-	(*RechargeListResponse)(nil),              // 28: user.RechargeListResponse
-	(*ConsumeRequest)(nil),                    // 29: user.ConsumeRequest
-	(*ConsumeResponse)(nil),                   // 30: user.ConsumeResponse
-	(*CompanionInfo)(nil),                     // 31: user.CompanionInfo
-	(*GameSkill)(nil),                         // 32: user.GameSkill
-	(*ListGameSkillsRequest)(nil),             // 33: user.ListGameSkillsRequest
-	(*ListGameSkillsResponse)(nil),            // 34: user.ListGameSkillsResponse
-	(*CreateGameSkillRequest)(nil),            // 35: user.CreateGameSkillRequest
-	(*CreateGameSkillResponse)(nil),           // 36: user.CreateGameSkillResponse
-	(*UpdateGameSkillRequest)(nil),            // 37: user.UpdateGameSkillRequest
-	(*UpdateGameSkillResponse)(nil),           // 38: user.UpdateGameSkillResponse
-	(*DeleteGameSkillRequest)(nil),            // 39: user.DeleteGameSkillRequest
-	(*DeleteGameSkillResponse)(nil),           // 40: user.DeleteGameSkillResponse
-	(*GetCompanionProfileRequest)(nil),        // 41: user.GetCompanionProfileRequest
-	(*GetCompanionProfileResponse)(nil),       // 42: user.GetCompanionProfileResponse
-	(*UpdateCompanionProfileRequest)(nil),     // 43: user.UpdateCompanionProfileRequest
-	(*UpdateCompanionProfileResponse)(nil),    // 44: user.UpdateCompanionProfileResponse
-	(*UpdateCompanionStatsRequest)(nil),       // 45: user.UpdateCompanionStatsRequest
-	(*UpdateCompanionStatsResponse)(nil),      // 46: user.UpdateCompanionStatsResponse
-	(*GetCompanionListRequest)(nil),           // 47: user.GetCompanionListRequest
-	(*GetCompanionListResponse)(nil),          // 48: user.GetCompanionListResponse
-	(*CompanionRankingItem)(nil),              // 49: user.CompanionRankingItem
-	(*GetCompanionRatingRankingRequest)(nil),  // 50: user.GetCompanionRatingRankingRequest
-	(*GetCompanionRatingRankingResponse)(nil), // 51: user.GetCompanionRatingRankingResponse
-	(*GetCompanionOrdersRankingRequest)(nil),  // 52: user.GetCompanionOrdersRankingRequest
-	(*GetCompanionOrdersRankingResponse)(nil), // 53: user.GetCompanionOrdersRankingResponse
-	(*FollowUserRequest)(nil),                 // 54: user.FollowUserRequest
-	(*FollowUserResponse)(nil),                // 55: user.FollowUserResponse
-	(*UnfollowUserRequest)(nil),               // 56: user.UnfollowUserRequest
-	(*UnfollowUserResponse)(nil),              // 57: user.UnfollowUserResponse
-	(*GetMyFollowingListRequest)(nil),         // 58: user.GetMyFollowingListRequest
-	(*GetMyFollowersListRequest)(nil),         // 59: user.GetMyFollowersListRequest
-	(*GetMutualFollowListRequest)(nil),        // 60: user.GetMutualFollowListRequest
-	(*CheckFollowStatusRequest)(nil),          // 61: user.CheckFollowStatusRequest
-	(*CheckFollowStatusResponse)(nil),         // 62: user.CheckFollowStatusResponse
-	(*UserFollowInfo)(nil),                    // 63: user.UserFollowInfo
-	(*GetMyFollowingListResponse)(nil),        // 64: user.GetMyFollowingListResponse
-	(*GetMyFollowersListResponse)(nil),        // 65: user.GetMyFollowersListResponse
-	(*GetMutualFollowListResponse)(nil),       // 66: user.GetMutualFollowListResponse
+	(*RegisterRequest)(nil),                    // 0: user.RegisterRequest
+	(*RegisterResponse)(nil),                   // 1: user.RegisterResponse
+	(*LoginRequest)(nil),                       // 2: user.LoginRequest
+	(*LoginResponse)(nil),                      // 3: user.LoginResponse
+	(*GetUserRequest)(nil),                     // 4: user.GetUserRequest
+	(*UserInfo)(nil),                           // 5: user.UserInfo
+	(*GetUserResponse)(nil),                    // 6: user.GetUserResponse
+	(*UpdateUserRequest)(nil),                  // 7: user.UpdateUserRequest
+	(*UpdateUserResponse)(nil),                 // 8: user.UpdateUserResponse
+	(*LoginByCodeRequest)(nil),                 // 9: user.LoginByCodeRequest
+	(*LoginByCodeResponse)(nil),                // 10: user.LoginByCodeResponse
+	(*UnlockLoginRequest)(nil),                 // 11: user.UnlockLoginRequest
+	(*UnlockLoginResponse)(nil),                // 12: user.UnlockLoginResponse
+	(*RecordLoginEventRequest)(nil),            // 13: user.RecordLoginEventRequest
+	(*RecordLoginEventResponse)(nil),           // 14: user.RecordLoginEventResponse
+	(*LoginEventInfo)(nil),                     // 15: user.LoginEventInfo
+	(*ListLoginEventsRequest)(nil),             // 16: user.ListLoginEventsRequest
+	(*ListLoginEventsResponse)(nil),            // 17: user.ListLoginEventsResponse
+	(*SetUserStatusRequest)(nil),               // 18: user.SetUserStatusRequest
+	(*SetUserStatusResponse)(nil),              // 19: user.SetUserStatusResponse
+	(*FilterBannedUsersRequest)(nil),           // 20: user.FilterBannedUsersRequest
+	(*FilterBannedUsersResponse)(nil),          // 21: user.FilterBannedUsersResponse
+	(*ForgetPasswordRequest)(nil),              // 22: user.ForgetPasswordRequest
+	(*ForgetPasswordResponse)(nil),             // 23: user.ForgetPasswordResponse
+	(*ChangePhoneRequest)(nil),                 // 24: user.ChangePhoneRequest
+	(*ChangePhoneResponse)(nil),                // 25: user.ChangePhoneResponse
+	(*BindEmailRequest)(nil),                   // 26: user.BindEmailRequest
+	(*BindEmailResponse)(nil),                  // 27: user.BindEmailResponse
+	(*ChangePasswordRequest)(nil),              // 28: user.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),             // 29: user.ChangePasswordResponse
+	(*WalletInfo)(nil),                         // 30: user.WalletInfo
+	(*GetWalletRequest)(nil),                   // 31: user.GetWalletRequest
+	(*GetWalletResponse)(nil),                  // 32: user.GetWalletResponse
+	(*RechargeRequest)(nil),                    // 33: user.RechargeRequest
+	(*RechargeResponse)(nil),                   // 34: user.RechargeResponse
+	(*CreateRechargeOrderRequest)(nil),         // 35: user.CreateRechargeOrderRequest
+	(*CreateRechargeOrderResponse)(nil),        // 36: user.CreateRechargeOrderResponse
+	(*UpdateRechargeOrderStatusRequest)(nil),   // 37: user.UpdateRechargeOrderStatusRequest
+	(*UpdateRechargeOrderStatusResponse)(nil),  // 38: user.UpdateRechargeOrderStatusResponse
+	(*RechargeOrderInfo)(nil),                  // 39: user.RechargeOrderInfo
+	(*RechargeListRequest)(nil),                // 40: user.RechargeListRequest
+	(*RechargeListResponse)(nil),               // 41: user.RechargeListResponse
+	(*ConsumeRequest)(nil),                     // 42: user.ConsumeRequest
+	(*ConsumeResponse)(nil),                    // 43: user.ConsumeResponse
+	(*GiftInfo)(nil),                           // 44: user.GiftInfo
+	(*ListGiftsRequest)(nil),                   // 45: user.ListGiftsRequest
+	(*ListGiftsResponse)(nil),                  // 46: user.ListGiftsResponse
+	(*CreateGiftRequest)(nil),                  // 47: user.CreateGiftRequest
+	(*CreateGiftResponse)(nil),                 // 48: user.CreateGiftResponse
+	(*UpdateGiftRequest)(nil),                  // 49: user.UpdateGiftRequest
+	(*UpdateGiftResponse)(nil),                 // 50: user.UpdateGiftResponse
+	(*TransferRequest)(nil),                    // 51: user.TransferRequest
+	(*TransferResponse)(nil),                   // 52: user.TransferResponse
+	(*SendGiftRequest)(nil),                    // 53: user.SendGiftRequest
+	(*SendGiftResponse)(nil),                   // 54: user.SendGiftResponse
+	(*VipPlanInfo)(nil),                        // 55: user.VipPlanInfo
+	(*ListVipPlansRequest)(nil),                // 56: user.ListVipPlansRequest
+	(*ListVipPlansResponse)(nil),               // 57: user.ListVipPlansResponse
+	(*VipSubscriptionInfo)(nil),                // 58: user.VipSubscriptionInfo
+	(*SubscribeVipRequest)(nil),                // 59: user.SubscribeVipRequest
+	(*SubscribeVipResponse)(nil),               // 60: user.SubscribeVipResponse
+	(*SetVipAutoRenewRequest)(nil),             // 61: user.SetVipAutoRenewRequest
+	(*SetVipAutoRenewResponse)(nil),            // 62: user.SetVipAutoRenewResponse
+	(*GetVipEntitlementsRequest)(nil),          // 63: user.GetVipEntitlementsRequest
+	(*GetVipEntitlementsResponse)(nil),         // 64: user.GetVipEntitlementsResponse
+	(*CompanionSkill)(nil),                     // 65: user.CompanionSkill
+	(*CompanionInfo)(nil),                      // 66: user.CompanionInfo
+	(*GameSkill)(nil),                          // 67: user.GameSkill
+	(*ListGameSkillsRequest)(nil),              // 68: user.ListGameSkillsRequest
+	(*ListGameSkillsResponse)(nil),             // 69: user.ListGameSkillsResponse
+	(*CreateGameSkillRequest)(nil),             // 70: user.CreateGameSkillRequest
+	(*CreateGameSkillResponse)(nil),            // 71: user.CreateGameSkillResponse
+	(*UpdateGameSkillRequest)(nil),             // 72: user.UpdateGameSkillRequest
+	(*UpdateGameSkillResponse)(nil),            // 73: user.UpdateGameSkillResponse
+	(*DeleteGameSkillRequest)(nil),             // 74: user.DeleteGameSkillRequest
+	(*DeleteGameSkillResponse)(nil),            // 75: user.DeleteGameSkillResponse
+	(*GetCompanionProfileRequest)(nil),         // 76: user.GetCompanionProfileRequest
+	(*GetCompanionProfileResponse)(nil),        // 77: user.GetCompanionProfileResponse
+	(*UpdateCompanionProfileRequest)(nil),      // 78: user.UpdateCompanionProfileRequest
+	(*UpdateCompanionProfileResponse)(nil),     // 79: user.UpdateCompanionProfileResponse
+	(*UpdateCompanionStatsRequest)(nil),        // 80: user.UpdateCompanionStatsRequest
+	(*UpdateCompanionStatsResponse)(nil),       // 81: user.UpdateCompanionStatsResponse
+	(*GetCompanionListRequest)(nil),            // 82: user.GetCompanionListRequest
+	(*GetCompanionListResponse)(nil),           // 83: user.GetCompanionListResponse
+	(*CompanionHeartbeatRequest)(nil),          // 84: user.CompanionHeartbeatRequest
+	(*CompanionHeartbeatResponse)(nil),         // 85: user.CompanionHeartbeatResponse
+	(*GetCompanionPresenceRequest)(nil),        // 86: user.GetCompanionPresenceRequest
+	(*CompanionPresence)(nil),                  // 87: user.CompanionPresence
+	(*GetCompanionPresenceResponse)(nil),       // 88: user.GetCompanionPresenceResponse
+	(*CompanionApplicationInfo)(nil),           // 89: user.CompanionApplicationInfo
+	(*SubmitCompanionApplicationRequest)(nil),  // 90: user.SubmitCompanionApplicationRequest
+	(*SubmitCompanionApplicationResponse)(nil), // 91: user.SubmitCompanionApplicationResponse
+	(*GetMyCompanionApplicationRequest)(nil),   // 92: user.GetMyCompanionApplicationRequest
+	(*GetMyCompanionApplicationResponse)(nil),  // 93: user.GetMyCompanionApplicationResponse
+	(*ListCompanionApplicationsRequest)(nil),   // 94: user.ListCompanionApplicationsRequest
+	(*ListCompanionApplicationsResponse)(nil),  // 95: user.ListCompanionApplicationsResponse
+	(*ReviewCompanionApplicationRequest)(nil),  // 96: user.ReviewCompanionApplicationRequest
+	(*ReviewCompanionApplicationResponse)(nil), // 97: user.ReviewCompanionApplicationResponse
+	(*CompanionTierHistoryItem)(nil),           // 98: user.CompanionTierHistoryItem
+	(*GetCompanionTierHistoryRequest)(nil),     // 99: user.GetCompanionTierHistoryRequest
+	(*GetCompanionTierHistoryResponse)(nil),    // 100: user.GetCompanionTierHistoryResponse
+	(*SetCompanionTierOverrideRequest)(nil),    // 101: user.SetCompanionTierOverrideRequest
+	(*SetCompanionTierOverrideResponse)(nil),   // 102: user.SetCompanionTierOverrideResponse
+	(*CompanionRankingItem)(nil),               // 103: user.CompanionRankingItem
+	(*GetCompanionRatingRankingRequest)(nil),   // 104: user.GetCompanionRatingRankingRequest
+	(*GetCompanionRatingRankingResponse)(nil),  // 105: user.GetCompanionRatingRankingResponse
+	(*GetCompanionOrdersRankingRequest)(nil),   // 106: user.GetCompanionOrdersRankingRequest
+	(*GetCompanionOrdersRankingResponse)(nil),  // 107: user.GetCompanionOrdersRankingResponse
+	(*FollowUserRequest)(nil),                  // 108: user.FollowUserRequest
+	(*FollowUserResponse)(nil),                 // 109: user.FollowUserResponse
+	(*UnfollowUserRequest)(nil),                // 110: user.UnfollowUserRequest
+	(*UnfollowUserResponse)(nil),               // 111: user.UnfollowUserResponse
+	(*GetMyFollowingListRequest)(nil),          // 112: user.GetMyFollowingListRequest
+	(*GetMyFollowersListRequest)(nil),          // 113: user.GetMyFollowersListRequest
+	(*GetMutualFollowListRequest)(nil),         // 114: user.GetMutualFollowListRequest
+	(*CheckFollowStatusRequest)(nil),           // 115: user.CheckFollowStatusRequest
+	(*CheckFollowStatusResponse)(nil),          // 116: user.CheckFollowStatusResponse
+	(*UserFollowInfo)(nil),                     // 117: user.UserFollowInfo
+	(*GetMyFollowingListResponse)(nil),         // 118: user.GetMyFollowingListResponse
+	(*GetMyFollowersListResponse)(nil),         // 119: user.GetMyFollowersListResponse
+	(*GetMutualFollowListResponse)(nil),        // 120: user.GetMutualFollowListResponse
+	(*FeedItem)(nil),                           // 121: user.FeedItem
+	(*PublishCompanionPostRequest)(nil),        // 122: user.PublishCompanionPostRequest
+	(*PublishCompanionPostResponse)(nil),       // 123: user.PublishCompanionPostResponse
+	(*GetFeedRequest)(nil),                     // 124: user.GetFeedRequest
+	(*GetFeedResponse)(nil),                    // 125: user.GetFeedResponse
+	(*BlockUserRequest)(nil),                   // 126: user.BlockUserRequest
+	(*BlockUserResponse)(nil),                  // 127: user.BlockUserResponse
+	(*UnblockUserRequest)(nil),                 // 128: user.UnblockUserRequest
+	(*UnblockUserResponse)(nil),                // 129: user.UnblockUserResponse
+	(*ListBlockedRequest)(nil),                 // 130: user.ListBlockedRequest
+	(*BlockedUserInfo)(nil),                    // 131: user.BlockedUserInfo
+	(*ListBlockedResponse)(nil),                // 132: user.ListBlockedResponse
+	(*FilterBlockedUsersRequest)(nil),          // 133: user.FilterBlockedUsersRequest
+	(*FilterBlockedUsersResponse)(nil),         // 134: user.FilterBlockedUsersResponse
 }
 var file_user_proto_depIdxs = []int32{
-	5,  // 0: user.GetUserResponse.user:type_name -> user.UserInfo
-	5,  // 1: user.UpdateUserResponse.user:type_name -> user.UserInfo
-	17, // 2: user.GetWalletResponse.wallet:type_name -> user.WalletInfo
-	17, // 3: user.RechargeResponse.wallet:type_name -> user.WalletInfo
-	26, // 4: user.RechargeListResponse.orders:type_name -> user.RechargeOrderInfo
-	17, // 5: user.ConsumeResponse.wallet:type_name -> user.WalletInfo
-	32, // 6: user.ListGameSkillsResponse.skills:type_name -> user.GameSkill
-	32, // 7: user.CreateGameSkillResponse.skill:type_name -> user.GameSkill
-	32, // 8: user.UpdateGameSkillResponse.skill:type_name -> user.GameSkill
-	31, // 9: user.GetCompanionProfileResponse.profile:type_name -> user.CompanionInfo
-	31, // 10: user.UpdateCompanionProfileResponse.profile:type_name -> user.CompanionInfo
-	31, // 11: user.UpdateCompanionStatsResponse.profile:type_name -> user.CompanionInfo
-	31, // 12: user.GetCompanionListResponse.companions:type_name -> user.CompanionInfo
-	49, // 13: user.GetCompanionRatingRankingResponse.rankings:type_name -> user.CompanionRankingItem
-	49, // 14: user.GetCompanionOrdersRankingResponse.rankings:type_name -> user.CompanionRankingItem
-	63, // 15: user.GetMyFollowingListResponse.users:type_name -> user.UserFollowInfo
-	63, // 16: user.GetMyFollowersListResponse.users:type_name -> user.UserFollowInfo
-	63, // 17: user.GetMutualFollowListResponse.users:type_name -> user.UserFollowInfo
-	0,  // 18: user.User.Register:input_type -> user.RegisterRequest
-	2,  // 19: user.User.Login:input_type -> user.LoginRequest
-	4,  // 20: user.User.GetUser:input_type -> user.GetUserRequest
-	7,  // 21: user.User.UpdateUser:input_type -> user.UpdateUserRequest
-	9,  // 22: user.User.LoginByCode:input_type -> user.LoginByCodeRequest
-	11, // 23: user.User.ForgetPassword:input_type -> user.ForgetPasswordRequest
-	13, // 24: user.User.ChangePhone:input_type -> user.ChangePhoneRequest
-	15, // 25: user.User.ChangePassword:input_type -> user.ChangePasswordRequest
-	18, // 26: user.User.GetWallet:input_type -> user.GetWalletRequest
-	20, // 27: user.User.Recharge:input_type -> user.RechargeRequest
-	29, // 28: user.User.Consume:input_type -> user.ConsumeRequest
-	22, // 29: user.User.CreateRechargeOrder:input_type -> user.CreateRechargeOrderRequest
-	24, // 30: user.User.UpdateRechargeOrderStatus:input_type -> user.UpdateRechargeOrderStatusRequest
-	27, // 31: user.User.RechargeList:input_type -> user.RechargeListRequest
-	41, // 32: user.User.GetCompanionProfile:input_type -> user.GetCompanionProfileRequest
-	43, // 33: user.User.UpdateCompanionProfile:input_type -> user.UpdateCompanionProfileRequest
-	45, // 34: user.User.UpdateCompanionStats:input_type -> user.UpdateCompanionStatsRequest
-	47, // 35: user.User.GetCompanionList:input_type -> user.GetCompanionListRequest
-	50, // 36: user.User.GetCompanionRatingRanking:input_type -> user.GetCompanionRatingRankingRequest
-	52, // 37: user.User.GetCompanionOrdersRanking:input_type -> user.GetCompanionOrdersRankingRequest
-	33, // 38: user.User.ListGameSkills:input_type -> user.ListGameSkillsRequest
-	35, // 39: user.User.CreateGameSkill:input_type -> user.CreateGameSkillRequest
-	37, // 40: user.User.UpdateGameSkill:input_type -> user.UpdateGameSkillRequest
-	39, // 41: user.User.DeleteGameSkill:input_type -> user.DeleteGameSkillRequest
-	54, // 42: user.User.FollowUser:input_type -> user.FollowUserRequest
-	56, // 43: user.User.UnfollowUser:input_type -> user.UnfollowUserRequest
-	58, // 44: user.User.GetMyFollowingList:input_type -> user.GetMyFollowingListRequest
-	59, // 45: user.User.GetMyFollowersList:input_type -> user.GetMyFollowersListRequest
-	60, // 46: user.User.GetMutualFollowList:input_type -> user.GetMutualFollowListRequest
-	61, // 47: user.User.CheckFollowStatus:input_type -> user.CheckFollowStatusRequest
-	1,  // 48: user.User.Register:output_type -> user.RegisterResponse
-	3,  // 49: user.User.Login:output_type -> user.LoginResponse
-	6,  // 50: user.User.GetUser:output_type -> user.GetUserResponse
-	8,  // 51: user.User.UpdateUser:output_type -> user.UpdateUserResponse
-	10, // 52: user.User.LoginByCode:output_type -> user.LoginByCodeResponse
-	12, // 53: user.User.ForgetPassword:output_type -> user.ForgetPasswordResponse
-	14, // 54: user.User.ChangePhone:output_type -> user.ChangePhoneResponse
-	16, // 55: user.User.ChangePassword:output_type -> user.ChangePasswordResponse
-	19, // 56: user.User.GetWallet:output_type -> user.GetWalletResponse
-	21, // 57: user.User.Recharge:output_type -> user.RechargeResponse
-	30, // 58: user.User.Consume:output_type -> user.ConsumeResponse
-	23, // 59: user.User.CreateRechargeOrder:output_type -> user.CreateRechargeOrderResponse
-	25, // 60: user.User.UpdateRechargeOrderStatus:output_type -> user.UpdateRechargeOrderStatusResponse
-	28, // 61: user.User.RechargeList:output_type -> user.RechargeListResponse
-	42, // 62: user.User.GetCompanionProfile:output_type -> user.GetCompanionProfileResponse
-	44, // 63: user.User.UpdateCompanionProfile:output_type -> user.UpdateCompanionProfileResponse
-	46, // 64: user.User.UpdateCompanionStats:output_type -> user.UpdateCompanionStatsResponse
-	48, // 65: user.User.GetCompanionList:output_type -> user.GetCompanionListResponse
-	51, // 66: user.User.GetCompanionRatingRanking:output_type -> user.GetCompanionRatingRankingResponse
-	53, // 67: user.User.GetCompanionOrdersRanking:output_type -> user.GetCompanionOrdersRankingResponse
-	34, // 68: user.User.ListGameSkills:output_type -> user.ListGameSkillsResponse
-	36, // 69: user.User.CreateGameSkill:output_type -> user.CreateGameSkillResponse
-	38, // 70: user.User.UpdateGameSkill:output_type -> user.UpdateGameSkillResponse
-	40, // 71: user.User.DeleteGameSkill:output_type -> user.DeleteGameSkillResponse
-	55, // 72: user.User.FollowUser:output_type -> user.FollowUserResponse
-	57, // 73: user.User.UnfollowUser:output_type -> user.UnfollowUserResponse
-	64, // 74: user.User.GetMyFollowingList:output_type -> user.GetMyFollowingListResponse
-	65, // 75: user.User.GetMyFollowersList:output_type -> user.GetMyFollowersListResponse
-	66, // 76: user.User.GetMutualFollowList:output_type -> user.GetMutualFollowListResponse
-	62, // 77: user.User.CheckFollowStatus:output_type -> user.CheckFollowStatusResponse
-	48, // [48:78] is the sub-list for method output_type
-	18, // [18:48] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	5,   // 0: user.GetUserResponse.user:type_name -> user.UserInfo
+	5,   // 1: user.UpdateUserResponse.user:type_name -> user.UserInfo
+	15,  // 2: user.ListLoginEventsResponse.events:type_name -> user.LoginEventInfo
+	5,   // 3: user.SetUserStatusResponse.user:type_name -> user.UserInfo
+	5,   // 4: user.BindEmailResponse.user:type_name -> user.UserInfo
+	30,  // 5: user.GetWalletResponse.wallet:type_name -> user.WalletInfo
+	30,  // 6: user.RechargeResponse.wallet:type_name -> user.WalletInfo
+	39,  // 7: user.RechargeListResponse.orders:type_name -> user.RechargeOrderInfo
+	30,  // 8: user.ConsumeResponse.wallet:type_name -> user.WalletInfo
+	44,  // 9: user.ListGiftsResponse.gifts:type_name -> user.GiftInfo
+	44,  // 10: user.CreateGiftResponse.gift:type_name -> user.GiftInfo
+	44,  // 11: user.UpdateGiftResponse.gift:type_name -> user.GiftInfo
+	30,  // 12: user.TransferResponse.wallet:type_name -> user.WalletInfo
+	30,  // 13: user.SendGiftResponse.wallet:type_name -> user.WalletInfo
+	55,  // 14: user.ListVipPlansResponse.plans:type_name -> user.VipPlanInfo
+	58,  // 15: user.SubscribeVipResponse.subscription:type_name -> user.VipSubscriptionInfo
+	30,  // 16: user.SubscribeVipResponse.wallet:type_name -> user.WalletInfo
+	58,  // 17: user.SetVipAutoRenewResponse.subscription:type_name -> user.VipSubscriptionInfo
+	65,  // 18: user.CompanionInfo.skills:type_name -> user.CompanionSkill
+	67,  // 19: user.ListGameSkillsResponse.skills:type_name -> user.GameSkill
+	67,  // 20: user.CreateGameSkillResponse.skill:type_name -> user.GameSkill
+	67,  // 21: user.UpdateGameSkillResponse.skill:type_name -> user.GameSkill
+	66,  // 22: user.GetCompanionProfileResponse.profile:type_name -> user.CompanionInfo
+	65,  // 23: user.UpdateCompanionProfileRequest.skills:type_name -> user.CompanionSkill
+	66,  // 24: user.UpdateCompanionProfileResponse.profile:type_name -> user.CompanionInfo
+	66,  // 25: user.UpdateCompanionStatsResponse.profile:type_name -> user.CompanionInfo
+	66,  // 26: user.GetCompanionListResponse.companions:type_name -> user.CompanionInfo
+	87,  // 27: user.GetCompanionPresenceResponse.presences:type_name -> user.CompanionPresence
+	65,  // 28: user.CompanionApplicationInfo.skills:type_name -> user.CompanionSkill
+	65,  // 29: user.SubmitCompanionApplicationRequest.skills:type_name -> user.CompanionSkill
+	89,  // 30: user.SubmitCompanionApplicationResponse.application:type_name -> user.CompanionApplicationInfo
+	89,  // 31: user.GetMyCompanionApplicationResponse.application:type_name -> user.CompanionApplicationInfo
+	89,  // 32: user.ListCompanionApplicationsResponse.applications:type_name -> user.CompanionApplicationInfo
+	89,  // 33: user.ReviewCompanionApplicationResponse.application:type_name -> user.CompanionApplicationInfo
+	98,  // 34: user.GetCompanionTierHistoryResponse.items:type_name -> user.CompanionTierHistoryItem
+	103, // 35: user.GetCompanionRatingRankingResponse.rankings:type_name -> user.CompanionRankingItem
+	103, // 36: user.GetCompanionOrdersRankingResponse.rankings:type_name -> user.CompanionRankingItem
+	117, // 37: user.GetMyFollowingListResponse.users:type_name -> user.UserFollowInfo
+	117, // 38: user.GetMyFollowersListResponse.users:type_name -> user.UserFollowInfo
+	117, // 39: user.GetMutualFollowListResponse.users:type_name -> user.UserFollowInfo
+	121, // 40: user.GetFeedResponse.items:type_name -> user.FeedItem
+	131, // 41: user.ListBlockedResponse.users:type_name -> user.BlockedUserInfo
+	0,   // 42: user.User.Register:input_type -> user.RegisterRequest
+	2,   // 43: user.User.Login:input_type -> user.LoginRequest
+	4,   // 44: user.User.GetUser:input_type -> user.GetUserRequest
+	7,   // 45: user.User.UpdateUser:input_type -> user.UpdateUserRequest
+	9,   // 46: user.User.LoginByCode:input_type -> user.LoginByCodeRequest
+	11,  // 47: user.User.UnlockLogin:input_type -> user.UnlockLoginRequest
+	13,  // 48: user.User.RecordLoginEvent:input_type -> user.RecordLoginEventRequest
+	16,  // 49: user.User.ListLoginEvents:input_type -> user.ListLoginEventsRequest
+	18,  // 50: user.User.SetUserStatus:input_type -> user.SetUserStatusRequest
+	20,  // 51: user.User.FilterBannedUsers:input_type -> user.FilterBannedUsersRequest
+	22,  // 52: user.User.ForgetPassword:input_type -> user.ForgetPasswordRequest
+	24,  // 53: user.User.ChangePhone:input_type -> user.ChangePhoneRequest
+	28,  // 54: user.User.ChangePassword:input_type -> user.ChangePasswordRequest
+	26,  // 55: user.User.BindEmail:input_type -> user.BindEmailRequest
+	31,  // 56: user.User.GetWallet:input_type -> user.GetWalletRequest
+	33,  // 57: user.User.Recharge:input_type -> user.RechargeRequest
+	42,  // 58: user.User.Consume:input_type -> user.ConsumeRequest
+	35,  // 59: user.User.CreateRechargeOrder:input_type -> user.CreateRechargeOrderRequest
+	37,  // 60: user.User.UpdateRechargeOrderStatus:input_type -> user.UpdateRechargeOrderStatusRequest
+	40,  // 61: user.User.RechargeList:input_type -> user.RechargeListRequest
+	51,  // 62: user.User.Transfer:input_type -> user.TransferRequest
+	53,  // 63: user.User.SendGift:input_type -> user.SendGiftRequest
+	45,  // 64: user.User.ListGifts:input_type -> user.ListGiftsRequest
+	47,  // 65: user.User.CreateGift:input_type -> user.CreateGiftRequest
+	49,  // 66: user.User.UpdateGift:input_type -> user.UpdateGiftRequest
+	56,  // 67: user.User.ListVipPlans:input_type -> user.ListVipPlansRequest
+	59,  // 68: user.User.SubscribeVip:input_type -> user.SubscribeVipRequest
+	61,  // 69: user.User.SetVipAutoRenew:input_type -> user.SetVipAutoRenewRequest
+	63,  // 70: user.User.GetVipEntitlements:input_type -> user.GetVipEntitlementsRequest
+	76,  // 71: user.User.GetCompanionProfile:input_type -> user.GetCompanionProfileRequest
+	78,  // 72: user.User.UpdateCompanionProfile:input_type -> user.UpdateCompanionProfileRequest
+	80,  // 73: user.User.UpdateCompanionStats:input_type -> user.UpdateCompanionStatsRequest
+	82,  // 74: user.User.GetCompanionList:input_type -> user.GetCompanionListRequest
+	84,  // 75: user.User.CompanionHeartbeat:input_type -> user.CompanionHeartbeatRequest
+	86,  // 76: user.User.GetCompanionPresence:input_type -> user.GetCompanionPresenceRequest
+	90,  // 77: user.User.SubmitCompanionApplication:input_type -> user.SubmitCompanionApplicationRequest
+	92,  // 78: user.User.GetMyCompanionApplication:input_type -> user.GetMyCompanionApplicationRequest
+	94,  // 79: user.User.ListCompanionApplications:input_type -> user.ListCompanionApplicationsRequest
+	96,  // 80: user.User.ReviewCompanionApplication:input_type -> user.ReviewCompanionApplicationRequest
+	99,  // 81: user.User.GetCompanionTierHistory:input_type -> user.GetCompanionTierHistoryRequest
+	101, // 82: user.User.SetCompanionTierOverride:input_type -> user.SetCompanionTierOverrideRequest
+	104, // 83: user.User.GetCompanionRatingRanking:input_type -> user.GetCompanionRatingRankingRequest
+	106, // 84: user.User.GetCompanionOrdersRanking:input_type -> user.GetCompanionOrdersRankingRequest
+	68,  // 85: user.User.ListGameSkills:input_type -> user.ListGameSkillsRequest
+	70,  // 86: user.User.CreateGameSkill:input_type -> user.CreateGameSkillRequest
+	72,  // 87: user.User.UpdateGameSkill:input_type -> user.UpdateGameSkillRequest
+	74,  // 88: user.User.DeleteGameSkill:input_type -> user.DeleteGameSkillRequest
+	108, // 89: user.User.FollowUser:input_type -> user.FollowUserRequest
+	110, // 90: user.User.UnfollowUser:input_type -> user.UnfollowUserRequest
+	112, // 91: user.User.GetMyFollowingList:input_type -> user.GetMyFollowingListRequest
+	113, // 92: user.User.GetMyFollowersList:input_type -> user.GetMyFollowersListRequest
+	114, // 93: user.User.GetMutualFollowList:input_type -> user.GetMutualFollowListRequest
+	115, // 94: user.User.CheckFollowStatus:input_type -> user.CheckFollowStatusRequest
+	124, // 95: user.User.GetFeed:input_type -> user.GetFeedRequest
+	122, // 96: user.User.PublishCompanionPost:input_type -> user.PublishCompanionPostRequest
+	126, // 97: user.User.BlockUser:input_type -> user.BlockUserRequest
+	128, // 98: user.User.UnblockUser:input_type -> user.UnblockUserRequest
+	130, // 99: user.User.ListBlocked:input_type -> user.ListBlockedRequest
+	133, // 100: user.User.FilterBlockedUsers:input_type -> user.FilterBlockedUsersRequest
+	1,   // 101: user.User.Register:output_type -> user.RegisterResponse
+	3,   // 102: user.User.Login:output_type -> user.LoginResponse
+	6,   // 103: user.User.GetUser:output_type -> user.GetUserResponse
+	8,   // 104: user.User.UpdateUser:output_type -> user.UpdateUserResponse
+	10,  // 105: user.User.LoginByCode:output_type -> user.LoginByCodeResponse
+	12,  // 106: user.User.UnlockLogin:output_type -> user.UnlockLoginResponse
+	14,  // 107: user.User.RecordLoginEvent:output_type -> user.RecordLoginEventResponse
+	17,  // 108: user.User.ListLoginEvents:output_type -> user.ListLoginEventsResponse
+	19,  // 109: user.User.SetUserStatus:output_type -> user.SetUserStatusResponse
+	21,  // 110: user.User.FilterBannedUsers:output_type -> user.FilterBannedUsersResponse
+	23,  // 111: user.User.ForgetPassword:output_type -> user.ForgetPasswordResponse
+	25,  // 112: user.User.ChangePhone:output_type -> user.ChangePhoneResponse
+	29,  // 113: user.User.ChangePassword:output_type -> user.ChangePasswordResponse
+	27,  // 114: user.User.BindEmail:output_type -> user.BindEmailResponse
+	32,  // 115: user.User.GetWallet:output_type -> user.GetWalletResponse
+	34,  // 116: user.User.Recharge:output_type -> user.RechargeResponse
+	43,  // 117: user.User.Consume:output_type -> user.ConsumeResponse
+	36,  // 118: user.User.CreateRechargeOrder:output_type -> user.CreateRechargeOrderResponse
+	38,  // 119: user.User.UpdateRechargeOrderStatus:output_type -> user.UpdateRechargeOrderStatusResponse
+	41,  // 120: user.User.RechargeList:output_type -> user.RechargeListResponse
+	52,  // 121: user.User.Transfer:output_type -> user.TransferResponse
+	54,  // 122: user.User.SendGift:output_type -> user.SendGiftResponse
+	46,  // 123: user.User.ListGifts:output_type -> user.ListGiftsResponse
+	48,  // 124: user.User.CreateGift:output_type -> user.CreateGiftResponse
+	50,  // 125: user.User.UpdateGift:output_type -> user.UpdateGiftResponse
+	57,  // 126: user.User.ListVipPlans:output_type -> user.ListVipPlansResponse
+	60,  // 127: user.User.SubscribeVip:output_type -> user.SubscribeVipResponse
+	62,  // 128: user.User.SetVipAutoRenew:output_type -> user.SetVipAutoRenewResponse
+	64,  // 129: user.User.GetVipEntitlements:output_type -> user.GetVipEntitlementsResponse
+	77,  // 130: user.User.GetCompanionProfile:output_type -> user.GetCompanionProfileResponse
+	79,  // 131: user.User.UpdateCompanionProfile:output_type -> user.UpdateCompanionProfileResponse
+	81,  // 132: user.User.UpdateCompanionStats:output_type -> user.UpdateCompanionStatsResponse
+	83,  // 133: user.User.GetCompanionList:output_type -> user.GetCompanionListResponse
+	85,  // 134: user.User.CompanionHeartbeat:output_type -> user.CompanionHeartbeatResponse
+	88,  // 135: user.User.GetCompanionPresence:output_type -> user.GetCompanionPresenceResponse
+	91,  // 136: user.User.SubmitCompanionApplication:output_type -> user.SubmitCompanionApplicationResponse
+	93,  // 137: user.User.GetMyCompanionApplication:output_type -> user.GetMyCompanionApplicationResponse
+	95,  // 138: user.User.ListCompanionApplications:output_type -> user.ListCompanionApplicationsResponse
+	97,  // 139: user.User.ReviewCompanionApplication:output_type -> user.ReviewCompanionApplicationResponse
+	100, // 140: user.User.GetCompanionTierHistory:output_type -> user.GetCompanionTierHistoryResponse
+	102, // 141: user.User.SetCompanionTierOverride:output_type -> user.SetCompanionTierOverrideResponse
+	105, // 142: user.User.GetCompanionRatingRanking:output_type -> user.GetCompanionRatingRankingResponse
+	107, // 143: user.User.GetCompanionOrdersRanking:output_type -> user.GetCompanionOrdersRankingResponse
+	69,  // 144: user.User.ListGameSkills:output_type -> user.ListGameSkillsResponse
+	71,  // 145: user.User.CreateGameSkill:output_type -> user.CreateGameSkillResponse
+	73,  // 146: user.User.UpdateGameSkill:output_type -> user.UpdateGameSkillResponse
+	75,  // 147: user.User.DeleteGameSkill:output_type -> user.DeleteGameSkillResponse
+	109, // 148: user.User.FollowUser:output_type -> user.FollowUserResponse
+	111, // 149: user.User.UnfollowUser:output_type -> user.UnfollowUserResponse
+	118, // 150: user.User.GetMyFollowingList:output_type -> user.GetMyFollowingListResponse
+	119, // 151: user.User.GetMyFollowersList:output_type -> user.GetMyFollowersListResponse
+	120, // 152: user.User.GetMutualFollowList:output_type -> user.GetMutualFollowListResponse
+	116, // 153: user.User.CheckFollowStatus:output_type -> user.CheckFollowStatusResponse
+	125, // 154: user.User.GetFeed:output_type -> user.GetFeedResponse
+	123, // 155: user.User.PublishCompanionPost:output_type -> user.PublishCompanionPostResponse
+	127, // 156: user.User.BlockUser:output_type -> user.BlockUserResponse
+	129, // 157: user.User.UnblockUser:output_type -> user.UnblockUserResponse
+	132, // 158: user.User.ListBlocked:output_type -> user.ListBlockedResponse
+	134, // 159: user.User.FilterBlockedUsers:output_type -> user.FilterBlockedUsersResponse
+	101, // [101:160] is the sub-list for method output_type
+	42,  // [42:101] is the sub-list for method input_type
+	42,  // [42:42] is the sub-list for extension type_name
+	42,  // [42:42] is the sub-list for extension extendee
+	0,   // [0:42] is the sub-list for field type_name
 }
 
 func init() { file_user_proto_init() }
@@ -4582,7 +9969,7 @@ func file_user_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_user_proto_rawDesc), len(file_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   67,
+			NumMessages:   135,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -19,36 +19,65 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	User_Register_FullMethodName                  = "/user.User/Register"
-	User_Login_FullMethodName                     = "/user.User/Login"
-	User_GetUser_FullMethodName                   = "/user.User/GetUser"
-	User_UpdateUser_FullMethodName                = "/user.User/UpdateUser"
-	User_LoginByCode_FullMethodName               = "/user.User/LoginByCode"
-	User_ForgetPassword_FullMethodName            = "/user.User/ForgetPassword"
-	User_ChangePhone_FullMethodName               = "/user.User/ChangePhone"
-	User_ChangePassword_FullMethodName            = "/user.User/ChangePassword"
-	User_GetWallet_FullMethodName                 = "/user.User/GetWallet"
-	User_Recharge_FullMethodName                  = "/user.User/Recharge"
-	User_Consume_FullMethodName                   = "/user.User/Consume"
-	User_CreateRechargeOrder_FullMethodName       = "/user.User/CreateRechargeOrder"
-	User_UpdateRechargeOrderStatus_FullMethodName = "/user.User/UpdateRechargeOrderStatus"
-	User_RechargeList_FullMethodName              = "/user.User/RechargeList"
-	User_GetCompanionProfile_FullMethodName       = "/user.User/GetCompanionProfile"
-	User_UpdateCompanionProfile_FullMethodName    = "/user.User/UpdateCompanionProfile"
-	User_UpdateCompanionStats_FullMethodName      = "/user.User/UpdateCompanionStats"
-	User_GetCompanionList_FullMethodName          = "/user.User/GetCompanionList"
-	User_GetCompanionRatingRanking_FullMethodName = "/user.User/GetCompanionRatingRanking"
-	User_GetCompanionOrdersRanking_FullMethodName = "/user.User/GetCompanionOrdersRanking"
-	User_ListGameSkills_FullMethodName            = "/user.User/ListGameSkills"
-	User_CreateGameSkill_FullMethodName           = "/user.User/CreateGameSkill"
-	User_UpdateGameSkill_FullMethodName           = "/user.User/UpdateGameSkill"
-	User_DeleteGameSkill_FullMethodName           = "/user.User/DeleteGameSkill"
-	User_FollowUser_FullMethodName                = "/user.User/FollowUser"
-	User_UnfollowUser_FullMethodName              = "/user.User/UnfollowUser"
-	User_GetMyFollowingList_FullMethodName        = "/user.User/GetMyFollowingList"
-	User_GetMyFollowersList_FullMethodName        = "/user.User/GetMyFollowersList"
-	User_GetMutualFollowList_FullMethodName       = "/user.User/GetMutualFollowList"
-	User_CheckFollowStatus_FullMethodName         = "/user.User/CheckFollowStatus"
+	User_Register_FullMethodName                   = "/user.User/Register"
+	User_Login_FullMethodName                      = "/user.User/Login"
+	User_GetUser_FullMethodName                    = "/user.User/GetUser"
+	User_UpdateUser_FullMethodName                 = "/user.User/UpdateUser"
+	User_LoginByCode_FullMethodName                = "/user.User/LoginByCode"
+	User_UnlockLogin_FullMethodName                = "/user.User/UnlockLogin"
+	User_RecordLoginEvent_FullMethodName           = "/user.User/RecordLoginEvent"
+	User_ListLoginEvents_FullMethodName            = "/user.User/ListLoginEvents"
+	User_SetUserStatus_FullMethodName              = "/user.User/SetUserStatus"
+	User_FilterBannedUsers_FullMethodName          = "/user.User/FilterBannedUsers"
+	User_ForgetPassword_FullMethodName             = "/user.User/ForgetPassword"
+	User_ChangePhone_FullMethodName                = "/user.User/ChangePhone"
+	User_ChangePassword_FullMethodName             = "/user.User/ChangePassword"
+	User_BindEmail_FullMethodName                  = "/user.User/BindEmail"
+	User_GetWallet_FullMethodName                  = "/user.User/GetWallet"
+	User_Recharge_FullMethodName                   = "/user.User/Recharge"
+	User_Consume_FullMethodName                    = "/user.User/Consume"
+	User_CreateRechargeOrder_FullMethodName        = "/user.User/CreateRechargeOrder"
+	User_UpdateRechargeOrderStatus_FullMethodName  = "/user.User/UpdateRechargeOrderStatus"
+	User_RechargeList_FullMethodName               = "/user.User/RechargeList"
+	User_Transfer_FullMethodName                   = "/user.User/Transfer"
+	User_SendGift_FullMethodName                   = "/user.User/SendGift"
+	User_ListGifts_FullMethodName                  = "/user.User/ListGifts"
+	User_CreateGift_FullMethodName                 = "/user.User/CreateGift"
+	User_UpdateGift_FullMethodName                 = "/user.User/UpdateGift"
+	User_ListVipPlans_FullMethodName               = "/user.User/ListVipPlans"
+	User_SubscribeVip_FullMethodName               = "/user.User/SubscribeVip"
+	User_SetVipAutoRenew_FullMethodName            = "/user.User/SetVipAutoRenew"
+	User_GetVipEntitlements_FullMethodName         = "/user.User/GetVipEntitlements"
+	User_GetCompanionProfile_FullMethodName        = "/user.User/GetCompanionProfile"
+	User_UpdateCompanionProfile_FullMethodName     = "/user.User/UpdateCompanionProfile"
+	User_UpdateCompanionStats_FullMethodName       = "/user.User/UpdateCompanionStats"
+	User_GetCompanionList_FullMethodName           = "/user.User/GetCompanionList"
+	User_CompanionHeartbeat_FullMethodName         = "/user.User/CompanionHeartbeat"
+	User_GetCompanionPresence_FullMethodName       = "/user.User/GetCompanionPresence"
+	User_SubmitCompanionApplication_FullMethodName = "/user.User/SubmitCompanionApplication"
+	User_GetMyCompanionApplication_FullMethodName  = "/user.User/GetMyCompanionApplication"
+	User_ListCompanionApplications_FullMethodName  = "/user.User/ListCompanionApplications"
+	User_ReviewCompanionApplication_FullMethodName = "/user.User/ReviewCompanionApplication"
+	User_GetCompanionTierHistory_FullMethodName    = "/user.User/GetCompanionTierHistory"
+	User_SetCompanionTierOverride_FullMethodName   = "/user.User/SetCompanionTierOverride"
+	User_GetCompanionRatingRanking_FullMethodName  = "/user.User/GetCompanionRatingRanking"
+	User_GetCompanionOrdersRanking_FullMethodName  = "/user.User/GetCompanionOrdersRanking"
+	User_ListGameSkills_FullMethodName             = "/user.User/ListGameSkills"
+	User_CreateGameSkill_FullMethodName            = "/user.User/CreateGameSkill"
+	User_UpdateGameSkill_FullMethodName            = "/user.User/UpdateGameSkill"
+	User_DeleteGameSkill_FullMethodName            = "/user.User/DeleteGameSkill"
+	User_FollowUser_FullMethodName                 = "/user.User/FollowUser"
+	User_UnfollowUser_FullMethodName               = "/user.User/UnfollowUser"
+	User_GetMyFollowingList_FullMethodName         = "/user.User/GetMyFollowingList"
+	User_GetMyFollowersList_FullMethodName         = "/user.User/GetMyFollowersList"
+	User_GetMutualFollowList_FullMethodName        = "/user.User/GetMutualFollowList"
+	User_CheckFollowStatus_FullMethodName          = "/user.User/CheckFollowStatus"
+	User_GetFeed_FullMethodName                    = "/user.User/GetFeed"
+	User_PublishCompanionPost_FullMethodName       = "/user.User/PublishCompanionPost"
+	User_BlockUser_FullMethodName                  = "/user.User/BlockUser"
+	User_UnblockUser_FullMethodName                = "/user.User/UnblockUser"
+	User_ListBlocked_FullMethodName                = "/user.User/ListBlocked"
+	User_FilterBlockedUsers_FullMethodName         = "/user.User/FilterBlockedUsers"
 )
 
 // UserClient is the client API for User service.
@@ -60,9 +89,15 @@ type UserClient interface {
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
 	LoginByCode(ctx context.Context, in *LoginByCodeRequest, opts ...grpc.CallOption) (*LoginByCodeResponse, error)
+	UnlockLogin(ctx context.Context, in *UnlockLoginRequest, opts ...grpc.CallOption) (*UnlockLoginResponse, error)
+	RecordLoginEvent(ctx context.Context, in *RecordLoginEventRequest, opts ...grpc.CallOption) (*RecordLoginEventResponse, error)
+	ListLoginEvents(ctx context.Context, in *ListLoginEventsRequest, opts ...grpc.CallOption) (*ListLoginEventsResponse, error)
+	SetUserStatus(ctx context.Context, in *SetUserStatusRequest, opts ...grpc.CallOption) (*SetUserStatusResponse, error)
+	FilterBannedUsers(ctx context.Context, in *FilterBannedUsersRequest, opts ...grpc.CallOption) (*FilterBannedUsersResponse, error)
 	ForgetPassword(ctx context.Context, in *ForgetPasswordRequest, opts ...grpc.CallOption) (*ForgetPasswordResponse, error)
 	ChangePhone(ctx context.Context, in *ChangePhoneRequest, opts ...grpc.CallOption) (*ChangePhoneResponse, error)
 	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	BindEmail(ctx context.Context, in *BindEmailRequest, opts ...grpc.CallOption) (*BindEmailResponse, error)
 	// 帅币钱包相关接口
 	GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*GetWalletResponse, error)
 	Recharge(ctx context.Context, in *RechargeRequest, opts ...grpc.CallOption) (*RechargeResponse, error)
@@ -70,11 +105,32 @@ type UserClient interface {
 	CreateRechargeOrder(ctx context.Context, in *CreateRechargeOrderRequest, opts ...grpc.CallOption) (*CreateRechargeOrderResponse, error)
 	UpdateRechargeOrderStatus(ctx context.Context, in *UpdateRechargeOrderStatusRequest, opts ...grpc.CallOption) (*UpdateRechargeOrderStatusResponse, error)
 	RechargeList(ctx context.Context, in *RechargeListRequest, opts ...grpc.CallOption) (*RechargeListResponse, error)
+	// 打赏/礼物相关接口
+	Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error)
+	SendGift(ctx context.Context, in *SendGiftRequest, opts ...grpc.CallOption) (*SendGiftResponse, error)
+	ListGifts(ctx context.Context, in *ListGiftsRequest, opts ...grpc.CallOption) (*ListGiftsResponse, error)
+	CreateGift(ctx context.Context, in *CreateGiftRequest, opts ...grpc.CallOption) (*CreateGiftResponse, error)
+	UpdateGift(ctx context.Context, in *UpdateGiftRequest, opts ...grpc.CallOption) (*UpdateGiftResponse, error)
+	// 会员（VIP）相关接口
+	ListVipPlans(ctx context.Context, in *ListVipPlansRequest, opts ...grpc.CallOption) (*ListVipPlansResponse, error)
+	SubscribeVip(ctx context.Context, in *SubscribeVipRequest, opts ...grpc.CallOption) (*SubscribeVipResponse, error)
+	SetVipAutoRenew(ctx context.Context, in *SetVipAutoRenewRequest, opts ...grpc.CallOption) (*SetVipAutoRenewResponse, error)
+	GetVipEntitlements(ctx context.Context, in *GetVipEntitlementsRequest, opts ...grpc.CallOption) (*GetVipEntitlementsResponse, error)
 	// 陪玩信息相关接口
 	GetCompanionProfile(ctx context.Context, in *GetCompanionProfileRequest, opts ...grpc.CallOption) (*GetCompanionProfileResponse, error)
 	UpdateCompanionProfile(ctx context.Context, in *UpdateCompanionProfileRequest, opts ...grpc.CallOption) (*UpdateCompanionProfileResponse, error)
 	UpdateCompanionStats(ctx context.Context, in *UpdateCompanionStatsRequest, opts ...grpc.CallOption) (*UpdateCompanionStatsResponse, error)
 	GetCompanionList(ctx context.Context, in *GetCompanionListRequest, opts ...grpc.CallOption) (*GetCompanionListResponse, error)
+	CompanionHeartbeat(ctx context.Context, in *CompanionHeartbeatRequest, opts ...grpc.CallOption) (*CompanionHeartbeatResponse, error)
+	GetCompanionPresence(ctx context.Context, in *GetCompanionPresenceRequest, opts ...grpc.CallOption) (*GetCompanionPresenceResponse, error)
+	// 陪玩入驻申请与审核
+	SubmitCompanionApplication(ctx context.Context, in *SubmitCompanionApplicationRequest, opts ...grpc.CallOption) (*SubmitCompanionApplicationResponse, error)
+	GetMyCompanionApplication(ctx context.Context, in *GetMyCompanionApplicationRequest, opts ...grpc.CallOption) (*GetMyCompanionApplicationResponse, error)
+	ListCompanionApplications(ctx context.Context, in *ListCompanionApplicationsRequest, opts ...grpc.CallOption) (*ListCompanionApplicationsResponse, error)
+	ReviewCompanionApplication(ctx context.Context, in *ReviewCompanionApplicationRequest, opts ...grpc.CallOption) (*ReviewCompanionApplicationResponse, error)
+	// 陪玩等级相关接口
+	GetCompanionTierHistory(ctx context.Context, in *GetCompanionTierHistoryRequest, opts ...grpc.CallOption) (*GetCompanionTierHistoryResponse, error)
+	SetCompanionTierOverride(ctx context.Context, in *SetCompanionTierOverrideRequest, opts ...grpc.CallOption) (*SetCompanionTierOverrideResponse, error)
 	// 陪玩排名相关接口
 	GetCompanionRatingRanking(ctx context.Context, in *GetCompanionRatingRankingRequest, opts ...grpc.CallOption) (*GetCompanionRatingRankingResponse, error)
 	GetCompanionOrdersRanking(ctx context.Context, in *GetCompanionOrdersRankingRequest, opts ...grpc.CallOption) (*GetCompanionOrdersRankingResponse, error)
@@ -90,6 +146,13 @@ type UserClient interface {
 	GetMyFollowersList(ctx context.Context, in *GetMyFollowersListRequest, opts ...grpc.CallOption) (*GetMyFollowersListResponse, error)
 	GetMutualFollowList(ctx context.Context, in *GetMutualFollowListRequest, opts ...grpc.CallOption) (*GetMutualFollowListResponse, error)
 	CheckFollowStatus(ctx context.Context, in *CheckFollowStatusRequest, opts ...grpc.CallOption) (*CheckFollowStatusResponse, error)
+	GetFeed(ctx context.Context, in *GetFeedRequest, opts ...grpc.CallOption) (*GetFeedResponse, error)
+	PublishCompanionPost(ctx context.Context, in *PublishCompanionPostRequest, opts ...grpc.CallOption) (*PublishCompanionPostResponse, error)
+	// 拉黑相关接口
+	BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error)
+	UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error)
+	ListBlocked(ctx context.Context, in *ListBlockedRequest, opts ...grpc.CallOption) (*ListBlockedResponse, error)
+	FilterBlockedUsers(ctx context.Context, in *FilterBlockedUsersRequest, opts ...grpc.CallOption) (*FilterBlockedUsersResponse, error)
 }
 
 type userClient struct {
@@ -150,6 +213,56 @@ func (c *userClient) LoginByCode(ctx context.Context, in *LoginByCodeRequest, op
 	return out, nil
 }
 
+func (c *userClient) UnlockLogin(ctx context.Context, in *UnlockLoginRequest, opts ...grpc.CallOption) (*UnlockLoginResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnlockLoginResponse)
+	err := c.cc.Invoke(ctx, User_UnlockLogin_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) RecordLoginEvent(ctx context.Context, in *RecordLoginEventRequest, opts ...grpc.CallOption) (*RecordLoginEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RecordLoginEventResponse)
+	err := c.cc.Invoke(ctx, User_RecordLoginEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ListLoginEvents(ctx context.Context, in *ListLoginEventsRequest, opts ...grpc.CallOption) (*ListLoginEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLoginEventsResponse)
+	err := c.cc.Invoke(ctx, User_ListLoginEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SetUserStatus(ctx context.Context, in *SetUserStatusRequest, opts ...grpc.CallOption) (*SetUserStatusResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetUserStatusResponse)
+	err := c.cc.Invoke(ctx, User_SetUserStatus_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) FilterBannedUsers(ctx context.Context, in *FilterBannedUsersRequest, opts ...grpc.CallOption) (*FilterBannedUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FilterBannedUsersResponse)
+	err := c.cc.Invoke(ctx, User_FilterBannedUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userClient) ForgetPassword(ctx context.Context, in *ForgetPasswordRequest, opts ...grpc.CallOption) (*ForgetPasswordResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ForgetPasswordResponse)
@@ -180,6 +293,16 @@ func (c *userClient) ChangePassword(ctx context.Context, in *ChangePasswordReque
 	return out, nil
 }
 
+func (c *userClient) BindEmail(ctx context.Context, in *BindEmailRequest, opts ...grpc.CallOption) (*BindEmailResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BindEmailResponse)
+	err := c.cc.Invoke(ctx, User_BindEmail_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userClient) GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*GetWalletResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetWalletResponse)
@@ -240,6 +363,96 @@ func (c *userClient) RechargeList(ctx context.Context, in *RechargeListRequest,
 	return out, nil
 }
 
+func (c *userClient) Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TransferResponse)
+	err := c.cc.Invoke(ctx, User_Transfer_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SendGift(ctx context.Context, in *SendGiftRequest, opts ...grpc.CallOption) (*SendGiftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SendGiftResponse)
+	err := c.cc.Invoke(ctx, User_SendGift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ListGifts(ctx context.Context, in *ListGiftsRequest, opts ...grpc.CallOption) (*ListGiftsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListGiftsResponse)
+	err := c.cc.Invoke(ctx, User_ListGifts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) CreateGift(ctx context.Context, in *CreateGiftRequest, opts ...grpc.CallOption) (*CreateGiftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateGiftResponse)
+	err := c.cc.Invoke(ctx, User_CreateGift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UpdateGift(ctx context.Context, in *UpdateGiftRequest, opts ...grpc.CallOption) (*UpdateGiftResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateGiftResponse)
+	err := c.cc.Invoke(ctx, User_UpdateGift_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ListVipPlans(ctx context.Context, in *ListVipPlansRequest, opts ...grpc.CallOption) (*ListVipPlansResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListVipPlansResponse)
+	err := c.cc.Invoke(ctx, User_ListVipPlans_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SubscribeVip(ctx context.Context, in *SubscribeVipRequest, opts ...grpc.CallOption) (*SubscribeVipResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubscribeVipResponse)
+	err := c.cc.Invoke(ctx, User_SubscribeVip_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SetVipAutoRenew(ctx context.Context, in *SetVipAutoRenewRequest, opts ...grpc.CallOption) (*SetVipAutoRenewResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetVipAutoRenewResponse)
+	err := c.cc.Invoke(ctx, User_SetVipAutoRenew_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetVipEntitlements(ctx context.Context, in *GetVipEntitlementsRequest, opts ...grpc.CallOption) (*GetVipEntitlementsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetVipEntitlementsResponse)
+	err := c.cc.Invoke(ctx, User_GetVipEntitlements_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userClient) GetCompanionProfile(ctx context.Context, in *GetCompanionProfileRequest, opts ...grpc.CallOption) (*GetCompanionProfileResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCompanionProfileResponse)
@@ -280,6 +493,86 @@ func (c *userClient) GetCompanionList(ctx context.Context, in *GetCompanionListR
 	return out, nil
 }
 
+func (c *userClient) CompanionHeartbeat(ctx context.Context, in *CompanionHeartbeatRequest, opts ...grpc.CallOption) (*CompanionHeartbeatResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompanionHeartbeatResponse)
+	err := c.cc.Invoke(ctx, User_CompanionHeartbeat_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetCompanionPresence(ctx context.Context, in *GetCompanionPresenceRequest, opts ...grpc.CallOption) (*GetCompanionPresenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCompanionPresenceResponse)
+	err := c.cc.Invoke(ctx, User_GetCompanionPresence_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SubmitCompanionApplication(ctx context.Context, in *SubmitCompanionApplicationRequest, opts ...grpc.CallOption) (*SubmitCompanionApplicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SubmitCompanionApplicationResponse)
+	err := c.cc.Invoke(ctx, User_SubmitCompanionApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetMyCompanionApplication(ctx context.Context, in *GetMyCompanionApplicationRequest, opts ...grpc.CallOption) (*GetMyCompanionApplicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMyCompanionApplicationResponse)
+	err := c.cc.Invoke(ctx, User_GetMyCompanionApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ListCompanionApplications(ctx context.Context, in *ListCompanionApplicationsRequest, opts ...grpc.CallOption) (*ListCompanionApplicationsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListCompanionApplicationsResponse)
+	err := c.cc.Invoke(ctx, User_ListCompanionApplications_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ReviewCompanionApplication(ctx context.Context, in *ReviewCompanionApplicationRequest, opts ...grpc.CallOption) (*ReviewCompanionApplicationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReviewCompanionApplicationResponse)
+	err := c.cc.Invoke(ctx, User_ReviewCompanionApplication_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) GetCompanionTierHistory(ctx context.Context, in *GetCompanionTierHistoryRequest, opts ...grpc.CallOption) (*GetCompanionTierHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCompanionTierHistoryResponse)
+	err := c.cc.Invoke(ctx, User_GetCompanionTierHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) SetCompanionTierOverride(ctx context.Context, in *SetCompanionTierOverrideRequest, opts ...grpc.CallOption) (*SetCompanionTierOverrideResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SetCompanionTierOverrideResponse)
+	err := c.cc.Invoke(ctx, User_SetCompanionTierOverride_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userClient) GetCompanionRatingRanking(ctx context.Context, in *GetCompanionRatingRankingRequest, opts ...grpc.CallOption) (*GetCompanionRatingRankingResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetCompanionRatingRankingResponse)
@@ -400,6 +693,66 @@ func (c *userClient) CheckFollowStatus(ctx context.Context, in *CheckFollowStatu
 	return out, nil
 }
 
+func (c *userClient) GetFeed(ctx context.Context, in *GetFeedRequest, opts ...grpc.CallOption) (*GetFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetFeedResponse)
+	err := c.cc.Invoke(ctx, User_GetFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) PublishCompanionPost(ctx context.Context, in *PublishCompanionPostRequest, opts ...grpc.CallOption) (*PublishCompanionPostResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(PublishCompanionPostResponse)
+	err := c.cc.Invoke(ctx, User_PublishCompanionPost_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BlockUserResponse)
+	err := c.cc.Invoke(ctx, User_BlockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UnblockUserResponse)
+	err := c.cc.Invoke(ctx, User_UnblockUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) ListBlocked(ctx context.Context, in *ListBlockedRequest, opts ...grpc.CallOption) (*ListBlockedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListBlockedResponse)
+	err := c.cc.Invoke(ctx, User_ListBlocked_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userClient) FilterBlockedUsers(ctx context.Context, in *FilterBlockedUsersRequest, opts ...grpc.CallOption) (*FilterBlockedUsersResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(FilterBlockedUsersResponse)
+	err := c.cc.Invoke(ctx, User_FilterBlockedUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserServer is the server API for User service.
 // All implementations must embed UnimplementedUserServer
 // for forward compatibility.
@@ -409,9 +762,15 @@ type UserServer interface {
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
 	LoginByCode(context.Context, *LoginByCodeRequest) (*LoginByCodeResponse, error)
+	UnlockLogin(context.Context, *UnlockLoginRequest) (*UnlockLoginResponse, error)
+	RecordLoginEvent(context.Context, *RecordLoginEventRequest) (*RecordLoginEventResponse, error)
+	ListLoginEvents(context.Context, *ListLoginEventsRequest) (*ListLoginEventsResponse, error)
+	SetUserStatus(context.Context, *SetUserStatusRequest) (*SetUserStatusResponse, error)
+	FilterBannedUsers(context.Context, *FilterBannedUsersRequest) (*FilterBannedUsersResponse, error)
 	ForgetPassword(context.Context, *ForgetPasswordRequest) (*ForgetPasswordResponse, error)
 	ChangePhone(context.Context, *ChangePhoneRequest) (*ChangePhoneResponse, error)
 	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	BindEmail(context.Context, *BindEmailRequest) (*BindEmailResponse, error)
 	// 帅币钱包相关接口
 	GetWallet(context.Context, *GetWalletRequest) (*GetWalletResponse, error)
 	Recharge(context.Context, *RechargeRequest) (*RechargeResponse, error)
@@ -419,11 +778,32 @@ type UserServer interface {
 	CreateRechargeOrder(context.Context, *CreateRechargeOrderRequest) (*CreateRechargeOrderResponse, error)
 	UpdateRechargeOrderStatus(context.Context, *UpdateRechargeOrderStatusRequest) (*UpdateRechargeOrderStatusResponse, error)
 	RechargeList(context.Context, *RechargeListRequest) (*RechargeListResponse, error)
+	// 打赏/礼物相关接口
+	Transfer(context.Context, *TransferRequest) (*TransferResponse, error)
+	SendGift(context.Context, *SendGiftRequest) (*SendGiftResponse, error)
+	ListGifts(context.Context, *ListGiftsRequest) (*ListGiftsResponse, error)
+	CreateGift(context.Context, *CreateGiftRequest) (*CreateGiftResponse, error)
+	UpdateGift(context.Context, *UpdateGiftRequest) (*UpdateGiftResponse, error)
+	// 会员（VIP）相关接口
+	ListVipPlans(context.Context, *ListVipPlansRequest) (*ListVipPlansResponse, error)
+	SubscribeVip(context.Context, *SubscribeVipRequest) (*SubscribeVipResponse, error)
+	SetVipAutoRenew(context.Context, *SetVipAutoRenewRequest) (*SetVipAutoRenewResponse, error)
+	GetVipEntitlements(context.Context, *GetVipEntitlementsRequest) (*GetVipEntitlementsResponse, error)
 	// 陪玩信息相关接口
 	GetCompanionProfile(context.Context, *GetCompanionProfileRequest) (*GetCompanionProfileResponse, error)
 	UpdateCompanionProfile(context.Context, *UpdateCompanionProfileRequest) (*UpdateCompanionProfileResponse, error)
 	UpdateCompanionStats(context.Context, *UpdateCompanionStatsRequest) (*UpdateCompanionStatsResponse, error)
 	GetCompanionList(context.Context, *GetCompanionListRequest) (*GetCompanionListResponse, error)
+	CompanionHeartbeat(context.Context, *CompanionHeartbeatRequest) (*CompanionHeartbeatResponse, error)
+	GetCompanionPresence(context.Context, *GetCompanionPresenceRequest) (*GetCompanionPresenceResponse, error)
+	// 陪玩入驻申请与审核
+	SubmitCompanionApplication(context.Context, *SubmitCompanionApplicationRequest) (*SubmitCompanionApplicationResponse, error)
+	GetMyCompanionApplication(context.Context, *GetMyCompanionApplicationRequest) (*GetMyCompanionApplicationResponse, error)
+	ListCompanionApplications(context.Context, *ListCompanionApplicationsRequest) (*ListCompanionApplicationsResponse, error)
+	ReviewCompanionApplication(context.Context, *ReviewCompanionApplicationRequest) (*ReviewCompanionApplicationResponse, error)
+	// 陪玩等级相关接口
+	GetCompanionTierHistory(context.Context, *GetCompanionTierHistoryRequest) (*GetCompanionTierHistoryResponse, error)
+	SetCompanionTierOverride(context.Context, *SetCompanionTierOverrideRequest) (*SetCompanionTierOverrideResponse, error)
 	// 陪玩排名相关接口
 	GetCompanionRatingRanking(context.Context, *GetCompanionRatingRankingRequest) (*GetCompanionRatingRankingResponse, error)
 	GetCompanionOrdersRanking(context.Context, *GetCompanionOrdersRankingRequest) (*GetCompanionOrdersRankingResponse, error)
@@ -439,6 +819,13 @@ type UserServer interface {
 	GetMyFollowersList(context.Context, *GetMyFollowersListRequest) (*GetMyFollowersListResponse, error)
 	GetMutualFollowList(context.Context, *GetMutualFollowListRequest) (*GetMutualFollowListResponse, error)
 	CheckFollowStatus(context.Context, *CheckFollowStatusRequest) (*CheckFollowStatusResponse, error)
+	GetFeed(context.Context, *GetFeedRequest) (*GetFeedResponse, error)
+	PublishCompanionPost(context.Context, *PublishCompanionPostRequest) (*PublishCompanionPostResponse, error)
+	// 拉黑相关接口
+	BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error)
+	UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error)
+	ListBlocked(context.Context, *ListBlockedRequest) (*ListBlockedResponse, error)
+	FilterBlockedUsers(context.Context, *FilterBlockedUsersRequest) (*FilterBlockedUsersResponse, error)
 	mustEmbedUnimplementedUserServer()
 }
 
@@ -464,6 +851,21 @@ func (UnimplementedUserServer) UpdateUser(context.Context, *UpdateUserRequest) (
 func (UnimplementedUserServer) LoginByCode(context.Context, *LoginByCodeRequest) (*LoginByCodeResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method LoginByCode not implemented")
 }
+func (UnimplementedUserServer) UnlockLogin(context.Context, *UnlockLoginRequest) (*UnlockLoginResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnlockLogin not implemented")
+}
+func (UnimplementedUserServer) RecordLoginEvent(context.Context, *RecordLoginEventRequest) (*RecordLoginEventResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method RecordLoginEvent not implemented")
+}
+func (UnimplementedUserServer) ListLoginEvents(context.Context, *ListLoginEventsRequest) (*ListLoginEventsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLoginEvents not implemented")
+}
+func (UnimplementedUserServer) SetUserStatus(context.Context, *SetUserStatusRequest) (*SetUserStatusResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetUserStatus not implemented")
+}
+func (UnimplementedUserServer) FilterBannedUsers(context.Context, *FilterBannedUsersRequest) (*FilterBannedUsersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FilterBannedUsers not implemented")
+}
 func (UnimplementedUserServer) ForgetPassword(context.Context, *ForgetPasswordRequest) (*ForgetPasswordResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ForgetPassword not implemented")
 }
@@ -473,6 +875,9 @@ func (UnimplementedUserServer) ChangePhone(context.Context, *ChangePhoneRequest)
 func (UnimplementedUserServer) ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method ChangePassword not implemented")
 }
+func (UnimplementedUserServer) BindEmail(context.Context, *BindEmailRequest) (*BindEmailResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BindEmail not implemented")
+}
 func (UnimplementedUserServer) GetWallet(context.Context, *GetWalletRequest) (*GetWalletResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetWallet not implemented")
 }
@@ -491,6 +896,33 @@ func (UnimplementedUserServer) UpdateRechargeOrderStatus(context.Context, *Updat
 func (UnimplementedUserServer) RechargeList(context.Context, *RechargeListRequest) (*RechargeListResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method RechargeList not implemented")
 }
+func (UnimplementedUserServer) Transfer(context.Context, *TransferRequest) (*TransferResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method Transfer not implemented")
+}
+func (UnimplementedUserServer) SendGift(context.Context, *SendGiftRequest) (*SendGiftResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SendGift not implemented")
+}
+func (UnimplementedUserServer) ListGifts(context.Context, *ListGiftsRequest) (*ListGiftsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListGifts not implemented")
+}
+func (UnimplementedUserServer) CreateGift(context.Context, *CreateGiftRequest) (*CreateGiftResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateGift not implemented")
+}
+func (UnimplementedUserServer) UpdateGift(context.Context, *UpdateGiftRequest) (*UpdateGiftResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateGift not implemented")
+}
+func (UnimplementedUserServer) ListVipPlans(context.Context, *ListVipPlansRequest) (*ListVipPlansResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListVipPlans not implemented")
+}
+func (UnimplementedUserServer) SubscribeVip(context.Context, *SubscribeVipRequest) (*SubscribeVipResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubscribeVip not implemented")
+}
+func (UnimplementedUserServer) SetVipAutoRenew(context.Context, *SetVipAutoRenewRequest) (*SetVipAutoRenewResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetVipAutoRenew not implemented")
+}
+func (UnimplementedUserServer) GetVipEntitlements(context.Context, *GetVipEntitlementsRequest) (*GetVipEntitlementsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetVipEntitlements not implemented")
+}
 func (UnimplementedUserServer) GetCompanionProfile(context.Context, *GetCompanionProfileRequest) (*GetCompanionProfileResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetCompanionProfile not implemented")
 }
@@ -503,6 +935,30 @@ func (UnimplementedUserServer) UpdateCompanionStats(context.Context, *UpdateComp
 func (UnimplementedUserServer) GetCompanionList(context.Context, *GetCompanionListRequest) (*GetCompanionListResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetCompanionList not implemented")
 }
+func (UnimplementedUserServer) CompanionHeartbeat(context.Context, *CompanionHeartbeatRequest) (*CompanionHeartbeatResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method CompanionHeartbeat not implemented")
+}
+func (UnimplementedUserServer) GetCompanionPresence(context.Context, *GetCompanionPresenceRequest) (*GetCompanionPresenceResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCompanionPresence not implemented")
+}
+func (UnimplementedUserServer) SubmitCompanionApplication(context.Context, *SubmitCompanionApplicationRequest) (*SubmitCompanionApplicationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SubmitCompanionApplication not implemented")
+}
+func (UnimplementedUserServer) GetMyCompanionApplication(context.Context, *GetMyCompanionApplicationRequest) (*GetMyCompanionApplicationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetMyCompanionApplication not implemented")
+}
+func (UnimplementedUserServer) ListCompanionApplications(context.Context, *ListCompanionApplicationsRequest) (*ListCompanionApplicationsResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListCompanionApplications not implemented")
+}
+func (UnimplementedUserServer) ReviewCompanionApplication(context.Context, *ReviewCompanionApplicationRequest) (*ReviewCompanionApplicationResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ReviewCompanionApplication not implemented")
+}
+func (UnimplementedUserServer) GetCompanionTierHistory(context.Context, *GetCompanionTierHistoryRequest) (*GetCompanionTierHistoryResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetCompanionTierHistory not implemented")
+}
+func (UnimplementedUserServer) SetCompanionTierOverride(context.Context, *SetCompanionTierOverrideRequest) (*SetCompanionTierOverrideResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method SetCompanionTierOverride not implemented")
+}
 func (UnimplementedUserServer) GetCompanionRatingRanking(context.Context, *GetCompanionRatingRankingRequest) (*GetCompanionRatingRankingResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method GetCompanionRatingRanking not implemented")
 }
@@ -539,6 +995,24 @@ func (UnimplementedUserServer) GetMutualFollowList(context.Context, *GetMutualFo
 func (UnimplementedUserServer) CheckFollowStatus(context.Context, *CheckFollowStatusRequest) (*CheckFollowStatusResponse, error) {
 	return nil, status.Error(codes.Unimplemented, "method CheckFollowStatus not implemented")
 }
+func (UnimplementedUserServer) GetFeed(context.Context, *GetFeedRequest) (*GetFeedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetFeed not implemented")
+}
+func (UnimplementedUserServer) PublishCompanionPost(context.Context, *PublishCompanionPostRequest) (*PublishCompanionPostResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method PublishCompanionPost not implemented")
+}
+func (UnimplementedUserServer) BlockUser(context.Context, *BlockUserRequest) (*BlockUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method BlockUser not implemented")
+}
+func (UnimplementedUserServer) UnblockUser(context.Context, *UnblockUserRequest) (*UnblockUserResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method UnblockUser not implemented")
+}
+func (UnimplementedUserServer) ListBlocked(context.Context, *ListBlockedRequest) (*ListBlockedResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListBlocked not implemented")
+}
+func (UnimplementedUserServer) FilterBlockedUsers(context.Context, *FilterBlockedUsersRequest) (*FilterBlockedUsersResponse, error) {
+	return nil, status.Error(codes.Unimplemented, "method FilterBlockedUsers not implemented")
+}
 func (UnimplementedUserServer) mustEmbedUnimplementedUserServer() {}
 func (UnimplementedUserServer) testEmbeddedByValue()              {}
 
@@ -557,329 +1031,743 @@ func RegisterUserServer(s grpc.ServiceRegistrar, srv UserServer) {
 	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
 		t.testEmbeddedByValue()
 	}
-	s.RegisterService(&User_ServiceDesc, srv)
+	s.RegisterService(&User_ServiceDesc, srv)
+}
+
+func _User_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RegisterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Register(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_Register_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Register(ctx, req.(*RegisterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_Login_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetUser(ctx, req.(*GetUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_UpdateUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_LoginByCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginByCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).LoginByCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_LoginByCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).LoginByCode(ctx, req.(*LoginByCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UnlockLogin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockLoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UnlockLogin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_UnlockLogin_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UnlockLogin(ctx, req.(*UnlockLoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RecordLoginEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RecordLoginEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RecordLoginEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RecordLoginEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RecordLoginEvent(ctx, req.(*RecordLoginEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ListLoginEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLoginEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ListLoginEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ListLoginEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ListLoginEvents(ctx, req.(*ListLoginEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SetUserStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetUserStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SetUserStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_SetUserStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SetUserStatus(ctx, req.(*SetUserStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_FilterBannedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterBannedUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).FilterBannedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_FilterBannedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).FilterBannedUsers(ctx, req.(*FilterBannedUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ForgetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ForgetPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ForgetPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ForgetPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ForgetPassword(ctx, req.(*ForgetPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ChangePhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePhoneRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ChangePhone(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ChangePhone_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ChangePhone(ctx, req.(*ChangePhoneRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ChangePassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_BindEmail_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BindEmailRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).BindEmail(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_BindEmail_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).BindEmail(ctx, req.(*BindEmailRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_GetWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWalletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetWallet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetWallet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetWallet(ctx, req.(*GetWalletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Recharge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RechargeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Recharge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_Recharge_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Recharge(ctx, req.(*RechargeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Consume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConsumeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Consume(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_Consume_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Consume(ctx, req.(*ConsumeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_CreateRechargeOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateRechargeOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).CreateRechargeOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_CreateRechargeOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).CreateRechargeOrder(ctx, req.(*CreateRechargeOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UpdateRechargeOrderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateRechargeOrderStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UpdateRechargeOrderStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_UpdateRechargeOrderStatus_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UpdateRechargeOrderStatus(ctx, req.(*UpdateRechargeOrderStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_RechargeList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RechargeListRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).RechargeList(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_RechargeList_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).RechargeList(ctx, req.(*RechargeListRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_Transfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).Transfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_Transfer_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).Transfer(ctx, req.(*TransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_SendGift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SendGiftRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).SendGift(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_SendGift_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).SendGift(ctx, req.(*SendGiftRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ListGifts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGiftsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ListGifts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ListGifts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ListGifts(ctx, req.(*ListGiftsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _User_Register_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RegisterRequest)
+func _User_CreateGift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateGiftRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).Register(ctx, in)
+		return srv.(UserServer).CreateGift(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_Register_FullMethodName,
+		FullMethod: User_CreateGift_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).Register(ctx, req.(*RegisterRequest))
+		return srv.(UserServer).CreateGift(ctx, req.(*CreateGiftRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _User_UpdateGift_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateGiftRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).Login(ctx, in)
+		return srv.(UserServer).UpdateGift(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_Login_FullMethodName,
+		FullMethod: User_UpdateGift_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).Login(ctx, req.(*LoginRequest))
+		return srv.(UserServer).UpdateGift(ctx, req.(*UpdateGiftRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_GetUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUserRequest)
+func _User_ListVipPlans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListVipPlansRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).GetUser(ctx, in)
+		return srv.(UserServer).ListVipPlans(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_GetUser_FullMethodName,
+		FullMethod: User_ListVipPlans_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).GetUser(ctx, req.(*GetUserRequest))
+		return srv.(UserServer).ListVipPlans(ctx, req.(*ListVipPlansRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _User_SubscribeVip_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeVipRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).UpdateUser(ctx, in)
+		return srv.(UserServer).SubscribeVip(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_UpdateUser_FullMethodName,
+		FullMethod: User_SubscribeVip_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(UserServer).SubscribeVip(ctx, req.(*SubscribeVipRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_LoginByCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginByCodeRequest)
+func _User_SetVipAutoRenew_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetVipAutoRenewRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).LoginByCode(ctx, in)
+		return srv.(UserServer).SetVipAutoRenew(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_LoginByCode_FullMethodName,
+		FullMethod: User_SetVipAutoRenew_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).LoginByCode(ctx, req.(*LoginByCodeRequest))
+		return srv.(UserServer).SetVipAutoRenew(ctx, req.(*SetVipAutoRenewRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_ForgetPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ForgetPasswordRequest)
+func _User_GetVipEntitlements_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetVipEntitlementsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).ForgetPassword(ctx, in)
+		return srv.(UserServer).GetVipEntitlements(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_ForgetPassword_FullMethodName,
+		FullMethod: User_GetVipEntitlements_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).ForgetPassword(ctx, req.(*ForgetPasswordRequest))
+		return srv.(UserServer).GetVipEntitlements(ctx, req.(*GetVipEntitlementsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_ChangePhone_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangePhoneRequest)
+func _User_GetCompanionProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanionProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).ChangePhone(ctx, in)
+		return srv.(UserServer).GetCompanionProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_ChangePhone_FullMethodName,
+		FullMethod: User_GetCompanionProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).ChangePhone(ctx, req.(*ChangePhoneRequest))
+		return srv.(UserServer).GetCompanionProfile(ctx, req.(*GetCompanionProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ChangePasswordRequest)
+func _User_UpdateCompanionProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCompanionProfileRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).ChangePassword(ctx, in)
+		return srv.(UserServer).UpdateCompanionProfile(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_ChangePassword_FullMethodName,
+		FullMethod: User_UpdateCompanionProfile_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+		return srv.(UserServer).UpdateCompanionProfile(ctx, req.(*UpdateCompanionProfileRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_GetWallet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetWalletRequest)
+func _User_UpdateCompanionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCompanionStatsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).GetWallet(ctx, in)
+		return srv.(UserServer).UpdateCompanionStats(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_GetWallet_FullMethodName,
+		FullMethod: User_UpdateCompanionStats_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).GetWallet(ctx, req.(*GetWalletRequest))
+		return srv.(UserServer).UpdateCompanionStats(ctx, req.(*UpdateCompanionStatsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_Recharge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RechargeRequest)
+func _User_GetCompanionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanionListRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).Recharge(ctx, in)
+		return srv.(UserServer).GetCompanionList(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_Recharge_FullMethodName,
+		FullMethod: User_GetCompanionList_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).Recharge(ctx, req.(*RechargeRequest))
+		return srv.(UserServer).GetCompanionList(ctx, req.(*GetCompanionListRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_Consume_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConsumeRequest)
+func _User_CompanionHeartbeat_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompanionHeartbeatRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).Consume(ctx, in)
+		return srv.(UserServer).CompanionHeartbeat(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_Consume_FullMethodName,
+		FullMethod: User_CompanionHeartbeat_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).Consume(ctx, req.(*ConsumeRequest))
+		return srv.(UserServer).CompanionHeartbeat(ctx, req.(*CompanionHeartbeatRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_CreateRechargeOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateRechargeOrderRequest)
+func _User_GetCompanionPresence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanionPresenceRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).CreateRechargeOrder(ctx, in)
+		return srv.(UserServer).GetCompanionPresence(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_CreateRechargeOrder_FullMethodName,
+		FullMethod: User_GetCompanionPresence_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).CreateRechargeOrder(ctx, req.(*CreateRechargeOrderRequest))
+		return srv.(UserServer).GetCompanionPresence(ctx, req.(*GetCompanionPresenceRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_UpdateRechargeOrderStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateRechargeOrderStatusRequest)
+func _User_SubmitCompanionApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitCompanionApplicationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).UpdateRechargeOrderStatus(ctx, in)
+		return srv.(UserServer).SubmitCompanionApplication(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_UpdateRechargeOrderStatus_FullMethodName,
+		FullMethod: User_SubmitCompanionApplication_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).UpdateRechargeOrderStatus(ctx, req.(*UpdateRechargeOrderStatusRequest))
+		return srv.(UserServer).SubmitCompanionApplication(ctx, req.(*SubmitCompanionApplicationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_RechargeList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RechargeListRequest)
+func _User_GetMyCompanionApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMyCompanionApplicationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).RechargeList(ctx, in)
+		return srv.(UserServer).GetMyCompanionApplication(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_RechargeList_FullMethodName,
+		FullMethod: User_GetMyCompanionApplication_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).RechargeList(ctx, req.(*RechargeListRequest))
+		return srv.(UserServer).GetMyCompanionApplication(ctx, req.(*GetMyCompanionApplicationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_GetCompanionProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCompanionProfileRequest)
+func _User_ListCompanionApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCompanionApplicationsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).GetCompanionProfile(ctx, in)
+		return srv.(UserServer).ListCompanionApplications(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_GetCompanionProfile_FullMethodName,
+		FullMethod: User_ListCompanionApplications_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).GetCompanionProfile(ctx, req.(*GetCompanionProfileRequest))
+		return srv.(UserServer).ListCompanionApplications(ctx, req.(*ListCompanionApplicationsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_UpdateCompanionProfile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCompanionProfileRequest)
+func _User_ReviewCompanionApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReviewCompanionApplicationRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).UpdateCompanionProfile(ctx, in)
+		return srv.(UserServer).ReviewCompanionApplication(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_UpdateCompanionProfile_FullMethodName,
+		FullMethod: User_ReviewCompanionApplication_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).UpdateCompanionProfile(ctx, req.(*UpdateCompanionProfileRequest))
+		return srv.(UserServer).ReviewCompanionApplication(ctx, req.(*ReviewCompanionApplicationRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_UpdateCompanionStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateCompanionStatsRequest)
+func _User_GetCompanionTierHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompanionTierHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).UpdateCompanionStats(ctx, in)
+		return srv.(UserServer).GetCompanionTierHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_UpdateCompanionStats_FullMethodName,
+		FullMethod: User_GetCompanionTierHistory_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).UpdateCompanionStats(ctx, req.(*UpdateCompanionStatsRequest))
+		return srv.(UserServer).GetCompanionTierHistory(ctx, req.(*GetCompanionTierHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _User_GetCompanionList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCompanionListRequest)
+func _User_SetCompanionTierOverride_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCompanionTierOverrideRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(UserServer).GetCompanionList(ctx, in)
+		return srv.(UserServer).SetCompanionTierOverride(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: User_GetCompanionList_FullMethodName,
+		FullMethod: User_SetCompanionTierOverride_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(UserServer).GetCompanionList(ctx, req.(*GetCompanionListRequest))
+		return srv.(UserServer).SetCompanionTierOverride(ctx, req.(*SetCompanionTierOverrideRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1100,6 +1988,114 @@ func _User_CheckFollowStatus_Handler(srv interface{}, ctx context.Context, dec f
 	return interceptor(ctx, in, info, handler)
 }
 
+func _User_GetFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).GetFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_GetFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).GetFeed(ctx, req.(*GetFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_PublishCompanionPost_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishCompanionPostRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).PublishCompanionPost(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_PublishCompanionPost_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).PublishCompanionPost(ctx, req.(*PublishCompanionPostRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_BlockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BlockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).BlockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_BlockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).BlockUser(ctx, req.(*BlockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_UnblockUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnblockUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).UnblockUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_UnblockUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).UnblockUser(ctx, req.(*UnblockUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_ListBlocked_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListBlockedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).ListBlocked(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_ListBlocked_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).ListBlocked(ctx, req.(*ListBlockedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _User_FilterBlockedUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FilterBlockedUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServer).FilterBlockedUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: User_FilterBlockedUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServer).FilterBlockedUsers(ctx, req.(*FilterBlockedUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // User_ServiceDesc is the grpc.ServiceDesc for User service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1127,6 +2123,26 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "LoginByCode",
 			Handler:    _User_LoginByCode_Handler,
 		},
+		{
+			MethodName: "UnlockLogin",
+			Handler:    _User_UnlockLogin_Handler,
+		},
+		{
+			MethodName: "RecordLoginEvent",
+			Handler:    _User_RecordLoginEvent_Handler,
+		},
+		{
+			MethodName: "ListLoginEvents",
+			Handler:    _User_ListLoginEvents_Handler,
+		},
+		{
+			MethodName: "SetUserStatus",
+			Handler:    _User_SetUserStatus_Handler,
+		},
+		{
+			MethodName: "FilterBannedUsers",
+			Handler:    _User_FilterBannedUsers_Handler,
+		},
 		{
 			MethodName: "ForgetPassword",
 			Handler:    _User_ForgetPassword_Handler,
@@ -1139,6 +2155,10 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ChangePassword",
 			Handler:    _User_ChangePassword_Handler,
 		},
+		{
+			MethodName: "BindEmail",
+			Handler:    _User_BindEmail_Handler,
+		},
 		{
 			MethodName: "GetWallet",
 			Handler:    _User_GetWallet_Handler,
@@ -1163,6 +2183,42 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "RechargeList",
 			Handler:    _User_RechargeList_Handler,
 		},
+		{
+			MethodName: "Transfer",
+			Handler:    _User_Transfer_Handler,
+		},
+		{
+			MethodName: "SendGift",
+			Handler:    _User_SendGift_Handler,
+		},
+		{
+			MethodName: "ListGifts",
+			Handler:    _User_ListGifts_Handler,
+		},
+		{
+			MethodName: "CreateGift",
+			Handler:    _User_CreateGift_Handler,
+		},
+		{
+			MethodName: "UpdateGift",
+			Handler:    _User_UpdateGift_Handler,
+		},
+		{
+			MethodName: "ListVipPlans",
+			Handler:    _User_ListVipPlans_Handler,
+		},
+		{
+			MethodName: "SubscribeVip",
+			Handler:    _User_SubscribeVip_Handler,
+		},
+		{
+			MethodName: "SetVipAutoRenew",
+			Handler:    _User_SetVipAutoRenew_Handler,
+		},
+		{
+			MethodName: "GetVipEntitlements",
+			Handler:    _User_GetVipEntitlements_Handler,
+		},
 		{
 			MethodName: "GetCompanionProfile",
 			Handler:    _User_GetCompanionProfile_Handler,
@@ -1179,6 +2235,38 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetCompanionList",
 			Handler:    _User_GetCompanionList_Handler,
 		},
+		{
+			MethodName: "CompanionHeartbeat",
+			Handler:    _User_CompanionHeartbeat_Handler,
+		},
+		{
+			MethodName: "GetCompanionPresence",
+			Handler:    _User_GetCompanionPresence_Handler,
+		},
+		{
+			MethodName: "SubmitCompanionApplication",
+			Handler:    _User_SubmitCompanionApplication_Handler,
+		},
+		{
+			MethodName: "GetMyCompanionApplication",
+			Handler:    _User_GetMyCompanionApplication_Handler,
+		},
+		{
+			MethodName: "ListCompanionApplications",
+			Handler:    _User_ListCompanionApplications_Handler,
+		},
+		{
+			MethodName: "ReviewCompanionApplication",
+			Handler:    _User_ReviewCompanionApplication_Handler,
+		},
+		{
+			MethodName: "GetCompanionTierHistory",
+			Handler:    _User_GetCompanionTierHistory_Handler,
+		},
+		{
+			MethodName: "SetCompanionTierOverride",
+			Handler:    _User_SetCompanionTierOverride_Handler,
+		},
 		{
 			MethodName: "GetCompanionRatingRanking",
 			Handler:    _User_GetCompanionRatingRanking_Handler,
@@ -1227,6 +2315,30 @@ var User_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckFollowStatus",
 			Handler:    _User_CheckFollowStatus_Handler,
 		},
+		{
+			MethodName: "GetFeed",
+			Handler:    _User_GetFeed_Handler,
+		},
+		{
+			MethodName: "PublishCompanionPost",
+			Handler:    _User_PublishCompanionPost_Handler,
+		},
+		{
+			MethodName: "BlockUser",
+			Handler:    _User_BlockUser_Handler,
+		},
+		{
+			MethodName: "UnblockUser",
+			Handler:    _User_UnblockUser_Handler,
+		},
+		{
+			MethodName: "ListBlocked",
+			Handler:    _User_ListBlocked_Handler,
+		},
+		{
+			MethodName: "FilterBlockedUsers",
+			Handler:    _User_FilterBlockedUsers_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "user.proto",
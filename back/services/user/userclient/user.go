@@ -14,73 +14,141 @@ import (
 )
 
 type (
-	ChangePasswordRequest             = user.ChangePasswordRequest
-	ChangePasswordResponse            = user.ChangePasswordResponse
-	ChangePhoneRequest                = user.ChangePhoneRequest
-	ChangePhoneResponse               = user.ChangePhoneResponse
-	CheckFollowStatusRequest          = user.CheckFollowStatusRequest
-	CheckFollowStatusResponse         = user.CheckFollowStatusResponse
-	CompanionInfo                     = user.CompanionInfo
-	CompanionRankingItem              = user.CompanionRankingItem
-	ConsumeRequest                    = user.ConsumeRequest
-	ConsumeResponse                   = user.ConsumeResponse
-	CreateGameSkillRequest            = user.CreateGameSkillRequest
-	CreateGameSkillResponse           = user.CreateGameSkillResponse
-	CreateRechargeOrderRequest        = user.CreateRechargeOrderRequest
-	CreateRechargeOrderResponse       = user.CreateRechargeOrderResponse
-	DeleteGameSkillRequest            = user.DeleteGameSkillRequest
-	DeleteGameSkillResponse           = user.DeleteGameSkillResponse
-	FollowUserRequest                 = user.FollowUserRequest
-	FollowUserResponse                = user.FollowUserResponse
-	ForgetPasswordRequest             = user.ForgetPasswordRequest
-	ForgetPasswordResponse            = user.ForgetPasswordResponse
-	GameSkill                         = user.GameSkill
-	GetCompanionListRequest           = user.GetCompanionListRequest
-	GetCompanionListResponse          = user.GetCompanionListResponse
-	GetCompanionOrdersRankingRequest  = user.GetCompanionOrdersRankingRequest
-	GetCompanionOrdersRankingResponse = user.GetCompanionOrdersRankingResponse
-	GetCompanionProfileRequest        = user.GetCompanionProfileRequest
-	GetCompanionProfileResponse       = user.GetCompanionProfileResponse
-	GetCompanionRatingRankingRequest  = user.GetCompanionRatingRankingRequest
-	GetCompanionRatingRankingResponse = user.GetCompanionRatingRankingResponse
-	GetMutualFollowListRequest        = user.GetMutualFollowListRequest
-	GetMutualFollowListResponse       = user.GetMutualFollowListResponse
-	GetMyFollowersListRequest         = user.GetMyFollowersListRequest
-	GetMyFollowersListResponse        = user.GetMyFollowersListResponse
-	GetMyFollowingListRequest         = user.GetMyFollowingListRequest
-	GetMyFollowingListResponse        = user.GetMyFollowingListResponse
-	GetUserRequest                    = user.GetUserRequest
-	GetUserResponse                   = user.GetUserResponse
-	GetWalletRequest                  = user.GetWalletRequest
-	GetWalletResponse                 = user.GetWalletResponse
-	ListGameSkillsRequest             = user.ListGameSkillsRequest
-	ListGameSkillsResponse            = user.ListGameSkillsResponse
-	LoginByCodeRequest                = user.LoginByCodeRequest
-	LoginByCodeResponse               = user.LoginByCodeResponse
-	LoginRequest                      = user.LoginRequest
-	LoginResponse                     = user.LoginResponse
-	RechargeListRequest               = user.RechargeListRequest
-	RechargeListResponse              = user.RechargeListResponse
-	RechargeOrderInfo                 = user.RechargeOrderInfo
-	RechargeRequest                   = user.RechargeRequest
-	RechargeResponse                  = user.RechargeResponse
-	RegisterRequest                   = user.RegisterRequest
-	RegisterResponse                  = user.RegisterResponse
-	UnfollowUserRequest               = user.UnfollowUserRequest
-	UnfollowUserResponse              = user.UnfollowUserResponse
-	UpdateCompanionProfileRequest     = user.UpdateCompanionProfileRequest
-	UpdateCompanionProfileResponse    = user.UpdateCompanionProfileResponse
-	UpdateCompanionStatsRequest       = user.UpdateCompanionStatsRequest
-	UpdateCompanionStatsResponse      = user.UpdateCompanionStatsResponse
-	UpdateGameSkillRequest            = user.UpdateGameSkillRequest
-	UpdateGameSkillResponse           = user.UpdateGameSkillResponse
-	UpdateRechargeOrderStatusRequest  = user.UpdateRechargeOrderStatusRequest
-	UpdateRechargeOrderStatusResponse = user.UpdateRechargeOrderStatusResponse
-	UpdateUserRequest                 = user.UpdateUserRequest
-	UpdateUserResponse                = user.UpdateUserResponse
-	UserFollowInfo                    = user.UserFollowInfo
-	UserInfo                          = user.UserInfo
-	WalletInfo                        = user.WalletInfo
+	BindEmailRequest                   = user.BindEmailRequest
+	BindEmailResponse                  = user.BindEmailResponse
+	BlockUserRequest                   = user.BlockUserRequest
+	BlockUserResponse                  = user.BlockUserResponse
+	BlockedUserInfo                    = user.BlockedUserInfo
+	ChangePasswordRequest              = user.ChangePasswordRequest
+	ChangePasswordResponse             = user.ChangePasswordResponse
+	ChangePhoneRequest                 = user.ChangePhoneRequest
+	ChangePhoneResponse                = user.ChangePhoneResponse
+	CheckFollowStatusRequest           = user.CheckFollowStatusRequest
+	CheckFollowStatusResponse          = user.CheckFollowStatusResponse
+	CompanionApplicationInfo           = user.CompanionApplicationInfo
+	CompanionHeartbeatRequest          = user.CompanionHeartbeatRequest
+	CompanionHeartbeatResponse         = user.CompanionHeartbeatResponse
+	CompanionInfo                      = user.CompanionInfo
+	CompanionPresence                  = user.CompanionPresence
+	CompanionRankingItem               = user.CompanionRankingItem
+	CompanionSkill                     = user.CompanionSkill
+	CompanionTierHistoryItem           = user.CompanionTierHistoryItem
+	ConsumeRequest                     = user.ConsumeRequest
+	ConsumeResponse                    = user.ConsumeResponse
+	CreateGameSkillRequest             = user.CreateGameSkillRequest
+	CreateGameSkillResponse            = user.CreateGameSkillResponse
+	CreateGiftRequest                  = user.CreateGiftRequest
+	CreateGiftResponse                 = user.CreateGiftResponse
+	CreateRechargeOrderRequest         = user.CreateRechargeOrderRequest
+	CreateRechargeOrderResponse        = user.CreateRechargeOrderResponse
+	DeleteGameSkillRequest             = user.DeleteGameSkillRequest
+	DeleteGameSkillResponse            = user.DeleteGameSkillResponse
+	FeedItem                           = user.FeedItem
+	FilterBannedUsersRequest           = user.FilterBannedUsersRequest
+	FilterBannedUsersResponse          = user.FilterBannedUsersResponse
+	FilterBlockedUsersRequest          = user.FilterBlockedUsersRequest
+	FilterBlockedUsersResponse         = user.FilterBlockedUsersResponse
+	FollowUserRequest                  = user.FollowUserRequest
+	FollowUserResponse                 = user.FollowUserResponse
+	ForgetPasswordRequest              = user.ForgetPasswordRequest
+	ForgetPasswordResponse             = user.ForgetPasswordResponse
+	GameSkill                          = user.GameSkill
+	GetCompanionListRequest            = user.GetCompanionListRequest
+	GetCompanionListResponse           = user.GetCompanionListResponse
+	GetCompanionOrdersRankingRequest   = user.GetCompanionOrdersRankingRequest
+	GetCompanionOrdersRankingResponse  = user.GetCompanionOrdersRankingResponse
+	GetCompanionPresenceRequest        = user.GetCompanionPresenceRequest
+	GetCompanionPresenceResponse       = user.GetCompanionPresenceResponse
+	GetCompanionProfileRequest         = user.GetCompanionProfileRequest
+	GetCompanionProfileResponse        = user.GetCompanionProfileResponse
+	GetCompanionRatingRankingRequest   = user.GetCompanionRatingRankingRequest
+	GetCompanionRatingRankingResponse  = user.GetCompanionRatingRankingResponse
+	GetCompanionTierHistoryRequest     = user.GetCompanionTierHistoryRequest
+	GetCompanionTierHistoryResponse    = user.GetCompanionTierHistoryResponse
+	GetFeedRequest                     = user.GetFeedRequest
+	GetFeedResponse                    = user.GetFeedResponse
+	GetMutualFollowListRequest         = user.GetMutualFollowListRequest
+	GetMutualFollowListResponse        = user.GetMutualFollowListResponse
+	GetMyCompanionApplicationRequest   = user.GetMyCompanionApplicationRequest
+	GetMyCompanionApplicationResponse  = user.GetMyCompanionApplicationResponse
+	GetMyFollowersListRequest          = user.GetMyFollowersListRequest
+	GetMyFollowersListResponse         = user.GetMyFollowersListResponse
+	GetMyFollowingListRequest          = user.GetMyFollowingListRequest
+	GetMyFollowingListResponse         = user.GetMyFollowingListResponse
+	GetUserRequest                     = user.GetUserRequest
+	GetUserResponse                    = user.GetUserResponse
+	GetVipEntitlementsRequest          = user.GetVipEntitlementsRequest
+	GetVipEntitlementsResponse         = user.GetVipEntitlementsResponse
+	GetWalletRequest                   = user.GetWalletRequest
+	GetWalletResponse                  = user.GetWalletResponse
+	GiftInfo                           = user.GiftInfo
+	ListBlockedRequest                 = user.ListBlockedRequest
+	ListBlockedResponse                = user.ListBlockedResponse
+	ListCompanionApplicationsRequest   = user.ListCompanionApplicationsRequest
+	ListCompanionApplicationsResponse  = user.ListCompanionApplicationsResponse
+	ListGameSkillsRequest              = user.ListGameSkillsRequest
+	ListGameSkillsResponse             = user.ListGameSkillsResponse
+	ListGiftsRequest                   = user.ListGiftsRequest
+	ListGiftsResponse                  = user.ListGiftsResponse
+	ListLoginEventsRequest             = user.ListLoginEventsRequest
+	ListLoginEventsResponse            = user.ListLoginEventsResponse
+	ListVipPlansRequest                = user.ListVipPlansRequest
+	ListVipPlansResponse               = user.ListVipPlansResponse
+	LoginByCodeRequest                 = user.LoginByCodeRequest
+	LoginByCodeResponse                = user.LoginByCodeResponse
+	LoginEventInfo                     = user.LoginEventInfo
+	LoginRequest                       = user.LoginRequest
+	LoginResponse                      = user.LoginResponse
+	PublishCompanionPostRequest        = user.PublishCompanionPostRequest
+	PublishCompanionPostResponse       = user.PublishCompanionPostResponse
+	RechargeListRequest                = user.RechargeListRequest
+	RechargeListResponse               = user.RechargeListResponse
+	RechargeOrderInfo                  = user.RechargeOrderInfo
+	RechargeRequest                    = user.RechargeRequest
+	RechargeResponse                   = user.RechargeResponse
+	RecordLoginEventRequest            = user.RecordLoginEventRequest
+	RecordLoginEventResponse           = user.RecordLoginEventResponse
+	RegisterRequest                    = user.RegisterRequest
+	RegisterResponse                   = user.RegisterResponse
+	ReviewCompanionApplicationRequest  = user.ReviewCompanionApplicationRequest
+	ReviewCompanionApplicationResponse = user.ReviewCompanionApplicationResponse
+	SendGiftRequest                    = user.SendGiftRequest
+	SendGiftResponse                   = user.SendGiftResponse
+	SetCompanionTierOverrideRequest    = user.SetCompanionTierOverrideRequest
+	SetCompanionTierOverrideResponse   = user.SetCompanionTierOverrideResponse
+	SetUserStatusRequest               = user.SetUserStatusRequest
+	SetUserStatusResponse              = user.SetUserStatusResponse
+	SetVipAutoRenewRequest             = user.SetVipAutoRenewRequest
+	SetVipAutoRenewResponse            = user.SetVipAutoRenewResponse
+	SubmitCompanionApplicationRequest  = user.SubmitCompanionApplicationRequest
+	SubmitCompanionApplicationResponse = user.SubmitCompanionApplicationResponse
+	SubscribeVipRequest                = user.SubscribeVipRequest
+	SubscribeVipResponse               = user.SubscribeVipResponse
+	TransferRequest                    = user.TransferRequest
+	TransferResponse                   = user.TransferResponse
+	UnblockUserRequest                 = user.UnblockUserRequest
+	UnblockUserResponse                = user.UnblockUserResponse
+	UnfollowUserRequest                = user.UnfollowUserRequest
+	UnfollowUserResponse               = user.UnfollowUserResponse
+	UnlockLoginRequest                 = user.UnlockLoginRequest
+	UnlockLoginResponse                = user.UnlockLoginResponse
+	UpdateCompanionProfileRequest      = user.UpdateCompanionProfileRequest
+	UpdateCompanionProfileResponse     = user.UpdateCompanionProfileResponse
+	UpdateCompanionStatsRequest        = user.UpdateCompanionStatsRequest
+	UpdateCompanionStatsResponse       = user.UpdateCompanionStatsResponse
+	UpdateGameSkillRequest             = user.UpdateGameSkillRequest
+	UpdateGameSkillResponse            = user.UpdateGameSkillResponse
+	UpdateGiftRequest                  = user.UpdateGiftRequest
+	UpdateGiftResponse                 = user.UpdateGiftResponse
+	UpdateRechargeOrderStatusRequest   = user.UpdateRechargeOrderStatusRequest
+	UpdateRechargeOrderStatusResponse  = user.UpdateRechargeOrderStatusResponse
+	UpdateUserRequest                  = user.UpdateUserRequest
+	UpdateUserResponse                 = user.UpdateUserResponse
+	UserFollowInfo                     = user.UserFollowInfo
+	UserInfo                           = user.UserInfo
+	VipPlanInfo                        = user.VipPlanInfo
+	VipSubscriptionInfo                = user.VipSubscriptionInfo
+	WalletInfo                         = user.WalletInfo
 
 	User interface {
 		Register(ctx context.Context, in *RegisterRequest, opts ...grpc.CallOption) (*RegisterResponse, error)
@@ -88,9 +156,15 @@ type (
 		GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 		UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
 		LoginByCode(ctx context.Context, in *LoginByCodeRequest, opts ...grpc.CallOption) (*LoginByCodeResponse, error)
+		UnlockLogin(ctx context.Context, in *UnlockLoginRequest, opts ...grpc.CallOption) (*UnlockLoginResponse, error)
+		RecordLoginEvent(ctx context.Context, in *RecordLoginEventRequest, opts ...grpc.CallOption) (*RecordLoginEventResponse, error)
+		ListLoginEvents(ctx context.Context, in *ListLoginEventsRequest, opts ...grpc.CallOption) (*ListLoginEventsResponse, error)
+		SetUserStatus(ctx context.Context, in *SetUserStatusRequest, opts ...grpc.CallOption) (*SetUserStatusResponse, error)
+		FilterBannedUsers(ctx context.Context, in *FilterBannedUsersRequest, opts ...grpc.CallOption) (*FilterBannedUsersResponse, error)
 		ForgetPassword(ctx context.Context, in *ForgetPasswordRequest, opts ...grpc.CallOption) (*ForgetPasswordResponse, error)
 		ChangePhone(ctx context.Context, in *ChangePhoneRequest, opts ...grpc.CallOption) (*ChangePhoneResponse, error)
 		ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+		BindEmail(ctx context.Context, in *BindEmailRequest, opts ...grpc.CallOption) (*BindEmailResponse, error)
 		// 帅币钱包相关接口
 		GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*GetWalletResponse, error)
 		Recharge(ctx context.Context, in *RechargeRequest, opts ...grpc.CallOption) (*RechargeResponse, error)
@@ -98,11 +172,32 @@ type (
 		CreateRechargeOrder(ctx context.Context, in *CreateRechargeOrderRequest, opts ...grpc.CallOption) (*CreateRechargeOrderResponse, error)
 		UpdateRechargeOrderStatus(ctx context.Context, in *UpdateRechargeOrderStatusRequest, opts ...grpc.CallOption) (*UpdateRechargeOrderStatusResponse, error)
 		RechargeList(ctx context.Context, in *RechargeListRequest, opts ...grpc.CallOption) (*RechargeListResponse, error)
+		// 打赏/礼物相关接口
+		Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error)
+		SendGift(ctx context.Context, in *SendGiftRequest, opts ...grpc.CallOption) (*SendGiftResponse, error)
+		ListGifts(ctx context.Context, in *ListGiftsRequest, opts ...grpc.CallOption) (*ListGiftsResponse, error)
+		CreateGift(ctx context.Context, in *CreateGiftRequest, opts ...grpc.CallOption) (*CreateGiftResponse, error)
+		UpdateGift(ctx context.Context, in *UpdateGiftRequest, opts ...grpc.CallOption) (*UpdateGiftResponse, error)
+		// 会员（VIP）相关接口
+		ListVipPlans(ctx context.Context, in *ListVipPlansRequest, opts ...grpc.CallOption) (*ListVipPlansResponse, error)
+		SubscribeVip(ctx context.Context, in *SubscribeVipRequest, opts ...grpc.CallOption) (*SubscribeVipResponse, error)
+		SetVipAutoRenew(ctx context.Context, in *SetVipAutoRenewRequest, opts ...grpc.CallOption) (*SetVipAutoRenewResponse, error)
+		GetVipEntitlements(ctx context.Context, in *GetVipEntitlementsRequest, opts ...grpc.CallOption) (*GetVipEntitlementsResponse, error)
 		// 陪玩信息相关接口
 		GetCompanionProfile(ctx context.Context, in *GetCompanionProfileRequest, opts ...grpc.CallOption) (*GetCompanionProfileResponse, error)
 		UpdateCompanionProfile(ctx context.Context, in *UpdateCompanionProfileRequest, opts ...grpc.CallOption) (*UpdateCompanionProfileResponse, error)
 		UpdateCompanionStats(ctx context.Context, in *UpdateCompanionStatsRequest, opts ...grpc.CallOption) (*UpdateCompanionStatsResponse, error)
 		GetCompanionList(ctx context.Context, in *GetCompanionListRequest, opts ...grpc.CallOption) (*GetCompanionListResponse, error)
+		CompanionHeartbeat(ctx context.Context, in *CompanionHeartbeatRequest, opts ...grpc.CallOption) (*CompanionHeartbeatResponse, error)
+		GetCompanionPresence(ctx context.Context, in *GetCompanionPresenceRequest, opts ...grpc.CallOption) (*GetCompanionPresenceResponse, error)
+		// 陪玩入驻申请与审核
+		SubmitCompanionApplication(ctx context.Context, in *SubmitCompanionApplicationRequest, opts ...grpc.CallOption) (*SubmitCompanionApplicationResponse, error)
+		GetMyCompanionApplication(ctx context.Context, in *GetMyCompanionApplicationRequest, opts ...grpc.CallOption) (*GetMyCompanionApplicationResponse, error)
+		ListCompanionApplications(ctx context.Context, in *ListCompanionApplicationsRequest, opts ...grpc.CallOption) (*ListCompanionApplicationsResponse, error)
+		ReviewCompanionApplication(ctx context.Context, in *ReviewCompanionApplicationRequest, opts ...grpc.CallOption) (*ReviewCompanionApplicationResponse, error)
+		// 陪玩等级相关接口
+		GetCompanionTierHistory(ctx context.Context, in *GetCompanionTierHistoryRequest, opts ...grpc.CallOption) (*GetCompanionTierHistoryResponse, error)
+		SetCompanionTierOverride(ctx context.Context, in *SetCompanionTierOverrideRequest, opts ...grpc.CallOption) (*SetCompanionTierOverrideResponse, error)
 		// 陪玩排名相关接口
 		GetCompanionRatingRanking(ctx context.Context, in *GetCompanionRatingRankingRequest, opts ...grpc.CallOption) (*GetCompanionRatingRankingResponse, error)
 		GetCompanionOrdersRanking(ctx context.Context, in *GetCompanionOrdersRankingRequest, opts ...grpc.CallOption) (*GetCompanionOrdersRankingResponse, error)
@@ -118,6 +213,13 @@ type (
 		GetMyFollowersList(ctx context.Context, in *GetMyFollowersListRequest, opts ...grpc.CallOption) (*GetMyFollowersListResponse, error)
 		GetMutualFollowList(ctx context.Context, in *GetMutualFollowListRequest, opts ...grpc.CallOption) (*GetMutualFollowListResponse, error)
 		CheckFollowStatus(ctx context.Context, in *CheckFollowStatusRequest, opts ...grpc.CallOption) (*CheckFollowStatusResponse, error)
+		GetFeed(ctx context.Context, in *GetFeedRequest, opts ...grpc.CallOption) (*GetFeedResponse, error)
+		PublishCompanionPost(ctx context.Context, in *PublishCompanionPostRequest, opts ...grpc.CallOption) (*PublishCompanionPostResponse, error)
+		// 拉黑相关接口
+		BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error)
+		UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error)
+		ListBlocked(ctx context.Context, in *ListBlockedRequest, opts ...grpc.CallOption) (*ListBlockedResponse, error)
+		FilterBlockedUsers(ctx context.Context, in *FilterBlockedUsersRequest, opts ...grpc.CallOption) (*FilterBlockedUsersResponse, error)
 	}
 
 	defaultUser struct {
@@ -156,6 +258,31 @@ func (m *defaultUser) LoginByCode(ctx context.Context, in *LoginByCodeRequest, o
 	return client.LoginByCode(ctx, in, opts...)
 }
 
+func (m *defaultUser) UnlockLogin(ctx context.Context, in *UnlockLoginRequest, opts ...grpc.CallOption) (*UnlockLoginResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.UnlockLogin(ctx, in, opts...)
+}
+
+func (m *defaultUser) RecordLoginEvent(ctx context.Context, in *RecordLoginEventRequest, opts ...grpc.CallOption) (*RecordLoginEventResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.RecordLoginEvent(ctx, in, opts...)
+}
+
+func (m *defaultUser) ListLoginEvents(ctx context.Context, in *ListLoginEventsRequest, opts ...grpc.CallOption) (*ListLoginEventsResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.ListLoginEvents(ctx, in, opts...)
+}
+
+func (m *defaultUser) SetUserStatus(ctx context.Context, in *SetUserStatusRequest, opts ...grpc.CallOption) (*SetUserStatusResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.SetUserStatus(ctx, in, opts...)
+}
+
+func (m *defaultUser) FilterBannedUsers(ctx context.Context, in *FilterBannedUsersRequest, opts ...grpc.CallOption) (*FilterBannedUsersResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.FilterBannedUsers(ctx, in, opts...)
+}
+
 func (m *defaultUser) ForgetPassword(ctx context.Context, in *ForgetPasswordRequest, opts ...grpc.CallOption) (*ForgetPasswordResponse, error) {
 	client := user.NewUserClient(m.cli.Conn())
 	return client.ForgetPassword(ctx, in, opts...)
@@ -171,6 +298,11 @@ func (m *defaultUser) ChangePassword(ctx context.Context, in *ChangePasswordRequ
 	return client.ChangePassword(ctx, in, opts...)
 }
 
+func (m *defaultUser) BindEmail(ctx context.Context, in *BindEmailRequest, opts ...grpc.CallOption) (*BindEmailResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.BindEmail(ctx, in, opts...)
+}
+
 // 帅币钱包相关接口
 func (m *defaultUser) GetWallet(ctx context.Context, in *GetWalletRequest, opts ...grpc.CallOption) (*GetWalletResponse, error) {
 	client := user.NewUserClient(m.cli.Conn())
@@ -202,6 +334,53 @@ func (m *defaultUser) RechargeList(ctx context.Context, in *RechargeListRequest,
 	return client.RechargeList(ctx, in, opts...)
 }
 
+// 打赏/礼物相关接口
+func (m *defaultUser) Transfer(ctx context.Context, in *TransferRequest, opts ...grpc.CallOption) (*TransferResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.Transfer(ctx, in, opts...)
+}
+
+func (m *defaultUser) SendGift(ctx context.Context, in *SendGiftRequest, opts ...grpc.CallOption) (*SendGiftResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.SendGift(ctx, in, opts...)
+}
+
+func (m *defaultUser) ListGifts(ctx context.Context, in *ListGiftsRequest, opts ...grpc.CallOption) (*ListGiftsResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.ListGifts(ctx, in, opts...)
+}
+
+func (m *defaultUser) CreateGift(ctx context.Context, in *CreateGiftRequest, opts ...grpc.CallOption) (*CreateGiftResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.CreateGift(ctx, in, opts...)
+}
+
+func (m *defaultUser) UpdateGift(ctx context.Context, in *UpdateGiftRequest, opts ...grpc.CallOption) (*UpdateGiftResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.UpdateGift(ctx, in, opts...)
+}
+
+// 会员（VIP）相关接口
+func (m *defaultUser) ListVipPlans(ctx context.Context, in *ListVipPlansRequest, opts ...grpc.CallOption) (*ListVipPlansResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.ListVipPlans(ctx, in, opts...)
+}
+
+func (m *defaultUser) SubscribeVip(ctx context.Context, in *SubscribeVipRequest, opts ...grpc.CallOption) (*SubscribeVipResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.SubscribeVip(ctx, in, opts...)
+}
+
+func (m *defaultUser) SetVipAutoRenew(ctx context.Context, in *SetVipAutoRenewRequest, opts ...grpc.CallOption) (*SetVipAutoRenewResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.SetVipAutoRenew(ctx, in, opts...)
+}
+
+func (m *defaultUser) GetVipEntitlements(ctx context.Context, in *GetVipEntitlementsRequest, opts ...grpc.CallOption) (*GetVipEntitlementsResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.GetVipEntitlements(ctx, in, opts...)
+}
+
 // 陪玩信息相关接口
 func (m *defaultUser) GetCompanionProfile(ctx context.Context, in *GetCompanionProfileRequest, opts ...grpc.CallOption) (*GetCompanionProfileResponse, error) {
 	client := user.NewUserClient(m.cli.Conn())
@@ -223,6 +402,48 @@ func (m *defaultUser) GetCompanionList(ctx context.Context, in *GetCompanionList
 	return client.GetCompanionList(ctx, in, opts...)
 }
 
+func (m *defaultUser) CompanionHeartbeat(ctx context.Context, in *CompanionHeartbeatRequest, opts ...grpc.CallOption) (*CompanionHeartbeatResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.CompanionHeartbeat(ctx, in, opts...)
+}
+
+func (m *defaultUser) GetCompanionPresence(ctx context.Context, in *GetCompanionPresenceRequest, opts ...grpc.CallOption) (*GetCompanionPresenceResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.GetCompanionPresence(ctx, in, opts...)
+}
+
+// 陪玩入驻申请与审核
+func (m *defaultUser) SubmitCompanionApplication(ctx context.Context, in *SubmitCompanionApplicationRequest, opts ...grpc.CallOption) (*SubmitCompanionApplicationResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.SubmitCompanionApplication(ctx, in, opts...)
+}
+
+func (m *defaultUser) GetMyCompanionApplication(ctx context.Context, in *GetMyCompanionApplicationRequest, opts ...grpc.CallOption) (*GetMyCompanionApplicationResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.GetMyCompanionApplication(ctx, in, opts...)
+}
+
+func (m *defaultUser) ListCompanionApplications(ctx context.Context, in *ListCompanionApplicationsRequest, opts ...grpc.CallOption) (*ListCompanionApplicationsResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.ListCompanionApplications(ctx, in, opts...)
+}
+
+func (m *defaultUser) ReviewCompanionApplication(ctx context.Context, in *ReviewCompanionApplicationRequest, opts ...grpc.CallOption) (*ReviewCompanionApplicationResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.ReviewCompanionApplication(ctx, in, opts...)
+}
+
+// 陪玩等级相关接口
+func (m *defaultUser) GetCompanionTierHistory(ctx context.Context, in *GetCompanionTierHistoryRequest, opts ...grpc.CallOption) (*GetCompanionTierHistoryResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.GetCompanionTierHistory(ctx, in, opts...)
+}
+
+func (m *defaultUser) SetCompanionTierOverride(ctx context.Context, in *SetCompanionTierOverrideRequest, opts ...grpc.CallOption) (*SetCompanionTierOverrideResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.SetCompanionTierOverride(ctx, in, opts...)
+}
+
 // 陪玩排名相关接口
 func (m *defaultUser) GetCompanionRatingRanking(ctx context.Context, in *GetCompanionRatingRankingRequest, opts ...grpc.CallOption) (*GetCompanionRatingRankingResponse, error) {
 	client := user.NewUserClient(m.cli.Conn())
@@ -285,3 +506,34 @@ func (m *defaultUser) CheckFollowStatus(ctx context.Context, in *CheckFollowStat
 	client := user.NewUserClient(m.cli.Conn())
 	return client.CheckFollowStatus(ctx, in, opts...)
 }
+
+func (m *defaultUser) GetFeed(ctx context.Context, in *GetFeedRequest, opts ...grpc.CallOption) (*GetFeedResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.GetFeed(ctx, in, opts...)
+}
+
+func (m *defaultUser) PublishCompanionPost(ctx context.Context, in *PublishCompanionPostRequest, opts ...grpc.CallOption) (*PublishCompanionPostResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.PublishCompanionPost(ctx, in, opts...)
+}
+
+// 拉黑相关接口
+func (m *defaultUser) BlockUser(ctx context.Context, in *BlockUserRequest, opts ...grpc.CallOption) (*BlockUserResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.BlockUser(ctx, in, opts...)
+}
+
+func (m *defaultUser) UnblockUser(ctx context.Context, in *UnblockUserRequest, opts ...grpc.CallOption) (*UnblockUserResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.UnblockUser(ctx, in, opts...)
+}
+
+func (m *defaultUser) ListBlocked(ctx context.Context, in *ListBlockedRequest, opts ...grpc.CallOption) (*ListBlockedResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.ListBlocked(ctx, in, opts...)
+}
+
+func (m *defaultUser) FilterBlockedUsers(ctx context.Context, in *FilterBlockedUsersRequest, opts ...grpc.CallOption) (*FilterBlockedUsersResponse, error) {
+	client := user.NewUserClient(m.cli.Conn())
+	return client.FilterBlockedUsers(ctx, in, opts...)
+}
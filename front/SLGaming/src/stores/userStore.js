@@ -32,9 +32,12 @@ export const useUserStore = defineStore(
       userInfo.value = {};
     };
 
+    // 并发刷新时服务端可能不返回新的 refreshToken，此时保留当前持有的 refreshToken
     const setTokens = ({ accessToken, refreshToken }) => {
       userInfo.value.accessToken = accessToken;
-      userInfo.value.refreshToken = refreshToken;
+      if (refreshToken) {
+        userInfo.value.refreshToken = refreshToken;
+      }
     };
 
     // 封装通用退出登录逻辑